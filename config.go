@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFileName is the file, in the package directory, that main reads
+// settings from before applying the command-line flags.
+const configFileName = ".modusgraphgen.yaml"
+
+// config holds the settings of a configFileName file. Each key is the name of
+// the flag it sets, and so of the generator option it stands for:
+//
+//	output: internal/movies   # -output, generator.WithOutputDir
+//	package: movies           # -package, generator.WithPackageName
+//	no-cli: true              # -no-cli, generator.WithSkipCLI
+//	header: license.txt       # -header, generator.WithHeader
+//	templates: templates      # -templates, generator.WithTemplateDir
+//	strict: true              # -strict, generator.WithStrict
+//	naming: entity_dot_field  # -naming, parser.WithPredicateNaming
+//
+// Paths are relative to the package directory.
+type config struct {
+	Output    string `yaml:"output"`
+	Package   string `yaml:"package"`
+	NoCLI     bool   `yaml:"no-cli"`
+	Header    string `yaml:"header"`
+	Templates string `yaml:"templates"`
+	Strict    bool   `yaml:"strict"`
+	Naming    string `yaml:"naming"`
+}
+
+// loadConfig reads the configFileName file in dir. It returns nil, and no
+// error, if there is none. Unknown keys are an error, so that a misspelled
+// one is not silently ignored.
+func loadConfig(dir string) (*config, error) {
+	path := filepath.Join(dir, configFileName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cfg config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for _, p := range []*string{&cfg.Output, &cfg.Header, &cfg.Templates} {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+	return &cfg, nil
+}
+
+// flagValues returns the value of each flag that cfg sets, keyed by flag
+// name, in the form flag.Set takes.
+func (cfg *config) flagValues() map[string]string {
+	values := make(map[string]string)
+	for name, value := range map[string]string{
+		"output":    cfg.Output,
+		"package":   cfg.Package,
+		"header":    cfg.Header,
+		"templates": cfg.Templates,
+		"naming":    cfg.Naming,
+	} {
+		if value != "" {
+			values[name] = value
+		}
+	}
+	for name, value := range map[string]bool{
+		"no-cli": cfg.NoCLI,
+		"strict": cfg.Strict,
+	} {
+		if value {
+			values[name] = strconv.FormatBool(value)
+		}
+	}
+	return values
+}
+
+// apply sets each flag of flags that cfg has a value for, unless the command
+// line already set it.
+func (cfg *config) apply(flags *flag.FlagSet) error {
+	set := make(map[string]bool)
+	flags.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	for name, value := range cfg.flagValues() {
+		if set[name] {
+			continue
+		}
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
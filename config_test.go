@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := loadConfig(dir)
+	if err != nil || cfg != nil {
+		t.Fatalf("loadConfig without a file = %+v, %v, want nil, nil", cfg, err)
+	}
+
+	src := "output: gen\npackage: films\nno-cli: true\nheader: /etc/license.txt\ntemplates: tmpl\nstrict: true\nnaming: entity_dot_field\n"
+	if err := os.WriteFile(filepath.Join(dir, configFileName), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = loadConfig(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := config{
+		Output:    filepath.Join(dir, "gen"),
+		Package:   "films",
+		NoCLI:     true,
+		Header:    "/etc/license.txt",
+		Templates: filepath.Join(dir, "tmpl"),
+		Strict:    true,
+		Naming:    "entity_dot_field",
+	}
+	if *cfg != want {
+		t.Errorf("loadConfig = %+v, want %+v", *cfg, want)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, configFileName), []byte("no_cli: true\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadConfig(dir); err == nil || !strings.Contains(err.Error(), "no_cli") {
+		t.Errorf("loadConfig with an unknown key = %v, want an error naming it", err)
+	}
+}
+
+func TestConfigApply(t *testing.T) {
+	fs := flag.NewFlagSet("modusGraphGen", flag.ContinueOnError)
+	output := fs.String("output", "", "")
+	pkg := fs.String("package", "", "")
+	header := fs.String("header", "", "")
+	templates := fs.String("templates", "", "")
+	noCLI := fs.Bool("no-cli", false, "")
+	strict := fs.Bool("strict", false, "")
+	naming := fs.String("naming", "json_tag", "")
+	if err := fs.Parse([]string{"-output", "cmdline", "-strict=false"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config{Output: "config", Package: "films", NoCLI: true, Strict: true, Naming: "entity_dot_field"}
+	if err := cfg.apply(fs); err != nil {
+		t.Fatal(err)
+	}
+	// Flags on the command line win, even when set to their defaults.
+	if *output != "cmdline" || *strict {
+		t.Errorf("output, strict = %q, %v, want the command line's cmdline, false", *output, *strict)
+	}
+	if *pkg != "films" || !*noCLI || *naming != "entity_dot_field" {
+		t.Errorf("package, no-cli, naming = %q, %v, %q, want the config's films, true, entity_dot_field", *pkg, *noCLI, *naming)
+	}
+	if *header != "" || *templates != "" {
+		t.Errorf("header, templates = %q, %q, want them unset", *header, *templates)
+	}
+}
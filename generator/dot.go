@@ -0,0 +1,45 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// WriteDOT writes the entity graph of pkg to w as a Graphviz digraph, for
+// rendering with e.g. "dot -Tsvg". Each entity is a node, and each edge field
+// a directed edge from its entity to the target, labeled with its predicate:
+// dashed for a reverse predicate ("~genre"), and with "(count)" appended for
+// one tagged count. Targets in other packages, e.g. "people.Person", are
+// nodes as well.
+func WriteDOT(w io.Writer, pkg *model.Package) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, "digraph %q {\n", pkg.Name)
+	fmt.Fprintln(b, "\trankdir=LR;")
+	fmt.Fprintln(b, "\tnode [shape=box];")
+	for _, e := range pkg.Entities {
+		fmt.Fprintf(b, "\t%q;\n", e.Name)
+	}
+	fmt.Fprintln(b)
+	for _, e := range pkg.Entities {
+		for _, f := range edgeFields(e.Fields) {
+			if f.Predicate == "" {
+				continue
+			}
+			label := f.Predicate
+			if f.HasCount {
+				label += " (count)"
+			}
+			attrs := fmt.Sprintf("label=%q", label)
+			if strings.HasPrefix(f.Predicate, "~") {
+				attrs += ", style=dashed"
+			}
+			fmt.Fprintf(b, "\t%q -> %q [%s];\n", e.Name, f.EdgeEntity, attrs)
+		}
+	}
+	fmt.Fprintln(b, "}")
+	return b.Flush()
+}
@@ -0,0 +1,48 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+func TestWriteDOT(t *testing.T) {
+	pkg := &model.Package{
+		Name: "movies",
+		Entities: []model.Entity{
+			{Name: "Film", Fields: []model.Field{
+				{Name: "UID", GoType: "string", JSONTag: "uid", Predicate: "uid", IsUID: true},
+				{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "name"},
+				{Name: "Genres", GoType: "[]Genre", JSONTag: "genres", Predicate: "genre", IsEdge: true, IsReverse: true, HasCount: true, EdgeEntity: "Genre"},
+				{Name: "Sequel", GoType: "*Film", JSONTag: "sequel", Predicate: "sequel", IsEdge: true, EdgeEntity: "Film", IsSelfRef: true},
+				{Name: "Cast", GoType: "[]people.Person", JSONTag: "cast", Predicate: "cast", IsEdge: true, EdgeEntity: "people.Person"},
+				{Name: "GenreCount", GoType: "int", JSONTag: "genreCount", CountOf: "genre"},
+			}},
+			{Name: "Genre", Fields: []model.Field{
+				{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "name"},
+				{Name: "Films", GoType: "[]Film", JSONTag: "films", Predicate: "~genre", IsEdge: true, IsReverse: true, EdgeEntity: "Film"},
+			}},
+		},
+	}
+
+	var b strings.Builder
+	if err := WriteDOT(&b, pkg); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph "movies" {
+	rankdir=LR;
+	node [shape=box];
+	"Film";
+	"Genre";
+
+	"Film" -> "Genre" [label="genre (count)"];
+	"Film" -> "Film" [label="sequel"];
+	"Film" -> "people.Person" [label="cast"];
+	"Genre" -> "Film" [label="~genre", style=dashed];
+}
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteDOT =\n%s\nwant\n%s", got, want)
+	}
+}
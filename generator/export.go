@@ -0,0 +1,31 @@
+package generator
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// ExportModel writes pkg to w as JSON, for review or for diffing the model
+// between runs. Entities are sorted by name so that the output is stable
+// whatever the order of the source files. Each nesting level is indented by
+// indent; an empty indent writes compact JSON on a single line.
+func ExportModel(w io.Writer, pkg *model.Package, indent string) error {
+	sorted := *pkg
+	sorted.Entities = sortedEntities(pkg.Entities)
+	sorted.External = sortedEntities(pkg.External)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", indent)
+	return enc.Encode(sorted)
+}
+
+// sortedEntities returns a copy of entities sorted by name.
+func sortedEntities(entities []model.Entity) []model.Entity {
+	result := append([]model.Entity(nil), entities...)
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,53 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+func TestExportModel(t *testing.T) {
+	pkg := &model.Package{
+		Name: "movies",
+		Entities: []model.Entity{
+			{Name: "Genre", Fields: []model.Field{{Name: "Name", GoType: "string"}}},
+			{Name: "Film", Fields: []model.Field{{Name: "Name", GoType: "string"}}},
+		},
+	}
+
+	var compact, indented bytes.Buffer
+	if err := ExportModel(&compact, pkg, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExportModel(&indented, pkg, "  "); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := strings.Count(compact.String(), "\n"); n != 1 {
+		t.Errorf("compact output has %d lines, want 1:\n%s", n, compact.String())
+	}
+	if !strings.Contains(indented.String(), "\n  \"Name\": \"movies\",\n") {
+		t.Errorf("indented output is not indented by two spaces:\n%s", indented.String())
+	}
+	var a, b bytes.Buffer
+	if err := json.Compact(&a, compact.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Compact(&b, indented.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != b.String() {
+		t.Errorf("compact and indented exports differ:\n%s\n%s", a.String(), b.String())
+	}
+
+	// Entities are sorted, without reordering the caller's package.
+	if i, j := strings.Index(a.String(), `"Film"`), strings.Index(a.String(), `"Genre"`); i < 0 || i > j {
+		t.Errorf("entities are not sorted by name: %s", a.String())
+	}
+	if pkg.Entities[0].Name != "Genre" {
+		t.Error("ExportModel reordered the package's entities")
+	}
+}
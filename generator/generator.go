@@ -8,33 +8,108 @@ import (
 	"embed"
 	"fmt"
 	"go/format"
+	"go/token"
+	"go/types"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
-	"unicode"
 
+	"github.com/mlwelles/modusGraphGen/inflect"
+	"github.com/mlwelles/modusGraphGen/logging"
 	"github.com/mlwelles/modusGraphGen/model"
 )
 
 //go:embed templates/*.tmpl
 var templateFS embed.FS
 
-// header is prepended to every generated file.
+// header is prepended to every generated file unless WithHeader replaces it.
 const header = "// Code generated by modusGraphGen. DO NOT EDIT.\n\n"
 
+// packageNames are the package-level names generated for every package, which
+// an entity of the same name would collide with, mapped to what they declare.
+var packageNames = map[string]string{
+	"Entity": "the generated Entity interface",
+	"Filter": "the generated Filter type",
+	"And":    "the generated And function",
+	"Or":     "the generated Or function",
+	"Not":    "the generated Not function",
+}
+
 // Generate renders all code-generation templates against pkg and writes the
 // resulting Go source files into outputDir. The directory must already exist.
-func Generate(pkg *model.Package, outputDir string) error {
+// It is shorthand for Run with WithOutputDir(outputDir) ahead of opts.
+func Generate(pkg *model.Package, outputDir string, opts ...Option) error {
+	return Run(pkg, append([]Option{WithOutputDir(outputDir)}, opts...)...)
+}
+
+// Run renders all code-generation templates against pkg and writes the
+// resulting Go source files into the directory set by WithOutputDir, or the
+// current directory without one. The directory must already exist.
+func Run(pkg *model.Package, opts ...Option) error {
+	cfg := options{outputDir: ".", log: logging.Discard}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	outputDir := cfg.outputDir
+	if cfg.packageName != "" {
+		if !token.IsIdentifier(cfg.packageName) {
+			return fmt.Errorf("package name %q is not a Go identifier", cfg.packageName)
+		}
+		renamed := *pkg
+		renamed.Name = cfg.packageName
+		pkg = &renamed
+	}
+	if affixed := cfg.typePrefix + "X" + cfg.typeSuffix; !token.IsIdentifier(affixed) {
+		return fmt.Errorf("type affixes %q and %q do not form a Go identifier", cfg.typePrefix, cfg.typeSuffix)
+	}
+	dgraphTypes := make(map[string]string) // Dgraph type → entity
+	for _, e := range pkg.Entities {
+		if what, ok := packageNames[e.Name]; ok {
+			return fmt.Errorf("entity %s collides with %s; rename the struct", e.Name, what)
+		}
+		if other, dup := dgraphTypes[dgraphType(e)]; dup {
+			return fmt.Errorf("entities %s and %s both have Dgraph type %s", other, e.Name, dgraphType(e))
+		}
+		dgraphTypes[dgraphType(e)] = e.Name
+	}
+	if err := checkScalars(pkg); err != nil {
+		return err
+	}
+	if cfg.strict {
+		for _, msg := range orphanReverseEdges(pkg) {
+			if cfg.strictWarn == nil {
+				return fmt.Errorf("strict: %s", msg)
+			}
+			cfg.strictWarn(msg)
+		}
+	}
+	var ov *overlay
+	if cfg.overlay {
+		var err error
+		if ov, err = loadOverlay(outputDir, cfg.warn); err != nil {
+			return err
+		}
+	}
+
 	// Sort entities by name for deterministic output.
 	sort.Slice(pkg.Entities, func(i, j int) bool {
 		return pkg.Entities[i].Name < pkg.Entities[j].Name
 	})
+	plural := func(entity string) string {
+		if p, ok := cfg.plurals[entity]; ok {
+			return p
+		}
+		return inflect.Plural(entity)
+	}
 	funcMap := template.FuncMap{
 		"toLower":      strings.ToLower,
 		"toUpper":      strings.ToUpper,
-		"toSnakeCase":  toSnakeCase,
+		"toSnakeCase":  inflect.SnakeCase,
+		"article":      inflect.Article,
 		"toCamelCase":  toCamelCase,
 		"toLowerCamel": toLowerCamel,
 		"title":        strings.Title, //nolint:staticcheck
@@ -43,40 +118,179 @@ func Generate(pkg *model.Package, outputDir string) error {
 		"contains":     strings.Contains,
 		"trimPrefix":   strings.TrimPrefix,
 		"join":         strings.Join,
+		"base":         path.Base,
 		"sub":          func(a, b int) int { return a - b },
 		"add":          func(a, b int) int { return a + b },
+		"typeName": func(entity string) string {
+			return cfg.typePrefix + entity + cfg.typeSuffix
+		},
+		"plural": plural,
+		"sliceVar": func(entity, pkgName string) string {
+			return sliceVar(plural(entity), pkgName)
+		},
+		"fieldDoc": func(f model.Field) string {
+			return fieldDoc(f, cfg.positions)
+		},
+		"entityDoc": func(e model.Entity) string {
+			return entityDoc(e, cfg.positions)
+		},
+		"singleForward": func(f model.Field) bool {
+			return singleForward(pkg, f)
+		},
 
 		// Field helpers for templates.
-		"scalarFields":   scalarFields,
-		"edgeFields":     edgeFields,
-		"searchPredicate": searchPredicate,
+		"scalarFields":      scalarFields,
+		"predicateFields":   predicateFields,
+		"edgeFields":        edgeFields,
+		"selectableEdges":   selectableEdges,
+		"docComment":        docComment,
+		"lazyEdges":         lazyEdges,
+		"edgeImports":       edgeImports,
+		"singleEdge":        singleEdge,
+		"linkedEdges":       linkedEdges,
+		"recursiveEdges":    recursiveEdges,
+		"maxRecurseDepth":   func() int { return maxRecurseDepth },
+		"renamedPredicates": renamedPredicates,
+		"edgeParam":         edgeParam,
+		"edgeCount":         edgeCount,
+		"dgraphType":        dgraphType,
+		"pointerEdge":       pointerEdge,
+		"localeFields":      localeFields,
+		"listFields":        listFields,
+		"geoFields":         geoFields,
+		"termFields":        termFields,
+		"trigramFields":     trigramFields,
+		"lookupFields":      lookupFields,
+		"upsertFields":      upsertFields,
+		"setterFields":      setterFields,
+		"countedEdges":      countedEdges,
+		"vectorFields":      vectorFields,
+		"ownFields":         ownFields,
+		"embeddedFields":    embeddedFields,
+		"exactFields":       exactFields,
+		"stringValue":       stringValue,
+		"datetimeFields":    datetimeFields,
+		"hasSelfRef":        hasSelfRef,
+		"declaresTime":      declaresTime,
+		"hasEntity":         hasEntity,
+		"selectionFunc":     selectionFunc,
+		"selectionScalars":  selectionScalars,
+		"selectTerm":        selectTerm,
+		"searchPredicate":   searchPredicate,
+		"searchFields":      searchFields,
+		"mapValueType":      mapValueType,
+		"requiredFields":    requiredFields,
+		"nullFields":        nullFields,
+		"datetimeJSON":      datetimeJSONFields,
+		"geoJSON":           geoJSONFields,
+		"edgeJSON":          edgeJSONFields,
+		"mapJSON":           mapJSONFields,
+		"localeJSON":        localeJSONFields,
+		"countJSON":         countJSONFields,
+		"mapFields":         mapFields,
+		"passwordFields":    passwordFields,
+		"nullValue":         nullValue,
+		"jsonKey":           jsonKey,
+		"equalityFields":    equalityFields,
+		"zeroCheck":         zeroCheck,
+		"compositeType":     compositeType,
+		"elemType":          elemType,
+		"localeKey":         localeKey,
+		"localeSuffix":      localeSuffix,
+
+		// Conformance test helpers.
+		"conformanceFields":   conformanceFields,
+		"conformanceValue":    conformanceValue,
+		"conformanceMismatch": conformanceMismatch,
+		"unsetRequired":       unsetRequired,
 	}
 
 	tmpl, err := template.New("").Funcs(funcMap).ParseFS(templateFS, "templates/*.tmpl")
 	if err != nil {
 		return fmt.Errorf("parsing templates: %w", err)
 	}
+	if cfg.templateDir != "" {
+		if err := overrideTemplates(tmpl, cfg.templateDir, cfg.log); err != nil {
+			return err
+		}
+	}
+	hdr, err := newFileHeader(cfg.header, pkg.Name, outputDir)
+	if err != nil {
+		return err
+	}
+	out := &output{tmpl: tmpl, ov: ov, hdr: hdr, log: cfg.log, dir: outputDir}
+	if cfg.singleFile {
+		out.combined = &combinedFile{}
+	}
 
 	// 1. client.go.tmpl → client_gen.go (once)
-	if err := executeAndWrite(tmpl, "client.go.tmpl", pkg, filepath.Join(outputDir, "client_gen.go")); err != nil {
+	if err := out.write("client.go.tmpl", pkg, filepath.Join(outputDir, "client_gen.go")); err != nil {
 		return err
 	}
 
 	// 2. page_options.go.tmpl → page_options_gen.go (once)
-	if err := executeAndWrite(tmpl, "page_options.go.tmpl", pkg, filepath.Join(outputDir, "page_options_gen.go")); err != nil {
+	if err := out.write("page_options.go.tmpl", pkg, filepath.Join(outputDir, "page_options_gen.go")); err != nil {
 		return err
 	}
 
 	// 3. iter.go.tmpl → iter_gen.go (once)
-	if err := executeAndWrite(tmpl, "iter.go.tmpl", pkg, filepath.Join(outputDir, "iter_gen.go")); err != nil {
+	if err := out.write("iter.go.tmpl", pkg, filepath.Join(outputDir, "iter_gen.go")); err != nil {
+		return err
+	}
+
+	// 4. schema.go.tmpl → schema_gen.go (once)
+	if err := out.write("schema.go.tmpl", buildSchema(pkg), filepath.Join(outputDir, "schema_gen.go")); err != nil {
+		return err
+	}
+
+	// 5. dql.go.tmpl → dql_gen.go (once)
+	if err := out.write("dql.go.tmpl", pkg, filepath.Join(outputDir, "dql_gen.go")); err != nil {
+		return err
+	}
+
+	// 6. filter.go.tmpl → filter_gen.go (once)
+	if err := out.write("filter.go.tmpl", pkg, filepath.Join(outputDir, "filter_gen.go")); err != nil {
+		return err
+	}
+
+	// 7. get_options.go.tmpl → get_options_gen.go (once)
+	if err := out.write("get_options.go.tmpl", pkg, filepath.Join(outputDir, "get_options_gen.go")); err != nil {
+		return err
+	}
+
+	// 8. txn.go.tmpl → txn_gen.go (once)
+	if err := out.write("txn.go.tmpl", pkg, filepath.Join(outputDir, "txn_gen.go")); err != nil {
+		return err
+	}
+
+	// 9. entities.go.tmpl → entities_gen.go (once)
+	if err := out.write("entities.go.tmpl", pkg, filepath.Join(outputDir, "entities_gen.go")); err != nil {
 		return err
 	}
 
+	// 10. retry.go.tmpl → retry_gen.go (once)
+	if err := out.write("retry.go.tmpl", pkg, filepath.Join(outputDir, "retry_gen.go")); err != nil {
+		return err
+	}
+
+	// 11. dgraph_json.go.tmpl → dgraph_json_gen.go (once, if any entity has
+	// generated JSON methods)
+	if helpers := neededJSONHelpers(pkg); helpers != (jsonHelpers{}) {
+		data := struct {
+			PackageName string
+			jsonHelpers
+		}{pkg.Name, helpers}
+		if err := out.write("dgraph_json.go.tmpl", data, filepath.Join(outputDir, "dgraph_json_gen.go")); err != nil {
+			return err
+		}
+	}
+
 	// Per-entity templates.
 	type entityData struct {
 		PackageName string
 		Entity      model.Entity
 		Entities    []model.Entity
+		External    []model.Entity
 	}
 
 	for _, entity := range pkg.Entities {
@@ -84,78 +298,212 @@ func Generate(pkg *model.Package, outputDir string) error {
 			PackageName: pkg.Name,
 			Entity:      entity,
 			Entities:    pkg.Entities,
+			External:    pkg.External,
+		}
+		snake := inflect.SnakeCase(entity.Name)
+
+		// 12. entity.go.tmpl → <snake>_gen.go
+		if err := out.write("entity.go.tmpl", data, filepath.Join(outputDir, snake+"_gen.go")); err != nil {
+			return err
+		}
+
+		// 13. json.go.tmpl → <snake>_json_gen.go (entities with sql.Null*,
+		// datetime, geo, or edge fields)
+		if hasJSONMethods(entity) {
+			if err := out.write("json.go.tmpl", data, filepath.Join(outputDir, snake+"_json_gen.go")); err != nil {
+				return err
+			}
+		}
+
+		// 14. options.go.tmpl → <snake>_options_gen.go
+		if err := out.write("options.go.tmpl", data, filepath.Join(outputDir, snake+"_options_gen.go")); err != nil {
+			return err
 		}
-		snake := toSnakeCase(entity.Name)
 
-		// 4. entity.go.tmpl → <snake>_gen.go
-		if err := executeAndWrite(tmpl, "entity.go.tmpl", data, filepath.Join(outputDir, snake+"_gen.go")); err != nil {
+		// 15. query.go.tmpl → <snake>_query_gen.go
+		if err := out.write("query.go.tmpl", data, filepath.Join(outputDir, snake+"_query_gen.go")); err != nil {
 			return err
 		}
 
-		// 5. options.go.tmpl → <snake>_options_gen.go
-		if err := executeAndWrite(tmpl, "options.go.tmpl", data, filepath.Join(outputDir, snake+"_options_gen.go")); err != nil {
+		// 16. bench.go.tmpl → <snake>_bench_gen_test.go
+		if err := out.write("bench.go.tmpl", data, filepath.Join(outputDir, snake+"_bench_gen_test.go")); err != nil {
 			return err
 		}
 
-		// 6. query.go.tmpl → <snake>_query_gen.go
-		if err := executeAndWrite(tmpl, "query.go.tmpl", data, filepath.Join(outputDir, snake+"_query_gen.go")); err != nil {
+		// 17. conformance.go.tmpl → <snake>_conformance_gen_test.go
+		// (integration build tag), which writes, so not for read-only
+		// entities
+		if !entity.ReadOnly {
+			if err := out.write("conformance.go.tmpl", data, filepath.Join(outputDir, snake+"_conformance_gen_test.go")); err != nil {
+				return err
+			}
+		}
+
+		// 18. tests.go.tmpl → <snake>_gen_test.go (WithTests only)
+		if cfg.tests {
+			if err := out.write("tests.go.tmpl", data, filepath.Join(outputDir, snake+"_gen_test.go")); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 19. cli.go.tmpl → cmd/<name>/main.go (stub, unless WithSkipCLI)
+	if !cfg.skipCLI {
+		cliDir := filepath.Join(outputDir, "cmd", pkg.Name)
+		if err := os.MkdirAll(cliDir, 0o755); err != nil {
+			return fmt.Errorf("creating CLI directory: %w", err)
+		}
+		importPath := cfg.importPath
+		if importPath == "" {
+			if importPath, err = packageImportPath(outputDir); err != nil {
+				return fmt.Errorf("CLI stub: cannot tell the import path of %s (%w); pass it with -import-path (WithImportPath), or leave the stub out with -no-cli (WithSkipCLI)", pkg.Name, err)
+			}
+		}
+		generate, err := generateCommand(cfg, cliDir)
+		if err != nil {
+			return fmt.Errorf("CLI stub: %w", err)
+		}
+		cli := struct {
+			*model.Package
+			ImportPath string
+			Generate   string
+		}{pkg, importPath, generate}
+		if err := out.write("cli.go.tmpl", cli, filepath.Join(cliDir, "main.go")); err != nil {
 			return err
 		}
 	}
 
-	// 7. cli.go.tmpl → cmd/<name>/main.go (stub)
-	cliDir := filepath.Join(outputDir, "cmd", pkg.Name)
-	if err := os.MkdirAll(cliDir, 0o755); err != nil {
-		return fmt.Errorf("creating CLI directory: %w", err)
+	// 20. mock.go.tmpl → mock_client_gen.go (WithMock only)
+	if cfg.mock {
+		if err := out.write("mock.go.tmpl", pkg, filepath.Join(outputDir, "mock_client_gen.go")); err != nil {
+			return err
+		}
 	}
-	if err := executeAndWrite(tmpl, "cli.go.tmpl", pkg, filepath.Join(cliDir, "main.go")); err != nil {
-		return err
+
+	// With WithSingleFile, the package's files were collected rather than
+	// written; write them now as one.
+	if out.combined != nil {
+		if err := out.flush(pkg.Name); err != nil {
+			return err
+		}
+	}
+
+	// 21. graph_gen.mmd (WithMermaid only)
+	if cfg.mermaid {
+		path := filepath.Join(outputDir, "graph_gen.mmd")
+		if err := writeMermaidFile(path, pkg); err != nil {
+			return err
+		}
+		cfg.log.Debugf("wrote %s", path)
+	}
+
+	// 22. schema_gen.graphql (WithGraphQL only)
+	if cfg.graphql {
+		path := filepath.Join(outputDir, "schema_gen.graphql")
+		if err := writeGraphQLFile(path, pkg); err != nil {
+			return err
+		}
+		cfg.log.Debugf("wrote %s", path)
 	}
 
 	return nil
 }
 
-// executeAndWrite renders a named template and writes the gofmt'd result to path.
-func executeAndWrite(tmpl *template.Template, name string, data any, path string) error {
-	var buf bytes.Buffer
-	buf.WriteString(header)
+// overrideTemplates replaces the templates of tmpl with the same-named .tmpl
+// files of dir. The overrides are parsed with the same functions as the
+// built-in templates.
+func overrideTemplates(tmpl *template.Template, dir string, log logging.Sink) error {
+	if info, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("template directory: %w", err)
+	} else if !info.IsDir() {
+		return fmt.Errorf("template directory %s is not a directory", dir)
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tmpl"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if tmpl.Lookup(name) == nil {
+			return fmt.Errorf("template override %s matches no built-in template", path)
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading template override: %w", err)
+		}
+		if _, err := tmpl.New(name).Parse(string(src)); err != nil {
+			return fmt.Errorf("parsing template override %s: %w", path, err)
+		}
+		log.Debugf("using %s for %s", path, name)
+	}
+	return nil
+}
 
-	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+// output writes generated files into dir, each below the header from hdr.
+// With a non-nil overlay, each file is first checked against the hand-written
+// code in the output directory and may be left unwritten. With a non-nil
+// combined, the package's non-test Go files are collected into it instead, to
+// be written by flush. Each file written is logged through log's Debugf.
+type output struct {
+	tmpl     *template.Template
+	ov       *overlay
+	hdr      fileHeader
+	log      logging.Sink
+	dir      string
+	combined *combinedFile
+}
+
+// write renders a named template and writes the gofmt'd result to path.
+func (o *output) write(name string, data any, path string) error {
+	var buf bytes.Buffer
+	if err := o.tmpl.ExecuteTemplate(&buf, name, data); err != nil {
 		return fmt.Errorf("executing template %s: %w", name, err)
 	}
 
 	// Format the output with gofmt.
-	formatted, err := format.Source(buf.Bytes())
+	body, err := format.Source(buf.Bytes())
 	if err != nil {
 		// Write the unformatted output for debugging.
 		_ = os.WriteFile(path+".broken", buf.Bytes(), 0o644)
 		return fmt.Errorf("formatting %s: %w\nRaw output written to %s.broken", name, err, path)
 	}
-
-	if err := os.WriteFile(path, formatted, 0o644); err != nil {
-		return fmt.Errorf("writing %s: %w", path, err)
+	if o.combined != nil && filepath.Dir(path) == filepath.Clean(o.dir) && !strings.HasSuffix(path, "_test.go") {
+		return o.combined.add(name, body)
 	}
+	return o.writeFile(path, body)
+}
 
-	return nil
+// flush writes the files collected in combined as one, singleFileName, for
+// package pkgName.
+func (o *output) flush(pkgName string) error {
+	body, err := o.combined.source(pkgName)
+	if err != nil {
+		return err
+	}
+	return o.writeFile(filepath.Join(o.dir, singleFileName), body)
 }
 
-// toSnakeCase converts a Go identifier like "ContentRating" to "content_rating".
-func toSnakeCase(s string) string {
-	var result strings.Builder
-	for i, r := range s {
-		if unicode.IsUpper(r) {
-			if i > 0 {
-				prev := rune(s[i-1])
-				if unicode.IsLower(prev) || (i+1 < len(s) && unicode.IsLower(rune(s[i+1]))) {
-					result.WriteRune('_')
-				}
-			}
-			result.WriteRune(unicode.ToLower(r))
-		} else {
-			result.WriteRune(r)
+// writeFile writes body, below its header, to path.
+func (o *output) writeFile(path string, body []byte) error {
+	head, err := o.hdr.render(path, body)
+	if err != nil {
+		return err
+	}
+	formatted := append(head, body...)
+
+	if o.ov != nil {
+		ok, err := o.ov.check(path, formatted)
+		if err != nil || !ok {
+			return err
 		}
 	}
-	return result.String()
+
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	o.log.Debugf("wrote %s", path)
+
+	return nil
 }
 
 // toCamelCase converts a snake_case or lowercase string to CamelCase.
@@ -180,11 +528,44 @@ func toLowerCamel(s string) string {
 	return strings.ToLower(s[:1]) + s[1:]
 }
 
-// scalarFields returns fields that are not UID, DType, or edges.
+// sliceVarTaken holds the names, other than the package's, that the generated
+// code declares or uses alongside a sliceVar variable.
+var sliceVarTaken = map[string]bool{
+	"c": true, "cfg": true, "client": true, "ctx": true, "err": true,
+	"filter": true, "opt": true, "opts": true, "q": true, "results": true,
+}
+
+// sliceVar returns the name of a local variable holding entities, plural in
+// lower camel case, e.g. "films" for "Films" or "urls" for "URLs". It falls
+// back to "results" if that name is taken, by the generated code, the package
+// pkgName, or Go itself.
+func sliceVar(plural, pkgName string) string {
+	words := strings.Split(inflect.SnakeCase(plural), "_")
+	name := words[0] + toCamelCase(strings.Join(words[1:], "_"))
+	if sliceVarTaken[name] || name == pkgName || token.IsKeyword(name) || types.Universe.Lookup(name) != nil || !token.IsIdentifier(name) {
+		return "results"
+	}
+	return name
+}
+
+// scalarFields returns fields that are not UID, DType, edges, or counts.
 func scalarFields(fields []model.Field) []model.Field {
 	var result []model.Field
 	for _, f := range fields {
-		if f.IsUID || f.IsDType || f.IsEdge {
+		if f.IsUID || f.IsDType || f.IsEdge || f.CountOf != "" {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// predicateFields returns the fields stored in a predicate of their own,
+// scalar or edge, which get generated Has<Field> and Not<Field> filters.
+func predicateFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.IsUID || f.IsDType || f.Predicate == "" || f.JSONTag == "-" && f.ImplicitPredicate {
 			continue
 		}
 		result = append(result, f)
@@ -192,6 +573,30 @@ func scalarFields(fields []model.Field) []model.Field {
 	return result
 }
 
+// ownFields returns the fields declared directly on the entity struct, which
+// a composite literal of it can set.
+func ownFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.Embedded == "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// embeddedFields returns the fields inherited from an embedded struct, which
+// must be assigned after a composite literal rather than set within it.
+func embeddedFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.Embedded != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
 // edgeFields returns only edge fields.
 func edgeFields(fields []model.Field) []model.Field {
 	var result []model.Field
@@ -203,6 +608,877 @@ func edgeFields(fields []model.Field) []model.Field {
 	return result
 }
 
+// docComment returns doc, the doc comment of an entity or field, as a
+// paragraph to end the comment of a method generated for it: each line
+// commented out, after a blank comment line. It returns "" for an empty doc,
+// so that a template can write {{- docComment .Entity.Doc}} after a type's own
+// comment without changing it.
+func docComment(doc string) string {
+	if doc == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n//")
+	for _, line := range strings.Split(doc, "\n") {
+		b.WriteString("\n//")
+		if line != "" {
+			b.WriteString(" " + line)
+		}
+	}
+	return b.String()
+}
+
+// fieldDoc returns the paragraphs that end the comment of a method generated
+// for f, as docComment does: f's doc comment, then a "Deprecated:" paragraph
+// if f is tagged deprecated, which tools such as staticcheck report uses of.
+// With positions, a last line tells where f is declared.
+func fieldDoc(f model.Field, positions bool) string {
+	doc := docComment(f.Doc)
+	if f.Deprecated {
+		note := f.DeprecationNote
+		if note == "" {
+			note = f.Name + " is deprecated."
+		}
+		doc += docComment("Deprecated: " + note)
+	}
+	if positions {
+		doc += sourceComment(f.SourceFile, f.Line)
+	}
+	return doc
+}
+
+// entityDoc returns the paragraphs that end the comment of a type generated
+// for e, as docComment does: e's doc comment and, with positions, a last line
+// telling where e is declared.
+func entityDoc(e model.Entity, positions bool) string {
+	doc := docComment(e.Doc)
+	if positions {
+		doc += sourceComment(e.SourceFile, e.Line)
+	}
+	return doc
+}
+
+// sourceComment returns a "from film.go:42" paragraph naming file, by its base
+// name so that the output does not depend on where it was generated, and line,
+// or "" if file is unknown, as for a model built by hand.
+func sourceComment(file string, line int) string {
+	if file == "" {
+		return ""
+	}
+	return docComment(fmt.Sprintf("from %s:%d", filepath.Base(file), line))
+}
+
+// selectableEdges returns the edge fields that a DQL selection can name, those
+// that a query's With methods add.
+func selectableEdges(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range edgeFields(fields) {
+		if selectTerm(f) != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// lazyEdges returns the selectable edge fields held in a slice, which get a
+// Load<Field> method that fetches them on demand. A nil slice tells that the
+// edge is not loaded yet, an empty one that it has no targets.
+func lazyEdges(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range selectableEdges(fields) {
+		if strings.HasPrefix(underlyingType(f), "[]") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// edgeImports returns the import specs of the other packages that the lazy
+// edges of fields lead into, e.g. "example.com/people" for []people.Person, as
+// their Load<Field> methods name the target type. A package whose name differs
+// from the last element of its import path is imported under its qualifier.
+func edgeImports(fields []model.Field) []string {
+	seen := make(map[string]bool)
+	var specs []string
+	for _, f := range lazyEdges(fields) {
+		if f.EdgePackage == "" || seen[f.EdgePackage] {
+			continue
+		}
+		seen[f.EdgePackage] = true
+		spec := strconv.Quote(f.EdgePackage)
+		if qualifier, _, ok := strings.Cut(f.EdgeEntity, "."); ok && qualifier != path.Base(f.EdgePackage) {
+			spec = qualifier + " " + spec
+		}
+		specs = append(specs, spec)
+	}
+	sort.Strings(specs)
+	return specs
+}
+
+// dgraphType returns the Dgraph type name of e: its DgraphType, or its Name
+// if that is unset.
+func dgraphType(e model.Entity) string {
+	if e.DgraphType != "" {
+		return e.DgraphType
+	}
+	return e.Name
+}
+
+// singleEdge returns true if f is an edge to a single node, e.g. a *Studio
+// field, rather than a slice or array of them.
+func singleEdge(f model.Field) bool {
+	_, many := cutList(underlyingType(f))
+	return f.IsEdge && !many
+}
+
+// linkedEdges returns the edge fields stored in a predicate, forward or
+// reverse ("~"), which get generated methods linking nodes by UID:
+// Add<Field> and Remove<Field>, or Set<Field> and Remove<Field> for a single
+// forward edge.
+func linkedEdges(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range edgeFields(fields) {
+		if f.Predicate != "" && f.Predicate != "~" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// singleForward returns true if the reverse edge f ("~predicate") is the
+// reverse of a single edge, so each of its targets links to one node at most
+// through the forward predicate.
+func singleForward(pkg *model.Package, f model.Field) bool {
+	forward := strings.TrimPrefix(f.Predicate, "~")
+	for _, e := range append(append([]model.Entity(nil), pkg.Entities...), pkg.External...) {
+		if e.Name != f.EdgeEntity {
+			continue
+		}
+		for _, ef := range e.Fields {
+			if ef.Predicate == forward && singleEdge(ef) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// maxRecurseDepth bounds the depth of the generated Recurse<Field> methods.
+const maxRecurseDepth = 16
+
+// recursiveEdges returns the self-referential edges stored in a predicate,
+// which get generated Recurse<Field> methods.
+func recursiveEdges(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range edgeFields(fields) {
+		if f.IsSelfRef && selectTerm(f) != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// renamedPredicates returns the fields stored in a forward predicate other
+// than their JSON key, whose values GetExpanded renames to that key.
+func renamedPredicates(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if f.CountOf == "" && !strings.HasPrefix(f.Predicate, "~") && f.Predicate != jsonKey(f) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// edgeParam returns the edge's target entity name as a parameter name, to
+// which "UIDs" is appended for its target nodes, e.g. "genre" for []Genre or
+// "person" for []people.Person. A self-referential edge's targets are
+// "target", as the entity name is taken by the node the edge starts from.
+func edgeParam(f model.Field) string {
+	if f.IsSelfRef {
+		return "target"
+	}
+	return toLowerCamel(f.EdgeEntity[strings.LastIndex(f.EdgeEntity, ".")+1:])
+}
+
+// edgeCount returns a Go expression, in terms of the receiver v, for the number
+// of nodes an edge field holds: its length for a slice or array, and 0 or 1
+// for a single node, which counts if its pointer is set or, held by value, if
+// it has a UID.
+func edgeCount(f model.Field) string {
+	field := "v." + f.Name
+	switch {
+	case !singleEdge(f):
+		return "len(" + field + ")"
+	case pointerEdge(f):
+		return "nodeCount(" + field + ")"
+	}
+	return "min(len(" + field + ".UID), 1)"
+}
+
+// pointerEdge returns true if f is an edge to a single node held by pointer,
+// e.g. a *Studio field.
+func pointerEdge(f model.Field) bool {
+	return singleEdge(f) && strings.HasPrefix(underlyingType(f), "*")
+}
+
+// passwordFields returns the fields with a type=password hint, which are
+// checked with checkpwd rather than read.
+func passwordFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.TypeHint == "password" && f.Predicate != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// mapFields returns the map fields with string keys, which get accessors for
+// single entries, e.g. Labels map[string]string.
+func mapFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.IsMap && strings.HasPrefix(compositeType(f), "map[string]") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// listFields returns scalar-list fields, e.g. Aliases []string.
+func listFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.IsList {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// geoFields returns fields with a geo index.
+func geoFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if hasString(f.Indexes, "geo") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// termFields returns the fields with a term index, which supports the
+// allofterms and anyofterms functions. Localized fields are left out, as their
+// predicates carry a language tag.
+func termFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if hasString(f.Indexes, "term") && len(f.Locales) == 0 {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// trigramFields returns the fields with a trigram index, which supports the
+// regexp function. Localized fields are left out, as for termFields.
+func trigramFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if hasString(f.Indexes, "trigram") && len(f.Locales) == 0 {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// datetimeFields returns time.Time fields with a datetime index (year, month,
+// day, or hour).
+func datetimeFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if strings.TrimPrefix(underlyingType(f), "*") != "time.Time" {
+			continue
+		}
+		for _, idx := range []string{"year", "month", "day", "hour"} {
+			if hasString(f.Indexes, idx) {
+				result = append(result, f)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// localeFields returns map fields that declare a locales= directive.
+func localeFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if len(f.Locales) > 0 && strings.HasPrefix(underlyingType(f), "map[string]") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// mapValueType returns the value type of a map type string, e.g. "string" for
+// "map[string]string".
+func mapValueType(goType string) string {
+	if i := strings.Index(goType, "]"); i >= 0 && strings.HasPrefix(goType, "map[") {
+		return goType[i+1:]
+	}
+	return goType
+}
+
+// elemType returns the element type of a slice or array type string, e.g.
+// "string" for "[]string" or "[2]string".
+func elemType(goType string) string {
+	if elem, ok := cutList(goType); ok {
+		return elem
+	}
+	return goType
+}
+
+// requiredFields returns the fields marked with the required directive, other
+// than UID and DType, which Dgraph manages.
+func requiredFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.Required && !f.IsUID && !f.IsDType {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// equalityFields returns the single-valued scalar fields with a hash or exact
+// index, i.e. those that support eq() lookups.
+func equalityFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range scalarFields(fields) {
+		if f.IsUID || f.IsDType || f.IsList || f.Predicate == "" {
+			continue
+		}
+		if hasString(f.Indexes, "hash") || hasString(f.Indexes, "exact") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// countedEdges returns the edges tagged count, whose predicates have @count
+// and get a generated Count<Field> method.
+func countedEdges(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range edgeFields(fields) {
+		if f.HasCount && f.Predicate != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// vectorFields returns the fields with an hnsw index, which get generated
+// SimilarTo<Field> methods.
+func vectorFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.VectorMetric != "" && hasString(f.Indexes, "hnsw") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// lookupFields returns the string fields among equalityFields, which get
+// generated GetBy<Field> methods.
+func lookupFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range equalityFields(fields) {
+		if underlyingType(f) == "string" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// upsertFields returns the fields tagged upsert, whose predicates Create
+// with WithUpsert matches existing nodes on.
+func upsertFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.Upsert {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// exactFields returns the lookupFields with an exact index, which, unlike a
+// hash index, supports inequality.
+func exactFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range lookupFields(fields) {
+		if hasString(f.Indexes, "exact") {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// stringValue returns the Go expression converting name, of the field's type,
+// to a string: name itself for a string field, and string(name) for a named
+// string type such as Email.
+func stringValue(f model.Field, name string) string {
+	if f.GoType == "string" {
+		return name
+	}
+	return "string(" + name + ")"
+}
+
+// sqlNull describes a database/sql Null* type: the field holding its value
+// and that value's Go type.
+type sqlNull struct {
+	Field  string
+	GoType string
+}
+
+// sqlNullTypes maps the database/sql Null* types to their value fields.
+var sqlNullTypes = map[string]sqlNull{
+	"sql.NullString":  {"String", "string"},
+	"sql.NullInt64":   {"Int64", "int64"},
+	"sql.NullInt32":   {"Int32", "int32"},
+	"sql.NullInt16":   {"Int16", "int16"},
+	"sql.NullByte":    {"Byte", "byte"},
+	"sql.NullFloat64": {"Float64", "float64"},
+	"sql.NullBool":    {"Bool", "bool"},
+	"sql.NullTime":    {"Time", "time.Time"},
+}
+
+// setterFields returns the scalar fields that get a generated Set<Field>
+// method: those stored as a plain JSON value in a predicate of their own.
+// Fields that MarshalJSON encodes specially (sql.Null* types, datetimes with a
+// format= layout, geo points, and maps), localized fields, and vectors are
+// left out.
+func setterFields(fields []model.Field) []model.Field {
+	special := make(map[string]bool)
+	for _, group := range [][]model.Field{nullFields(fields), geoJSONFields(fields), mapJSONFields(fields), localeFields(fields)} {
+		for _, f := range group {
+			special[f.Name] = true
+		}
+	}
+	var result []model.Field
+	for _, f := range scalarFields(jsonFields(fields)) {
+		if special[f.Name] || f.TimeFormat != "" || len(f.Locales) > 0 || f.VectorMetric != "" {
+			continue
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+// nullFields returns the fields whose type is a database/sql Null* type or a
+// pointer to one.
+func nullFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range scalarFields(fields) {
+		if _, ok := sqlNullTypes[strings.TrimPrefix(underlyingType(f), "*")]; ok && f.Predicate != "" && f.JSONTag != "-" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// nullValue returns the value field of a database/sql Null* field, or of a
+// pointer to one, or the zero sqlNull for any other field.
+func nullValue(f model.Field) sqlNull {
+	return sqlNullTypes[strings.TrimPrefix(underlyingType(f), "*")]
+}
+
+// jsonFields returns the fields encoding/json sees under a JSON key that also
+// have a predicate, i.e. those present in Dgraph query results.
+func jsonFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.Predicate != "" && f.JSONTag != "-" && !f.IsUID && !f.IsDType {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// datetimeJSONFields returns the time.Time and *time.Time fields, which are
+// decoded from any of the datetime formats Dgraph returns, and encoded in
+// their format= layout if they have one.
+func datetimeJSONFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if f.GoType == "time.Time" || f.GoType == "*time.Time" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// geoJSONFields returns the []float64 geo fields, which Dgraph stores and
+// returns as GeoJSON points.
+func geoJSONFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if f.GoType == "[]float64" && (f.TypeHint == "geo" || hasString(f.Indexes, "geo")) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// edgeJSONFields returns the edge fields, whose JSON value Dgraph returns as a
+// single object rather than a list when the predicate is of type uid.
+func edgeJSONFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if f.IsEdge {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// mapJSONFields returns the map fields, which are stored as JSON text in a
+// string predicate.
+func mapJSONFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if f.IsMap {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// localeJSONFields returns the locales= map fields, whose values are sent and
+// read as one language-tagged predicate per locale, e.g. "name@en".
+func localeJSONFields(fields []model.Field) []model.Field {
+	return localeFields(jsonFields(fields))
+}
+
+// localeKey returns the JSON key of a locales= field's value in the given
+// language: its language-tagged predicate, e.g. "name@en". DQL aliases cannot
+// hold the tag, so it is the same in mutations and query results.
+func localeKey(f model.Field, locale string) string {
+	return f.Predicate + "@" + locale
+}
+
+// countJSONFields returns the count= fields, which are read from query
+// results but never written, since Dgraph computes them.
+func countJSONFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range fields {
+		if f.CountOf != "" && f.JSONTag != "-" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// hasJSONMethods returns true if the entity gets generated MarshalJSON and
+// UnmarshalJSON methods.
+func hasJSONMethods(entity model.Entity) bool {
+	return len(nullFields(entity.Fields)) > 0 || len(datetimeJSONFields(entity.Fields)) > 0 ||
+		len(geoJSONFields(entity.Fields)) > 0 || len(edgeJSONFields(entity.Fields)) > 0 ||
+		len(mapJSONFields(entity.Fields)) > 0 || len(countJSONFields(entity.Fields)) > 0 ||
+		len(localeJSONFields(entity.Fields)) > 0
+}
+
+// jsonHelpers records which decoding helpers the generated JSON methods of a
+// package call.
+type jsonHelpers struct {
+	Datetime       bool
+	DatetimeFormat bool
+	Geo            bool
+	Edges          bool
+	SingleEdges    bool
+	Maps           bool
+}
+
+// neededJSONHelpers returns the decoding helpers that pkg's entities need.
+func neededJSONHelpers(pkg *model.Package) jsonHelpers {
+	var h jsonHelpers
+	for _, e := range pkg.Entities {
+		h.Datetime = h.Datetime || len(datetimeJSONFields(e.Fields)) > 0
+		for _, f := range datetimeJSONFields(e.Fields) {
+			h.DatetimeFormat = h.DatetimeFormat || f.TimeFormat != ""
+		}
+		h.Geo = h.Geo || len(geoJSONFields(e.Fields)) > 0
+		for _, f := range edgeJSONFields(e.Fields) {
+			h.Edges = h.Edges || !singleEdge(f)
+			h.SingleEdges = h.SingleEdges || singleEdge(f)
+		}
+		h.Maps = h.Maps || len(mapJSONFields(e.Fields)) > 0
+	}
+	return h
+}
+
+// jsonKey returns the JSON object key encoding/json uses for the field.
+func jsonKey(f model.Field) string {
+	if f.JSONTag != "" {
+		return f.JSONTag
+	}
+	return f.Name
+}
+
+// zeroCheck returns a Go boolean expression, in terms of the receiver v, that is
+// true when the field holds its zero value.
+func zeroCheck(f model.Field) string {
+	field := "v." + f.Name
+	goType := underlyingType(f)
+	if _, ok := sqlNullTypes[goType]; ok {
+		return "!" + field + ".Valid"
+	}
+	switch {
+	case strings.HasPrefix(goType, "*"):
+		return field + " == nil"
+	case strings.HasPrefix(goType, "[]"), strings.HasPrefix(goType, "map["):
+		return "len(" + field + ") == 0"
+	}
+	switch goType {
+	case "string":
+		return field + ` == ""`
+	case "bool":
+		return "!" + field
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64":
+		return field + " == 0"
+	case "time.Time":
+		if f.GoType == goType {
+			return field + ".IsZero()"
+		}
+	}
+	return field + " == (" + f.GoType + "{})"
+}
+
+// compositeType returns the field's slice, array, or map type as written when it is
+// spelled out, e.g. "[]Email", and the resolved type when a named type hides
+// it, e.g. "[]string" for a field of type Tags declared as "type Tags []string".
+func compositeType(f model.Field) string {
+	if strings.HasPrefix(f.GoType, "[") || strings.HasPrefix(f.GoType, "map[") {
+		return f.GoType
+	}
+	return underlyingType(f)
+}
+
+// conformanceFields returns the fields that the generated conformance test
+// sets: stored scalars and scalar lists whose type it can give a non-zero
+// value, per conformanceValue.
+func conformanceFields(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range jsonFields(fields) {
+		if conformanceValue(f) != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// conformanceValue returns a Go expression for a non-zero test value of the
+// field, e.g. `"Name-" + suffix` for a string, where suffix is a variable of
+// the generated test, or "" if the field is not a plain scalar or scalar list
+// or has a type= hint overriding the Dgraph type of its Go type.
+func conformanceValue(f model.Field) string {
+	if f.IsEdge || f.IsMap || f.CountOf != "" || len(f.Locales) > 0 || f.TypeHint != "" {
+		return ""
+	}
+	if f.IsList {
+		composite := compositeType(f)
+		v := scalarValue(f.Name, elemType(composite), elemType(underlyingType(f)))
+		if v == "" {
+			return ""
+		}
+		return composite + "{" + v + "}"
+	}
+	return scalarValue(f.Name, f.GoType, underlyingType(f))
+}
+
+// scalarValue returns a Go expression of type goType, whose resolved type is
+// underlying, for a test value of the named field, or "" if underlying is not
+// a basic type or time.Time.
+func scalarValue(name, goType, underlying string) string {
+	var v string
+	switch underlying {
+	case "string":
+		v = `"` + name + `-" + suffix`
+	case "bool":
+		v = "true"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		v = "7"
+	case "float32", "float64":
+		v = "1.5"
+	case "time.Time":
+		v = "time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)"
+	default:
+		return ""
+	}
+	if goType != underlying {
+		v = goType + "(" + v + ")"
+	}
+	return v
+}
+
+// unsetRequired returns the required fields that conformanceValue has no value
+// for, which the generated test scaffolding cannot fill in.
+func unsetRequired(fields []model.Field) []model.Field {
+	var result []model.Field
+	for _, f := range requiredFields(fields) {
+		if conformanceValue(f) == "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// conformanceMismatch returns a Go boolean expression, in terms of the
+// generated test's got and want, that is true when the field did not round
+// trip.
+func conformanceMismatch(f model.Field) string {
+	if underlyingType(f) == "time.Time" {
+		return "!time.Time(got." + f.Name + ").Equal(time.Time(want." + f.Name + "))"
+	}
+	return "got." + f.Name + " != want." + f.Name
+}
+
+// localeSuffix converts a language tag like "en" or "pt-BR" into an identifier
+// suffix like "En" or "PtBR".
+func localeSuffix(locale string) string {
+	parts := strings.FieldsFunc(locale, func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+	var result strings.Builder
+	for _, p := range parts {
+		result.WriteString(strings.ToUpper(p[:1]))
+		result.WriteString(p[1:])
+	}
+	return result.String()
+}
+
+// hasSelfRef returns true if any edge of entity targets the entity itself.
+func hasSelfRef(entity model.Entity) bool {
+	for _, f := range entity.Fields {
+		if f.IsSelfRef {
+			return true
+		}
+	}
+	return false
+}
+
+// declaresTime returns true if the entity file uses time.Time: in a field of
+// its struct, if entity is declared by a directive block so the struct is
+// generated, or in a Set<Field> method.
+func declaresTime(entity model.Entity) bool {
+	fields := setterFields(entity.Fields)
+	if entity.Declaration != "" {
+		fields = entity.Fields
+	}
+	for _, f := range fields {
+		if strings.Contains(f.GoType, "time.Time") {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEntity returns true if an entity with the given name exists.
+func hasEntity(entities []model.Entity, name string) bool {
+	for _, e := range entities {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// selectionFunc returns the name of the generated function building the DQL
+// selection for the named entity, e.g. "filmSelection" for "Film" and
+// "peoplePersonSelection" for "people.Person" in another package.
+func selectionFunc(entity string) string {
+	if pkg, name, ok := strings.Cut(entity, "."); ok {
+		return pkg + name + "Selection"
+	}
+	return toLowerCamel(entity) + "Selection"
+}
+
+// selectionScalars returns the DQL selection of an entity's uid, type,
+// scalar predicates, and counts, e.g. "uid dgraph.type name tagline".
+func selectionScalars(entity model.Entity) string {
+	parts := []string{"uid", "dgraph.type"}
+	for _, f := range scalarFields(entity.Fields) {
+		if term := selectTerm(f); term != "" {
+			parts = append(parts, term)
+		}
+	}
+	for _, f := range countJSONFields(entity.Fields) {
+		parts = append(parts, selectTerm(f))
+	}
+	return strings.Join(parts, " ")
+}
+
+// selectTerm returns the DQL selection term for a field: the bare predicate
+// when it matches the field's JSON key, else an alias such as
+// "initialReleaseDate: initial_release_date" so the result decodes into the
+// struct. A locales= map selects its predicate in each of its languages, e.g.
+// "name@en name@fr". A count= field selects
+// "performanceCount: count(performance)", and an edge with a facet order is
+// followed by e.g. "@facets(orderasc: billing_order)". Fields without a predicate, and
+// password fields, whose hashes must not leak into results, yield "".
+func selectTerm(f model.Field) string {
+	if f.JSONTag == "-" || f.TypeHint == "password" {
+		return ""
+	}
+	key := jsonKey(f)
+	if f.CountOf != "" {
+		return key + ": count(" + f.CountOf + ")"
+	}
+	if f.Predicate == "" {
+		return ""
+	}
+	if len(localeFields([]model.Field{f})) > 0 {
+		terms := make([]string, len(f.Locales))
+		for i, locale := range f.Locales {
+			terms[i] = localeKey(f, locale)
+		}
+		return strings.Join(terms, " ")
+	}
+	term := f.Predicate
+	if key != f.Predicate {
+		term = key + ": " + f.Predicate
+	}
+	if f.FacetOrder != "" {
+		order := "orderasc"
+		if f.FacetOrderDesc {
+			order = "orderdesc"
+		}
+		term += " @facets(" + order + ": " + f.FacetOrder + ")"
+	}
+	return term
+}
+
+// searchFields returns the entity's fulltext fields, in the order of
+// SearchFields.
+func searchFields(entity model.Entity) []model.Field {
+	var result []model.Field
+	for _, name := range entity.SearchFields {
+		for _, f := range entity.Fields {
+			if f.Name == name {
+				result = append(result, f)
+				break
+			}
+		}
+	}
+	return result
+}
+
 // searchPredicate returns the dgraph predicate name for the entity's search
 // field, or empty string if not searchable.
 func searchPredicate(entity model.Entity) string {
@@ -1,13 +1,25 @@
 package generator
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"flag"
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/mlwelles/modusGraphGen/logging"
+	"github.com/mlwelles/modusGraphGen/model"
 	"github.com/mlwelles/modusGraphGen/parser"
 )
 
@@ -44,12 +56,2889 @@ func TestGenerate(t *testing.T) {
 	}
 
 	// Generate to a temp directory.
-	tmpDir := t.TempDir()
+	tmpDir := outputDir(t)
 	if err := Generate(pkg, tmpDir); err != nil {
 		t.Fatalf("Generate failed: %v", err)
 	}
 
-	golden := goldenDir(t)
+	checkGolden(t, tmpDir, goldenDir(t))
+}
+
+// TestGenerateFixtures runs the golden comparison for the small fixture
+// packages under testdata/<name>/, whose golden files live in
+// testdata/<name>/golden/. Each fixture exercises a struct shape or Generate
+// option that the movies project doesn't cover.
+func TestGenerateFixtures(t *testing.T) {
+	fixtures := []struct {
+		name string
+		opts []Option
+	}{
+		{name: "aliases"},
+		{name: "crosspkg"},
+		{name: "declared"},
+		{name: "dgraphtype"},
+		{name: "embedded"},
+		{name: "facets"},
+		{name: "lists"},
+		{name: "maps"},
+		{name: "locales"},
+		{name: "mock", opts: []Option{WithMock()}},
+		{name: "multisearch"},
+		{name: "nulls", opts: []Option{WithMock()}},
+		{name: "passwords", opts: []Option{WithMock()}},
+		{name: "pointers", opts: []Option{WithMock()}},
+		{name: "rawjson"},
+		{name: "readonly"},
+		{name: "recurse"},
+		{name: "required"},
+		{name: "scaffold", opts: []Option{WithTests()}},
+		{name: "schema"},
+		{name: "selfref"},
+		{name: "single"},
+		{name: "terms"},
+		{name: "timeformat"},
+		{name: "timelists"},
+		{name: "unique", opts: []Option{WithGraphQL()}},
+		{name: "vectors"},
+	}
+	for _, fx := range fixtures {
+		t.Run(fx.name, func(t *testing.T) {
+			dir := fixtureDir(t, fx.name)
+			pkg := parseFixture(t, dir)
+
+			tmpDir := outputDir(t)
+			if err := Generate(pkg, tmpDir, fx.opts...); err != nil {
+				t.Fatalf("Generate failed: %v", err)
+			}
+
+			checkGolden(t, tmpDir, filepath.Join(dir, "golden"))
+		})
+	}
+}
+
+// requiredTest is run against the required fixture and its generated
+// Validate, Add, and Create methods.
+const requiredTest = `package required
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// insertRecorder records the nodes Add inserts.
+type insertRecorder struct {
+	*modusgraph.Recorder
+	inserted []any
+}
+
+func (r *insertRecorder) Insert(ctx context.Context, obj any) error {
+	r.inserted = append(r.inserted, obj)
+	return nil
+}
+
+func valid() *Account {
+	return &Account{
+		Email:  "al@example.com",
+		Handle: "al",
+		Age:    42,
+		Joined: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Roles:  []string{"admin"},
+	}
+}
+
+func TestRequired(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		field string
+		clear func(v *Account)
+	}{
+		{"Email", func(v *Account) { v.Email = "" }},
+		{"Handle", func(v *Account) { v.Handle = "" }},
+		{"Age", func(v *Account) { v.Age = 0 }},
+		{"Joined", func(v *Account) { v.Joined = time.Time{} }},
+		{"Roles", func(v *Account) { v.Roles = nil }},
+	} {
+		t.Run(tt.field, func(t *testing.T) {
+			conn := &insertRecorder{Recorder: &modusgraph.Recorder{}}
+			client := NewFromClient(conn).Account
+			v := valid()
+			tt.clear(v)
+			check := func(op string, err error) {
+				t.Helper()
+				if !errors.Is(err, ErrRequired) || !strings.Contains(err.Error(), "Account."+tt.field) {
+					t.Errorf("%s = %v, want ErrRequired for Account.%s", op, err, tt.field)
+				}
+			}
+			check("Validate", v.Validate())
+			check("Add", client.Add(ctx, v))
+			_, err := client.Create(ctx, v)
+			check("Create", err)
+			if len(conn.inserted) != 0 || len(conn.Set) != 0 {
+				t.Errorf("invalid account was written: inserted %v, mutations %q", conn.inserted, conn.Set)
+			}
+		})
+	}
+
+	// Nickname is not required, so it may be left empty.
+	conn := &insertRecorder{Recorder: &modusgraph.Recorder{
+		MutateResp: &api.Response{Uids: map[string]string{"node": "0x1"}},
+	}}
+	client := NewFromClient(conn).Account
+	if err := valid().Validate(); err != nil {
+		t.Errorf("Validate = %v for a valid account", err)
+	}
+	if err := client.Add(ctx, valid()); err != nil || len(conn.inserted) != 1 {
+		t.Errorf("Add = %v, inserted %d nodes; want it inserted", err, len(conn.inserted))
+	}
+	if uid, err := client.Create(ctx, valid()); err != nil || uid != "0x1" || len(conn.Set) != 1 {
+		t.Errorf("Create = %q, %v with mutations %q; want 0x1 from one mutation", uid, err, conn.Set)
+	}
+}
+`
+
+// TestGenerateRequiredValidation compiles the generated Validate and checks
+// that it reports each empty required field, that Add and Create fail
+// without writing when it does, and that optional fields may be left empty.
+func TestGenerateRequiredValidation(t *testing.T) {
+	runGeneratedTest(t, "required", requiredTest, nil)
+}
+
+// loadTest is run against the mock fixture and its generated Load method.
+const loadTest = `package mock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// storedConn is a Recorder whose Get decodes node, recording the UID asked for.
+type storedConn struct {
+	*modusgraph.Recorder
+	node string
+	uid  string
+}
+
+func (c *storedConn) Get(ctx context.Context, obj any, uid string) error {
+	c.uid = uid
+	return json.Unmarshal([]byte(c.node), obj)
+}
+
+func TestLoad(t *testing.T) {
+	ctx := context.Background()
+	conn := &storedConn{
+		Recorder: &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x2","name":"Grace","teams":[{"uid":"0x3","label":"Core"}]}]}` + "`" + `},
+		node:     ` + "`" + `{"uid":"0x1","name":"Ada","email":"ada@example.com"}` + "`" + `,
+	}
+	client := NewFromClient(conn)
+
+	// Load replaces the whole receiver, so fields it had are not kept.
+	v := Person{Name: "stale", Age: 9}
+	if err := v.Load(ctx, client, "0x1"); err != nil {
+		t.Fatal(err)
+	}
+	if want := (Person{UID: "0x1", Name: "Ada", Email: "ada@example.com"}); !reflect.DeepEqual(v, want) || conn.uid != "0x1" {
+		t.Errorf("Load(0x1) = %+v from %s, want %+v", v, conn.uid, want)
+	}
+
+	// Options are passed on to Get, which expands the edges.
+	if err := v.Load(ctx, client, "0x2", WithDepth(1)); err != nil {
+		t.Fatal(err)
+	}
+	if v.UID != "0x2" || v.Name != "Grace" || len(v.Teams) != 1 || v.Teams[0].Label != "Core" || conn.LastVars["$uid"] != "0x2" {
+		t.Errorf("Load(0x2, WithDepth(1)) = %+v", v)
+	}
+
+	// A failed Load leaves the receiver as it was.
+	conn.Resp = ` + "`" + `{"q":[]}` + "`" + `
+	if err := v.Load(ctx, client, "0x4", WithDepth(1)); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Load(missing) = %v, want ErrNotFound", err)
+	}
+	if v.UID != "0x2" {
+		t.Errorf("failed Load changed the receiver to %+v", v)
+	}
+}
+`
+
+// TestGenerateLoad compiles the generated Load method and checks that it
+// fills its receiver from the client's Get, so that a zero-value entity can
+// be loaded in place.
+func TestGenerateLoad(t *testing.T) {
+	runGeneratedTest(t, "mock", loadTest, nil)
+}
+
+// nullRoundTripTest is run against the nulls fixture and its generated JSON
+// methods and options.
+const nullRoundTripTest = `package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestRoundTrip(t *testing.T) {
+	in := Legacy{
+		Title: sql.NullString{String: "Alien", Valid: true},
+		Count: sql.NullInt64{Int64: 42, Valid: true},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), ` + "`" + `{"title":"Alien","count":42}` + "`" + `; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+	var out Legacy
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Title != in.Title || out.Count != in.Count || out.Score.Valid {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// Invalid values: omitempty fields are left out, others are null.
+	data, err = json.Marshal(Legacy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), ` + "`" + `{"count":null}` + "`" + `; got != want {
+		t.Errorf("Marshal(invalid) = %s, want %s", got, want)
+	}
+	out = Legacy{Title: sql.NullString{String: "stale", Valid: true}}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Title.Valid || out.Title.String != "" || out.Count.Valid {
+		t.Errorf("Unmarshal(invalid) = %+v, want all fields invalid", out)
+	}
+}
+
+func TestPointerRoundTrip(t *testing.T) {
+	var in Entry
+	ApplyEntryOptions(&in, WithEntryMemo(&sql.NullString{String: "paid", Valid: true}),
+		WithEntryPosted(&sql.NullTime{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Valid: true}))
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), ` + "`" + `{"memo":"paid","posted":"2024-01-02T00:00:00Z"}` + "`" + `; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+	var out Entry
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Memo == nil || *out.Memo != *in.Memo || out.Posted == nil || !out.Posted.Time.Equal(in.Posted.Time) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// Nil and invalid pointers are both left out, and decode as nil.
+	for _, e := range []Entry{{}, {Memo: &sql.NullString{}}} {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "{}" {
+			t.Errorf("Marshal(%+v) = %s, want {}", e, data)
+		}
+	}
+	out = Entry{Memo: &sql.NullString{String: "stale", Valid: true}}
+	if err := json.Unmarshal([]byte(` + "`" + `{"memo":null}` + "`" + `), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Memo != nil || out.Posted != nil {
+		t.Errorf("Unmarshal(null) = %+v, want nil pointers", out)
+	}
+}
+`
+
+// TestGenerateNullRoundTrip compiles the generated sql.Null* JSON methods
+// and checks that sql.NullString and sql.NullInt64, and pointers to
+// sql.NullString and sql.NullTime, survive a round trip.
+func TestGenerateNullRoundTrip(t *testing.T) {
+	runGeneratedTest(t, "nulls", nullRoundTripTest, []Option{WithMock()})
+}
+
+// rawJSONTest is run against the rawjson fixture and its generated JSON
+// methods.
+const rawJSONTest = `package rawjson
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// response is a Dgraph query result: a date-only datetime, a GeoJSON point,
+// and a uid edge returned as a single object.
+const response = ` + "`" + `{
+	"uid": "0x1",
+	"dgraph.type": ["Venue"],
+	"name": "Paradiso",
+	"opened": "1968-03-30",
+	"closed": "2020-03-13T23:00:00Z",
+	"loc": {"type": "Point", "coordinates": [4.8838, 52.3622]},
+	"acts": {"uid": "0x2", "name": "Pink Floyd", "start": "1968-03-30T21:00:00"}
+}` + "`" + `
+
+func TestDecodeResponse(t *testing.T) {
+	var v Venue
+	if err := json.Unmarshal([]byte(response), &v); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(1968, 3, 30, 0, 0, 0, 0, time.UTC); !v.Opened.Equal(want) {
+		t.Errorf("Opened = %v, want %v", v.Opened, want)
+	}
+	if v.Closed == nil || v.Closed.Year() != 2020 {
+		t.Errorf("Closed = %v, want 2020-03-13", v.Closed)
+	}
+	if len(v.Loc) != 2 || v.Loc[0] != 4.8838 || v.Loc[1] != 52.3622 {
+		t.Errorf("Loc = %v, want [4.8838 52.3622]", v.Loc)
+	}
+	if len(v.Acts) != 1 || v.Acts[0].UID != "0x2" || v.Acts[0].Start.Hour() != 21 {
+		t.Errorf("Acts = %+v, want one act starting at 21:00", v.Acts)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	in := Venue{
+		UID:  "0x1",
+		Name: "Paradiso",
+		Loc:  []float64{4.8838, 52.3622},
+		Acts: []Act{{UID: "0x2", Start: time.Date(1968, 3, 30, 21, 0, 0, 0, time.UTC)}},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The zero Opened is left out and Loc, encoded after the plain fields, is
+	// a GeoJSON point.
+	want := ` + "`" + `{"uid":"0x1","name":"Paradiso","acts":[{"uid":"0x2","start":"1968-03-30T21:00:00Z"}],"loc":{"type":"Point","coordinates":[4.8838,52.3622]}}` + "`" + `
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var out Venue
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Name != in.Name || len(out.Loc) != 2 || len(out.Acts) != 1 || !out.Acts[0].Start.Equal(in.Acts[0].Start) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// Null clears values decoded earlier.
+	if err := json.Unmarshal([]byte(` + "`" + `{"closed":null,"loc":null,"acts":null}` + "`" + `), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Closed != nil || out.Loc != nil || out.Acts != nil {
+		t.Errorf("after nulls = %+v, want Closed, Loc and Acts cleared", out)
+	}
+
+	if err := json.Unmarshal([]byte(` + "`" + `{"opened":"March 1968"}` + "`" + `), &out); err == nil {
+		t.Error("Unmarshal accepted an unrecognized datetime")
+	}
+}
+`
+
+// TestGenerateRawJSON compiles the generated datetime, geo, and edge JSON
+// methods and checks that a raw Dgraph response decodes and round-trips.
+func TestGenerateRawJSON(t *testing.T) {
+	runGeneratedTest(t, "rawjson", rawJSONTest, nil)
+}
+
+// mapsTest is run against the maps fixture and its generated JSON methods
+// and accessors.
+const mapsTest = `package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMapRoundTrip(t *testing.T) {
+	var in Asset
+	in.SetLabelsValue("team", "infra")
+	in.SetAttrsValue("color", "red")
+	if got := in.LabelsValue("team"); got != "infra" {
+		t.Errorf("LabelsValue = %q, want infra", got)
+	}
+
+	// Maps are stored as JSON text; empty ones are null or left out.
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ` + "`" + `{"labels":"{\"team\":\"infra\"}","scores":null,"attrs":"{\"color\":\"red\"}"}` + "`" + `
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	out := Asset{Labels: map[string]string{"stale": "x"}}
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// Plain JSON objects are accepted too.
+	if err := json.Unmarshal([]byte(` + "`" + `{"scores":{"a":1},"labels":""}` + "`" + `), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ScoresValue("a") != 1 || out.Labels != nil {
+		t.Errorf("Unmarshal(object) = %+v, want scores a=1 and no labels", out)
+	}
+}
+`
+
+// TestGenerateMapRoundTrip compiles the generated map JSON methods and checks
+// that map fields survive a round trip through their stored JSON text.
+func TestGenerateMapRoundTrip(t *testing.T) {
+	runGeneratedTest(t, "maps", mapsTest, nil)
+}
+
+// facetsTest is run against the facets fixture and its generated JSON
+// methods.
+const facetsTest = `package facets
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCountRoundTrip(t *testing.T) {
+	// A count and a facet-ordered expansion of the same edge decode together.
+	raw := ` + "`" + `{"uid":"0x1","performanceCount":2,"performances":[{"uid":"0x2","filmCount":1},{"uid":"0x3"}]}` + "`" + `
+	var film Film
+	if err := json.Unmarshal([]byte(raw), &film); err != nil {
+		t.Fatal(err)
+	}
+	if film.PerformanceCount != 2 || len(film.Performances) != 2 || film.Performances[0].FilmCount != 1 {
+		t.Errorf("Unmarshal = %+v, want 2 counted and expanded performances", film)
+	}
+
+	// Counts are computed by Dgraph and never written.
+	data, err := json.Marshal(film)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ` + "`" + `{"uid":"0x1","performances":[{"uid":"0x2"},{"uid":"0x3"}]}` + "`" + `
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+}
+`
+
+// TestGenerateCountRoundTrip compiles the generated JSON methods of an entity
+// with count= fields and checks that counts are read but not written.
+func TestGenerateCountRoundTrip(t *testing.T) {
+	runGeneratedTest(t, "facets", facetsTest, nil)
+}
+
+// countEdgesTest is run against the facets fixture and its generated
+// Count<Field> method.
+const countEdgesTest = `package facets
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestCountPerformances(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"count(performance)":3}]}` + "`" + `}
+	client := NewFromClient(conn)
+	n, err := client.Film.CountPerformances(ctx, "0x1")
+	if err != nil || n != 3 {
+		t.Fatalf("CountPerformances = %d, %v; want 3", n, err)
+	}
+	if !strings.Contains(conn.LastQuery, "@filter(type(Film)) { count(performance) }") || conn.LastVars["$uid"] != "0x1" {
+		t.Errorf("query = %q, vars = %v", conn.LastQuery, conn.LastVars)
+	}
+
+	conn.Resp = ` + "`" + `{"q":[]}` + "`" + `
+	if _, err := client.Film.CountPerformances(ctx, "0x2"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("CountPerformances(missing) error = %v, want ErrNotFound", err)
+	}
+}
+`
+
+// singleEdgesTest is run against the single fixture and its generated JSON
+// and String methods.
+const singleEdgesTest = `package single
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecodeSingleEdges(t *testing.T) {
+	data := []byte(` + "`" + `{
+		"uid": "0x2",
+		"studio": {"uid": "0x10", "name": "Pixar"},
+		"director": {"uid": "0x20"},
+		"prequel": [{"uid": "0x1", "name": "Toy Story"}]
+	}` + "`" + `)
+	var f Film
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if f.Studio == nil || f.Studio.Name != "Pixar" {
+		t.Errorf("Studio = %+v, want Pixar", f.Studio)
+	}
+	if f.Director.UID != "0x20" {
+		t.Errorf("Director.UID = %q, want 0x20", f.Director.UID)
+	}
+	if f.Prequel == nil || f.Prequel.Name != "Toy Story" {
+		t.Errorf("Prequel = %+v, want the first element of the reverse list", f.Prequel)
+	}
+	if f.Sequel != nil {
+		t.Errorf("Sequel = %+v, want nil", f.Sequel)
+	}
+	if got, want := f.String(), "Film(0x2 studio=1 director=1 sequel=0 prequel=1)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	if err := json.Unmarshal([]byte(` + "`" + `{"prequel": []}` + "`" + `), &f); err != nil || f.Prequel == nil {
+		t.Errorf("empty reverse list: Prequel = %+v, err = %v; want it left as is", f.Prequel, err)
+	}
+}
+`
+
+// TestGenerateSingleEdges compiles the generated code for edges to a single
+// node, e.g. *Film, and checks that a reverse edge's list decodes into it.
+func TestGenerateSingleEdges(t *testing.T) {
+	runGeneratedTest(t, "single", singleEdgesTest, nil)
+}
+
+// localesTest is run against the locales fixture and its generated JSON
+// methods and selection.
+const localesTest = `package locales
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestLocalesRoundTrip(t *testing.T) {
+	p := Place{UID: "0x1", Name: map[string]string{"en": "Lisbon", "pt-BR": "Lisboa"}}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), ` + "`" + `{"uid":"0x1","name@en":"Lisbon","name@pt-BR":"Lisboa"}` + "`" + `; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+	var back Place
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, p) {
+		t.Errorf("round trip = %+v, want %+v", back, p)
+	}
+
+	p.Name["de"] = "Lissabon"
+	if _, err := json.Marshal(p); err == nil || !strings.Contains(err.Error(), ` + "`" + `"de"` + "`" + `) {
+		t.Errorf("Marshal with an undeclared locale: error = %v, want one naming it", err)
+	}
+}
+
+func TestLocalesGet(t *testing.T) {
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name@en":"Lisbon","name@fr":"Lisbonne"}]}` + "`" + `}
+	p, err := NewFromClient(conn).Place.Get(context.Background(), "0x1", WithDepth(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.NameEn() != "Lisbon" || p.NameFr() != "Lisbonne" || p.NamePtBR() != "" {
+		t.Errorf("Name = %v, want Lisbon and Lisbonne", p.Name)
+	}
+	if !strings.Contains(conn.LastQuery, "name@en name@fr name@pt-BR") {
+		t.Errorf("query = %q, want each locale of name selected", conn.LastQuery)
+	}
+}
+`
+
+// TestGenerateLocales compiles the generated JSON methods of a locales= map
+// and checks that it is sent and read as language-tagged predicates.
+func TestGenerateLocales(t *testing.T) {
+	runGeneratedTest(t, "locales", localesTest, nil)
+}
+
+// TestGenerateCountEdges compiles the generated Count<Field> method and checks
+// the count query it runs.
+func TestGenerateCountEdges(t *testing.T) {
+	runGeneratedTest(t, "facets", countEdgesTest, nil)
+}
+
+// vectorsTest is run against the vectors fixture and its generated SimilarTo
+// methods.
+const vectorsTest = `package vectors
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestSimilarTo(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[
+		{"uid":"0x1","title":"far","embedding":[0,1],"position":[3,4]},
+		{"uid":"0x2","title":"near","embedding":[1,0],"position":[0,0]}
+	]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	matches, err := client.Doc.SimilarToEmbedding(ctx, []float32{1, 0.5}, 2)
+	if err != nil {
+		t.Fatalf("SimilarToEmbedding failed: %v", err)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `similar_to(doc_embedding, 2, "[1,0.5]")) @filter(type(Doc))` + "`" + `) {
+		t.Errorf("query = %q", conn.LastQuery)
+	}
+	if len(matches) != 2 || matches[0].Title != "near" || matches[1].Title != "far" {
+		t.Fatalf("matches = %+v, want near then far", matches)
+	}
+	if d := matches[0].Distance; d < 0.105 || d > 0.106 {
+		t.Errorf("cosine distance = %v, want about 0.1056", d)
+	}
+
+	matches, err = client.Doc.SimilarToPosition(ctx, []float32{0, 0}, 2)
+	if err != nil {
+		t.Fatalf("SimilarToPosition failed: %v", err)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `similar_to(position, 2, "[0,0]")` + "`" + `) {
+		t.Errorf("query = %q", conn.LastQuery)
+	}
+	if matches[0].Distance != 0 || matches[1].Distance != 5 {
+		t.Errorf("euclidean distances = %v, %v; want 0, 5", matches[0].Distance, matches[1].Distance)
+	}
+}
+`
+
+// TestGenerateSimilarTo compiles the generated SimilarTo<Field> methods and
+// checks the similar_to query and distances they produce.
+func TestGenerateSimilarTo(t *testing.T) {
+	runGeneratedTest(t, "vectors", vectorsTest, nil)
+}
+
+// passwordsTest is run against the passwords fixture and its generated
+// CheckPassword method.
+const passwordsTest = `package passwords
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestCheckPassword(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"checkpwd(password)":true}]}` + "`" + `}
+	client := NewFromClient(conn)
+	ok, err := client.Account.CheckPassword(ctx, "0x1", "s3cret")
+	if err != nil || !ok {
+		t.Fatalf("CheckPassword = %v, %v; want true", ok, err)
+	}
+	if !strings.Contains(conn.LastQuery, "checkpwd(password, $password)") || strings.Contains(conn.LastQuery, "s3cret") {
+		t.Errorf("query = %q, want checkpwd with the plaintext as a variable", conn.LastQuery)
+	}
+	if conn.LastVars["$uid"] != "0x1" || conn.LastVars["$password"] != "s3cret" {
+		t.Errorf("vars = %v", conn.LastVars)
+	}
+
+	conn.Resp = ` + "`" + `{"q":[{"checkpwd(password)":false}]}` + "`" + `
+	if ok, err := client.Account.CheckPassword(ctx, "0x1", "wrong"); err != nil || ok {
+		t.Errorf("CheckPassword(wrong) = %v, %v; want false", ok, err)
+	}
+	conn.Resp = ` + "`" + `{"q":[]}` + "`" + `
+	if _, err := client.Account.CheckPassword(ctx, "0x2", "s3cret"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("CheckPassword(missing) error = %v, want ErrNotFound", err)
+	}
+}
+`
+
+// updatePasswordTest is run against the passwords fixture and its generated
+// Update and MockClient Update, which leave an empty Password out.
+const updatePasswordTest = `package passwords
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// updateConn records the entities passed to its Update.
+type updateConn struct {
+	*modusgraph.Recorder
+	updated []any
+}
+
+func (c *updateConn) Update(ctx context.Context, obj any) error {
+	c.updated = append(c.updated, obj)
+	return nil
+}
+
+func TestUpdateKeepsPassword(t *testing.T) {
+	ctx := context.Background()
+	conn := &updateConn{Recorder: &modusgraph.Recorder{}}
+	client := NewFromClient(conn)
+
+	if err := client.Account.Update(ctx, &Account{UID: "0x1", Email: "ada@example.com"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(conn.updated) != 0 || len(conn.Set) != 1 {
+		t.Fatalf("Update made %d Updates and %d mutations, want 0 and 1", len(conn.updated), len(conn.Set))
+	}
+	if set := conn.Set[0]; strings.Contains(set, "password") || !strings.Contains(set, ` + "`" + `"uid":"0x1"` + "`" + `) || !strings.Contains(set, "ada@example.com") {
+		t.Errorf("Update sent %s, want the uid and email without the password", set)
+	}
+
+	if err := client.Account.Update(ctx, &Account{UID: "0x1", Password: "n3w"}); err != nil {
+		t.Fatalf("Update with a password: %v", err)
+	}
+	if len(conn.updated) != 1 || len(conn.Set) != 1 {
+		t.Errorf("Update with a password made %d Updates and %d mutations, want 1 and 1", len(conn.updated), len(conn.Set))
+	}
+
+	if err := client.Account.Update(ctx, &Account{Email: "ada@example.com"}); err == nil {
+		t.Error("Update without a UID succeeded")
+	}
+
+	mock := NewMockClient()
+	a := &Account{Email: "ada@example.com", Password: "s3cret"}
+	if err := mock.Account.Add(ctx, a); err != nil {
+		t.Fatalf("mock Add: %v", err)
+	}
+	if err := mock.Account.Update(ctx, &Account{UID: a.UID, Email: "lovelace@example.com"}); err != nil {
+		t.Fatalf("mock Update: %v", err)
+	}
+	if got, err := mock.Account.Get(ctx, a.UID); err != nil || got.Password != "s3cret" || got.Email != "lovelace@example.com" {
+		t.Errorf("mock Get after Update = %+v, %v; want the new email and the old password", got, err)
+	}
+}
+`
+
+// TestGenerateUpdatePassword compiles the generated Update of an entity with
+// a password and checks that an empty password is not written over the
+// stored one.
+func TestGenerateUpdatePassword(t *testing.T) {
+	runGeneratedTest(t, "passwords", updatePasswordTest, []Option{WithMock()})
+}
+
+// TestGenerateCheckPassword compiles the generated CheckPassword method and
+// checks the checkpwd query it runs.
+func TestGenerateCheckPassword(t *testing.T) {
+	runGeneratedTest(t, "passwords", passwordsTest, nil)
+}
+
+// searchTest is run against the aliases fixture and its generated
+// SearchAllOfText and SearchAnyOfText methods.
+const searchTest = `package aliases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestSearchText(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Ada Lovelace"}]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	people, err := client.Person.SearchAllOfText(ctx, "ada lovelace", First(5), Offset(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 1 || people[0].Name != "Ada Lovelace" {
+		t.Errorf("SearchAllOfText = %+v", people)
+	}
+	if !strings.Contains(conn.LastQuery, "q(func: alloftext(name, $terms), first: 5, offset: 10) @filter(type(Person))") {
+		t.Errorf("query = %q, want alloftext as the root function", conn.LastQuery)
+	}
+	if strings.Contains(conn.LastQuery, "lovelace") || conn.LastVars["$terms"] != "ada lovelace" {
+		t.Errorf("query = %q, vars = %v; want the terms as a variable", conn.LastQuery, conn.LastVars)
+	}
+
+	conn.Resp = ` + "`" + `{"q":[]}` + "`" + `
+	people, err = client.Person.SearchAnyOfText(ctx, "ada grace")
+	if err != nil || len(people) != 0 {
+		t.Errorf("SearchAnyOfText = %v, %v; want no results", people, err)
+	}
+	if !strings.Contains(conn.LastQuery, "q(func: anyoftext(name, $terms), first: 50) @filter(type(Person))") {
+		t.Errorf("query = %q, want anyoftext as the root function", conn.LastQuery)
+	}
+}
+`
+
+// TestGenerateSearchText compiles the generated fulltext search methods and
+// checks the root functions they query with.
+func TestGenerateSearchText(t *testing.T) {
+	runGeneratedTest(t, "aliases", searchTest, nil)
+}
+
+// multiSearchTest is run against the multisearch fixture and its generated
+// per-field search methods.
+const multiSearchTest = `package multisearch
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestSearchFields(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[]}` + "`" + `}
+	client := NewFromClient(conn)
+	for _, tt := range []struct {
+		search func(context.Context, string, ...PageOption) ([]Film, error)
+		want   string
+	}{
+		{client.Film.SearchAllOfText, "alloftext(name, $terms)"},
+		{client.Film.SearchTagline, "alloftext(tagline, $terms)"},
+		{client.Film.SearchName, "alloftext(name, $terms)"},
+		{client.Film.SearchSynopsis, "alloftext(film_synopsis, $terms)"},
+	} {
+		if _, err := tt.search(ctx, "heist"); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(conn.LastQuery, tt.want) {
+			t.Errorf("query = %q, want %s", conn.LastQuery, tt.want)
+		}
+	}
+}
+`
+
+// TestGenerateSearchFields compiles a search method per fulltext field and
+// checks that the default ones use the field named Name.
+func TestGenerateSearchFields(t *testing.T) {
+	runGeneratedTest(t, "multisearch", multiSearchTest, nil)
+}
+
+// termsTest is run against the terms fixture and its generated term filters
+// and regexp methods.
+const termsTest = `package terms
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestTitleRegexp(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","title":"and/or"}]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	articles, err := client.Article.TitleRegexp(ctx, "^and/or$", First(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(articles) != 1 || articles[0].Title != "and/or" {
+		t.Errorf("TitleRegexp = %+v", articles)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `@filter(regexp(article_title, /^and\/or$/))` + "`" + `) {
+		t.Errorf("query = %q, want regexp on article_title with the slash escaped", conn.LastQuery)
+	}
+
+	// An already escaped slash is not escaped again.
+	if _, err := client.Article.TitleRegexp(ctx, ` + "`" + `a\/b` + "`" + `); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `regexp(article_title, /a\/b/)` + "`" + `) {
+		t.Errorf("query = %q, want the escaped slash kept as is", conn.LastQuery)
+	}
+
+	conn.LastQuery = ""
+	if _, err := client.Article.TitleRegexp(ctx, "(unclosed"); err == nil {
+		t.Error("TitleRegexp with an invalid pattern succeeded")
+	}
+	if conn.LastQuery != "" {
+		t.Errorf("TitleRegexp with an invalid pattern ran %q", conn.LastQuery)
+	}
+}
+
+func TestTermFilters(t *testing.T) {
+	q := (&ArticleClient{}).Query(context.Background()).
+		TitleAllOfTerms("graph \"databases\"").
+		TagsAnyOfTerms("go dgraph")
+	want := ` + "`" + `(allofterms(article_title, "graph \"databases\"")) AND anyofterms(article_tag, "go dgraph")` + "`" + `
+	if q.filter != want {
+		t.Errorf("filter = %s, want %s", q.filter, want)
+	}
+}
+`
+
+// lookupTest is run against the mock fixture and its generated GetBy methods
+// and exact-index range filters.
+const lookupTest = `package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestGetBy(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Ada"},{"uid":"0x2","name":"Ada"}]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	people, err := client.Person.GetByName(ctx, "Ada", First(10))
+	if err != nil || len(people) != 2 {
+		t.Fatalf("GetByName = %v, %v; want two people", people, err)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `first: 10) @filter(eq(name, "Ada"))` + "`" + `) {
+		t.Errorf("query = %q, want eq on name", conn.LastQuery)
+	}
+
+	// Email is unique: two matches are an error, none is ErrNotFound.
+	if _, err := client.Person.GetByEmail(ctx, "ada@example.com"); !errors.Is(err, ErrNotUnique) {
+		t.Errorf("GetByEmail with two matches: error = %v, want ErrNotUnique", err)
+	}
+	if !strings.Contains(conn.LastQuery, ` + "`" + `first: 2) @filter(eq(email, "ada@example.com"))` + "`" + `) {
+		t.Errorf("query = %q, want eq on email limited to two results", conn.LastQuery)
+	}
+	conn.Resp = ` + "`" + `{"q":[{"uid":"0x1","email":"ada@example.com"}]}` + "`" + `
+	p, err := client.Person.GetByEmail(ctx, "ada@example.com")
+	if err != nil || p.UID != "0x1" {
+		t.Errorf("GetByEmail = %+v, %v; want 0x1", p, err)
+	}
+	conn.Resp = ` + "`" + `{"q":[]}` + "`" + `
+	if _, err := client.Person.GetByEmail(ctx, "nobody@example.com"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetByEmail with no match: error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestExactRangeFilters(t *testing.T) {
+	q := (&PersonClient{}).Query(context.Background()).
+		EmailGe("a").
+		EmailLe("m").
+		EmailBetween("b", "c")
+	want := ` + "`" + `((ge(email, "a")) AND le(email, "m")) AND between(email, "b", "c")` + "`" + `
+	if q.filter != want {
+		t.Errorf("filter = %s, want %s", q.filter, want)
+	}
+}
+`
+
+// TestGenerateLookups compiles the generated GetBy methods and exact-index
+// range filters and checks the queries they build.
+func TestGenerateLookups(t *testing.T) {
+	runGeneratedTest(t, "mock", lookupTest, []Option{WithMock()})
+}
+
+// TestGenerateTermFilters compiles the generated allofterms and anyofterms
+// filters and regexp methods and checks that they use the resolved predicates.
+func TestGenerateTermFilters(t *testing.T) {
+	runGeneratedTest(t, "terms", termsTest, nil)
+}
+
+// streamTest is run against the selfref fixture and its generated StreamPeople
+// method.
+const streamTest = `package selfref
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// pagingConn answers every query with pages of people, the last of which is
+// short once total people have been sent; a negative total never ends.
+type pagingConn struct {
+	modusgraph.Client
+	total, sent int
+}
+
+func (c *pagingConn) Query(ctx context.Context, model any) *modusgraph.Query {
+	return &modusgraph.Query{NodesFunc: func(v any) error {
+		page := v.(*[]Person)
+		for i := 0; i < 2 && (c.total < 0 || c.sent < c.total); i++ {
+			c.sent++
+			*page = append(*page, Person{UID: fmt.Sprintf("0x%x", c.sent)})
+		}
+		return nil
+	}}
+}
+
+func TestStream(t *testing.T) {
+	client := NewFromClient(&pagingConn{total: 5})
+	people, errc := client.Person.StreamPeople(context.Background(), First(2))
+	var uids []string
+	for p := range people {
+		uids = append(uids, p.UID)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("StreamPeople error = %v", err)
+	}
+	if fmt.Sprint(uids) != "[0x1 0x2 0x3 0x4 0x5]" {
+		t.Errorf("streamed %v, want 0x1 through 0x5", uids)
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewFromClient(&pagingConn{total: -1})
+	people, errc := client.Person.StreamPeople(ctx, First(2))
+	if p := <-people; p == nil || p.UID != "0x1" {
+		t.Fatalf("first person = %+v, want 0x1", p)
+	}
+	cancel()
+	n := 0
+	for range people {
+		n++
+	}
+	if n > 1 {
+		t.Errorf("%d people sent after cancel, want at most 1", n)
+	}
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("StreamPeople error = %v, want context.Canceled", err)
+	}
+}
+`
+
+// TestGenerateStream compiles the generated StreamPeople method and checks that
+// it sends every page and stops when its context is canceled.
+func TestGenerateStream(t *testing.T) {
+	runGeneratedTest(t, "selfref", streamTest, nil)
+}
+
+// retryTest is run against the selfref fixture and its generated WithRetry
+// client option.
+const retryTest = `package selfref
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// flakyConn fails each operation with errs, one per call, before succeeding.
+type flakyConn struct {
+	modusgraph.Client
+	errs  []error
+	calls int
+}
+
+func (c *flakyConn) next() error {
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *flakyConn) Insert(ctx context.Context, obj any) error            { return c.next() }
+func (c *flakyConn) Get(ctx context.Context, obj any, uid string) error { return c.next() }
+
+var errUnavailable = errors.New("rpc error: code = Unavailable desc = connection refused")
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		errs      []error
+		op        func(*Client) error
+		wantCalls int
+		wantErr   error
+	}{
+		{"write conflict", []error{dgo.ErrAborted, dgo.ErrAborted}, add, 3, nil},
+		{"write gives up", []error{dgo.ErrAborted, dgo.ErrAborted, dgo.ErrAborted, dgo.ErrAborted}, add, 3, dgo.ErrAborted},
+		{"write unavailable", []error{errUnavailable}, add, 1, errUnavailable},
+		{"read unavailable", []error{errUnavailable}, get, 2, nil},
+		{"read conflict", []error{dgo.ErrAborted}, get, 1, dgo.ErrAborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &flakyConn{errs: tt.errs}
+			err := tt.op(NewFromClient(conn, WithRetry(3, time.Millisecond)))
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+			if conn.calls != tt.wantCalls {
+				t.Errorf("%d calls, want %d", conn.calls, tt.wantCalls)
+			}
+		})
+	}
+
+	conn := &flakyConn{errs: []error{dgo.ErrAborted}}
+	if err := add(NewFromClient(conn)); !errors.Is(err, dgo.ErrAborted) || conn.calls != 1 {
+		t.Errorf("without WithRetry: %d calls, error %v; want 1 call failing", conn.calls, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	conn = &flakyConn{errs: []error{dgo.ErrAborted, dgo.ErrAborted}}
+	start := time.Now()
+	err := NewFromClient(conn, WithRetry(5, time.Hour)).Person.Add(ctx, &Person{})
+	if !errors.Is(err, dgo.ErrAborted) || conn.calls != 1 || time.Since(start) > time.Second {
+		t.Errorf("past the deadline: %d calls in %v, error %v; want 1 call failing at once", conn.calls, time.Since(start), err)
+	}
+}
+
+func add(c *Client) error { return c.Person.Add(context.Background(), &Person{}) }
+
+func get(c *Client) error {
+	_, err := c.Person.Get(context.Background(), "0x1", WithDepth(0))
+	return err
+}
+`
+
+// TestGenerateRetry compiles the generated WithRetry option and checks which
+// errors it retries, how often, and that the context deadline bounds it.
+func TestGenerateRetry(t *testing.T) {
+	runGeneratedTest(t, "selfref", retryTest, nil)
+}
+
+// dgoTest is run against the mock fixture and its generated NewClientWithDgo,
+// over a fake dgo.Dgraph that records its mutations and answers its queries.
+const dgoTest = `package mock
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+func TestNewClientWithDgo(t *testing.T) {
+	ctx := context.Background()
+	var set, del, queries []string
+	dg := &dgo.Dgraph{
+		MutateFunc: func(mu *api.Mutation) (*api.Response, error) {
+			set = append(set, string(mu.SetJson))
+			del = append(del, string(mu.DeleteJson))
+			return &api.Response{Uids: map[string]string{"node": "0x7"}}, nil
+		},
+		QueryFunc: func(q string, vars map[string]string) (*api.Response, error) {
+			queries = append(queries, q)
+			total := ""
+			if strings.Contains(q, "total(") {
+				total = ` + "`" + `,"total":[{"count":12}]` + "`" + `
+			}
+			return &api.Response{Json: []byte(` + "`" + `{"q":[{"uid":"0x7","name":"Ada","email":"ada@example.com","age":36}]` + "`" + ` + total + "}")}, nil
+		},
+	}
+	c := NewClientWithDgo(dg)
+	defer c.Close()
+
+	p := &Person{Name: "Ada", Email: "ada@example.com", Age: 36}
+	if err := c.Person.Add(ctx, p); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if p.UID != "0x7" {
+		t.Errorf("Add set UID %q, want 0x7", p.UID)
+	}
+	for _, want := range []string{` + "`" + `"uid":"_:node"` + "`" + `, ` + "`" + `"dgraph.type":["Person"]` + "`" + `, ` + "`" + `"age":36` + "`" + `} {
+		if len(set) != 1 || !strings.Contains(set[0], want) {
+			t.Errorf("Add sent %q, want %s in it", set, want)
+		}
+	}
+
+	got, err := c.Person.Get(ctx, "0x7")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got.Name != "Ada" || got.Age != 36 {
+		t.Errorf("Get = %+v", got)
+	}
+	if len(queries) != 1 || !strings.Contains(queries[0], "type(Person)") {
+		t.Errorf("Get queried %q", queries)
+	}
+
+	p.Name = "Ada Lovelace"
+	if err := c.Person.Update(ctx, p); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(set) != 2 || !strings.Contains(set[1], ` + "`" + `"uid":"0x7"` + "`" + `) || !strings.Contains(set[1], "Ada Lovelace") {
+		t.Errorf("Update sent %q", set[1:])
+	}
+
+	var people []Person
+	if err := c.Person.Query(ctx).Filter(` + "`" + `eq(name, "Ada")` + "`" + `).Exec(&people); err != nil {
+		t.Fatalf("Query.Exec: %v", err)
+	}
+	if len(people) != 1 || people[0].Email != "ada@example.com" {
+		t.Errorf("Exec = %+v", people)
+	}
+	if last := queries[len(queries)-1]; !strings.Contains(last, ` + "`" + `eq(name, "Ada")` + "`" + `) {
+		t.Errorf("Exec queried %q, want the filter in it", last)
+	}
+
+	// The methods that use modusgraph's query builder run their own DQL.
+	list, err := c.Person.List(ctx, First(5), Offset(10))
+	if err != nil || len(list) != 1 || list[0].UID != "0x7" {
+		t.Errorf("List = %+v, %v", list, err)
+	}
+	if last := queries[len(queries)-1]; !strings.Contains(last, "q(func: type(Person), first: 5, offset: 10)") {
+		t.Errorf("List queried %q", last)
+	}
+	found, err := c.Person.Search(ctx, "engine")
+	if err != nil || len(found) != 1 {
+		t.Errorf("Search = %+v, %v", found, err)
+	}
+	if last := queries[len(queries)-1]; !strings.Contains(last, "alloftext(bio, $terms)") {
+		t.Errorf("Search queried %q", last)
+	}
+	n, err := c.Person.Query(ctx).Filter("has(name)").First(1).ExecAndCount(&people)
+	if err != nil || n != 12 || len(people) != 1 {
+		t.Errorf("ExecAndCount = %d, %+v, %v; want 12 and one person", n, people, err)
+	}
+	it := c.Person.ResumeIterator("0x3", First(2))
+	if v, ok := it.Next(ctx); !ok || v.UID != "0x7" {
+		t.Errorf("Iterator.Next = %+v, %v, %v", v, ok, it.Err())
+	}
+	if last := queries[len(queries)-1]; !strings.Contains(last, "q(func: type(Person), first: 2, after: 0x3)") {
+		t.Errorf("Iterator queried %q", last)
+	}
+	if _, ok := it.Next(ctx); ok || it.Err() != nil {
+		t.Errorf("Iterator went on past a short page: %v", it.Err())
+	}
+	out, errc := c.Person.StreamPeople(ctx)
+	var streamed int
+	for range out {
+		streamed++
+	}
+	if err := <-errc; err != nil || streamed != 1 {
+		t.Errorf("StreamPeople sent %d, %v; want 1", streamed, err)
+	}
+
+	// WithUpsert updates the node an upsert block finds, or adds one.
+	var requests []*api.Request
+	dg.DoFunc = func(req *api.Request) (*api.Response, error) {
+		requests = append(requests, req)
+		if len(requests) == 1 {
+			return &api.Response{Json: []byte(` + "`" + `{"q":[{"uid":"0x9"}]}` + "`" + `)}, nil
+		}
+		return &api.Response{Uids: map[string]string{"uid(node)": "0xa"}}, nil
+	}
+	for _, want := range []string{"0x9", "0xa"} {
+		uid, err := c.Person.Create(ctx, &Person{Name: "Ada", Email: "ada@example.com"}, WithUpsert())
+		if err != nil || uid != want {
+			t.Errorf("Create WithUpsert = %q, %v; want %s", uid, err, want)
+		}
+	}
+	if req := requests[0]; !strings.Contains(req.Query, "eq(email, $value)") || req.Vars["$value"] != "ada@example.com" ||
+		!strings.Contains(string(req.Mutations[0].SetJson), ` + "`" + `"uid":"uid(node)"` + "`" + `) || !req.CommitNow {
+		t.Errorf("upsert request = %+v", req)
+	}
+
+	if err := c.Person.Delete(ctx, "0x7"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if last := del[len(del)-1]; last != ` + "`" + `[{"uid":"0x7"}]` + "`" + ` {
+		t.Errorf("Delete sent %s", last)
+	}
+}
+`
+
+// TestGenerateClientWithDgo compiles the generated NewClientWithDgo and checks
+// that it adds, gets, updates, queries, lists, searches, counts, iterates,
+// streams, upserts, and deletes over the bare connection.
+func TestGenerateClientWithDgo(t *testing.T) {
+	runGeneratedTest(t, "mock", dgoTest, nil)
+}
+
+// metricsTest is run against the selfref fixture and its generated
+// WithMetricsPrefix client option.
+const metricsTest = `package selfref
+
+import (
+	"context"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestMetricsPrefix(t *testing.T) {
+	ctx := context.Background()
+	a := NewFromClient(&flakyConn{errs: []error{dgo.ErrAborted}}, WithMetricsPrefix("clients_a"), WithRetry(2, time.Millisecond))
+	b := NewFromClient(&flakyConn{errs: []error{dgo.ErrAborted}}, WithMetricsPrefix("clients_b"))
+	again := NewFromClient(&flakyConn{}, WithMetricsPrefix("clients_b"))
+	NewFromClient(&flakyConn{}, WithMetricsPrefix(""))
+	NewFromClient(&flakyConn{}, WithMetricsPrefix("memstats"))
+
+	if err := a.Person.Add(ctx, &Person{}); err != nil {
+		t.Errorf("a: Add: %v", err)
+	}
+	if err := b.Person.Add(ctx, &Person{}); err == nil {
+		t.Error("b: Add succeeded, want the conflict")
+	}
+	if _, err := again.Person.Get(ctx, "0x1", WithDepth(0)); err != nil {
+		t.Errorf("again: Get: %v", err)
+	}
+
+	tests := []struct {
+		prefix, counter string
+		want            string
+	}{
+		{"clients_a", "writes", "1"},
+		{"clients_a", "write_errors", ""},
+		{"clients_a", "retries", "1"},
+		{"clients_b", "writes", "1"},
+		{"clients_b", "write_errors", "1"},
+		{"clients_b", "reads", "1"},
+		{"clients_b", "retries", ""},
+	}
+	for _, tt := range tests {
+		m, ok := expvar.Get(tt.prefix).(*expvar.Map)
+		if !ok {
+			t.Fatalf("%s: not published", tt.prefix)
+		}
+		got := ""
+		if v := m.Get(tt.counter); v != nil {
+			got = v.String()
+		}
+		if got != tt.want {
+			t.Errorf("%s.%s = %q, want %q", tt.prefix, tt.counter, got, tt.want)
+		}
+	}
+	if _, ok := expvar.Get("selfref").(*expvar.Map); !ok {
+		t.Error("the empty prefix did not publish under the package name")
+	}
+}
+
+// flakyConn fails each operation with errs, one per call, before succeeding.
+type flakyConn struct {
+	modusgraph.Client
+	errs []error
+}
+
+func (c *flakyConn) next() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *flakyConn) Insert(ctx context.Context, obj any) error            { return c.next() }
+func (c *flakyConn) Get(ctx context.Context, obj any, uid string) error { return c.next() }
+`
+
+// TestGenerateMetricsPrefix compiles the generated WithMetricsPrefix option
+// and checks that clients with different prefixes, or the same one, can be
+// made in one process and count their operations under their prefixes.
+func TestGenerateMetricsPrefix(t *testing.T) {
+	runGeneratedTest(t, "selfref", metricsTest, nil)
+}
+
+// hasTest is run against the selfref fixture and its generated existence
+// filters.
+const hasTest = `package selfref
+
+import (
+	"context"
+	"testing"
+)
+
+func TestHasFilters(t *testing.T) {
+	q := (&PersonClient{}).Query(context.Background()).
+		HasName().
+		NotMentors().
+		HasTeams()
+	want := "((has(name)) AND NOT has(mentor)) AND has(team)"
+	if q.filter != want {
+		t.Errorf("filter = %s, want %s", q.filter, want)
+	}
+}
+`
+
+// uidInTest is run against the selfref fixture and its generated uid_in
+// filters.
+const uidInTest = `package selfref
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestUIDInFilters(t *testing.T) {
+	q := (&PersonClient{}).Query(context.Background()).
+		MentorsContains("0x5").
+		TeamsContains("0x1a", "42")
+	want := "(uid_in(mentor, 0x5)) AND uid_in(team, [0x1a, 42])"
+	if q.filter != want || q.err != nil {
+		t.Errorf("filter = %s, err = %v, want %s", q.filter, q.err, want)
+	}
+
+	for _, uids := range [][]string{nil, {"0x5", "0x5) OR has(name"}, {"0x"}} {
+		q := (&PersonClient{}).Query(context.Background()).TeamsContains(uids...)
+		if q.filter != "" {
+			t.Errorf("TeamsContains(%q) set filter %s", uids, q.filter)
+		}
+		if err := q.Exec(nil); err == nil || !strings.HasPrefix(err.Error(), "Person.Teams: ") {
+			t.Errorf("TeamsContains(%q): Exec = %v, want an error naming the field", uids, err)
+		}
+	}
+}
+`
+
+// createTest is run against the mock fixture and its generated Create
+// methods.
+const createTest = `package mock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// Team has no upsert field, so its Create takes no CreateOption.
+var _ func(context.Context, *Team) (string, error) = (*TeamClient)(nil).Create
+
+// createConn is a Recorder that records the predicates of each upsert and
+// gives the upserted Person the UID 0x9.
+type createConn struct {
+	*modusgraph.Recorder
+	predicates []string
+}
+
+func (c *createConn) Upsert(ctx context.Context, obj any, predicates ...string) error {
+	c.predicates = predicates
+	obj.(*Person).UID = "0x9"
+	return nil
+}
+
+// abortingConn aborts its first mutation and its first upsert, as Dgraph does
+// in a conflict, and then succeeds.
+type abortingConn struct {
+	modusgraph.Client
+	mutations, upserts int
+}
+
+func (c *abortingConn) DgraphClient() (*dgo.Dgraph, func(), error) {
+	return &dgo.Dgraph{MutateFunc: func(mu *api.Mutation) (*api.Response, error) {
+		if c.mutations++; c.mutations == 1 {
+			return nil, dgo.ErrAborted
+		}
+		return &api.Response{Uids: map[string]string{"node": "0x7"}}, nil
+	}}, func() {}, nil
+}
+
+func (c *abortingConn) Upsert(ctx context.Context, obj any, predicates ...string) error {
+	if c.upserts++; c.upserts == 1 {
+		return dgo.ErrAborted
+	}
+	obj.(*Person).UID = "0x9"
+	return nil
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	conn := &createConn{Recorder: &modusgraph.Recorder{
+		MutateResp: &api.Response{Uids: map[string]string{"node": "0x7"}},
+	}}
+	client := NewFromClient(conn)
+
+	p := Person{Email: "a@example.com"}
+	uid, err := client.Person.Create(ctx, &p)
+	if err != nil || uid != "0x7" || p.UID != "0x7" {
+		t.Fatalf("Create = %q, %v with UID %q, want 0x7", uid, err, p.UID)
+	}
+	for _, want := range []string{` + "`" + `"uid":"_:node"` + "`" + `, ` + "`" + `"dgraph.type":["Person"]` + "`" + `} {
+		if len(conn.Set) != 1 || !strings.Contains(conn.Set[0], want) {
+			t.Errorf("set JSON %q lacks %s", conn.Set, want)
+		}
+	}
+
+	if _, err := client.Person.Create(ctx, &p); err == nil {
+		t.Error("Create succeeded for a Person that already has a UID")
+	}
+	if _, err := client.Person.Create(ctx, &Person{}); !errors.Is(err, ErrRequired) {
+		t.Errorf("Create without Email = %v, want ErrRequired", err)
+	}
+
+	uid, err = client.Person.Create(ctx, &Person{Email: "b@example.com"}, WithUpsert())
+	if err != nil || uid != "0x9" {
+		t.Errorf("Create(WithUpsert) = %q, %v, want 0x9", uid, err)
+	}
+	if len(conn.predicates) != 1 || conn.predicates[0] != "email" {
+		t.Errorf("upsert predicates = %v, want [email]", conn.predicates)
+	}
+
+	aborting := &abortingConn{}
+	client = NewFromClient(aborting, WithRetry(2, time.Millisecond))
+	if uid, err := client.Person.Create(ctx, &Person{Email: "d@example.com"}); err != nil || uid != "0x7" || aborting.mutations != 2 {
+		t.Errorf("Create with WithRetry = %q, %v after %d mutations, want 0x7 after 2", uid, err, aborting.mutations)
+	}
+	if uid, err := client.Person.Create(ctx, &Person{Email: "e@example.com"}, WithUpsert()); err != nil || uid != "0x9" || aborting.upserts != 2 {
+		t.Errorf("Create(WithUpsert) with WithRetry = %q, %v after %d upserts, want 0x9 after 2", uid, err, aborting.upserts)
+	}
+
+	mock := NewMockClient()
+	uid, err = mock.Person.Create(ctx, &Person{Email: "c@example.com"})
+	if err != nil || uid == "" {
+		t.Errorf("MockClient Create = %q, %v, want a UID", uid, err)
+	}
+}
+`
+
+// TestGenerateCreate compiles the generated Create methods and checks that
+// they return the UID assigned to the blank node, or go through Upsert, that
+// WithRetry retries both, and that only entities with upsert fields take
+// WithUpsert.
+func TestGenerateCreate(t *testing.T) {
+	runGeneratedTest(t, "mock", createTest, []Option{WithMock()})
+}
+
+// dateRangeTest is run against the required fixture and the generated date
+// range filters of its day-indexed Joined field.
+const dateRangeTest = `package required
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateRange(t *testing.T) {
+	from := time.Date(2020, time.January, 2, 3, 4, 5, 123456789, time.FixedZone("CET", 3600))
+	to := time.Date(2020, time.January, 2, 23, 0, 0, 500, time.UTC)
+	tests := []struct {
+		name string
+		got  Filter[Account]
+		want string
+	}{
+		{"DateBetween", AccountWhere.JoinedDateBetween(from, to),
+			` + "`" + `between(joined, "2020-01-02T02:04:05.123456789Z", "2020-01-02T23:00:00.0000005Z")` + "`" + `},
+		{"YearEquals", AccountWhere.JoinedYearEquals(1999),
+			` + "`" + `between(joined, "1999-01-01T00:00:00Z", "1999-12-31T23:59:59.999999999Z")` + "`" + `},
+		{"YearBetween", AccountWhere.JoinedYearBetween(1999, 2001),
+			` + "`" + `between(joined, "1999-01-01T00:00:00Z", "2001-12-31T23:59:59.999999999Z")` + "`" + `},
+	}
+	for _, tt := range tests {
+		if tt.got.expr != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got.expr, tt.want)
+		}
+	}
+}
+`
+
+// timeListsTest is run against the timelists fixture, whose only time fields
+// are a list and a pointer, and its generated options.
+const timeListsTest = `package timelists
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeOptions(t *testing.T) {
+	ended := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	dates := []time.Time{ended.AddDate(0, 0, -1), ended}
+	var e Event
+	ApplyEventOptions(&e, WithEventDates(dates), WithEventEnded(&ended))
+	if len(e.Dates) != 2 || !e.Dates[1].Equal(ended) || e.Ended != &ended {
+		t.Errorf("options set %+v", e)
+	}
+}
+`
+
+// TestGenerateTimeLists compiles the options of an entity whose time fields
+// are all lists or pointers, which must still import time.
+func TestGenerateTimeLists(t *testing.T) {
+	runGeneratedTest(t, "timelists", timeListsTest, nil)
+}
+
+// TestGenerateDateRange compiles the generated date range filters and checks
+// that their bounds keep sub-second precision and that a year ends at its
+// last nanosecond.
+func TestGenerateDateRange(t *testing.T) {
+	runGeneratedTest(t, "required", dateRangeTest, nil)
+}
+
+// setterTest is run against the required fixture and its generated
+// UpdateFields and Set<Field> methods.
+const setterTest = `package required
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestSetters(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name     string
+		op       func(c *AccountClient) error
+		set, del string
+	}{
+		{"scalar", func(c *AccountClient) error { return c.SetNickname(ctx, "0x1", "Al") },
+			` + "`" + `{"nickname":"Al","uid":"0x1"}` + "`" + `, ""},
+		{"named type", func(c *AccountClient) error { return c.SetHandle(ctx, "0x1", Handle("al")) },
+			` + "`" + `{"handle":"al","uid":"0x1"}` + "`" + `, ""},
+		{"list", func(c *AccountClient) error { return c.SetRoles(ctx, "0x1", []string{"admin"}) },
+			` + "`" + `{"roles":["admin"],"uid":"0x1"}` + "`" + `, ` + "`" + `{"roles":null,"uid":"0x1"}` + "`" + `},
+		{"fields", func(c *AccountClient) error {
+			return c.UpdateFields(ctx, "0x1", map[string]any{"age": 42, "nickname": "Al"})
+		}, ` + "`" + `{"age":42,"nickname":"Al","uid":"0x1"}` + "`" + `, ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &modusgraph.Recorder{}
+			if err := tt.op(NewFromClient(conn).Account); err != nil {
+				t.Fatal(err)
+			}
+			if len(conn.Set) != 1 || conn.Set[0] != tt.set || conn.Del[0] != tt.del {
+				t.Errorf("mutations set %q, delete %q; want set %s, delete %s", conn.Set, conn.Del, tt.set, tt.del)
+			}
+		})
+	}
+
+	conn := &modusgraph.Recorder{}
+	client := NewFromClient(conn).Account
+	if err := client.SetAge(ctx, "", 42); err == nil {
+		t.Error("SetAge succeeded without a UID")
+	}
+	if err := client.UpdateFields(ctx, "0x1", map[string]any{"uid": "0x2"}); err == nil {
+		t.Error("UpdateFields succeeded setting the uid")
+	}
+	if len(conn.Set) != 0 {
+		t.Errorf("invalid updates sent mutations %q", conn.Set)
+	}
+}
+`
+
+// TestGenerateSetters compiles the generated partial updates and checks that
+// they touch only the given predicates, replacing lists.
+func TestGenerateSetters(t *testing.T) {
+	runGeneratedTest(t, "required", setterTest, nil)
+}
+
+// recurseTest is run against the recurse fixture and its generated Recurse
+// methods.
+const recurseTest = `package recurse
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestRecurse(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Music","subgenres":[
+		{"uid":"0x2","name":"Rock","subgenres":[{"uid":"0x3","name":"Punk"}]}]}]}` + "`" + `}
+	client := NewFromClient(conn)
+	g, err := client.Genre.RecurseSubgenres(ctx, "0x1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Subgenres) != 1 || len(g.Subgenres[0].Subgenres) != 1 || g.Subgenres[0].Subgenres[0].Name != "Punk" {
+		t.Errorf("RecurseSubgenres = %+v, want Music > Rock > Punk", g)
+	}
+	for _, want := range []string{"@recurse(depth: 2, loop: false)", "subgenres: subgenre }"} {
+		if !strings.Contains(conn.Queries[0], want) {
+			t.Errorf("query %s lacks %s", conn.Queries[0], want)
+		}
+	}
+
+	for _, depth := range []int{0, maxRecurseDepth + 1} {
+		if _, err := client.Genre.RecurseParent(ctx, "0x3", depth); err == nil {
+			t.Errorf("RecurseParent at depth %d succeeded", depth)
+		}
+	}
+	if len(conn.Queries) != 1 {
+		t.Errorf("out-of-range depths sent queries: %q", conn.Queries[1:])
+	}
+}
+`
+
+// TestGenerateRecurse compiles the generated Recurse methods, only for
+// self-referential edges, and checks their @recurse queries and depth bound.
+func TestGenerateRecurse(t *testing.T) {
+	runGeneratedTest(t, "recurse", recurseTest, nil)
+	data, err := os.ReadFile(filepath.Join(fixtureDir(t, "recurse"), "golden", "genre_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "RecurseFilms") {
+		t.Error("RecurseFilms generated for an edge to another entity")
+	}
+}
+
+// expandedTest is run against the selfref fixture and its generated
+// GetExpanded methods.
+const expandedTest = `package selfref
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestGetExpanded(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{RespFunc: func(q string, vars map[string]string) (string, error) {
+		if vars["$uid"] != "0x1" {
+			return ` + "`" + `{"q":[]}` + "`" + `, nil
+		}
+		return ` + "`" + `{"q":[{"uid":"0x1","name":"Ada","team":[{"uid":"0x2"}]}]}` + "`" + `, nil
+	}}
+	client := NewFromClient(conn)
+	p, err := client.Person.GetExpanded(ctx, "0x1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Ada" || len(p.Teams) != 1 || p.Teams[0].UID != "0x2" {
+		t.Errorf("GetExpanded = %+v, want Ada with team 0x2", p)
+	}
+	for _, want := range []string{"@filter(type(Person))", "expand(_all_) { uid }"} {
+		if !strings.Contains(conn.LastQuery, want) {
+			t.Errorf("query %s lacks %s", conn.LastQuery, want)
+		}
+	}
+	if _, err := client.Person.GetExpanded(ctx, "0x9"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetExpanded(0x9) = %v, want ErrNotFound", err)
+	}
+}
+`
+
+// TestGenerateGetExpanded compiles the generated GetExpanded methods and
+// checks that they query expand(_all_) on nodes of the entity's type.
+func TestGenerateGetExpanded(t *testing.T) {
+	runGeneratedTest(t, "selfref", expandedTest, nil)
+}
+
+// withTest is run against the mock fixture and its generated query With
+// methods.
+const withTest = `package mock
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestQueryWith(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Ada","teams":[{"uid":"0x2","label":"Core"}]}]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	p, err := client.Person.Query(ctx).WithTeams().WithTeams().GetByUID("0x1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Ada" || len(p.Teams) != 1 || p.Teams[0].Label != "Core" {
+		t.Errorf("GetByUID = %+v, want Ada with team Core", p)
+	}
+	q := conn.Queries[len(conn.Queries)-1]
+	if want := "uid dgraph.type name email age bio teams: team { uid dgraph.type label }"; !strings.Contains(q, want) || strings.Count(q, "team {") != 1 {
+		t.Errorf("query %s does not select %s once", q, want)
+	}
+	if _, err := client.Person.Query(ctx).GetByUID("0x1"); err != nil {
+		t.Fatal(err)
+	}
+	if q := conn.Queries[len(conn.Queries)-1]; strings.Contains(q, "team") {
+		t.Errorf("query %s selects teams without WithTeams", q)
+	}
+
+	var people []Person
+	err = client.Person.Query(ctx).Filter("eq(name, \"Ada\")").OrderDesc("name").First(5).WithTeams().Exec(&people)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(people) != 1 || len(people[0].Teams) != 1 {
+		t.Errorf("Exec = %+v, want Ada with her team", people)
+	}
+	q = conn.Queries[len(conn.Queries)-1]
+	for _, want := range []string{"type(Person), orderdesc: name, first: 5", "@filter(eq(name, \"Ada\"))", "teams: team { uid dgraph.type label }"} {
+		if !strings.Contains(q, want) {
+			t.Errorf("query %s lacks %s", q, want)
+		}
+	}
+
+	if _, err := client.Person.Query(ctx).WithTeams().ExecAndCount(&people); err == nil {
+		t.Error("ExecAndCount with WithTeams succeeded, want an error")
+	}
+}
+`
+
+// TestGenerateQueryWith compiles the generated With methods and checks that
+// they add their edges to the one query of GetByUID and Exec.
+func TestGenerateQueryWith(t *testing.T) {
+	runGeneratedTest(t, "mock", withTest, nil)
+}
+
+// lazyTest is run against the mock fixture and its generated Load<Edge>
+// methods.
+const lazyTest = `package mock
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestLoadEdge(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","teams":[{"uid":"0x2","label":"Core"}]}]}` + "`" + `}
+	client := NewFromClient(conn)
+
+	p := Person{UID: "0x1"}
+	for range 2 {
+		teams, err := p.LoadTeams(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(teams) != 1 || teams[0].Label != "Core" || len(p.Teams) != 1 {
+			t.Errorf("LoadTeams = %+v, want team Core", teams)
+		}
+	}
+	if len(conn.Queries) != 1 {
+		t.Fatalf("two LoadTeams made %d queries, want 1", len(conn.Queries))
+	}
+	if q := conn.Queries[0]; !strings.Contains(q, "teams: team { uid dgraph.type label }") {
+		t.Errorf("query %s does not select teams", q)
+	}
+
+	// An edge with no targets is loaded, and not queried again.
+	conn.Resp = ` + "`" + `{"q":[{"uid":"0x3"}]}` + "`" + `
+	p = Person{UID: "0x3"}
+	for range 2 {
+		teams, err := p.LoadTeams(ctx, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if teams == nil || len(teams) != 0 {
+			t.Errorf("LoadTeams of no teams = %#v, want empty and non-nil", teams)
+		}
+	}
+	if len(conn.Queries) != 2 {
+		t.Errorf("LoadTeams of no teams made %d queries, want 1", len(conn.Queries)-1)
+	}
+
+	if _, err := (&Person{}).LoadTeams(ctx, client); err == nil {
+		t.Error("LoadTeams without a UID succeeded, want an error")
+	}
+}
+`
+
+// TestGenerateLoadEdge compiles the generated Load<Edge> methods and checks
+// that they query an edge once, telling an empty edge from an unloaded one.
+func TestGenerateLoadEdge(t *testing.T) {
+	runGeneratedTest(t, "mock", lazyTest, nil)
+}
+
+// crossPackageTest is run against the crosspkg fixture and its generated Get
+// and Load<Edge> methods for an edge into the people package.
+const crossPackageTest = `package crosspkg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestLoadCrossPackageEdge(t *testing.T) {
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","cast":[{"uid":"0x2","name":"Ada"}]}]}` + "`" + `}
+	f := Film{UID: "0x1"}
+	cast, err := f.LoadCast(context.Background(), NewFromClient(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cast) != 1 || cast[0].UID != "0x2" || cast[0].Name != "Ada" {
+		t.Errorf("LoadCast = %+v, want Ada", cast)
+	}
+	if !strings.Contains(conn.LastQuery, "cast: film.cast { uid") {
+		t.Errorf("query = %q, want film.cast aliased to cast", conn.LastQuery)
+	}
+}
+
+func TestGetCrossPackageEdge(t *testing.T) {
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Heat","cast":[
+		{"uid":"0x2","name":"Ada","friends":[{"uid":"0x3","name":"Grace"}]}
+	]}]}` + "`" + `}
+	f, err := NewFromClient(conn).Film.Get(context.Background(), "0x1", WithDepth(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Cast) != 1 || len(f.Cast[0].Friends) != 1 || f.Cast[0].Friends[0].Name != "Grace" {
+		t.Errorf("Get = %+v, want Ada, a friend of Grace", f)
+	}
+	want := "cast: film.cast { uid dgraph.type name friends: person.friend { uid dgraph.type name } }"
+	if !strings.Contains(conn.LastQuery, want) {
+		t.Errorf("query = %q, want the people.Person selection %q", conn.LastQuery, want)
+	}
+}
+`
+
+// TestGenerateCrossPackage compiles the generated code of an entity with an
+// edge into another package, which its file must import, against that package
+// and checks that the edge is selected with the other entity's predicates.
+func TestGenerateCrossPackage(t *testing.T) {
+	runGeneratedTest(t, "crosspkg", crossPackageTest, nil)
+}
+
+// existsTest is run against the selfref fixture and its generated Exists
+// methods.
+const existsTest = `package selfref
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestExists(t *testing.T) {
+	ctx := context.Background()
+	client := NewFromClient(&modusgraph.Recorder{RespFunc: func(q string, vars map[string]string) (string, error) {
+		if vars["$uid"] == "0x1" && strings.Contains(q, "@filter(type(Person))") {
+			return ` + "`" + `{"q":[{"uid":"0x1"}]}` + "`" + `, nil
+		}
+		return ` + "`" + `{"q":[]}` + "`" + `, nil
+	}})
+	for _, tt := range []struct {
+		name   string
+		exists func(ctx context.Context, uid string) (bool, error)
+		uid    string
+		want   bool
+	}{
+		{"Person", client.Person.Exists, "0x1", true},
+		{"missing Person", client.Person.Exists, "0x2", false},
+		{"Person as a Team", client.Team.Exists, "0x1", false},
+	} {
+		got, err := tt.exists(ctx, tt.uid)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: Exists(%s) = %v, %v, want %v", tt.name, tt.uid, got, err, tt.want)
+		}
+	}
+
+	broken := errors.New("unavailable")
+	fail := func(string, map[string]string) (string, error) { return "", broken }
+	if _, err := NewFromClient(&modusgraph.Recorder{RespFunc: fail}).Person.Exists(ctx, "0x1"); !errors.Is(err, broken) {
+		t.Errorf("Exists = %v, want the query error", err)
+	}
+}
+`
+
+// TestGenerateExists compiles the generated Exists methods and checks that
+// they match only nodes of the entity's type.
+func TestGenerateExists(t *testing.T) {
+	runGeneratedTest(t, "selfref", existsTest, nil)
+}
+
+// linkTest is run against the selfref fixture and its generated methods
+// linking nodes through list edges.
+const linkTest = `package selfref
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestLinks(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{}
+	client := NewFromClient(conn)
+	if err := client.Person.AddTeams(ctx, "0x1", "0x2", "0x3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Person.RemoveMentors(ctx, "0x1", "0x4"); err != nil {
+		t.Fatal(err)
+	}
+	wantSet := []string{` + "`" + `[{"team":[{"uid":"0x2"},{"uid":"0x3"}],"uid":"0x1"}]` + "`" + `, ""}
+	wantDel := []string{"", ` + "`" + `[{"mentor":[{"uid":"0x4"}],"uid":"0x1"}]` + "`" + `}
+	for i := range wantSet {
+		if i >= len(conn.Set) || conn.Set[i] != wantSet[i] || conn.Del[i] != wantDel[i] {
+			t.Fatalf("mutations set %q, delete %q; want set %q, delete %q", conn.Set, conn.Del, wantSet, wantDel)
+		}
+	}
+
+	// Without targets nothing is written; a malformed UID is an error.
+	conn.Set = nil
+	if err := client.Person.AddTeams(ctx, "0x1"); err != nil {
+		t.Errorf("AddTeams without targets = %v", err)
+	}
+	if err := client.Person.AddTeams(ctx, "0x1", "team"); err == nil {
+		t.Error("AddTeams succeeded with a malformed UID")
+	}
+	if len(conn.Set) != 0 {
+		t.Errorf("sent mutations %q, want none", conn.Set)
+	}
+}
+`
+
+// singleLinkTest is run against the single fixture and its generated methods
+// linking nodes through single and reverse edges.
+const singleLinkTest = `package single
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestSingleLinks(t *testing.T) {
+	ctx := context.Background()
+	for _, tt := range []struct {
+		name     string
+		op       func(c *Client) error
+		set, del string
+	}{
+		{"set", func(c *Client) error { return c.Film.SetStudio(ctx, "0x1", "0x5") },
+			` + "`" + `{"film.studio":{"uid":"0x5"},"uid":"0x1"}` + "`" + `, ` + "`" + `{"film.studio":null,"uid":"0x1"}` + "`" + `},
+		{"remove", func(c *Client) error { return c.Film.RemoveDirector(ctx, "0x1") },
+			"", ` + "`" + `{"film.director":null,"uid":"0x1"}` + "`" + `},
+		{"reverse", func(c *Client) error { return c.Studio.AddFilms(ctx, "0x5", "0x1", "0x2") },
+			` + "`" + `[{"film.studio":{"uid":"0x5"},"uid":"0x1"},{"film.studio":{"uid":"0x5"},"uid":"0x2"}]` + "`" + `, ""},
+		{"reverse self", func(c *Client) error { return c.Film.RemovePrequel(ctx, "0x2", "0x1") },
+			"", ` + "`" + `[{"sequel":{"uid":"0x2"},"uid":"0x1"}]` + "`" + `},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &modusgraph.Recorder{}
+			if err := tt.op(NewFromClient(conn)); err != nil {
+				t.Fatal(err)
+			}
+			if len(conn.Set) != 1 || conn.Set[0] != tt.set || conn.Del[0] != tt.del {
+				t.Errorf("mutations set %q, delete %q; want set %s, delete %s", conn.Set, conn.Del, tt.set, tt.del)
+			}
+		})
+	}
+
+	if err := NewFromClient(&modusgraph.Recorder{}).Film.SetStudio(ctx, "0x1", ""); err == nil {
+		t.Error("SetStudio succeeded without a studio UID")
+	}
+	if err := NewFromClient(&modusgraph.Recorder{}).Studio.AddFilms(ctx, "studio", "0x1"); err == nil {
+		t.Error("AddFilms succeeded with a malformed studio UID")
+	}
+}
+`
+
+// TestGenerateLinks compiles the generated Add<Edge>, Remove<Edge>, and
+// Set<Edge> methods and checks the mutations they send, on the forward
+// predicate for a reverse edge.
+func TestGenerateLinks(t *testing.T) {
+	runGeneratedTest(t, "selfref", linkTest, nil)
+	runGeneratedTest(t, "single", singleLinkTest, nil)
+}
+
+// pointersTest is run against the pointers fixture and the generated methods
+// of its []*Genre edge and the reverse []*Film.
+const pointersTest = `package pointers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+func TestPointerSliceEdges(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Heat","genres":[{"uid":"0x2","name":"Crime"},{"uid":"0x3","name":"Drama"}]}]}` + "`" + `}
+	client := NewFromClient(conn)
+	f, err := client.Film.Get(ctx, "0x1", WithDepth(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Genres) != 2 || f.Genres[0] == nil || f.Genres[0].Name != "Crime" || f.Genres[1].Name != "Drama" {
+		t.Fatalf("Get = %+v, want Crime and Drama", f)
+	}
+
+	// A single object where the list was expected decodes as one target.
+	conn.Resp = ` + "`" + `{"q":[{"uid":"0x1","genres":{"uid":"0x2","name":"Crime"}}]}` + "`" + `
+	lazy := Film{UID: "0x1"}
+	genres, err := lazy.LoadGenres(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(genres) != 1 || genres[0].UID != "0x2" || len(lazy.Genres) != 1 {
+		t.Errorf("LoadGenres = %+v, want Crime", genres)
+	}
+
+	if err := client.Film.AddGenres(ctx, "0x1", "0x2", "0x3"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Genre.AddFilms(ctx, "0x2", "0x4"); err != nil {
+		t.Fatal(err)
+	}
+	wantSet := []string{
+		` + "`" + `[{"genre":[{"uid":"0x2"},{"uid":"0x3"}],"uid":"0x1"}]` + "`" + `,
+		` + "`" + `[{"genre":[{"uid":"0x2"}],"uid":"0x4"}]` + "`" + `,
+	}
+	if len(conn.Set) != 2 || conn.Set[0] != wantSet[0] || conn.Set[1] != wantSet[1] {
+		t.Errorf("mutations set %q, want %q", conn.Set, wantSet)
+	}
+
+	mock := NewMockClient()
+	crime := Genre{Name: "Crime"}
+	if err := mock.Genre.Add(ctx, &crime); err != nil {
+		t.Fatal(err)
+	}
+	heat := Film{Name: "Heat", Genres: []*Genre{&crime}}
+	if err := mock.Film.Add(ctx, &heat); err != nil {
+		t.Fatal(err)
+	}
+	got, err := mock.Film.Get(ctx, heat.UID)
+	if err != nil || len(got.Genres) != 1 || got.Genres[0].UID != crime.UID {
+		t.Errorf("MockClient Get = %+v, %v; want Heat in Crime", got, err)
+	}
+}
+`
+
+// TestGeneratePointerSliceEdges compiles the pointers fixture, whose edges are
+// slices of pointers, and runs Get, Load<Edge>, Add<Edge>, and the mock on
+// them.
+func TestGeneratePointerSliceEdges(t *testing.T) {
+	runGeneratedTest(t, "pointers", pointersTest, []Option{WithMock()})
+}
+
+// whereTest is run against the selfref fixture and its generated filter
+// conditions and combinators.
+const whereTest = `package selfref
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestWhere(t *testing.T) {
+	f := Or(
+		And(PersonWhere.HasName(), Not(PersonWhere.HasTeams())),
+		PersonWhere.MentorsContains("0x5"),
+	)
+	q := (&PersonClient{}).Query(context.Background()).HasName().Where(Not(f))
+	want := "(has(name)) AND NOT ((has(name) AND NOT (has(team))) OR uid_in(mentor, 0x5))"
+	if q.filter != want || q.err != nil {
+		t.Errorf("filter = %s, err = %v, want %s", q.filter, q.err, want)
+	}
+
+	// Empty combinations place no condition, and a single one is not grouped.
+	q = (&PersonClient{}).Query(context.Background()).
+		Where(And[Person]()).
+		Where(Or(Filter[Person]{}, PersonWhere.NotMentors()))
+	if want := "NOT has(mentor)"; q.filter != want {
+		t.Errorf("filter = %s, want %s", q.filter, want)
+	}
+
+	// An invalid argument anywhere in the tree fails Exec.
+	q = (&PersonClient{}).Query(context.Background()).
+		Where(Or(PersonWhere.HasName(), Not(PersonWhere.TeamsContains())))
+	if q.filter != "" {
+		t.Errorf("filter = %s, want none", q.filter)
+	}
+	if err := q.Exec(nil); err == nil || !strings.HasPrefix(err.Error(), "Person.Teams: ") {
+		t.Errorf("Exec = %v, want an error naming the field", err)
+	}
+}
+`
+
+// TestGenerateWhere compiles the generated filter conditions and checks that
+// And, Or, and Not group them explicitly.
+func TestGenerateWhere(t *testing.T) {
+	runGeneratedTest(t, "selfref", whereTest, nil)
+}
+
+// TestGenerateUIDInFilters compiles the generated uid_in filters and checks
+// that they render one or several UIDs and reject anything else.
+func TestGenerateUIDInFilters(t *testing.T) {
+	runGeneratedTest(t, "selfref", uidInTest, nil)
+}
+
+// TestGenerateHasFilters compiles the generated has() filters for scalar and
+// edge fields and checks that they use the resolved predicates.
+func TestGenerateHasFilters(t *testing.T) {
+	runGeneratedTest(t, "selfref", hasTest, nil)
+}
+
+// timeFormatTest is run against the timeformat fixture and its generated
+// JSON methods.
+const timeFormatTest = `package timeformat
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeFormatRoundTrip(t *testing.T) {
+	starts := time.Date(2001, 2, 3, 19, 30, 0, 0, time.UTC)
+	in := Event{
+		Name:   "Premiere",
+		Day:    time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC),
+		Starts: &starts,
+		Ends:   time.Date(2001, 2, 3, 22, 0, 0, 0, time.UTC),
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Day and Starts use their layouts; Ends, without one, stays RFC 3339.
+	want := ` + "`" + `{"name":"Premiere","ends":"2001-02-03T22:00:00Z","day":"2001-02-03","starts":"2001-02-03T19:30"}` + "`" + `
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+	var out Event
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Day.Equal(in.Day) || out.Starts == nil || !out.Starts.Equal(starts) || !out.Ends.Equal(in.Ends) {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// The zero Day is left out, and RFC 3339 from Dgraph is still accepted.
+	data, err = json.Marshal(Event{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := ` + "`" + `{"ends":"0001-01-01T00:00:00Z","starts":null}` + "`" + `; string(data) != want {
+		t.Errorf("Marshal(zero) = %s, want %s", data, want)
+	}
+	if err := json.Unmarshal([]byte(` + "`" + `{"day":"2001-02-03T00:00:00Z"}` + "`" + `), &out); err != nil || !out.Day.Equal(in.Day) {
+		t.Errorf("Unmarshal(RFC 3339) = %v, %v; want %v", out.Day, err, in.Day)
+	}
+}
+`
+
+// TestGenerateTimeFormatRoundTrip compiles the generated JSON methods of an
+// entity with format= datetimes and checks that they round-trip in their
+// layouts.
+func TestGenerateTimeFormatRoundTrip(t *testing.T) {
+	runGeneratedTest(t, "timeformat", timeFormatTest, nil)
+}
+
+// embeddedTest is run against the embedded fixture, whose entities inherit
+// their UID, DType, and a datetime from an embedded Node.
+const embeddedTest = `package embedded
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEmbeddedRoundTrip(t *testing.T) {
+	var f Film
+	data := ` + "`" + `{"uid":"0x1","dgraph.type":["Film"],"created":"2024-03-01","label":"L","name":"N","studios":{"uid":"0x2","name":"S"}}` + "`" + `
+	if err := json.Unmarshal([]byte(data), &f); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if f.UID != "0x1" || f.Label != "L" || !f.Created.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Film = %+v, want inherited fields set", f)
+	}
+	if len(f.Studios) != 1 || f.Studios[0].UID != "0x2" {
+		t.Errorf("Studios = %+v, want studio 0x2", f.Studios)
+	}
+
+	out, err := json.Marshal(Film{Name: "N"})
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	if strings.Contains(string(out), "created") {
+		t.Errorf("Marshal = %s, want the zero Created left out", out)
+	}
+	if got := (&Film{Node: Node{UID: "0x3"}}).GetUID(); got != "0x3" {
+		t.Errorf("GetUID = %q, want 0x3", got)
+	}
+}
+
+func TestQueryDQL(t *testing.T) {
+	got := (&FilmClient{}).Query(context.Background()).
+		Filter(` + "`" + `has(name)` + "`" + `).OrderDesc("name").First(10).Offset(20).dql()
+	want := "{\n\tq(func: type(Film), orderdesc: name, first: 10, offset: 20) @filter(has(name)) { " + filmSelection(0) + " }\n}"
+	if got != want {
+		t.Errorf("dql = %q, want %q", got, want)
+	}
+}
+`
+
+// TestGenerateEmbedded compiles the generated code, including the benchmarks,
+// for entities that embed a base struct, round-trips one through JSON, and
+// checks the DQL text its query builder renders.
+func TestGenerateEmbedded(t *testing.T) {
+	runGeneratedTest(t, "embedded", embeddedTest, nil, "-bench", ".", "-benchtime", "1x")
+}
+
+func TestGenerateTemplateDir(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	want := outputDir(t)
+	if err := Generate(pkg, want); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	// An override replaces its built-in template, with the same functions.
+	tmplDir := t.TempDir()
+	override := "package {{.Name}}\n\n// Client has {{len .Entities}} entities: {{range .Entities}}{{toSnakeCase .Name}} {{end}}\ntype Client struct{}\n"
+	if err := os.WriteFile(filepath.Join(tmplDir, "client.go.tmpl"), []byte(override), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got := outputDir(t)
+	if err := Generate(pkg, got, WithTemplateDir(tmplDir)); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	client, err := os.ReadFile(filepath.Join(got, "client_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(client), "// Client has 2 entities: person team") {
+		t.Errorf("client_gen.go does not come from the override:\n%s", client)
+	}
+
+	// The other templates are the built-in ones.
+	for _, name := range []string{"person_gen.go", "dql_gen.go"} {
+		a, _ := os.ReadFile(filepath.Join(want, name))
+		b, _ := os.ReadFile(filepath.Join(got, name))
+		if !bytes.Equal(a, b) {
+			t.Errorf("%s differs from the built-in output", name)
+		}
+	}
+
+	// A file naming no built-in template and a malformed one are errors.
+	for file, src := range map[string]string{
+		"clinet.go.tmpl": "package x\n",
+		"entity.go.tmpl": "{{if}}",
+	} {
+		bad := t.TempDir()
+		if err := os.WriteFile(filepath.Join(bad, file), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := Generate(pkg, t.TempDir(), WithTemplateDir(bad)); err == nil || !strings.Contains(err.Error(), file) {
+			t.Errorf("Generate with %s: error = %v, want one naming it", file, err)
+		}
+	}
+}
+
+// TestGenerateDeterministic parses and generates a multi-file package twice
+// and checks that both runs write identical files, with the entities in name
+// order.
+func TestGenerateDeterministic(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "zoo")
+	sources := map[string]string{
+		"gen.go": "//go:build ignore\n\npackage main\n\nfunc main() {}\n",
+		"a.go": "package zoo\n\ntype Zebra struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+			"\tName string `json:\"name,omitempty\" dgraph:\"index=exact\"`\n" +
+			"\tKeeper []Keeper `json:\"keeper,omitempty\"`\n}\n",
+		"b.go": "package zoo\n\ntype Keeper struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+			"\tName string `json:\"name,omitempty\" dgraph:\"index=exact\"`\n" +
+			"\tAge int `json:\"age,omitempty\"`\n}\n\n" +
+			"type Aardvark struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+			"\tName string `json:\"name,omitempty\" dgraph:\"index=exact\"`\n" +
+			"\tKeepers []Keeper `json:\"keeper,omitempty\"`\n}\n",
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range sources {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var outputs []map[string]string
+	for range 2 {
+		pkg, err := parser.Parse(dir)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if pkg.Name != "zoo" {
+			t.Fatalf("package = %q, want zoo", pkg.Name)
+		}
+		var names []string
+		for _, e := range pkg.Entities {
+			names = append(names, e.Name)
+		}
+		if got := strings.Join(names, " "); got != "Aardvark Keeper Zebra" {
+			t.Errorf("entities = %s, want Aardvark Keeper Zebra", got)
+		}
+
+		outDir := outputDir(t)
+		if err := Generate(pkg, outDir, WithMock(), WithMermaid()); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		files := make(map[string]string)
+		err = filepath.WalkDir(outDir, func(path string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			rel, _ := filepath.Rel(outDir, path)
+			files[rel] = string(data)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		outputs = append(outputs, files)
+	}
+
+	if len(outputs[0]) != len(outputs[1]) {
+		t.Fatalf("runs wrote %d and %d files", len(outputs[0]), len(outputs[1]))
+	}
+	for name, data := range outputs[0] {
+		if outputs[1][name] != data {
+			t.Errorf("%s differs between runs", name)
+		}
+	}
+}
+
+// TestGenerateConformance compiles the generated conformance tests under the
+// integration build tag and checks that they skip without DGRAPH_ADDR.
+func TestGenerateConformance(t *testing.T) {
+	t.Setenv("DGRAPH_ADDR", "")
+	for _, fx := range []struct{ name, test string }{
+		{"aliases", "TestPersonConformance"},
+		{"required", "TestAccountConformance"},
+		{"nulls", "TestLegacyConformance"},
+		{"embedded", "TestFilmConformance"},
+	} {
+		out := runGeneratedTest(t, fx.name, "package "+fx.name+"\n", nil, "-tags", "integration", "-run", "Conformance", "-v")
+		if !strings.Contains(string(out), "--- SKIP: "+fx.test) {
+			t.Errorf("%s did not skip:\n%s", fx.test, out)
+		}
+	}
+}
+
+// TestGenerateScaffold runs the test scaffolding generated by WithTests, which
+// must pass as generated, or skip where it cannot fill in a required field.
+func TestGenerateScaffold(t *testing.T) {
+	for _, fx := range []struct {
+		name          string
+		pass, skipped []string
+	}{
+		{"scaffold", []string{"TestFilmRoundTrip"}, []string{"TestStudioRoundTrip"}},
+		{"embedded", []string{"TestFilmRoundTrip", "TestStudioRoundTrip"}, nil},
+		{"required", []string{"TestAccountRoundTrip"}, nil},
+	} {
+		out := runGeneratedTest(t, fx.name, "package "+fx.name+"\n", []Option{WithTests()}, "-run", "RoundTrip", "-v")
+		for _, test := range fx.pass {
+			if !strings.Contains(string(out), "--- PASS: "+test) {
+				t.Errorf("%s did not pass:\n%s", test, out)
+			}
+		}
+		for _, test := range fx.skipped {
+			if !strings.Contains(string(out), "--- SKIP: "+test) {
+				t.Errorf("%s did not skip:\n%s", test, out)
+			}
+		}
+	}
+}
+
+// concurrencyTest is run against the mock fixture, with the race detector, to
+// check that Client and MockClient can be shared by goroutines.
+const concurrencyTest = `package mock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// fakeConn is a modusgraph.Client that keeps nodes as JSON, like Dgraph.
+type fakeConn struct {
+	mu    sync.Mutex
+	last  int
+	nodes map[string][]byte
+}
+
+func (c *fakeConn) Insert(ctx context.Context, v any) error {
+	c.mu.Lock()
+	c.last++
+	uid := fmt.Sprintf("0x%x", c.last)
+	c.mu.Unlock()
+	if err := json.Unmarshal([]byte(` + "`" + `{"uid":"` + "`" + `+uid+` + "`" + `"}` + "`" + `), v); err != nil {
+		return err
+	}
+	return c.Update(ctx, v)
+}
+
+func (c *fakeConn) Update(ctx context.Context, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var node struct{ UID string ` + "`" + `json:"uid"` + "`" + ` }
+	if err := json.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[node.UID] = data
+	return nil
+}
+
+func (c *fakeConn) Get(ctx context.Context, v any, uid string) error {
+	c.mu.Lock()
+	data, ok := c.nodes[uid]
+	c.mu.Unlock()
+	if !ok {
+		return ErrNotFound
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (c *fakeConn) Delete(ctx context.Context, uids []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, uid := range uids {
+		delete(c.nodes, uid)
+	}
+	return nil
+}
+
+func (c *fakeConn) Upsert(ctx context.Context, v any, predicates ...string) error { return c.Insert(ctx, v) }
+func (c *fakeConn) Query(ctx context.Context, model any) *modusgraph.Query       { return &modusgraph.Query{} }
+func (c *fakeConn) Close()                                                       {}
+func (c *fakeConn) QueryRaw(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	return []byte("{}"), nil
+}
+func (c *fakeConn) DgraphClient() (*dgo.Dgraph, func(), error) { return &dgo.Dgraph{}, func() {}, nil }
+
+// hammer runs ops from several goroutines at once.
+func hammer(t *testing.T, ops func(g int) error) {
+	t.Helper()
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := ops(g); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// exercise adds, reads, updates, and deletes people through api, and edits
+// the values it passes and gets back as callers may.
+func exercise(ctx context.Context, api PersonAPI, g int) error {
+	for i := range 20 {
+		p := Person{Name: "p", Email: fmt.Sprintf("%d.%d@example.com", g, i), Teams: []Team{{Label: "a"}}}
+		if err := api.Add(ctx, &p); err != nil {
+			return err
+		}
+		p.Teams[0].Label = "b"
+		got, err := api.Get(ctx, p.UID)
+		if err != nil {
+			return err
+		}
+		if label := got.Teams[0].Label; label != "a" {
+			return fmt.Errorf("Get after editing the added value: team label %q, want \"a\"", label)
+		}
+		got.Teams[0].Label = "c"
+		got.Age++
+		if err := api.Update(ctx, got); err != nil {
+			return err
+		}
+		people, err := api.List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, q := range people {
+			for j := range q.Teams {
+				q.Teams[j].Label = "d"
+			}
+		}
+		if _, err := api.Find(ctx, ` + "`" + `eq(name, "p")` + "`" + `); err != nil {
+			return err
+		}
+		if i%2 == 0 {
+			if err := api.Delete(ctx, p.UID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func TestClientConcurrent(t *testing.T) {
+	client := NewFromClient(&fakeConn{nodes: make(map[string][]byte)})
+	hammer(t, func(g int) error {
+		return exercise(context.Background(), client.Person, g)
+	})
+}
+
+func TestMockClientConcurrent(t *testing.T) {
+	client := NewMockClient()
+	hammer(t, func(g int) error {
+		return exercise(context.Background(), client.Person, g)
+	})
+}
+`
+
+// mockFindTest is run against the mock fixture and its generated MockClient
+// Find.
+const mockFindTest = `package mock
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMockFind(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockClient()
+	for _, p := range []Person{
+		{Name: "Fast AND Furious", Email: "f@example.com"},
+		{Name: "Fast", Email: "f@example.com"},
+		{Name: "Fast", Email: "g@example.com"},
+		{Name: "Heat (1995)", Email: "h@example.com"},
+		{Name: "say \"AND\" AND mean it", Email: "s@example.com"},
+	} {
+		if err := mock.Person.Add(ctx, &p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{` + "`" + `eq(name, "Fast AND Furious")` + "`" + `, []string{"Fast AND Furious"}},
+		{` + "`" + `eq(name, "Fast") AND eq(email, "f@example.com")` + "`" + `, []string{"Fast"}},
+		{` + "`" + `(eq(name, "Heat (1995)")) AND (eq(email, "h@example.com"))` + "`" + `, []string{"Heat (1995)"}},
+		{` + "`" + `eq(name, "say \"AND\" AND mean it")` + "`" + `, []string{"say \"AND\" AND mean it"}},
+	}
+	for _, tt := range tests {
+		got, err := mock.Person.Find(ctx, tt.filter)
+		if err != nil {
+			t.Errorf("Find(%s): %v", tt.filter, err)
+			continue
+		}
+		var names []string
+		for _, p := range got {
+			names = append(names, p.Name)
+		}
+		if len(names) != len(tt.want) || len(names) > 0 && names[0] != tt.want[0] {
+			t.Errorf("Find(%s) = %q, want %q", tt.filter, names, tt.want)
+		}
+	}
+	if _, err := mock.Person.Find(ctx, ` + "`" + `eq(name, "Fast AND eq(email, "f@example.com")` + "`" + `); err == nil {
+		t.Error("Find with an unterminated string succeeded")
+	}
+}
+`
+
+// TestGenerateMockFind compiles the generated MockClient Find and checks that
+// AND inside a quoted value does not split the filter.
+func TestGenerateMockFind(t *testing.T) {
+	runGeneratedTest(t, "mock", mockFindTest, []Option{WithMock()})
+}
+
+// TestGenerateConcurrency runs concurrencyTest under the race detector.
+func TestGenerateConcurrency(t *testing.T) {
+	if out, err := exec.Command("go", "env", "CGO_ENABLED").Output(); err != nil || strings.TrimSpace(string(out)) != "1" {
+		t.Skip("the race detector needs cgo")
+	}
+	runGeneratedTest(t, "mock", concurrencyTest, []Option{WithMock()}, "-race")
+}
+
+// runGeneratedTest generates the named fixture with opts and runs the Go test
+// source test against the fixture and its generated files in a scratch module,
+// passing args to go test, and returns its output. The module is built
+// against the fakes of modusgraph and dgo in testdata/fake, whose
+// modusgraph.Recorder records and answers the queries and mutations of a test.
+func runGeneratedTest(t *testing.T, fixture, test string, opts []Option, args ...string) []byte {
+	t.Helper()
+	if testing.Short() {
+		t.Skip("runs the go tool")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+	dir := fixtureDir(t, fixture)
+	pkg := parseFixture(t, dir)
+	genDir := outputDir(t)
+	if err := Generate(pkg, genDir, opts...); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	modDir := t.TempDir()
+	write := func(name string, data []byte) {
+		t.Helper()
+		name = filepath.Join(modDir, name)
+		if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The module has the fixture's own import path, so that the imports of
+	// its packages in other directories, e.g. crosspkg/people, resolve.
+	fake := fixtureDir(t, "fake")
+	write("go.mod", []byte("module github.com/mlwelles/modusGraphGen/generator/testdata/"+fixture+"\n\ngo 1.22\n\n"+
+		"require (\n\tgithub.com/matthewmcneely/modusgraph v0.0.0\n\tgithub.com/dgraph-io/dgo/v250 v250.0.0\n)\n\n"+
+		"replace github.com/matthewmcneely/modusgraph => "+filepath.Join(fake, "modusgraph")+"\n\n"+
+		"replace github.com/dgraph-io/dgo/v250 => "+filepath.Join(fake, "dgo")+"\n"))
+	write("fixture_test.go", []byte(test))
+	sources, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	generated, _ := filepath.Glob(filepath.Join(genDir, "*.go"))
+	for _, src := range append(sources, generated...) {
+		data, err := os.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(filepath.Base(src), data)
+	}
+	subpackages, _ := filepath.Glob(filepath.Join(dir, "*", "*.go"))
+	for _, src := range subpackages {
+		sub := filepath.Base(filepath.Dir(src))
+		if sub == "golden" {
+			continue
+		}
+		data, err := os.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		write(filepath.Join(sub, filepath.Base(src)), data)
+	}
+
+	cmd := exec.Command(goTool, append([]string{"test"}, append(args, "./...")...)...)
+	cmd.Dir = modDir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off", "GOPROXY=off")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("go test failed: %v\n%s", err, out)
+	}
+	return out
+}
+
+// outputDir returns a new temporary directory to generate code into. It lies
+// in a module, example.com/app, so that the CLI stub can tell the generated
+// package's import path.
+func outputDir(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "out")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// parseFixture parses the fixture in dir: its Dgraph schema file if it has
+// one, as ParseSchema does, and otherwise its Go package.
+func parseFixture(t *testing.T, dir string) *model.Package {
+	t.Helper()
+	parse := parser.Parse
+	if schemas, _ := filepath.Glob(filepath.Join(dir, "*.schema")); len(schemas) == 1 {
+		parse = parser.ParseSchema
+		dir = schemas[0]
+	}
+	pkg, err := parse(dir)
+	if err != nil {
+		t.Fatalf("Parse(%s) failed: %v", dir, err)
+	}
+	return pkg
+}
+
+// fixtureDir returns the path to the fixture package testdata/<name>.
+func fixtureDir(t *testing.T, name string) string {
+	t.Helper()
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("runtime.Caller failed")
+	}
+	return filepath.Join(filepath.Dir(thisFile), "testdata", name)
+}
+
+// checkGolden compares every generated file in tmpDir against the golden
+// file of the same name in golden, or rewrites golden when -update is set.
+func checkGolden(t *testing.T, tmpDir, golden string) {
+	t.Helper()
 
 	if *update {
 		// Copy all generated files to golden directory.
@@ -58,100 +2947,503 @@ func TestGenerate(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		// Clean golden dir first.
-		_ = os.RemoveAll(golden)
-		if err := os.MkdirAll(golden, 0o755); err != nil {
+		// Clean golden dir first.
+		_ = os.RemoveAll(golden)
+		if err := os.MkdirAll(golden, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue // skip cmd/ directory for golden tests
+			}
+			src := filepath.Join(tmpDir, entry.Name())
+			dst := filepath.Join(golden, entry.Name())
+			data, err := os.ReadFile(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(dst, data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		t.Log("Golden files updated.")
+		return
+	}
+
+	// Compare generated files against golden files.
+	goldenEntries, err := os.ReadDir(golden)
+	if err != nil {
+		t.Fatalf("Reading golden dir %s: %v\nRun with -update to create golden files.", golden, err)
+	}
+
+	if len(goldenEntries) == 0 {
+		t.Fatalf("No golden files found in %s. Run with -update to create them.", golden)
+	}
+
+	for _, entry := range goldenEntries {
+		if entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		t.Run(name, func(t *testing.T) {
+			goldenPath := filepath.Join(golden, name)
+			generatedPath := filepath.Join(tmpDir, name)
+
+			goldenData, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("reading golden file: %v", err)
+			}
+
+			generatedData, err := os.ReadFile(generatedPath)
+			if err != nil {
+				t.Fatalf("reading generated file: %v", err)
+			}
+
+			if string(goldenData) != string(generatedData) {
+				t.Errorf("generated output differs from golden file %s", name)
+				// Show a diff summary.
+				goldenLines := strings.Split(string(goldenData), "\n")
+				generatedLines := strings.Split(string(generatedData), "\n")
+				maxLines := len(goldenLines)
+				if len(generatedLines) > maxLines {
+					maxLines = len(generatedLines)
+				}
+				diffCount := 0
+				for i := 0; i < maxLines; i++ {
+					var gl, genl string
+					if i < len(goldenLines) {
+						gl = goldenLines[i]
+					}
+					if i < len(generatedLines) {
+						genl = generatedLines[i]
+					}
+					if gl != genl {
+						if diffCount < 10 {
+							t.Errorf("  line %d:\n    golden:    %q\n    generated: %q", i+1, gl, genl)
+						}
+						diffCount++
+					}
+				}
+				if diffCount > 10 {
+					t.Errorf("  ... and %d more differences", diffCount-10)
+				}
+			}
+		})
+	}
+}
+
+// affixTest is run against the selfref fixture generated WithTypeAffixes("Gen",
+// "Model"). It declares the types that the affixes keep the generated ones
+// from colliding with.
+const affixTest = `package selfref
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// PersonClient and TeamQuery are hand-written, as in a package that already
+// had them before it was generated.
+type PersonClient struct{ name string }
+type TeamQuery []Team
+
+var (
+	_ GenPersonModelAPI = (*GenPersonModelClient)(nil)
+	_ GenPersonModelAPI = (*MockGenPersonModelClient)(nil)
+	_ GenTeamModelAPI   = (*GenTeamModelClient)(nil)
+)
+
+func TestTypeAffixes(t *testing.T) {
+	ctx := context.Background()
+	conn := &modusgraph.Recorder{Resp: ` + "`" + `{"q":[{"uid":"0x1","name":"Ada","mentors":[{"uid":"0x2","name":"Grace"}]}]}` + "`" + `}
+	var people *GenPersonModelClient = NewFromClient(conn).Person
+	p, err := people.Get(ctx, "0x1", WithDepth(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Ada" || len(p.Mentors) != 1 || p.Mentors[0].Name != "Grace" {
+		t.Errorf("Get = %+v, want Ada, mentored by Grace", p)
+	}
+	var q *GenTeamModelQuery = NewFromClient(conn).Team.Query(ctx)
+	if q == nil {
+		t.Error("Query returned nil")
+	}
+
+	mock := NewMockClient()
+	ada := Person{Name: "Ada"}
+	if err := mock.Person.Add(ctx, &ada); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := mock.Person.Get(ctx, ada.UID); err != nil || got.Name != "Ada" {
+		t.Errorf("MockClient Get = %+v, %v; want Ada", got, err)
+	}
+}
+`
+
+// TestGenerateTypeAffixes checks that WithTypeAffixes renames every generated
+// per-entity type, declaration and use alike, while the entities keep theirs,
+// and compiles the result alongside hand-written types with the unaffixed
+// names.
+func TestGenerateTypeAffixes(t *testing.T) {
+	runGeneratedTest(t, "selfref", affixTest, []Option{WithMock(), WithTypeAffixes("Gen", "Model")})
+
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	if err := Generate(pkg, tmpDir, WithMock(), WithTypeAffixes("Gen", "Model")); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	declared := make(map[string]bool)
+	used := make(map[string]bool)
+	files, _ := filepath.Glob(filepath.Join(tmpDir, "*.go"))
+	fset := token.NewFileSet()
+	for _, path := range files {
+		file, err := goparser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("generated file does not parse: %v", err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.TypeSpec:
+				declared[n.Name.Name] = true
+			case *ast.Ident:
+				used[n.Name] = true
+			}
+			return true
+		})
+	}
+	for _, name := range []string{"Person", "Team"} {
+		for _, kind := range []string{"API", "Client", "Query", "Txn", "Iterator", "Option"} {
+			if want := "Gen" + name + "Model" + kind; !declared[want] {
+				t.Errorf("%s is not declared", want)
+			}
+			if old := name + kind; used[old] {
+				t.Errorf("%s is still referenced", old)
+			}
+		}
+		if want := "MockGen" + name + "ModelClient"; !declared[want] {
+			t.Errorf("%s is not declared", want)
+		}
+	}
+	if !used["Person"] || !used["Team"] {
+		t.Error("entity structs are no longer referenced by their own names")
+	}
+
+	if err := Generate(pkg, tmpDir, WithTypeAffixes("", "-v2")); err == nil {
+		t.Error("Generate accepted a suffix that is not part of an identifier")
+	}
+}
+
+// TestGeneratePlurals checks that List, Find, and the CLI stub name and
+// describe entities in the plural, from inflect.Plural or WithPlurals.
+func TestGeneratePlurals(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "mock"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	if err := Generate(pkg, tmpDir, WithPlurals(map[string]string{"Team": "Squads"})); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	for file, wants := range map[string][]string{
+		"person_gen.go":    {"// List retrieves People with", "var people []Person", "return people, nil"},
+		"team_gen.go":      {"// Find retrieves Squads matching", "var squads []Team"},
+		"cmd/mock/main.go": {`help:"List People."`, "people, err := client.Person.List("},
+		"iter_gen.go":      {"over all People."},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s does not contain %q", file, want)
+			}
+		}
+	}
+}
+
+// TestGenerateSourcePositions checks that WithSourcePositions ends the comments
+// of the entity client and of the field methods with their declarations, and
+// that they are left alone without it.
+func TestGenerateSourcePositions(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "mock"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	wants := []string{
+		"// from person.go:4\ntype PersonClient struct",
+		"// Name is how the person is addressed, not necessarily unique.\n//\n// from person.go:8\nfunc (c *PersonClient) SetName(",
+		"// Deprecated: Age is deprecated.\n//\n// from person.go:11\nfunc (c *PersonClient) SetAge(",
+	}
+	for _, positions := range []bool{false, true} {
+		var opts []Option
+		if positions {
+			opts = append(opts, WithSourcePositions())
+		}
+		tmpDir := outputDir(t)
+		if err := Generate(pkg, tmpDir, opts...); err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+		data, err := os.ReadFile(filepath.Join(tmpDir, "person_gen.go"))
+		if err != nil {
 			t.Fatal(err)
 		}
-		for _, entry := range entries {
-			if entry.IsDir() {
-				continue // skip cmd/ directory for golden tests
-			}
-			src := filepath.Join(tmpDir, entry.Name())
-			dst := filepath.Join(golden, entry.Name())
-			data, err := os.ReadFile(src)
-			if err != nil {
-				t.Fatal(err)
-			}
-			if err := os.WriteFile(dst, data, 0o644); err != nil {
-				t.Fatal(err)
+		for _, want := range wants {
+			if strings.Contains(string(data), want) != positions {
+				t.Errorf("WithSourcePositions %v: person_gen.go contains %q: %v", positions, want, !positions)
 			}
 		}
-		t.Log("Golden files updated.")
-		return
 	}
+}
 
-	// Compare generated files against golden files.
-	goldenEntries, err := os.ReadDir(golden)
+func TestSliceVar(t *testing.T) {
+	tests := []struct {
+		plural, pkgName, want string
+	}{
+		{"Films", "movies", "films"},
+		{"ContentRatings", "movies", "contentRatings"},
+		{"URLs", "movies", "urls"},
+		{"HTTPServers", "movies", "httpServers"},
+		{"Films", "films", "results"},
+		{"Opts", "movies", "results"},
+	}
+	for _, tt := range tests {
+		if got := sliceVar(tt.plural, tt.pkgName); got != tt.want {
+			t.Errorf("sliceVar(%q, %q) = %q, want %q", tt.plural, tt.pkgName, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateEntityNameCollision(t *testing.T) {
+	tests := []struct {
+		entity string
+		want   string
+	}{
+		{"Entity", "the generated Entity interface"},
+		{"Filter", "the generated Filter type"},
+		{"And", "the generated And function"},
+		{"Not", "the generated Not function"},
+	}
+	for _, tt := range tests {
+		pkg := &model.Package{
+			Name: "things",
+			Entities: []model.Entity{{Name: tt.entity, Fields: []model.Field{
+				{Name: "UID", GoType: "string", IsUID: true},
+				{Name: "DType", GoType: "[]string", IsDType: true},
+			}}},
+		}
+		err := Generate(pkg, t.TempDir())
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Generate of entity %s: error = %v, want a collision with %s", tt.entity, err, tt.want)
+		}
+	}
+}
+
+func TestGenerateDgraphTypeCollision(t *testing.T) {
+	node := []model.Field{
+		{Name: "UID", GoType: "string", IsUID: true},
+		{Name: "DType", GoType: "[]string", IsDType: true},
+	}
+	pkg := &model.Package{
+		Name: "things",
+		Entities: []model.Entity{
+			{Name: "Film", DgraphType: "Movie", Fields: node},
+			{Name: "Movie", Fields: node},
+		},
+	}
+	err := Generate(pkg, t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "both have Dgraph type Movie") {
+		t.Errorf("Generate error = %v, want a Dgraph type collision", err)
+	}
+}
+
+// TestRunOptions checks that Run writes where WithOutputDir says, under the
+// package name from WithPackageName, with a CLI stub that imports the package
+// from WithImportPath.
+func TestRunOptions(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
 	if err != nil {
-		t.Fatalf("Reading golden dir %s: %v\nRun with -update to create golden files.", golden, err)
+		t.Fatalf("Parse failed: %v", err)
+	}
+	name := pkg.Name
+	tmpDir := t.TempDir()
+	err = Run(pkg, WithOutputDir(tmpDir), WithPackageName("people"), WithImportPath("example.com/app/crew"))
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if pkg.Name != name {
+		t.Errorf("Run renamed the parsed package to %q", pkg.Name)
 	}
 
-	if len(goldenEntries) == 0 {
-		t.Fatalf("No golden files found in %s. Run with -update to create them.", golden)
+	client, err := os.ReadFile(filepath.Join(tmpDir, "client_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(client, []byte("\npackage people\n")) {
+		t.Error("client_gen.go does not declare package people")
+	}
+	cli, err := os.ReadFile(filepath.Join(tmpDir, "cmd", "people", "main.go"))
+	if err != nil {
+		t.Fatalf("CLI stub not found: %v", err)
+	}
+	if !bytes.Contains(cli, []byte(`people "example.com/app/crew"`)) {
+		t.Errorf("CLI stub does not import the package from its import path:\n%s", cli)
 	}
 
-	for _, entry := range goldenEntries {
-		if entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
-		t.Run(name, func(t *testing.T) {
-			goldenPath := filepath.Join(golden, name)
-			generatedPath := filepath.Join(tmpDir, name)
+	if err := Run(pkg, WithOutputDir(tmpDir), WithPackageName("my-people")); err == nil {
+		t.Error("Run accepted a package name that is not an identifier")
+	}
+}
 
-			goldenData, err := os.ReadFile(goldenPath)
-			if err != nil {
-				t.Fatalf("reading golden file: %v", err)
-			}
+// TestRunWithoutModule checks that the CLI stub is not guessed an import path
+// when the output directory is outside a module and WithImportPath is unset.
+func TestRunWithoutModule(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	err = Run(pkg, WithOutputDir(t.TempDir()))
+	if err == nil || !strings.Contains(err.Error(), "pass it with -import-path") {
+		t.Errorf("Run error = %v, want one asking for -import-path", err)
+	}
+	if err := Run(pkg, WithOutputDir(t.TempDir()), WithSkipCLI()); err != nil {
+		t.Errorf("Run with WithSkipCLI failed: %v", err)
+	}
+}
 
-			generatedData, err := os.ReadFile(generatedPath)
+// TestGenerateDirective checks the go:generate directive of the CLI stub,
+// which must rerun modusGraphGen from the stub's directory with the flags that
+// Run's options stand for.
+func TestGenerateDirective(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	root := t.TempDir()
+	out := filepath.Join(root, "internal", "crew")
+	for _, dir := range []string{out, filepath.Join(root, "templates")} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{
+			name: "defaults",
+			want: "go run github.com/mlwelles/modusGraphGen -pkg ../..",
+		},
+		{
+			name: "options",
+			opts: []Option{
+				WithSourceDir(filepath.Join(root, "crew")),
+				WithPackageName("people"),
+				WithTests(),
+				WithSourcePositions(),
+				WithTypeAffixes("Gen", ""),
+				WithTemplateDir(filepath.Join(root, "templates")),
+				WithImportPath("example.com/app/crew"),
+				WithGenerateFlags("-naming", "field_name", "-header", filepath.Join(root, "license header.txt")),
+			},
+			want: "go run github.com/mlwelles/modusGraphGen -pkg ../../../../crew -output ../.. -package people -tests -source-positions" +
+				" -entity-prefix Gen -templates ../../../../templates -import-path example.com/app/crew -naming field_name -header \"../../../../license header.txt\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Run(pkg, append([]Option{WithOutputDir(out)}, tt.opts...)...); err != nil {
+				t.Fatalf("Run failed: %v", err)
+			}
+			stubs, _ := filepath.Glob(filepath.Join(out, "cmd", "*", "main.go"))
+			if len(stubs) != 1 {
+				t.Fatalf("CLI stubs = %q, want one", stubs)
+			}
+			cli, err := os.ReadFile(stubs[0])
 			if err != nil {
-				t.Fatalf("reading generated file: %v", err)
+				t.Fatal(err)
 			}
-
-			if string(goldenData) != string(generatedData) {
-				t.Errorf("generated output differs from golden file %s", name)
-				// Show a diff summary.
-				goldenLines := strings.Split(string(goldenData), "\n")
-				generatedLines := strings.Split(string(generatedData), "\n")
-				maxLines := len(goldenLines)
-				if len(generatedLines) > maxLines {
-					maxLines = len(generatedLines)
-				}
-				diffCount := 0
-				for i := 0; i < maxLines; i++ {
-					var gl, genl string
-					if i < len(goldenLines) {
-						gl = goldenLines[i]
-					}
-					if i < len(generatedLines) {
-						genl = generatedLines[i]
-					}
-					if gl != genl {
-						if diffCount < 10 {
-							t.Errorf("  line %d:\n    golden:    %q\n    generated: %q", i+1, gl, genl)
-						}
-						diffCount++
-					}
-				}
-				if diffCount > 10 {
-					t.Errorf("  ... and %d more differences", diffCount-10)
-				}
+			if want := "\n//go:generate " + tt.want + "\n"; !bytes.Contains(cli, []byte(want)) {
+				t.Errorf("CLI stub lacks %q:\n%s", want, cli)
+			}
+			if err := os.RemoveAll(filepath.Join(out, "cmd")); err != nil {
+				t.Fatal(err)
 			}
 		})
 	}
 }
 
+// TestGenerateSingleFile checks that WithSingleFile writes one generated.go,
+// with one header, beside the test files and CLI stub, and that the result
+// compiles and runs.
+func TestGenerateSingleFile(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "facets"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	if err := Generate(pkg, tmpDir, WithSingleFile(), WithMock()); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(tmpDir, "*.go"))
+	for _, path := range files {
+		if name := filepath.Base(path); name != "generated.go" && !strings.HasSuffix(name, "_test.go") {
+			t.Errorf("%s written beside generated.go", name)
+		}
+	}
+	src, err := os.ReadFile(filepath.Join(tmpDir, "generated.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := bytes.Count(src, []byte(strings.TrimSpace(header))); n != 1 {
+		t.Errorf("generated.go has %d headers, want 1", n)
+	}
+	if n := bytes.Count(src, []byte("\npackage ")); n != 1 {
+		t.Errorf("generated.go has %d package clauses, want 1", n)
+	}
+	if !bytes.Contains(src, []byte("type MockClient struct")) {
+		t.Error("generated.go lacks the mock client")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "cmd", pkg.Name, "main.go")); err != nil {
+		t.Errorf("CLI stub not found: %v", err)
+	}
+
+	runGeneratedTest(t, "facets", countEdgesTest, []Option{WithSingleFile()})
+}
+
+// TestGenerateOutputFiles checks the files Generate writes for the movies
+// package, with and without WithSkipCLI.
 func TestGenerateOutputFiles(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		checkOutputFiles(t, nil, true)
+	})
+	t.Run("WithSkipCLI", func(t *testing.T) {
+		checkOutputFiles(t, []Option{WithSkipCLI()}, false)
+	})
+}
+
+// checkOutputFiles generates the movies package with opts and checks that the
+// expected files were written, including the CLI stub if wantCLI.
+func checkOutputFiles(t *testing.T, opts []Option, wantCLI bool) {
+	t.Helper()
 	dir := moviesDir(t)
 	pkg, err := parser.Parse(dir)
 	if err != nil {
 		t.Fatalf("Parse(%s) failed: %v", dir, err)
 	}
 
-	tmpDir := t.TempDir()
-	if err := Generate(pkg, tmpDir); err != nil {
+	tmpDir := outputDir(t)
+	if err := Generate(pkg, tmpDir, opts...); err != nil {
 		t.Fatalf("Generate failed: %v", err)
 	}
 
@@ -160,6 +3452,14 @@ func TestGenerateOutputFiles(t *testing.T) {
 		"client_gen.go",
 		"page_options_gen.go",
 		"iter_gen.go",
+		"schema_gen.go",
+		"dql_gen.go",
+		"filter_gen.go",
+		"get_options_gen.go",
+		"txn_gen.go",
+		"entities_gen.go",
+		"retry_gen.go",
+		"dgraph_json_gen.go",
 	}
 
 	// Per-entity files.
@@ -172,6 +3472,8 @@ func TestGenerateOutputFiles(t *testing.T) {
 			e+"_gen.go",
 			e+"_options_gen.go",
 			e+"_query_gen.go",
+			e+"_bench_gen_test.go",
+			e+"_conformance_gen_test.go",
 		)
 	}
 
@@ -188,11 +3490,19 @@ func TestGenerateOutputFiles(t *testing.T) {
 		})
 	}
 
+	// The mock client is only generated on request.
+	if _, err := os.Stat(filepath.Join(tmpDir, "mock_client_gen.go")); err == nil {
+		t.Error("mock_client_gen.go generated without WithMock")
+	}
+
 	// Verify CLI stub.
 	cliPath := filepath.Join(tmpDir, "cmd", "movies", "main.go")
-	if _, err := os.Stat(cliPath); err != nil {
+	if _, err := os.Stat(cliPath); wantCLI && err != nil {
 		t.Errorf("CLI stub not found: %v", err)
 	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "cmd")); !wantCLI && err == nil {
+		t.Error("cmd directory generated with WithSkipCLI")
+	}
 }
 
 func TestGenerateHeader(t *testing.T) {
@@ -202,7 +3512,7 @@ func TestGenerateHeader(t *testing.T) {
 		t.Fatalf("Parse(%s) failed: %v", dir, err)
 	}
 
-	tmpDir := t.TempDir()
+	tmpDir := outputDir(t)
 	if err := Generate(pkg, tmpDir); err != nil {
 		t.Fatalf("Generate failed: %v", err)
 	}
@@ -225,30 +3535,75 @@ func TestGenerateHeader(t *testing.T) {
 			if !strings.HasPrefix(string(data), "// Code generated by modusGraphGen. DO NOT EDIT.") {
 				t.Errorf("file %s does not start with expected header", entry.Name())
 			}
+			if !hasGeneratedHeader(data) {
+				t.Errorf("file %s is not recognized as generated", entry.Name())
+			}
 		})
 	}
 }
 
-func TestToSnakeCase(t *testing.T) {
-	tests := []struct {
-		input string
-		want  string
-	}{
-		{"Film", "film"},
-		{"ContentRating", "content_rating"},
-		{"UID", "uid"},
-		{"HTTPServer", "http_server"},
-		{"Actor", "actor"},
-		{"Performance", "performance"},
-		{"Location", "location"},
+func TestGenerateCustomHeader(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
 	}
-	for _, tt := range tests {
-		t.Run(tt.input, func(t *testing.T) {
-			got := toSnakeCase(tt.input)
-			if got != tt.want {
-				t.Errorf("toSnakeCase(%q) = %q, want %q", tt.input, got, tt.want)
-			}
-		})
+	const license = "Copyright 2026 Example Corp.\n\nSPDX-License-Identifier: Apache-2.0\n// source-hash: {{.Hash}} {{.Package}}/{{.File}}\n"
+
+	tmpDir := outputDir(t)
+	if err := Generate(pkg, tmpDir, WithHeader(license)); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	for _, name := range []string{"person_gen.go", "cmd/selfref/main.go"} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		head, body, ok := strings.Cut(string(data), "DO NOT EDIT.\n\n")
+		if !ok {
+			t.Fatalf("%s has no generated line:\n%s", name, data)
+		}
+		sum := sha256.Sum256([]byte(body))
+		want := "// Copyright 2026 Example Corp.\n//\n// SPDX-License-Identifier: Apache-2.0\n" +
+			"// source-hash: " + hex.EncodeToString(sum[:]) + " selfref/" + name + "\n" +
+			"// Code generated by modusGraphGen. "
+		if head != want {
+			t.Errorf("%s header =\n%s\nwant\n%s", name, head, want)
+		}
+		if !hasGeneratedHeader(data) {
+			t.Errorf("%s is not recognized as generated", name)
+		}
+		file, err := goparser.ParseFile(token.NewFileSet(), name, data, goparser.ParseComments)
+		if err != nil {
+			t.Fatalf("%s does not parse: %v", name, err)
+		}
+		if file.Doc != nil {
+			t.Errorf("%s: header became the package doc comment", name)
+		}
+	}
+
+	// Files with the custom header still count as generated under -overlay.
+	err = Generate(pkg, tmpDir, WithHeader(license), WithOverlay(func(msg string) {
+		t.Errorf("unexpected overlay warning: %s", msg)
+	}))
+	if err != nil {
+		t.Fatalf("Generate with overlay failed: %v", err)
+	}
+
+	// A header with its own generated line gets no second one.
+	own := "// Code generated by acme-gen via modusGraphGen. DO NOT EDIT.\n"
+	if err := Generate(pkg, tmpDir, WithHeader(own)); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, "client_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), own+"\npackage selfref") {
+		t.Errorf("client_gen.go starts with\n%.120s\nwant only the custom generated line", data)
+	}
+
+	if err := Generate(pkg, t.TempDir(), WithHeader("{{.Nope")); err == nil {
+		t.Error("Generate with a malformed header succeeded")
 	}
 }
 
@@ -269,3 +3624,190 @@ func TestSearchPredicate(t *testing.T) {
 		}
 	}
 }
+
+func TestSelectionScalars(t *testing.T) {
+	entity := model.Entity{
+		Name: "Location",
+		Fields: []model.Field{
+			{Name: "UID", GoType: "string", IsUID: true},
+			{Name: "DType", GoType: "[]string", IsDType: true},
+			{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "name"},
+			{Name: "Capacity", GoType: "int", JSONTag: "capacity", Predicate: "capacity", TypeHint: "float"},
+			{Name: "Password", GoType: "string", JSONTag: "password", Predicate: "password", TypeHint: "password"},
+		},
+	}
+	// Password hashes are never selected.
+	if got, want := selectionScalars(entity), "uid dgraph.type name capacity"; got != want {
+		t.Errorf("selectionScalars = %q, want %q", got, want)
+	}
+}
+
+func TestGenerateLogger(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "multisearch"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	dir := outputDir(t)
+	if err := Generate(pkg, dir, WithLogger(logging.New(&buf, logging.Verbose))); err != nil {
+		t.Fatal(err)
+	}
+	if want := "wrote " + filepath.Join(dir, "film_gen.go") + "\n"; !strings.Contains(buf.String(), want) {
+		t.Errorf("log does not contain %q:\n%s", want, buf.String())
+	}
+
+	buf.Reset()
+	if err := Generate(pkg, dir, WithLogger(logging.New(&buf, logging.Normal))); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Normal level logged:\n%s", buf.String())
+	}
+
+	// Any logging.Sink gets every detail.
+	var sink recordSink
+	if err := Generate(pkg, dir, WithLogger(&sink)); err != nil {
+		t.Fatal(err)
+	}
+	if want := "wrote " + filepath.Join(dir, "film_gen.go"); !slices.Contains(sink.debug, want) {
+		t.Errorf("Debugf got %q, want %q", sink.debug, want)
+	}
+}
+
+// recordSink is a logging.Sink that records the details it gets. It is safe
+// for concurrent use.
+type recordSink struct {
+	mu    sync.Mutex
+	debug []string
+}
+
+func (s *recordSink) Debugf(format string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.debug = append(s.debug, fmt.Sprintf(format, args...))
+}
+
+func (s *recordSink) Warnf(format string, args ...any) {}
+
+// schemaTest is run against the code generated from the schema fixture's
+// movies.schema, whose structs are generated too.
+const schemaTest = `package movies
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSchemaStructs(t *testing.T) {
+	f := Film{
+		Name:               "Alien",
+		InitialReleaseDate: time.Date(1979, 5, 25, 0, 0, 0, 0, time.UTC),
+		Runtime:            117,
+		Genre:              []Genre{{Name: "Horror"}},
+		Director:           &Director{Name: "Ridley Scott"},
+	}
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{` + "`" + `"name":"Alien"` + "`" + `, ` + "`" + `"runtime":117` + "`" + `, ` + "`" + `"genre":[` + "`" + `, ` + "`" + `"film.director":{` + "`" + `} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("Film JSON %s lacks %s", data, key)
+		}
+	}
+	var g Genre
+	if err := json.Unmarshal([]byte(` + "`" + `{"name":"Horror","films":[{"name":"Alien"}]}` + "`" + `), &g); err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Films) != 1 || g.Films[0].Name != "Alien" {
+		t.Errorf("Genre = %+v, want its film Alien", g)
+	}
+}
+`
+
+// TestGenerateFromSchema checks that the code generated from a Dgraph schema
+// file compiles, and that its DQLSchema declares the file's predicates as the
+// file does.
+func TestGenerateFromSchema(t *testing.T) {
+	runGeneratedTest(t, "schema", schemaTest, nil)
+
+	src, err := os.ReadFile(filepath.Join(fixtureDir(t, "schema"), "movies.schema"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated, err := os.ReadFile(filepath.Join(fixtureDir(t, "schema"), "golden", "schema_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(src), "\n") {
+		if !strings.HasSuffix(line, " .") || strings.HasPrefix(line, "dgraph.") {
+			continue
+		}
+		want := strings.NewReplacer("<", "", ">", "").Replace(line)
+		if !strings.Contains(string(generated), "\n"+want+"\n") {
+			t.Errorf("DQLSchema lacks %q", want)
+		}
+	}
+}
+
+// readOnlyTest is run against the readonly fixture, whose Rating entity has a
+// readonly directive.
+const readOnlyTest = `package readonly
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestReadOnly(t *testing.T) {
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf((*RatingAPI)(nil)).Elem(),
+		reflect.TypeOf(&RatingClient{}),
+		reflect.TypeOf(&RatingTxn{}),
+	} {
+		for _, name := range []string{"Add", "Create", "Update", "UpdateFields", "SetScore", "Delete", "AddTags", "AddFilms"} {
+			if _, ok := typ.MethodByName(name); ok {
+				t.Errorf("%s has a %s method", typ, name)
+			}
+		}
+		for _, name := range []string{"Get", "Exists", "List", "Find"} {
+			if _, ok := typ.MethodByName(name); !ok {
+				t.Errorf("%s lacks a %s method", typ, name)
+			}
+		}
+	}
+	if _, ok := reflect.TypeOf(&FilmClient{}).MethodByName("Add"); !ok {
+		t.Error("FilmClient lacks Add")
+	}
+
+	// The mock can still be seeded with ratings for code reading them
+	// through RatingAPI.
+	mock := NewMockClient()
+	ctx := context.Background()
+	r := Rating{Source: "critics", Score: 4.5}
+	if err := mock.Rating.Add(ctx, &r); err != nil {
+		t.Fatal(err)
+	}
+	var api RatingAPI = mock.Rating
+	got, err := api.Get(ctx, r.UID)
+	if err != nil || got.Score != 4.5 {
+		t.Errorf("Get = %+v, %v, want the seeded rating", got, err)
+	}
+}
+`
+
+// TestGenerateReadOnly checks that a readonly entity gets read methods only,
+// in its client, API, and Txn, and no conformance test, which would write.
+func TestGenerateReadOnly(t *testing.T) {
+	runGeneratedTest(t, "readonly", readOnlyTest, []Option{WithMock()})
+	golden := filepath.Join(fixtureDir(t, "readonly"), "golden")
+	if _, err := os.Stat(filepath.Join(golden, "rating_conformance_gen_test.go")); !os.IsNotExist(err) {
+		t.Errorf("conformance test generated for Rating: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(golden, "rating_summary_gen.go")); !os.IsNotExist(err) {
+		t.Errorf("client generated for RatingSummary, which has generate=false: %v", err)
+	}
+}
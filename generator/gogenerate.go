@@ -0,0 +1,109 @@
+package generator
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// generatorPath is the import path of the command that the CLI stub's
+// go:generate directive runs.
+const generatorPath = "github.com/mlwelles/modusGraphGen"
+
+// generateCommand returns the command of the CLI stub's go:generate directive:
+// a modusGraphGen command line that, run from cliDir as go generate runs it,
+// regenerates the package with the settings of cfg. Settings that only the
+// library has, such as WithPlurals and the text of WithHeader, have no flag and
+// are left out; WithGenerateFlags supplies the flags they came from, if any.
+func generateCommand(cfg options, cliDir string) (string, error) {
+	rel := func(path string) (string, error) {
+		from, err := filepath.Abs(cliDir)
+		if err != nil {
+			return "", err
+		}
+		to, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		r, err := filepath.Rel(from, to)
+		if err != nil {
+			return "", err
+		}
+		return filepath.ToSlash(r), nil
+	}
+
+	source := cfg.sourceDir
+	if source == "" {
+		source = cfg.outputDir
+	}
+	pkgDir, err := rel(source)
+	if err != nil {
+		return "", err
+	}
+	args := []string{"go", "run", generatorPath, "-pkg", pkgDir}
+	if outDir, err := rel(cfg.outputDir); err != nil {
+		return "", err
+	} else if outDir != pkgDir {
+		args = append(args, "-output", outDir)
+	}
+	if cfg.packageName != "" {
+		args = append(args, "-package", cfg.packageName)
+	}
+	switch {
+	case cfg.tests:
+		args = append(args, "-tests")
+	case cfg.mock:
+		args = append(args, "-mock")
+	}
+	if cfg.singleFile {
+		args = append(args, "-single-file")
+	}
+	if cfg.mermaid {
+		args = append(args, "-mermaid")
+	}
+	if cfg.graphql {
+		args = append(args, "-graphql")
+	}
+	if cfg.strict {
+		args = append(args, "-strict")
+	}
+	if cfg.overlay {
+		args = append(args, "-overlay")
+	}
+	if cfg.positions {
+		args = append(args, "-source-positions")
+	}
+	if cfg.typePrefix != "" {
+		args = append(args, "-entity-prefix", cfg.typePrefix)
+	}
+	if cfg.typeSuffix != "" {
+		args = append(args, "-entity-suffix", cfg.typeSuffix)
+	}
+	if cfg.templateDir != "" {
+		dir, err := rel(cfg.templateDir)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-templates", dir)
+	}
+	if cfg.importPath != "" {
+		args = append(args, "-import-path", cfg.importPath)
+	}
+	for _, arg := range cfg.genFlags {
+		if filepath.IsAbs(arg) {
+			if arg, err = rel(arg); err != nil {
+				return "", err
+			}
+		}
+		args = append(args, arg)
+	}
+
+	// go generate splits the command on spaces, keeping double-quoted
+	// strings whole.
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"") {
+			args[i] = strconv.Quote(arg)
+		}
+	}
+	return strings.Join(args, " "), nil
+}
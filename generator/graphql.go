@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// writeGraphQLFile writes the Dgraph GraphQL schema of pkg to path.
+func writeGraphQLFile(path string, pkg *model.Package) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	err = writeGraphQL(f, pkg)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeGraphQL writes pkg to w as a Dgraph GraphQL schema: a type per entity,
+// including those of other packages that edges lead into, mapped onto the
+// same Dgraph types and predicates as DQLSchema with @dgraph. A field tagged
+// unique is an @id. Dgraph GraphQL has no composite @id, so the fields of a
+// unique=<key> are listed in a comment above their type instead.
+func writeGraphQL(w io.Writer, pkg *model.Package) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintln(b, "# Code generated by modusGraphGen. DO NOT EDIT.")
+	for _, e := range append(append([]model.Entity(nil), pkg.Entities...), pkg.External...) {
+		fmt.Fprintln(b)
+		name := graphqlTypeName(e.Name)
+		var keys []string
+		members := make(map[string][]string)
+		var secrets []model.Field
+		for _, f := range e.Fields {
+			if f.UniqueKey == "" {
+				continue
+			}
+			if members[f.UniqueKey] == nil {
+				keys = append(keys, f.UniqueKey)
+			}
+			members[f.UniqueKey] = append(members[f.UniqueKey], graphqlFieldName(f))
+		}
+		for _, key := range keys {
+			ms := members[key]
+			together := strings.Join(ms[:len(ms)-1], ", ") + " and " + ms[len(ms)-1]
+			fmt.Fprintf(b, "# %s are unique together (unique=%s). @id marks single fields\n", together, key)
+			fmt.Fprintln(b, "# only, so this schema does not enforce it.")
+		}
+
+		var lines []string
+		for _, f := range e.Fields {
+			switch {
+			case f.IsUID:
+				lines = append(lines, "id: ID!")
+			case f.IsDType || f.JSONTag == "-" || f.Predicate == "" || f.CountOf != "":
+			case f.TypeHint == "password":
+				secrets = append(secrets, f)
+			case len(localeFields([]model.Field{f})) > 0:
+				for _, locale := range f.Locales {
+					lines = append(lines, fmt.Sprintf("%s%s: String @dgraph(pred: %q)", graphqlFieldName(f), localeSuffix(locale), localeKey(f, locale)))
+				}
+			default:
+				lines = append(lines, graphqlField(f))
+			}
+		}
+
+		fmt.Fprintf(b, "type %s", name)
+		if dt := dgraphType(e); dt[strings.LastIndex(dt, ".")+1:] != name {
+			fmt.Fprintf(b, " @dgraph(type: %q)", dt)
+		}
+		for _, f := range secrets {
+			fmt.Fprintf(b, " @secret(field: %q, pred: %q)", graphqlFieldName(f), f.Predicate)
+		}
+		fmt.Fprintln(b, " {")
+		for _, line := range lines {
+			fmt.Fprintf(b, "\t%s\n", line)
+		}
+		fmt.Fprintln(b, "}")
+	}
+	return b.Flush()
+}
+
+// graphqlField returns the GraphQL field definition of f, an edge or stored
+// scalar, e.g. `name: String! @id @search(by: [hash]) @dgraph(pred: "name")`.
+func graphqlField(f model.Field) string {
+	var typ string
+	switch {
+	case f.IsEdge:
+		typ = graphqlTypeName(f.EdgeEntity)
+		if !singleEdge(f) {
+			typ = "[" + typ + "]"
+		}
+	case f.VectorMetric != "":
+		typ = "[Float!]"
+	default:
+		typ = graphqlScalar(f)
+		if f.IsList {
+			typ = "[" + typ + "]"
+		}
+	}
+	if f.Required || f.Unique {
+		typ += "!"
+	}
+	def := graphqlFieldName(f) + ": " + typ
+	if f.Unique {
+		def += " @id"
+	}
+	if f.VectorMetric != "" {
+		def += " @embedding"
+	}
+	if search := graphqlSearch(f); search != "" {
+		def += " " + search
+	}
+	return def + fmt.Sprintf(" @dgraph(pred: %q)", f.Predicate)
+}
+
+// graphqlScalar returns the GraphQL type of a scalar field's values, from
+// its Dgraph type: Int for Go integers of up to 32 bits and Int64 for wider
+// ones, as GraphQL's Int is 32-bit.
+func graphqlScalar(f model.Field) string {
+	switch dgraphScalar(f) {
+	case "int":
+		goType := strings.TrimPrefix(underlyingType(f), "*")
+		if f.IsList {
+			goType, _ = cutList(goType)
+		}
+		switch goType {
+		case "int8", "int16", "int32", "uint8", "uint16", "byte":
+			return "Int"
+		}
+		return "Int64"
+	case "float":
+		return "Float"
+	case "bool":
+		return "Boolean"
+	case "datetime":
+		return "DateTime"
+	case "geo":
+		return "Point"
+	}
+	return "String"
+}
+
+// graphqlSearch returns the @search directive for the indexes of f, e.g.
+// "@search(by: [hash, term])", or "" if it has none. Indexes of numbers,
+// booleans, and geo values take no arguments.
+func graphqlSearch(f model.Field) string {
+	var by []string
+	bare := false
+	for _, idx := range f.Indexes {
+		switch idx {
+		case "hash", "exact", "term", "fulltext", "trigram", "regexp", "year", "month", "day", "hour":
+			by = append(by, idx)
+		case "hnsw":
+			by = append(by, fmt.Sprintf("%q", "hnsw(metric: "+f.VectorMetric+")"))
+		case "int", "float", "bool", "geo":
+			bare = true
+		}
+	}
+	switch {
+	case len(by) > 0:
+		return "@search(by: [" + strings.Join(by, ", ") + "])"
+	case bare:
+		return "@search"
+	}
+	return ""
+}
+
+// graphqlTypeName returns the GraphQL type of an entity: its name without the
+// package qualifier, e.g. "Person" for "people.Person", as its Dgraph type is.
+func graphqlTypeName(entity string) string {
+	return entity[strings.LastIndex(entity, ".")+1:]
+}
+
+// graphqlFieldName returns the GraphQL name of f: its JSON key if that is a
+// valid GraphQL name, e.g. "initialReleaseDate", and its Go name in lower
+// camel case otherwise.
+func graphqlFieldName(f model.Field) string {
+	key := jsonKey(f)
+	for i, r := range key {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			return toLowerCamel(f.Name)
+		}
+	}
+	if key == "" || strings.HasPrefix(key, "__") {
+		return toLowerCamel(f.Name)
+	}
+	return key
+}
@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+func TestGenerateGraphQL(t *testing.T) {
+	pkg := &model.Package{
+		Name: "movies",
+		Entities: []model.Entity{
+			{Name: "Film", DgraphType: "film", Fields: []model.Field{
+				{Name: "UID", GoType: "string", JSONTag: "uid", Predicate: "uid", IsUID: true},
+				{Name: "DType", GoType: "[]string", JSONTag: "dgraph.type", Predicate: "dgraph.type", IsDType: true},
+				{Name: "Name", GoType: "map[string]string", JSONTag: "name", Predicate: "name", IsMap: true, Locales: []string{"en", "pt-BR"}},
+				{Name: "Rating", GoType: "int32", JSONTag: "rating", Predicate: "rating", Required: true},
+				{Name: "Embedding", GoType: "[]float32", JSONTag: "embedding", Predicate: "embedding", IsList: true, Indexes: []string{"hnsw"}, VectorMetric: "cosine"},
+				{Name: "Cast", GoType: "[]people.Person", JSONTag: "cast", Predicate: "film.cast", IsEdge: true, EdgeEntity: "people.Person"},
+				{Name: "CastCount", GoType: "int", JSONTag: "castCount", Predicate: "film.cast", CountOf: "film.cast"},
+			}},
+		},
+		External: []model.Entity{
+			{Name: "people.Person", Fields: []model.Field{
+				{Name: "UID", GoType: "string", JSONTag: "uid", Predicate: "uid", IsUID: true},
+				{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "person.name"},
+			}},
+		},
+	}
+
+	dir := outputDir(t)
+	if err := Generate(pkg, dir, WithGraphQL()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "schema_gen.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+
+	for _, want := range []string{
+		"type Film @dgraph(type: \"film\") {\n\tid: ID!\n",
+		"\tnameEn: String @dgraph(pred: \"name@en\")\n",
+		"\tnamePtBR: String @dgraph(pred: \"name@pt-BR\")\n",
+		"\trating: Int! @dgraph(pred: \"rating\")\n",
+		"\tembedding: [Float!] @embedding @search(by: [\"hnsw(metric: cosine)\"]) @dgraph(pred: \"embedding\")\n",
+		"\tcast: [Person] @dgraph(pred: \"film.cast\")\n",
+		"type Person {\n\tid: ID!\n\tname: String @dgraph(pred: \"person.name\")\n}\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("schema_gen.graphql does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "castCount") {
+		t.Errorf("schema_gen.graphql declares the count field:\n%s", got)
+	}
+
+	// Without WithGraphQL no schema is written.
+	dir = outputDir(t)
+	if err := Generate(pkg, dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "schema_gen.graphql")); !os.IsNotExist(err) {
+		t.Errorf("schema_gen.graphql written without WithGraphQL: %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// generatedLine matches the line that marks a Go file as generated, per the
+// convention that go generate tooling and linters recognize.
+var generatedLine = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// headerData is the data a custom header template is executed with.
+type headerData struct {
+	File    string // Path of the file relative to the output directory, e.g. "film_gen.go"
+	Package string // Name of the package the files are generated for, e.g. "movies"
+	Hash    string // Hex SHA-256 of the file's content below the header
+}
+
+// fileHeader renders the comment block that starts each generated Go file.
+type fileHeader struct {
+	tmpl      *template.Template // Custom header from WithHeader; nil for the default
+	pkgName   string
+	outputDir string
+}
+
+// newFileHeader parses the custom header text, if any, for the files that
+// Generate writes for pkgName into outputDir.
+func newFileHeader(text, pkgName, outputDir string) (fileHeader, error) {
+	h := fileHeader{pkgName: pkgName, outputDir: outputDir}
+	if text == "" {
+		return h, nil
+	}
+	tmpl, err := template.New("header").Parse(text)
+	if err != nil {
+		return fileHeader{}, fmt.Errorf("parsing header: %w", err)
+	}
+	h.tmpl = tmpl
+	return h, nil
+}
+
+// render returns the header for the file at path with the given content. A
+// custom header has each line that is not already a comment turned into one,
+// and the default "Code generated" line appended unless it has one of its own.
+func (h fileHeader) render(path string, body []byte) ([]byte, error) {
+	if h.tmpl == nil {
+		return []byte(header), nil
+	}
+	rel, err := filepath.Rel(h.outputDir, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+	sum := sha256.Sum256(body)
+	var text bytes.Buffer
+	data := headerData{File: filepath.ToSlash(rel), Package: h.pkgName, Hash: hex.EncodeToString(sum[:])}
+	if err := h.tmpl.Execute(&text, data); err != nil {
+		return nil, fmt.Errorf("executing header: %w", err)
+	}
+
+	var out bytes.Buffer
+	marked := false
+	for _, line := range strings.Split(strings.TrimRight(text.String(), "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		switch {
+		case line == "":
+			line = "//"
+		case !strings.HasPrefix(line, "//"):
+			line = "// " + line
+		}
+		marked = marked || generatedLine.MatchString(line)
+		out.WriteString(line + "\n")
+	}
+	if !marked {
+		out.WriteString(strings.TrimSuffix(header, "\n"))
+	}
+	out.WriteString("\n")
+	return out.Bytes(), nil
+}
+
+// hasGeneratedHeader returns true if the comment lines at the top of src,
+// before any code, include a "Code generated ... DO NOT EDIT." line.
+func hasGeneratedHeader(src []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if generatedLine.MatchString(line) {
+			return true
+		}
+		if line != "" && !strings.HasPrefix(line, "//") {
+			return false
+		}
+	}
+	return false
+}
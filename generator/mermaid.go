@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// writeMermaidFile writes the Mermaid ER diagram of pkg to path.
+func writeMermaidFile(path string, pkg *model.Package) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	err = writeMermaid(f, pkg)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeMermaid writes pkg to w as a Mermaid erDiagram. Each entity lists its
+// stored scalar predicates with their Dgraph types, "string[]" for a list,
+// and each forward edge is a relationship labeled with its predicate, to many
+// entities for a list and to one otherwise. Reverse edges are left out, as
+// the forward edge already draws them.
+func writeMermaid(w io.Writer, pkg *model.Package) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintln(b, "%% Code generated by modusGraphGen. DO NOT EDIT.")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "erDiagram")
+	for _, e := range pkg.Entities {
+		var attrs []model.Field
+		for _, f := range jsonFields(e.Fields) {
+			if !f.IsEdge && !strings.HasPrefix(f.Predicate, "~") {
+				attrs = append(attrs, f)
+			}
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(b, "    %s {\n    }\n", mermaidName(e.Name))
+			continue
+		}
+		fmt.Fprintf(b, "    %s {\n", mermaidName(e.Name))
+		for _, f := range attrs {
+			typ := dgraphScalar(f)
+			if f.IsList {
+				typ += "[]"
+			}
+			fmt.Fprintf(b, "        %s %s\n", typ, mermaidName(f.Predicate))
+		}
+		fmt.Fprintln(b, "    }")
+	}
+	for _, e := range pkg.Entities {
+		for _, f := range edgeFields(e.Fields) {
+			if f.Predicate == "" || strings.HasPrefix(f.Predicate, "~") {
+				continue
+			}
+			card := "o|"
+			if _, many := cutList(underlyingType(f)); many {
+				card = "o{"
+			}
+			fmt.Fprintf(b, "    %s }o--%s %s : %q\n", mermaidName(e.Name), card, mermaidName(f.EdgeEntity), f.Predicate)
+		}
+	}
+	return b.Flush()
+}
+
+// mermaidName replaces the characters Mermaid does not allow in entity and
+// attribute names, e.g. "people.Person" becomes "people_Person".
+func mermaidName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
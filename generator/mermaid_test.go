@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+func TestGenerateMermaid(t *testing.T) {
+	pkg := &model.Package{
+		Name: "movies",
+		Entities: []model.Entity{
+			{Name: "Film", Fields: []model.Field{
+				{Name: "UID", GoType: "string", JSONTag: "uid", Predicate: "uid", IsUID: true},
+				{Name: "DType", GoType: "[]string", JSONTag: "dgraph.type", Predicate: "dgraph.type", IsDType: true},
+				{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "name"},
+				{Name: "InitialReleaseDate", GoType: "time.Time", JSONTag: "initialReleaseDate", Predicate: "initial_release_date"},
+				{Name: "Tags", GoType: "[]string", JSONTag: "tags", Predicate: "tags", IsList: true},
+				{Name: "Genres", GoType: "[]Genre", JSONTag: "genres", Predicate: "genre", IsEdge: true, EdgeEntity: "Genre"},
+				{Name: "Sequel", GoType: "*Film", JSONTag: "sequel", Predicate: "sequel", IsEdge: true, EdgeEntity: "Film", IsSelfRef: true},
+			}},
+			{Name: "Genre", Fields: []model.Field{
+				{Name: "Name", GoType: "string", JSONTag: "name", Predicate: "name"},
+				{Name: "Films", GoType: "[]Film", JSONTag: "films", Predicate: "~genre", IsEdge: true, IsReverse: true, EdgeEntity: "Film"},
+			}},
+		},
+	}
+
+	dir := outputDir(t)
+	if err := Generate(pkg, dir, WithMermaid()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "graph_gen.mmd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+
+	for _, want := range []string{
+		"erDiagram\n",
+		"    Film {\n        string name\n        datetime initial_release_date\n        string[] tags\n    }\n",
+		"    Genre {\n        string name\n    }\n",
+		"    Film }o--o{ Genre : \"genre\"\n",
+		"    Film }o--o| Film : \"sequel\"\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("graph_gen.mmd does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "~genre") {
+		t.Errorf("graph_gen.mmd draws the reverse edge:\n%s", got)
+	}
+
+	// Without WithMermaid no diagram is written.
+	dir = outputDir(t)
+	if err := Generate(pkg, dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "graph_gen.mmd")); !os.IsNotExist(err) {
+		t.Errorf("graph_gen.mmd written without WithMermaid: %v", err)
+	}
+}
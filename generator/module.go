@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/mod/modfile"
+)
+
+// goModDir returns the directory of the go.mod nearest to dir: dir itself or
+// the closest parent that has one.
+func goModDir(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for d := abs; ; {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(d)
+		if parent == d {
+			return "", fmt.Errorf("no go.mod found in %s or any parent directory", abs)
+		}
+		d = parent
+	}
+}
+
+// modulePath returns the module path declared by the go.mod nearest to dir,
+// e.g. "github.com/mlwelles/modusGraphMoviesProject".
+func modulePath(dir string) (string, error) {
+	root, err := goModDir(dir)
+	if err != nil {
+		return "", err
+	}
+	name := filepath.Join(root, "go.mod")
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	path := modfile.ModulePath(data)
+	if path == "" {
+		return "", fmt.Errorf("%s: no module directive", name)
+	}
+	return path, nil
+}
+
+// packageImportPath returns the import path of the package in dir: the module
+// path of the nearest go.mod joined with dir's place below it.
+func packageImportPath(dir string) (string, error) {
+	modPath, err := modulePath(dir)
+	if err != nil {
+		return "", err
+	}
+	root, err := goModDir(dir)
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == "." {
+		return modPath, err
+	}
+	return modPath + "/" + filepath.ToSlash(rel), nil
+}
@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/parser"
+)
+
+// writeModule creates a module with the given go.mod text in a temporary
+// directory, with an empty movies package directory, and returns the module
+// directory.
+func writeModule(t *testing.T, gomod string) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte(gomod), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "internal", "movies"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestModulePath(t *testing.T) {
+	tests := []struct {
+		name  string
+		gomod string
+		want  string
+	}{
+		{"plain", "module example.com/app\n\ngo 1.22\n", "example.com/app"},
+		{"quoted", "// The app.\nmodule \"example.com/app\"\n", "example.com/app"},
+		{"comment", "module example.com/app // the app\n", "example.com/app"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := writeModule(t, tt.gomod)
+			for _, dir := range []string{root, filepath.Join(root, "internal", "movies")} {
+				got, err := modulePath(dir)
+				if err != nil || got != tt.want {
+					t.Errorf("modulePath(%s) = %q, %v; want %q", dir, got, err, tt.want)
+				}
+			}
+		})
+	}
+
+	root := writeModule(t, "go 1.22\n")
+	if _, err := modulePath(root); err == nil || !strings.Contains(err.Error(), "no module directive") {
+		t.Errorf("modulePath without a module directive: error = %v", err)
+	}
+}
+
+func TestModulePathMissing(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := goModDir(dir); err == nil {
+		t.Skipf("%s is inside a module", dir)
+	}
+	_, err := modulePath(dir)
+	if err == nil || !strings.Contains(err.Error(), "no go.mod found") {
+		t.Errorf("modulePath error = %v, want one saying no go.mod was found", err)
+	}
+}
+
+func TestPackageImportPath(t *testing.T) {
+	root := writeModule(t, "module example.com/app\n")
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{root, "example.com/app"},
+		{filepath.Join(root, "internal", "movies"), "example.com/app/internal/movies"},
+	}
+	for _, tt := range tests {
+		got, err := packageImportPath(tt.dir)
+		if err != nil || got != tt.want {
+			t.Errorf("packageImportPath(%s) = %q, %v; want %q", tt.dir, got, err, tt.want)
+		}
+	}
+}
+
+// TestGenerateModuleImportPath checks that the CLI stub imports the generated
+// package by the import path its output directory has in the enclosing module.
+func TestGenerateModuleImportPath(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	outDir := filepath.Join(writeModule(t, "module example.com/app\n"), "internal", "movies")
+	if err := Generate(pkg, outDir); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	cli, err := os.ReadFile(filepath.Join(outDir, "cmd", pkg.Name, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := pkg.Name + ` "example.com/app/internal/movies"`; !strings.Contains(string(cli), want) {
+		t.Errorf("CLI stub does not import %s:\n%s", want, cli)
+	}
+}
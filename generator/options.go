@@ -0,0 +1,224 @@
+package generator
+
+import "github.com/mlwelles/modusGraphGen/logging"
+
+// Option configures Generate.
+type Option func(*options)
+
+// options holds the settings applied by Option values.
+type options struct {
+	overlay     bool
+	warn        func(msg string)
+	mock        bool
+	tests       bool
+	mermaid     bool
+	graphql     bool
+	strict      bool
+	strictWarn  func(msg string)
+	typePrefix  string
+	typeSuffix  string
+	log         logging.Sink
+	templateDir string
+	header      string
+	outputDir   string
+	packageName string
+	importPath  string
+	skipCLI     bool
+	singleFile  bool
+	plurals     map[string]string
+	sourceDir   string
+	genFlags    []string
+	positions   bool
+}
+
+// WithOverlay makes Generate respect hand-written files kept alongside the
+// generated ones. Files in the output directory that lack the generated header
+// are never overwritten, and generated methods whose names collide with
+// methods declared in hand-written files are reported. Each problem is passed
+// to warn; if warn is nil, Generate fails on the first one instead.
+func WithOverlay(warn func(msg string)) Option {
+	return func(o *options) {
+		o.overlay = true
+		o.warn = warn
+	}
+}
+
+// WithMock makes Generate also emit mock_client_gen.go, an in-memory
+// MockClient for unit tests. It is off by default so that production builds
+// don't carry the mock.
+func WithMock() Option {
+	return func(o *options) {
+		o.mock = true
+	}
+}
+
+// WithTests makes Generate also emit <entity>_gen_test.go for each entity, a
+// table-driven test that creates a sample entity with the MockClient, gets it
+// back, and deletes it, with TODO markers for the cases and assertions to add.
+// It implies WithMock.
+func WithTests() Option {
+	return func(o *options) {
+		o.mock = true
+		o.tests = true
+	}
+}
+
+// WithMermaid makes Generate also write graph_gen.mmd, a Mermaid erDiagram of
+// the entities, their scalar predicates, and the edges between them, for
+// documentation.
+func WithMermaid() Option {
+	return func(o *options) {
+		o.mermaid = true
+	}
+}
+
+// WithGraphQL makes Generate also write schema_gen.graphql, a Dgraph GraphQL
+// schema of the entities over the same types and predicates, in which fields
+// tagged unique are @id.
+func WithGraphQL() Option {
+	return func(o *options) {
+		o.graphql = true
+	}
+}
+
+// WithStrict makes Generate report model problems that it otherwise tolerates,
+// such as a "~predicate" field whose forward predicate no entity in the
+// package declares. Each problem is passed to warn; if warn is nil, Generate
+// fails on the first one instead.
+func WithStrict(warn func(msg string)) Option {
+	return func(o *options) {
+		o.strict = true
+		o.strictWarn = warn
+	}
+}
+
+// WithLogger makes Generate log each file it writes, and each template
+// override it uses, through l's Debugf. A *logging.Logger writes them at
+// logging.Verbose only.
+func WithLogger(l logging.Sink) Option {
+	return func(o *options) {
+		if l == nil {
+			l = logging.Discard
+		}
+		o.log = l
+	}
+}
+
+// WithTypeAffixes makes Generate wrap the entity name in the names of the
+// types it generates per entity, so that with prefix "Gen" and suffix "Model"
+// the Film entity gets GenFilmModelClient, GenFilmModelQuery, and so on. The
+// entity structs themselves keep their names. Use it when the package already
+// declares types such as FilmClient.
+func WithTypeAffixes(prefix, suffix string) Option {
+	return func(o *options) {
+		o.typePrefix = prefix
+		o.typeSuffix = suffix
+	}
+}
+
+// WithTemplateDir makes Generate use the templates in dir in place of the
+// built-in ones of the same file name, e.g. dir/entity.go.tmpl for
+// entity.go.tmpl. Built-in templates without an override are used as usual.
+// A .tmpl file in dir that names no built-in template is an error.
+func WithTemplateDir(dir string) Option {
+	return func(o *options) {
+		o.templateDir = dir
+	}
+}
+
+// WithHeader makes Generate start each Go file it writes with text instead of
+// the default "// Code generated by modusGraphGen. DO NOT EDIT." line. text is
+// a text/template executed with .File, the file's path relative to the output
+// directory; .Package, the package name; and .Hash, the hex SHA-256 of the
+// file's content below the header. Lines that are not already comments are
+// made into "//" comments, and the default line is appended unless text has a
+// "// Code generated ... DO NOT EDIT." line of its own, so that tools still
+// recognize the file as generated.
+func WithHeader(text string) Option {
+	return func(o *options) {
+		o.header = text
+	}
+}
+
+// WithOutputDir makes Run write the generated files into dir instead of the
+// current directory. The directory must already exist.
+func WithOutputDir(dir string) Option {
+	return func(o *options) {
+		o.outputDir = dir
+	}
+}
+
+// WithPackageName makes Run use name in the package clause of the generated
+// files instead of the parsed package's name, e.g. when generating into a
+// directory whose package is named differently.
+func WithPackageName(name string) Option {
+	return func(o *options) {
+		o.packageName = name
+	}
+}
+
+// WithImportPath makes the generated CLI stub import the generated package
+// from path, e.g. "example.com/app/movies". Without it, the import path is
+// worked out from the go.mod enclosing the output directory, and Run fails
+// if there is none, unless WithSkipCLI leaves the stub out.
+func WithImportPath(path string) Option {
+	return func(o *options) {
+		o.importPath = path
+	}
+}
+
+// WithSkipCLI makes Run leave out the CLI stub, cmd/<pkg>/main.go, and its
+// cmd directory, for library-only packages.
+func WithSkipCLI() Option {
+	return func(o *options) {
+		o.skipCLI = true
+	}
+}
+
+// WithSingleFile makes Run write the package's generated Go code into one file,
+// generated.go, with a single package clause, a merged import block, and one
+// header, instead of a file per template and entity. Test files, the CLI stub,
+// and graph_gen.mmd are written as usual.
+func WithSingleFile() Option {
+	return func(o *options) {
+		o.singleFile = true
+	}
+}
+
+// WithPlurals makes Run use the plurals in overrides, keyed by entity name,
+// e.g. {"Criterion": "Criteria"}, in place of those inflect.Plural forms, in
+// the names and doc comments it generates.
+func WithPlurals(overrides map[string]string) Option {
+	return func(o *options) {
+		o.plurals = overrides
+	}
+}
+
+// WithSourceDir tells Run the directory of the parsed package, which the CLI
+// stub's go:generate directive passes as -pkg. Without it, the package is
+// taken to be in the output directory, as it is by default.
+func WithSourceDir(dir string) Option {
+	return func(o *options) {
+		o.sourceDir = dir
+	}
+}
+
+// WithGenerateFlags adds flags to the command of the CLI stub's go:generate
+// directive, after those that Run's own options stand for: settings of the
+// parse, such as "-naming", "field_name", or the file that WithHeader's text
+// was read from. Absolute paths among them are written relative to the stub.
+func WithGenerateFlags(flags ...string) Option {
+	return func(o *options) {
+		o.genFlags = append(o.genFlags, flags...)
+	}
+}
+
+// WithSourcePositions makes Generate end the comment of each method generated
+// for a field, and of each <Entity>Client, with a line telling where the field
+// or entity is declared, e.g. "from film.go:42", for debugging the generated
+// code. The positions are those that Parse records in the model.
+func WithSourcePositions() Option {
+	return func(o *options) {
+		o.positions = true
+	}
+}
@@ -0,0 +1,174 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// overlay tracks the hand-written code in an output directory so that
+// generation can avoid clobbering it.
+type overlay struct {
+	dir  string
+	warn func(msg string)
+	// decls maps each package-level name, and "Type.Method" for methods, to
+	// the kind and position of its hand-written declaration.
+	decls map[string]handWritten
+}
+
+// handWritten is the kind and "file:line" position of a hand-written
+// declaration.
+type handWritten struct {
+	kind string
+	pos  string
+}
+
+// loadOverlay collects the package-level declarations and methods in every
+// hand-written Go file of the package in dir, i.e. every file not named
+// *_gen.go or *_gen_test.go and without the generated header, such as the
+// generated.go of WithSingleFile. Files of an external _test package are left
+// out, as their names cannot collide.
+func loadOverlay(dir string, warn func(msg string)) (*overlay, error) {
+	o := &overlay{dir: dir, warn: warn, decls: make(map[string]handWritten)}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_gen.go") || strings.HasSuffix(path, "_gen_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if hasGeneratedHeader(src) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing hand-written file %s: %w", path, err)
+		}
+		if strings.HasSuffix(file.Name.Name, "_test") {
+			continue
+		}
+		for _, d := range declarations(file) {
+			pos := fset.Position(d.pos)
+			o.decls[d.key] = handWritten{kind: d.kind, pos: fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)}
+		}
+	}
+	return o, nil
+}
+
+// check reports the problems with writing src to path: an existing file at path
+// that was not generated, and generated methods and package-level names, such
+// as the Filter type and the And, Or, and Not functions, that a hand-written
+// file in the same package already declares. It returns false if path must not
+// be written.
+func (o *overlay) check(path string, src []byte) (bool, error) {
+	if existing, err := os.ReadFile(path); err == nil && !hasGeneratedHeader(existing) {
+		return false, o.report(fmt.Sprintf("%s exists and was not generated by modusGraphGen; leaving it unchanged", path))
+	}
+	if filepath.Dir(path) != filepath.Clean(o.dir) {
+		return true, nil // e.g. the CLI, which lives in its own package
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, src, parser.SkipObjectResolution)
+	if err != nil {
+		return false, fmt.Errorf("parsing generated %s: %w", path, err)
+	}
+	var collisions []string
+	for _, d := range declarations(file) {
+		if hw, ok := o.decls[d.key]; ok {
+			collisions = append(collisions, fmt.Sprintf("generated %s %s in %s collides with hand-written %s at %s",
+				d.kind, d.key, filepath.Base(path), hw.kind, hw.pos))
+		}
+	}
+	sort.Strings(collisions)
+	for _, c := range collisions {
+		if err := o.report(c); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// report passes msg to the warning callback, or returns it as an error when
+// there is none.
+func (o *overlay) report(msg string) error {
+	if o.warn == nil {
+		return fmt.Errorf("overlay: %s", msg)
+	}
+	o.warn(msg)
+	return nil
+}
+
+// declaration is a declaration of kind "method", "func", "type", "var", or
+// "const", keyed by its name, or by "Type.Method" for a method.
+type declaration struct {
+	kind string
+	key  string
+	pos  token.Pos
+}
+
+// declarations returns the named package-level declarations and the methods
+// in file. Blank identifiers and init functions, which cannot collide, are
+// left out.
+func declarations(file *ast.File) []declaration {
+	var decls []declaration
+	add := func(kind string, name *ast.Ident) {
+		if name.Name != "_" {
+			decls = append(decls, declaration{kind: kind, key: name.Name, pos: name.Pos()})
+		}
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil && decl.Name.Name != "init" {
+				add("func", decl.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					add("type", spec.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						add(decl.Tok.String(), name)
+					}
+				}
+			}
+		}
+	}
+	return append(decls, declaredMethods(file)...)
+}
+
+// declaredMethods returns the methods declared in file.
+func declaredMethods(file *ast.File) []declaration {
+	var methods []declaration
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+			continue
+		}
+		recv := fn.Recv.List[0].Type
+		if star, ok := recv.(*ast.StarExpr); ok {
+			recv = star.X
+		}
+		if idx, ok := recv.(*ast.IndexExpr); ok {
+			recv = idx.X
+		}
+		ident, ok := recv.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		methods = append(methods, declaration{kind: "method", key: ident.Name + "." + fn.Name.Name, pos: fn.Name.Pos()})
+	}
+	return methods
+}
@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/parser"
+)
+
+const customPerson = `package selfref
+
+import "context"
+
+// List shadows the generated PersonClient.List.
+func (c *PersonClient) List(ctx context.Context) ([]Person, error) {
+	return nil, nil
+}
+
+// Popular does not collide with anything generated.
+func (c *PersonClient) Popular() {}
+`
+
+func TestGenerateOverlayCollision(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	if err := os.WriteFile(filepath.Join(tmpDir, "person_custom.go"), []byte(customPerson), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var warnings []string
+	err = Generate(pkg, tmpDir, WithOverlay(func(msg string) {
+		warnings = append(warnings, msg)
+	}))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %q", len(warnings), warnings)
+	}
+	for _, want := range []string{"PersonClient.List", "person_gen.go", "person_custom.go:6"} {
+		if !strings.Contains(warnings[0], want) {
+			t.Errorf("warning %q does not mention %q", warnings[0], want)
+		}
+	}
+
+	// Without a warning callback the collision is an error.
+	if err := Generate(pkg, tmpDir, WithOverlay(nil)); err == nil {
+		t.Error("Generate with WithOverlay(nil) succeeded despite a collision")
+	}
+}
+
+const customFilter = `package selfref
+
+// Filter is a hand-written type that the generated Filter would redeclare.
+type Filter struct{}
+
+// Or is a hand-written function that the generated Or would redeclare.
+func Or() {}
+`
+
+func TestGenerateOverlayPackageNameCollision(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	if err := os.WriteFile(filepath.Join(tmpDir, "filter.go"), []byte(customFilter), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	external := []byte("package selfref_test\n\nfunc And() {}\n")
+	if err := os.WriteFile(filepath.Join(tmpDir, "and_test.go"), external, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var warnings []string
+	err = Generate(pkg, tmpDir, WithOverlay(func(msg string) {
+		warnings = append(warnings, msg)
+	}))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	want := []string{
+		"generated func Or in filter_gen.go collides with hand-written func at filter.go:7",
+		"generated type Filter in filter_gen.go collides with hand-written type at filter.go:4",
+	}
+	if len(warnings) != len(want) {
+		t.Fatalf("got warnings %q, want %q", warnings, want)
+	}
+	for i := range want {
+		if warnings[i] != want[i] {
+			t.Errorf("warning %d = %q, want %q", i, warnings[i], want[i])
+		}
+	}
+}
+
+func TestGenerateOverlaySingleFileRerun(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	var warnings []string
+	for range 2 {
+		err = Generate(pkg, tmpDir, WithSingleFile(), WithOverlay(func(msg string) {
+			warnings = append(warnings, msg)
+		}))
+		if err != nil {
+			t.Fatalf("Generate failed: %v", err)
+		}
+	}
+	if len(warnings) != 0 {
+		t.Errorf("regenerating generated.go warned %q", warnings)
+	}
+}
+
+func TestGenerateOverlayKeepsHandWrittenFiles(t *testing.T) {
+	pkg, err := parser.Parse(fixtureDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	tmpDir := outputDir(t)
+	cliPath := filepath.Join(tmpDir, "cmd", pkg.Name, "main.go")
+	if err := os.MkdirAll(filepath.Dir(cliPath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	custom := []byte("package main\n\nfunc main() {}\n")
+	if err := os.WriteFile(cliPath, custom, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var warnings []string
+	err = Generate(pkg, tmpDir, WithOverlay(func(msg string) {
+		warnings = append(warnings, msg)
+	}))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	data, err := os.ReadFile(cliPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(custom) {
+		t.Error("hand-written cmd main.go was overwritten")
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "main.go") {
+		t.Errorf("warnings = %q, want one about main.go", warnings)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "person_gen.go")); err != nil {
+		t.Errorf("person_gen.go not generated: %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// schemaPredicate is a single predicate declaration in the emitted DQL schema.
+type schemaPredicate struct {
+	Name    string   // Predicate name, e.g. "initial_release_date"
+	Type    string   // Dgraph scalar type, e.g. "datetime", "uid"
+	IsList  bool     // True if the predicate holds a list, rendered as [type]
+	Indexes []string // Index tokenizers, rendered as @index(...)
+	Reverse bool     // Rendered as @reverse
+	Count   bool     // Rendered as @count
+	Upsert  bool     // Rendered as @upsert
+	Unique  bool     // Rendered as @unique
+	Lang    bool     // Rendered as @lang
+}
+
+// schemaType is a Dgraph type block listing the predicates of one entity.
+type schemaType struct {
+	Name       string
+	Predicates []string
+}
+
+// dqlSchema is the data passed to schema.go.tmpl.
+type dqlSchema struct {
+	PackageName string
+	Predicates  []schemaPredicate
+	Types       []schemaType
+}
+
+// buildSchema derives the DQL schema for pkg. Predicates are global in
+// Dgraph, so a predicate shared by several entities is declared once, and
+// reverse predicates (those starting with "~") are never declared: they are
+// derived from @reverse on the forward predicate, which is set whenever the
+// forward field asks for it or a linked reverse field traverses it. A reverse
+// field whose forward predicate is declared outside the package is left out
+// of its type block as well, since the schema cannot vouch for its @reverse.
+func buildSchema(pkg *model.Package) dqlSchema {
+	byName := make(map[string]*schemaPredicate)
+	schema := dqlSchema{PackageName: pkg.Name}
+
+	// A forward predicate traversed by a linked "~predicate" field needs
+	// @reverse even when its own tag omits the reverse keyword.
+	reversed := make(map[string]bool)
+	for _, e := range pkg.Entities {
+		for _, f := range e.Fields {
+			if strings.HasPrefix(f.Predicate, "~") && f.ForwardEntity != "" {
+				reversed[f.Predicate[1:]] = true
+			}
+		}
+	}
+
+	for _, e := range pkg.Entities {
+		st := schemaType{Name: dgraphType(e)}
+		for _, f := range e.Fields {
+			if f.IsUID || f.IsDType || f.Predicate == "" {
+				continue
+			}
+			if strings.HasPrefix(f.Predicate, "~") {
+				if f.ForwardEntity != "" {
+					st.Predicates = append(st.Predicates, "<"+f.Predicate+">")
+				}
+				continue
+			}
+			st.Predicates = append(st.Predicates, f.Predicate)
+
+			p, ok := byName[f.Predicate]
+			if !ok {
+				p = &schemaPredicate{
+					Name:   f.Predicate,
+					Type:   dgraphScalar(f),
+					IsList: schemaList(f),
+				}
+				byName[f.Predicate] = p
+			}
+			for _, idx := range schemaIndexes(f) {
+				if !hasString(p.Indexes, idx) {
+					p.Indexes = append(p.Indexes, idx)
+				}
+			}
+			p.Reverse = p.Reverse || (f.IsEdge && f.IsReverse) || reversed[f.Predicate]
+			p.Count = p.Count || f.HasCount
+			p.Upsert = p.Upsert || f.Upsert
+			p.Unique = p.Unique || f.Unique
+			p.Lang = p.Lang || len(f.Locales) > 0
+		}
+		schema.Types = append(schema.Types, st)
+	}
+
+	for _, p := range byName {
+		schema.Predicates = append(schema.Predicates, *p)
+	}
+	sort.Slice(schema.Predicates, func(i, j int) bool {
+		return schema.Predicates[i].Name < schema.Predicates[j].Name
+	})
+	return schema
+}
+
+// orphanReverseEdges describes each "~predicate" field whose forward
+// predicate no entity in pkg declares.
+func orphanReverseEdges(pkg *model.Package) []string {
+	var msgs []string
+	for _, e := range pkg.Entities {
+		for _, f := range e.Fields {
+			if strings.HasPrefix(f.Predicate, "~") && f.ForwardEntity == "" {
+				msgs = append(msgs, fmt.Sprintf("%s.%s traverses %s, but no entity in package %s declares the forward predicate %s",
+					e.Name, f.Name, f.Predicate, pkg.Name, f.Predicate[1:]))
+			}
+		}
+	}
+	return msgs
+}
+
+// Line renders the predicate as a single DQL schema line, e.g.
+// "genre: [uid] @reverse @count .".
+func (p schemaPredicate) Line() string {
+	var b strings.Builder
+	b.WriteString(p.Name)
+	b.WriteString(": ")
+	if p.IsList {
+		b.WriteString("[" + p.Type + "]")
+	} else {
+		b.WriteString(p.Type)
+	}
+	if len(p.Indexes) > 0 {
+		b.WriteString(" @index(" + strings.Join(p.Indexes, ", ") + ")")
+	}
+	if p.Reverse {
+		b.WriteString(" @reverse")
+	}
+	if p.Count {
+		b.WriteString(" @count")
+	}
+	if p.Upsert {
+		b.WriteString(" @upsert")
+	}
+	if p.Unique {
+		b.WriteString(" @unique")
+	}
+	if p.Lang {
+		b.WriteString(" @lang")
+	}
+	b.WriteString(" .")
+	return b.String()
+}
+
+// schemaList returns true if the field's predicate holds a list: a scalar list
+// or an edge to many nodes.
+func schemaList(f model.Field) bool {
+	_, many := cutList(underlyingType(f))
+	return f.IsList || (f.IsEdge && many)
+}
+
+// cutList returns the element type of a slice or array type, e.g. "Genre" for
+// "[]Genre" or "[3]Genre", and false for any other type.
+func cutList(goType string) (string, bool) {
+	if !strings.HasPrefix(goType, "[") {
+		return "", false
+	}
+	i := strings.Index(goType, "]")
+	if i < 0 {
+		return "", false
+	}
+	return goType[i+1:], true
+}
+
+// schemaIndexes returns the field's index tokenizers as written in @index(...),
+// with an hnsw index carrying its metric, e.g. `hnsw(metric:"cosine")`.
+func schemaIndexes(f model.Field) []string {
+	var result []string
+	for _, idx := range f.Indexes {
+		if idx == "hnsw" {
+			idx = `hnsw(metric:"` + f.VectorMetric + `")`
+		}
+		result = append(result, idx)
+	}
+	return result
+}
+
+// dgraphScalar maps a field to its Dgraph scalar type. An explicit type= hint
+// wins; otherwise the underlying Go type decides. Edges map to "uid", hnsw
+// vectors to "float32vector", and maps, which are stored as JSON text, to
+// "string".
+func dgraphScalar(f model.Field) string {
+	if f.TypeHint != "" {
+		return f.TypeHint
+	}
+	if f.VectorMetric != "" {
+		return "float32vector"
+	}
+	if f.IsEdge {
+		return "uid"
+	}
+	if f.IsMap {
+		// Maps are stored as JSON text.
+		return "string"
+	}
+	goType := strings.TrimPrefix(underlyingType(f), "*")
+	if goType == "[]byte" || goType == "[]uint8" {
+		// encoding/json renders byte slices as a base64 string.
+		return "string"
+	}
+	if f.IsList {
+		goType, _ = cutList(goType)
+	}
+	if strings.HasPrefix(goType, "map[") {
+		goType = mapValueType(goType)
+	}
+	if n, ok := sqlNullTypes[goType]; ok {
+		goType = n.GoType
+	}
+	switch goType {
+	case "string":
+		return "string"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		return "int"
+	case "float32", "float64":
+		return "float"
+	case "bool":
+		return "bool"
+	case "time.Time":
+		return "datetime"
+	}
+	return "default"
+}
+
+// checkScalars returns an error naming the first field of pkg's entities
+// whose Go type maps to no Dgraph scalar, such as a struct that is not an
+// entity or a type from another module. Such a field would be declared as
+// "default" in the schema and not round-trip. An explicit "type=default"
+// accepts it.
+func checkScalars(pkg *model.Package) error {
+	for _, e := range pkg.Entities {
+		for _, f := range e.Fields {
+			if f.IsUID || f.IsDType || f.Predicate == "" {
+				continue
+			}
+			if f.TypeHint == "" && dgraphScalar(f) == "default" {
+				return fmt.Errorf("%s.%s: Go type %s has no Dgraph scalar type; tag it dgraph:\"type=...\" to choose one",
+					e.Name, f.Name, f.GoType)
+			}
+		}
+	}
+	return nil
+}
+
+// underlyingType returns the field's resolved Go type, falling back to GoType
+// for fields built without one.
+func underlyingType(f model.Field) string {
+	if f.UnderlyingType != "" {
+		return f.UnderlyingType
+	}
+	return f.GoType
+}
+
+// hasString returns true if s appears in list.
+func hasString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
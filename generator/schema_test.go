@@ -0,0 +1,225 @@
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+	"github.com/mlwelles/modusGraphGen/parser"
+)
+
+func TestBuildSchemaReverseDeclaredOnce(t *testing.T) {
+	dir := moviesDir(t)
+	pkg, err := parser.Parse(dir)
+	if err != nil {
+		t.Fatalf("Parse(%s) failed: %v", dir, err)
+	}
+
+	schema := buildSchema(pkg)
+
+	var genre []schemaPredicate
+	for _, p := range schema.Predicates {
+		if strings.HasPrefix(p.Name, "~") {
+			t.Errorf("reverse predicate %q must not be declared", p.Name)
+		}
+		if p.Name == "genre" {
+			genre = append(genre, p)
+		}
+	}
+	if len(genre) != 1 {
+		t.Fatalf("got %d genre predicates, want exactly 1", len(genre))
+	}
+	if want := "genre: [uid] @reverse @count ."; genre[0].Line() != want {
+		t.Errorf("genre line = %q, want %q", genre[0].Line(), want)
+	}
+}
+
+func TestBuildSchemaReverseFixture(t *testing.T) {
+	// Performance.Films traverses ~performance; only Film's forward
+	// performance predicate is declared, and it carries @reverse.
+	dir := fixtureDir(t, "facets")
+	pkg, err := parser.Parse(dir)
+	if err != nil {
+		t.Fatalf("Parse(%s) failed: %v", dir, err)
+	}
+
+	schema := buildSchema(pkg)
+	var lines []string
+	for _, p := range schema.Predicates {
+		if strings.Contains(p.Name, "performance") {
+			lines = append(lines, p.Line())
+		}
+	}
+	if want := "performance: [uid] @reverse @count ."; len(lines) != 1 || lines[0] != want {
+		t.Errorf("performance predicates = %q, want only %q", lines, want)
+	}
+	for _, st := range schema.Types {
+		if st.Name == "Performance" && !hasString(st.Predicates, "<~performance>") {
+			t.Errorf("Performance type predicates = %q, want <~performance>", st.Predicates)
+		}
+	}
+}
+
+func TestBuildSchemaLinkedReverseAddsDirective(t *testing.T) {
+	// The forward field omits the reverse keyword, but a linked ~genre field
+	// on Genre still requires @reverse on the forward predicate.
+	pkg := &model.Package{
+		Name: "movies",
+		Entities: []model.Entity{
+			{Name: "Film", Fields: []model.Field{
+				{Name: "Genres", GoType: "[]Genre", Predicate: "genre", IsEdge: true, EdgeEntity: "Genre"},
+			}},
+			{Name: "Genre", Fields: []model.Field{
+				{Name: "Films", GoType: "[]Film", Predicate: "~genre", IsEdge: true, EdgeEntity: "Film", IsReverse: true, ForwardEntity: "Film"},
+			}},
+		},
+	}
+
+	schema := buildSchema(pkg)
+	if len(schema.Predicates) != 1 {
+		t.Fatalf("got %d predicates, want 1: %+v", len(schema.Predicates), schema.Predicates)
+	}
+	if want := "genre: [uid] @reverse ."; schema.Predicates[0].Line() != want {
+		t.Errorf("line = %q, want %q", schema.Predicates[0].Line(), want)
+	}
+}
+
+func TestBuildSchemaUnique(t *testing.T) {
+	pkg := &model.Package{
+		Name: "people",
+		Entities: []model.Entity{
+			{Name: "Person", Fields: []model.Field{
+				{Name: "Email", GoType: "string", Predicate: "email", Indexes: []string{"exact"}, Upsert: true, Unique: true},
+			}},
+		},
+	}
+
+	schema := buildSchema(pkg)
+	if want := "email: string @index(exact) @upsert @unique ."; schema.Predicates[0].Line() != want {
+		t.Errorf("line = %q, want %q", schema.Predicates[0].Line(), want)
+	}
+}
+
+func TestBuildSchemaOrphanReverse(t *testing.T) {
+	// Only the reverse view is modeled; the forward genre predicate is
+	// declared by some other package.
+	pkg := &model.Package{
+		Name: "catalog",
+		Entities: []model.Entity{
+			{Name: "Genre", Fields: []model.Field{
+				{Name: "UID", GoType: "string", IsUID: true},
+				{Name: "DType", GoType: "[]string", IsDType: true},
+				{Name: "Name", GoType: "string", Predicate: "name", Indexes: []string{"exact"}},
+				{Name: "Films", GoType: "[]Film", Predicate: "~genre", IsEdge: true, EdgeEntity: "Film", IsReverse: true},
+			}},
+		},
+	}
+
+	schema := buildSchema(pkg)
+	for _, p := range schema.Predicates {
+		if strings.Contains(p.Name, "genre") {
+			t.Errorf("predicate %q must not be declared", p.Name)
+		}
+	}
+	if got := strings.Join(schema.Types[0].Predicates, " "); got != "name" {
+		t.Errorf("Genre type predicates = %q, want %q", got, "name")
+	}
+
+	var warnings []string
+	err := Generate(pkg, outputDir(t), WithStrict(func(msg string) {
+		warnings = append(warnings, msg)
+	}))
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "Genre.Films") ||
+		!strings.Contains(warnings[0], "forward predicate genre") {
+		t.Errorf("warnings = %q, want one about Genre.Films and genre", warnings)
+	}
+
+	if err := Generate(pkg, outputDir(t), WithStrict(nil)); err == nil {
+		t.Error("Generate with WithStrict(nil) succeeded despite an orphan reverse edge")
+	}
+	if err := Generate(pkg, outputDir(t)); err != nil {
+		t.Errorf("Generate without WithStrict failed: %v", err)
+	}
+}
+
+func TestBuildSchemaVector(t *testing.T) {
+	pkg := &model.Package{
+		Name: "docs",
+		Entities: []model.Entity{
+			{Name: "Doc", Fields: []model.Field{
+				{Name: "Embedding", GoType: "[]float32", Predicate: "embedding", Indexes: []string{"hnsw"}, VectorMetric: "cosine"},
+			}},
+		},
+	}
+
+	schema := buildSchema(pkg)
+	if want := `embedding: float32vector @index(hnsw(metric:"cosine")) .`; schema.Predicates[0].Line() != want {
+		t.Errorf("line = %q, want %q", schema.Predicates[0].Line(), want)
+	}
+}
+
+func TestDgraphScalar(t *testing.T) {
+	tests := []struct {
+		name  string
+		field model.Field
+		want  string
+	}{
+		{"string", model.Field{GoType: "string"}, "string"},
+		{"bytes", model.Field{GoType: "[]byte"}, "string"},
+		{"uint8 slice", model.Field{GoType: "[]uint8"}, "string"},
+		{"int", model.Field{GoType: "int"}, "int"},
+		{"int32", model.Field{GoType: "int32"}, "int"},
+		{"int64", model.Field{GoType: "int64"}, "int"},
+		{"uint", model.Field{GoType: "uint"}, "int"},
+		{"uint64", model.Field{GoType: "uint64"}, "int"},
+		{"float32", model.Field{GoType: "float32"}, "float"},
+		{"float64", model.Field{GoType: "float64"}, "float"},
+		{"bool", model.Field{GoType: "bool"}, "bool"},
+		{"pointer", model.Field{GoType: "*int64"}, "int"},
+		{"named int", model.Field{GoType: "Rating", UnderlyingType: "int32"}, "int"},
+		{"int list", model.Field{GoType: "[]int", IsList: true}, "int"},
+		{"time", model.Field{GoType: "time.Time"}, "datetime"},
+		{"geo hint", model.Field{GoType: "[]float64", TypeHint: "geo"}, "geo"},
+		{"vector", model.Field{GoType: "[]float32", VectorMetric: "cosine"}, "float32vector"},
+		{"float hint on int", model.Field{GoType: "int", TypeHint: "float"}, "float"},
+		{"datetime hint on string", model.Field{GoType: "string", TypeHint: "datetime"}, "datetime"},
+		{"password hint", model.Field{GoType: "string", TypeHint: "password"}, "password"},
+		{"edge", model.Field{GoType: "[]Genre", IsEdge: true}, "uid"},
+		{"null string", model.Field{GoType: "sql.NullString"}, "string"},
+		{"null int64", model.Field{GoType: "sql.NullInt64"}, "int"},
+		{"null time", model.Field{GoType: "sql.NullTime"}, "datetime"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dgraphScalar(tt.field); got != tt.want {
+				t.Errorf("dgraphScalar(%s) = %q, want %q", tt.field.GoType, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckScalars(t *testing.T) {
+	pkg := func(f model.Field) *model.Package {
+		return &model.Package{Name: "media", Entities: []model.Entity{{Name: "Film", Fields: []model.Field{
+			{Name: "UID", GoType: "string", Predicate: "uid", IsUID: true},
+			f,
+		}}}}
+	}
+	if err := checkScalars(pkg(model.Field{Name: "Runtime", GoType: "uint16", Predicate: "runtime"})); err != nil {
+		t.Errorf("checkScalars(uint16) = %v, want nil", err)
+	}
+	if err := checkScalars(pkg(model.Field{Name: "Budget", GoType: "money.Amount", Predicate: "budget", TypeHint: "default"})); err != nil {
+		t.Errorf("checkScalars(type=default) = %v, want nil", err)
+	}
+
+	err := checkScalars(pkg(model.Field{Name: "Budget", GoType: "money.Amount", Predicate: "budget"}))
+	if want := "Film.Budget: Go type money.Amount has no Dgraph scalar type"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("checkScalars(money.Amount) = %v, want %q", err, want)
+	}
+	if err := Generate(pkg(model.Field{Name: "Budget", GoType: "complex128", Predicate: "budget"}), outputDir(t)); err == nil {
+		t.Error("Generate succeeded with a complex128 field")
+	}
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// singleFileName is the file WithSingleFile writes the package's generated Go
+// code into.
+const singleFileName = "generated.go"
+
+// combinedFile accumulates the generated files of a package for WithSingleFile:
+// their imports, merged, and the code that follows them.
+type combinedFile struct {
+	imports map[string]string // import spec, e.g. `json "encoding/json"` → path
+	bodies  [][]byte
+}
+
+// add takes in a gofmt'd generated file.
+func (c *combinedFile) add(name string, src []byte) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, src, parser.ImportsOnly|parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("parsing generated %s: %w", name, err)
+	}
+	if c.imports == nil {
+		c.imports = make(map[string]string)
+	}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return fmt.Errorf("parsing generated %s: %w", name, err)
+		}
+		key := spec.Path.Value
+		if spec.Name != nil {
+			key = spec.Name.Name + " " + key
+		}
+		c.imports[key] = path
+	}
+
+	// The code starts after the last import declaration, or after the package
+	// clause if there is none.
+	end := file.Name.End()
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			end = gen.End()
+		}
+	}
+	c.bodies = append(c.bodies, bytes.TrimSpace(src[fset.Position(end).Offset:]))
+	return nil
+}
+
+// source returns the combined file for package pkgName: a single package
+// clause and import block followed by the code of each file in turn, gofmt'd.
+func (c *combinedFile) source(pkgName string) ([]byte, error) {
+	specs := make([]string, 0, len(c.imports))
+	for spec := range c.imports {
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		si, sj := isStdImport(c.imports[specs[i]]), isStdImport(c.imports[specs[j]])
+		if si != sj {
+			return si
+		}
+		return c.imports[specs[i]] < c.imports[specs[j]]
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "package %s\n", pkgName)
+	if len(specs) > 0 {
+		// Standard library imports come first, as goimports groups them.
+		buf.WriteString("\nimport (\n")
+		std := isStdImport(c.imports[specs[0]])
+		for _, spec := range specs {
+			if std && !isStdImport(c.imports[spec]) {
+				std = false
+				buf.WriteString("\n")
+			}
+			fmt.Fprintf(&buf, "\t%s\n", spec)
+		}
+		buf.WriteString(")\n")
+	}
+	for _, body := range c.bodies {
+		buf.WriteString("\n")
+		buf.Write(body)
+		buf.WriteString("\n")
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting %s: %w", singleFileName, err)
+	}
+	return src, nil
+}
+
+// isStdImport returns true if path names a standard library package, whose
+// first element has no dot.
+func isStdImport(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
@@ -0,0 +1,25 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package aliases
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a Person from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Person) UnmarshalJSON(data []byte) error {
+	type plain Person
+	in := struct {
+		*plain
+		Friends json.RawMessage `json:"friends"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Friends, &v.Friends); err != nil {
+		return fmt.Errorf("Person.Friends: %w", err)
+	}
+	return nil
+}
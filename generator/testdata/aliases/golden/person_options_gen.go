@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package aliases
+
+// PersonOption is a functional option for configuring Person mutations.
+type PersonOption func(*Person)
+
+// WithPersonName sets the Name field on a Person.
+func WithPersonName(v Handle) PersonOption {
+	return func(e *Person) {
+		e.Name = v
+	}
+}
+
+// WithPersonEmail sets the Email field on a Person.
+func WithPersonEmail(v Email) PersonOption {
+	return func(e *Person) {
+		e.Email = v
+	}
+}
+
+// WithPersonBorn sets the Born field on a Person.
+func WithPersonBorn(v Timestamp) PersonOption {
+	return func(e *Person) {
+		e.Born = v
+	}
+}
+
+// WithPersonLabels sets the Labels field on a Person.
+func WithPersonLabels(v Labels) PersonOption {
+	return func(e *Person) {
+		e.Labels = v
+	}
+}
+
+// WithPersonAliases sets the Aliases field on a Person.
+func WithPersonAliases(v []Email) PersonOption {
+	return func(e *Person) {
+		e.Aliases = v
+	}
+}
+
+// ApplyPersonOptions applies the given options to a Person.
+func ApplyPersonOptions(e *Person, opts ...PersonOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
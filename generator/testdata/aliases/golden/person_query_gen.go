@@ -0,0 +1,464 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package aliases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PersonQuery is a typed query builder for Person entities.
+type PersonQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Person entities.
+func (c *PersonClient) Query(ctx context.Context) *PersonQuery {
+	return &PersonQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *PersonQuery) Filter(f string) *PersonQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *PersonQuery) where(expr string) *PersonQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PersonWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PersonQuery) Where(f Filter[Person]) *PersonQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Person entities that have a value for Name, using
+// has(name).
+func (q *PersonQuery) HasName() *PersonQuery {
+	return q.Where(PersonWhere.HasName())
+}
+
+// NotName filters to Person entities that have no Name value.
+func (q *PersonQuery) NotName() *PersonQuery {
+	return q.Where(PersonWhere.NotName())
+}
+
+// HasEmail filters to Person entities that have a value for Email, using
+// has(email).
+func (q *PersonQuery) HasEmail() *PersonQuery {
+	return q.Where(PersonWhere.HasEmail())
+}
+
+// NotEmail filters to Person entities that have no Email value.
+func (q *PersonQuery) NotEmail() *PersonQuery {
+	return q.Where(PersonWhere.NotEmail())
+}
+
+// HasBorn filters to Person entities that have a value for Born, using
+// has(born).
+func (q *PersonQuery) HasBorn() *PersonQuery {
+	return q.Where(PersonWhere.HasBorn())
+}
+
+// NotBorn filters to Person entities that have no Born value.
+func (q *PersonQuery) NotBorn() *PersonQuery {
+	return q.Where(PersonWhere.NotBorn())
+}
+
+// HasLabels filters to Person entities that have a value for Labels, using
+// has(labels).
+func (q *PersonQuery) HasLabels() *PersonQuery {
+	return q.Where(PersonWhere.HasLabels())
+}
+
+// NotLabels filters to Person entities that have no Labels value.
+func (q *PersonQuery) NotLabels() *PersonQuery {
+	return q.Where(PersonWhere.NotLabels())
+}
+
+// HasAliases filters to Person entities that have a value for Aliases, using
+// has(aliases).
+func (q *PersonQuery) HasAliases() *PersonQuery {
+	return q.Where(PersonWhere.HasAliases())
+}
+
+// NotAliases filters to Person entities that have no Aliases value.
+func (q *PersonQuery) NotAliases() *PersonQuery {
+	return q.Where(PersonWhere.NotAliases())
+}
+
+// HasFriends filters to Person entities that have a value for Friends, using
+// has(friends).
+func (q *PersonQuery) HasFriends() *PersonQuery {
+	return q.Where(PersonWhere.HasFriends())
+}
+
+// NotFriends filters to Person entities that have no Friends value.
+func (q *PersonQuery) NotFriends() *PersonQuery {
+	return q.Where(PersonWhere.NotFriends())
+}
+
+// FriendsContains filters to Person entities whose Friends include any of the
+// Person nodes with the given uids, using uid_in(friends, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *PersonQuery) FriendsContains(uids ...string) *PersonQuery {
+	return q.Where(PersonWhere.FriendsContains(uids...))
+}
+
+// LabelsAllOfTerms filters to Person entities whose Labels contains all of the terms.
+func (q *PersonQuery) LabelsAllOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.LabelsAllOfTerms(terms))
+}
+
+// LabelsAnyOfTerms filters to Person entities whose Labels contains any of the terms.
+func (q *PersonQuery) LabelsAnyOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.LabelsAnyOfTerms(terms))
+}
+
+// EmailGe filters to Person entities whose Email sorts at or after value.
+func (q *PersonQuery) EmailGe(value Email) *PersonQuery {
+	return q.Where(PersonWhere.EmailGe(value))
+}
+
+// EmailLe filters to Person entities whose Email sorts at or before value.
+func (q *PersonQuery) EmailLe(value Email) *PersonQuery {
+	return q.Where(PersonWhere.EmailLe(value))
+}
+
+// EmailBetween filters to Person entities whose Email sorts from from through to,
+// inclusive.
+func (q *PersonQuery) EmailBetween(from, to Email) *PersonQuery {
+	return q.Where(PersonWhere.EmailBetween(from, to))
+}
+
+// BornYearEquals filters to Person entities whose Born falls in year.
+func (q *PersonQuery) BornYearEquals(year int) *PersonQuery {
+	return q.Where(PersonWhere.BornYearEquals(year))
+}
+
+// BornYearBetween filters to Person entities whose Born falls in the
+// years from through to, inclusive.
+func (q *PersonQuery) BornYearBetween(from, to int) *PersonQuery {
+	return q.Where(PersonWhere.BornYearBetween(from, to))
+}
+
+// BornDateBetween filters to Person entities whose Born lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (q *PersonQuery) BornDateBetween(from, to time.Time) *PersonQuery {
+	return q.Where(PersonWhere.BornDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *PersonQuery) OrderAsc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *PersonQuery) OrderDesc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *PersonQuery) First(n int) *PersonQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *PersonQuery) Offset(n int) *PersonQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *PersonQuery) withEdge(selection string) *PersonQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFriends makes GetByUID and Exec also fetch the Friends edge, with the
+// scalar predicates of each Person it leads to, in the same query.
+func (q *PersonQuery) WithFriends() *PersonQuery {
+	return q.withEdge("friends { " + personSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Person and the edges added by the With methods.
+func (q *PersonQuery) selection() string {
+	s := personSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PersonQuery) dql() string {
+	return nodesQuery("Person", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PersonQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Person with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Person.
+func (q *PersonQuery) GetByUID(uid string) (*Person, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Person
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Person", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *PersonQuery) Exec(dst *[]Person) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *PersonQuery) ExecAndCount(dst *[]Person) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("PersonQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Person", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PersonWhere builds the conditions on Person fields that PersonQuery.Where takes.
+var PersonWhere PersonConditions
+
+// PersonConditions has a method for each typed filter of PersonQuery, returning it as a
+// Filter[Person] to combine with And, Or, and Not.
+type PersonConditions struct{}
+
+// HasName matches Person entities that have a value for Name, using
+// has(name).
+func (PersonConditions) HasName() Filter[Person] {
+	return Filter[Person]{expr: "has(name)"}
+}
+
+// NotName matches Person entities that have no Name value.
+func (PersonConditions) NotName() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(name)"}
+}
+
+// HasEmail matches Person entities that have a value for Email, using
+// has(email).
+func (PersonConditions) HasEmail() Filter[Person] {
+	return Filter[Person]{expr: "has(email)"}
+}
+
+// NotEmail matches Person entities that have no Email value.
+func (PersonConditions) NotEmail() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(email)"}
+}
+
+// HasBorn matches Person entities that have a value for Born, using
+// has(born).
+func (PersonConditions) HasBorn() Filter[Person] {
+	return Filter[Person]{expr: "has(born)"}
+}
+
+// NotBorn matches Person entities that have no Born value.
+func (PersonConditions) NotBorn() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(born)"}
+}
+
+// HasLabels matches Person entities that have a value for Labels, using
+// has(labels).
+func (PersonConditions) HasLabels() Filter[Person] {
+	return Filter[Person]{expr: "has(labels)"}
+}
+
+// NotLabels matches Person entities that have no Labels value.
+func (PersonConditions) NotLabels() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(labels)"}
+}
+
+// HasAliases matches Person entities that have a value for Aliases, using
+// has(aliases).
+func (PersonConditions) HasAliases() Filter[Person] {
+	return Filter[Person]{expr: "has(aliases)"}
+}
+
+// NotAliases matches Person entities that have no Aliases value.
+func (PersonConditions) NotAliases() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(aliases)"}
+}
+
+// HasFriends matches Person entities that have a value for Friends, using
+// has(friends).
+func (PersonConditions) HasFriends() Filter[Person] {
+	return Filter[Person]{expr: "has(friends)"}
+}
+
+// NotFriends matches Person entities that have no Friends value.
+func (PersonConditions) NotFriends() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(friends)"}
+}
+
+// FriendsContains matches Person entities whose Friends include any of the
+// Person nodes with the given uids, using uid_in(friends, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (PersonConditions) FriendsContains(uids ...string) Filter[Person] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Person]{err: fmt.Errorf("Person.Friends: %w", err)}
+	}
+	return Filter[Person]{expr: "uid_in(friends, " + list + ")"}
+}
+
+// LabelsAllOfTerms matches Person entities whose Labels contains all of the terms.
+func (PersonConditions) LabelsAllOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "allofterms(labels, " + formatString(terms) + ")"}
+}
+
+// LabelsAnyOfTerms matches Person entities whose Labels contains any of the terms.
+func (PersonConditions) LabelsAnyOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "anyofterms(labels, " + formatString(terms) + ")"}
+}
+
+// EmailGe matches Person entities whose Email sorts at or after value.
+func (PersonConditions) EmailGe(value Email) Filter[Person] {
+	return Filter[Person]{expr: "ge(email, " + formatString(string(value)) + ")"}
+}
+
+// EmailLe matches Person entities whose Email sorts at or before value.
+func (PersonConditions) EmailLe(value Email) Filter[Person] {
+	return Filter[Person]{expr: "le(email, " + formatString(string(value)) + ")"}
+}
+
+// EmailBetween matches Person entities whose Email sorts from from through to,
+// inclusive.
+func (PersonConditions) EmailBetween(from, to Email) Filter[Person] {
+	return Filter[Person]{expr: "between(email, " + formatString(string(from)) + ", " + formatString(string(to)) + ")"}
+}
+
+// BornYearEquals matches Person entities whose Born falls in year.
+func (c PersonConditions) BornYearEquals(year int) Filter[Person] {
+	return c.BornYearBetween(year, year)
+}
+
+// BornYearBetween matches Person entities whose Born falls in the
+// years from through to, inclusive.
+func (c PersonConditions) BornYearBetween(from, to int) Filter[Person] {
+	return c.BornDateBetween(yearStart(from), yearEnd(to))
+}
+
+// BornDateBetween matches Person entities whose Born lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (PersonConditions) BornDateBetween(from, to time.Time) Filter[Person] {
+	return Filter[Person]{expr: "between(born, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
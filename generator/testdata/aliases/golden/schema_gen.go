@@ -0,0 +1,24 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package aliases
+
+// DQLSchema is the Dgraph schema for the aliases data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+aliases: [string] .
+born: datetime @index(year) .
+email: string @index(exact) @upsert .
+friends: [uid] .
+labels: [string] @index(term) .
+name: string @index(hash, fulltext) .
+
+type Person {
+	name
+	email
+	born
+	labels
+	aliases
+	friends
+}
+`
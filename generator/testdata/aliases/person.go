@@ -0,0 +1,30 @@
+package aliases
+
+import "time"
+
+// Email is a named string type.
+type Email string
+
+// Timestamp is an alias of time.Time.
+type Timestamp = time.Time
+
+// Labels is a named slice of strings.
+type Labels []string
+
+// Crew is an alias of a slice of entities.
+type Crew = []Person
+
+// Handle resolves through Email to string.
+type Handle Email
+
+// Person declares fields whose types are same-package named types and aliases.
+type Person struct {
+	UID     string    `json:"uid,omitempty"`
+	DType   []string  `json:"dgraph.type,omitempty"`
+	Name    Handle    `json:"name,omitempty" dgraph:"index=hash,fulltext"`
+	Email   Email     `json:"email,omitempty" dgraph:"index=exact upsert"`
+	Born    Timestamp `json:"born,omitempty" dgraph:"index=year"`
+	Labels  Labels    `json:"labels,omitempty" dgraph:"index=term"`
+	Aliases []Email   `json:"aliases,omitempty"`
+	Friends Crew      `json:"friends,omitempty"`
+}
@@ -0,0 +1,11 @@
+package crosspkg
+
+import "github.com/mlwelles/modusGraphGen/generator/testdata/crosspkg/people"
+
+// Film's cast are people, an entity declared in another package.
+type Film struct {
+	UID   string          `json:"uid,omitempty"`
+	DType []string        `json:"dgraph.type,omitempty"`
+	Name  string          `json:"name,omitempty" dgraph:"index=hash"`
+	Cast  []people.Person `json:"cast,omitempty" dgraph:"predicate=film.cast"`
+}
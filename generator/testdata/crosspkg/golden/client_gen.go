@@ -0,0 +1,63 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+import (
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// Client provides typed access to the crosspkg data model. A Client holds no
+// mutable state of its own and is safe for concurrent use by multiple
+// goroutines, as its modusgraph.Client connection is.
+type Client struct {
+	conn graphConn
+	Film *FilmClient
+}
+
+// New creates a new Client connected to the graph database at connStr.
+func New(connStr string, opts ...modusgraph.ClientOpt) (*Client, error) {
+	conn, err := modusgraph.NewClient(connStr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromClient(conn), nil
+}
+
+// NewFromClient creates a new Client from an existing modusgraph.Client
+// connection, configured by opts such as WithRetry and WithMetricsPrefix.
+func NewFromClient(conn modusgraph.Client, opts ...ClientOption) *Client {
+	var cfg clientConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.attempts > 1 || cfg.metrics != nil {
+		return newClient(&retryConn{graphConn: conn, attempts: cfg.attempts, baseDelay: cfg.baseDelay, metrics: cfg.metrics})
+	}
+	return newClient(conn)
+}
+
+// newClient returns a Client whose entity clients share conn.
+func newClient(conn graphConn) *Client {
+	return &Client{
+		conn: conn,
+		Film: &FilmClient{conn: conn},
+	}
+}
+
+// NewClientWithDgo creates a new Client over dg, an existing Dgraph connection,
+// e.g. one made with custom gRPC interceptors. The caller keeps ownership of dg,
+// which Close leaves open. Every method works as it does over modusgraph, but
+// queries and mutations go over dg as raw DQL and JSON: List, the iterators,
+// the Stream methods, and a Query's Exec and ExecAndCount build their DQL
+// themselves, Search uses alloftext as its root function, and WithUpsert runs a
+// DQL upsert block that matches on the first upsert field that is set. There is no retrying, as
+// WithRetry is an option of NewFromClient.
+func NewClientWithDgo(dg *dgo.Dgraph) *Client {
+	return newClient(&dgoConn{dg: dg})
+}
+
+// Close releases all resources used by the client.
+func (c *Client) Close() {
+	c.conn.Close()
+}
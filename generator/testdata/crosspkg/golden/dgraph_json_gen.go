@@ -0,0 +1,22 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// decodeEdges decodes raw, the value of an edge predicate, into dst, a pointer
+// to a slice of entities. Dgraph returns a single object rather than a list
+// for a predicate of type uid; it is decoded as a one-element slice.
+func decodeEdges(raw json.RawMessage, dst any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, dst)
+}
@@ -0,0 +1,25 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Cast json.RawMessage `json:"cast"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Cast, &v.Cast); err != nil {
+		return fmt.Errorf("Film.Cast: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmName sets the Name field on a Film.
+func WithFilmName(v string) FilmOption {
+	return func(e *Film) {
+		e.Name = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
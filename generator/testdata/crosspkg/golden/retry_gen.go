@@ -0,0 +1,185 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// ClientOption configures NewFromClient.
+type ClientOption func(*clientConfig)
+
+// clientConfig holds the settings applied by ClientOption values.
+type clientConfig struct {
+	attempts  int
+	baseDelay time.Duration
+	metrics   *expvar.Map
+}
+
+// WithRetry makes the client try each operation up to maxAttempts times,
+// waiting baseDelay before the second attempt and twice as long before each
+// one after that. Writes are retried when Dgraph aborts their transaction in
+// a conflict with another; reads are retried only when the connection is
+// unavailable. ctx's deadline bounds the total time spent: no attempt is
+// started that the wait before it would push past the deadline.
+// Transactions from NewTxn are not retried; run them again from the start on
+// a conflict.
+func WithRetry(maxAttempts int, baseDelay time.Duration) ClientOption {
+	return func(c *clientConfig) {
+		c.attempts = maxAttempts
+		c.baseDelay = baseDelay
+	}
+}
+
+// WithMetricsPrefix makes the client count its operations in an expvar.Map
+// published under prefix, or under "crosspkg" if prefix is empty, and so served
+// by expvar's /debug/vars handler. Its counters are "reads" and "writes", the
+// operations made; "read_errors" and "write_errors", those that failed; and
+// "retries", the attempts that WithRetry added. Clients given the same prefix
+// share the counters, so any number of them can be made; give clients
+// different prefixes to count them apart. If prefix names an expvar variable
+// that is not a Map, such as "memstats", the counters are kept but not
+// published.
+func WithMetricsPrefix(prefix string) ClientOption {
+	if prefix == "" {
+		prefix = "crosspkg"
+	}
+	return func(c *clientConfig) {
+		c.metrics = publishedMetrics(prefix)
+	}
+}
+
+// metricsMu serializes the lookup and publication of metrics maps, which
+// expvar.Publish would panic on if two were made under one name.
+var metricsMu sync.Mutex
+
+// publishedMetrics returns the expvar.Map published under prefix, publishing
+// a new one if there is none.
+func publishedMetrics(prefix string) *expvar.Map {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	switch v := expvar.Get(prefix).(type) {
+	case *expvar.Map:
+		return v
+	case nil:
+		return expvar.NewMap(prefix)
+	}
+	return new(expvar.Map)
+}
+
+// retryConn is a graphConn whose operations are retried as WithRetry
+// describes and counted as WithMetricsPrefix describes.
+type retryConn struct {
+	graphConn
+	attempts  int
+	baseDelay time.Duration
+	metrics   *expvar.Map
+}
+
+func (r *retryConn) Insert(ctx context.Context, obj any) error {
+	return r.do(ctx, "write", isConflict, func() error { return r.graphConn.Insert(ctx, obj) })
+}
+
+func (r *retryConn) Upsert(ctx context.Context, obj any, predicates ...string) error {
+	return r.do(ctx, "write", isConflict, func() error { return r.graphConn.Upsert(ctx, obj, predicates...) })
+}
+
+func (r *retryConn) Update(ctx context.Context, obj any) error {
+	return r.do(ctx, "write", isConflict, func() error { return r.graphConn.Update(ctx, obj) })
+}
+
+func (r *retryConn) Delete(ctx context.Context, uids []string) error {
+	return r.do(ctx, "write", isConflict, func() error { return r.graphConn.Delete(ctx, uids) })
+}
+
+func (r *retryConn) Get(ctx context.Context, obj any, uid string) error {
+	return r.do(ctx, "read", isUnavailable, func() error { return r.graphConn.Get(ctx, obj, uid) })
+}
+
+func (r *retryConn) QueryRaw(ctx context.Context, query string, vars map[string]string) ([]byte, error) {
+	var resp []byte
+	err := r.do(ctx, "read", isUnavailable, func() error {
+		var err error
+		resp, err = r.graphConn.QueryRaw(ctx, query, vars)
+		return err
+	})
+	return resp, err
+}
+
+// do runs op, a "read" or "write" as kind says, until it succeeds, fails with
+// an error that retryable rejects, or has run r.attempts times, and returns
+// its last error.
+func (r *retryConn) do(ctx context.Context, kind string, retryable func(error) bool, op func() error) error {
+	err := r.attempt(ctx, retryable, op)
+	if r.metrics != nil {
+		r.metrics.Add(kind+"s", 1)
+		if err != nil {
+			r.metrics.Add(kind+"_errors", 1)
+		}
+	}
+	return err
+}
+
+// attempt runs op for do, waiting between attempts.
+func (r *retryConn) attempt(ctx context.Context, retryable func(error) bool, op func() error) error {
+	delay := r.baseDelay
+	for attempt := 1; ; attempt++ {
+		err := op()
+		if err == nil || attempt >= r.attempts || !retryable(err) {
+			return err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		if r.metrics != nil {
+			r.metrics.Add("retries", 1)
+		}
+		delay *= 2
+	}
+}
+
+// retryWrite runs op, a write made through conn other than by its methods,
+// retrying and counting it if conn was set up by WithRetry or
+// WithMetricsPrefix.
+func retryWrite(ctx context.Context, conn graphConn, op func() error) error {
+	if r, ok := conn.(*retryConn); ok {
+		return r.do(ctx, "write", isConflict, op)
+	}
+	return op()
+}
+
+// retryRead runs op, a read made through conn other than by its methods, e.g.
+// with its query builder, retrying and counting it if conn was set up by
+// WithRetry or WithMetricsPrefix.
+func retryRead(ctx context.Context, conn graphConn, op func() error) error {
+	if r, ok := conn.(*retryConn); ok {
+		return r.do(ctx, "read", isUnavailable, op)
+	}
+	return op()
+}
+
+// isConflict returns true if err reports a transaction aborted in a conflict
+// with another, which may succeed if run again.
+func isConflict(err error) bool {
+	return errors.Is(err, dgo.ErrAborted) || strings.Contains(err.Error(), "code = Aborted")
+}
+
+// isUnavailable returns true if err reports that the Dgraph connection is
+// unavailable, a transient condition.
+func isUnavailable(err error) bool {
+	return strings.Contains(err.Error(), "code = Unavailable")
+}
@@ -0,0 +1,16 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package crosspkg
+
+// DQLSchema is the Dgraph schema for the crosspkg data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+film.cast: [uid] .
+name: string @index(hash) .
+
+type Film {
+	name
+	film.cast
+}
+`
@@ -0,0 +1,9 @@
+package people
+
+// Person knows other people in the same package.
+type Person struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Name    string   `json:"name,omitempty" dgraph:"index=exact"`
+	Friends []Person `json:"friends,omitempty" dgraph:"predicate=person.friend"`
+}
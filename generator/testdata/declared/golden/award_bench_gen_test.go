@@ -0,0 +1,47 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkAwardToMap measures converting an Award to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkAwardToMap(b *testing.B) {
+	v := Award{
+		UID:  "0x1",
+		Name: "Name",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkAwardQueryBuild measures building an Award query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkAwardQueryBuild(b *testing.B) {
+	c := &AwardClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
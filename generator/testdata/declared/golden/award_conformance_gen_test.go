@@ -0,0 +1,63 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package declared
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestAwardConformance adds an Award to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestAwardConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Award{
+		Name:    "Name-" + suffix,
+		Year:    7,
+		Awarded: time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+	}
+	if err := client.Award.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Award.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Award.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if got.Name != want.Name {
+		t.Errorf("Name = %v, want %v", got.Name, want.Name)
+	}
+	if got.Year != want.Year {
+		t.Errorf("Year = %v, want %v", got.Year, want.Year)
+	}
+	if !time.Time(got.Awarded).Equal(time.Time(want.Awarded)) {
+		t.Errorf("Awarded = %v, want %v", got.Awarded, want.Awarded)
+	}
+}
@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes an Award in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Award) MarshalJSON() ([]byte, error) {
+	type plain Award
+	out := struct {
+		plain
+		Awarded *time.Time `json:"awarded,omitempty"`
+	}{plain: plain(v)}
+	if !v.Awarded.IsZero() {
+		out.Awarded = &v.Awarded
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an Award from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Award) UnmarshalJSON(data []byte) error {
+	type plain Award
+	in := struct {
+		*plain
+		Awarded json.RawMessage `json:"awarded"`
+		Films   json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Awarded, &v.Awarded); err != nil {
+		return fmt.Errorf("Award.Awarded: %w", err)
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("Award.Films: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import "time"
+
+// AwardOption is a functional option for configuring Award mutations.
+type AwardOption func(*Award)
+
+// WithAwardName sets the Name field on an Award.
+func WithAwardName(v string) AwardOption {
+	return func(e *Award) {
+		e.Name = v
+	}
+}
+
+// WithAwardYear sets the Year field on an Award.
+func WithAwardYear(v int) AwardOption {
+	return func(e *Award) {
+		e.Year = v
+	}
+}
+
+// WithAwardAwarded sets the Awarded field on an Award.
+func WithAwardAwarded(v time.Time) AwardOption {
+	return func(e *Award) {
+		e.Awarded = v
+	}
+}
+
+// ApplyAwardOptions applies the given options to an Award.
+func ApplyAwardOptions(e *Award, opts ...AwardOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
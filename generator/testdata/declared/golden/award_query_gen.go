@@ -0,0 +1,368 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AwardQuery is a typed query builder for Award entities.
+type AwardQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Award entities.
+func (c *AwardClient) Query(ctx context.Context) *AwardQuery {
+	return &AwardQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *AwardQuery) Filter(f string) *AwardQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *AwardQuery) where(expr string) *AwardQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from AwardWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *AwardQuery) Where(f Filter[Award]) *AwardQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Award entities that have a value for Name, using
+// has(name).
+func (q *AwardQuery) HasName() *AwardQuery {
+	return q.Where(AwardWhere.HasName())
+}
+
+// NotName filters to Award entities that have no Name value.
+func (q *AwardQuery) NotName() *AwardQuery {
+	return q.Where(AwardWhere.NotName())
+}
+
+// HasYear filters to Award entities that have a value for Year, using
+// has(year).
+func (q *AwardQuery) HasYear() *AwardQuery {
+	return q.Where(AwardWhere.HasYear())
+}
+
+// NotYear filters to Award entities that have no Year value.
+func (q *AwardQuery) NotYear() *AwardQuery {
+	return q.Where(AwardWhere.NotYear())
+}
+
+// HasAwarded filters to Award entities that have a value for Awarded, using
+// has(awarded).
+func (q *AwardQuery) HasAwarded() *AwardQuery {
+	return q.Where(AwardWhere.HasAwarded())
+}
+
+// NotAwarded filters to Award entities that have no Awarded value.
+func (q *AwardQuery) NotAwarded() *AwardQuery {
+	return q.Where(AwardWhere.NotAwarded())
+}
+
+// HasFilms filters to Award entities that have a value for Films, using
+// has(award_film).
+func (q *AwardQuery) HasFilms() *AwardQuery {
+	return q.Where(AwardWhere.HasFilms())
+}
+
+// NotFilms filters to Award entities that have no Films value.
+func (q *AwardQuery) NotFilms() *AwardQuery {
+	return q.Where(AwardWhere.NotFilms())
+}
+
+// FilmsContains filters to Award entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(award_film, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *AwardQuery) FilmsContains(uids ...string) *AwardQuery {
+	return q.Where(AwardWhere.FilmsContains(uids...))
+}
+
+// AwardedYearEquals filters to Award entities whose Awarded falls in year.
+func (q *AwardQuery) AwardedYearEquals(year int) *AwardQuery {
+	return q.Where(AwardWhere.AwardedYearEquals(year))
+}
+
+// AwardedYearBetween filters to Award entities whose Awarded falls in the
+// years from through to, inclusive.
+func (q *AwardQuery) AwardedYearBetween(from, to int) *AwardQuery {
+	return q.Where(AwardWhere.AwardedYearBetween(from, to))
+}
+
+// AwardedDateBetween filters to Award entities whose Awarded lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *AwardQuery) AwardedDateBetween(from, to time.Time) *AwardQuery {
+	return q.Where(AwardWhere.AwardedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *AwardQuery) OrderAsc(field string) *AwardQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *AwardQuery) OrderDesc(field string) *AwardQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *AwardQuery) First(n int) *AwardQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *AwardQuery) Offset(n int) *AwardQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *AwardQuery) withEdge(selection string) *AwardQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *AwardQuery) WithFilms() *AwardQuery {
+	return q.withEdge("films: award_film { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Award and the edges added by the With methods.
+func (q *AwardQuery) selection() string {
+	s := awardSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *AwardQuery) dql() string {
+	return nodesQuery("Award", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *AwardQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Award with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Award.
+func (q *AwardQuery) GetByUID(uid string) (*Award, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Award
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Award", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *AwardQuery) Exec(dst *[]Award) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Award{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *AwardQuery) ExecAndCount(dst *[]Award) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("AwardQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Award", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Award{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// AwardWhere builds the conditions on Award fields that AwardQuery.Where takes.
+var AwardWhere AwardConditions
+
+// AwardConditions has a method for each typed filter of AwardQuery, returning it as a
+// Filter[Award] to combine with And, Or, and Not.
+type AwardConditions struct{}
+
+// HasName matches Award entities that have a value for Name, using
+// has(name).
+func (AwardConditions) HasName() Filter[Award] {
+	return Filter[Award]{expr: "has(name)"}
+}
+
+// NotName matches Award entities that have no Name value.
+func (AwardConditions) NotName() Filter[Award] {
+	return Filter[Award]{expr: "NOT has(name)"}
+}
+
+// HasYear matches Award entities that have a value for Year, using
+// has(year).
+func (AwardConditions) HasYear() Filter[Award] {
+	return Filter[Award]{expr: "has(year)"}
+}
+
+// NotYear matches Award entities that have no Year value.
+func (AwardConditions) NotYear() Filter[Award] {
+	return Filter[Award]{expr: "NOT has(year)"}
+}
+
+// HasAwarded matches Award entities that have a value for Awarded, using
+// has(awarded).
+func (AwardConditions) HasAwarded() Filter[Award] {
+	return Filter[Award]{expr: "has(awarded)"}
+}
+
+// NotAwarded matches Award entities that have no Awarded value.
+func (AwardConditions) NotAwarded() Filter[Award] {
+	return Filter[Award]{expr: "NOT has(awarded)"}
+}
+
+// HasFilms matches Award entities that have a value for Films, using
+// has(award_film).
+func (AwardConditions) HasFilms() Filter[Award] {
+	return Filter[Award]{expr: "has(award_film)"}
+}
+
+// NotFilms matches Award entities that have no Films value.
+func (AwardConditions) NotFilms() Filter[Award] {
+	return Filter[Award]{expr: "NOT has(award_film)"}
+}
+
+// FilmsContains matches Award entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(award_film, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (AwardConditions) FilmsContains(uids ...string) Filter[Award] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Award]{err: fmt.Errorf("Award.Films: %w", err)}
+	}
+	return Filter[Award]{expr: "uid_in(award_film, " + list + ")"}
+}
+
+// AwardedYearEquals matches Award entities whose Awarded falls in year.
+func (c AwardConditions) AwardedYearEquals(year int) Filter[Award] {
+	return c.AwardedYearBetween(year, year)
+}
+
+// AwardedYearBetween matches Award entities whose Awarded falls in the
+// years from through to, inclusive.
+func (c AwardConditions) AwardedYearBetween(from, to int) Filter[Award] {
+	return c.AwardedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// AwardedDateBetween matches Award entities whose Awarded lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (AwardConditions) AwardedDateBetween(from, to time.Time) Filter[Award] {
+	return Filter[Award]{expr: "between(awarded, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		Released *time.Time `json:"released,omitempty"`
+	}{plain: plain(v)}
+	if !v.Released.IsZero() {
+		out.Released = &v.Released
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Released json.RawMessage `json:"released"`
+		Awards   json.RawMessage `json:"awards"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Released, &v.Released); err != nil {
+		return fmt.Errorf("Film.Released: %w", err)
+	}
+	if err := decodeEdges(in.Awards, &v.Awards); err != nil {
+		return fmt.Errorf("Film.Awards: %w", err)
+	}
+	return nil
+}
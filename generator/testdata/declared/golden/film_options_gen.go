@@ -0,0 +1,29 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import "time"
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmName sets the Name field on a Film.
+func WithFilmName(v string) FilmOption {
+	return func(e *Film) {
+		e.Name = v
+	}
+}
+
+// WithFilmReleased sets the Released field on a Film.
+func WithFilmReleased(v time.Time) FilmOption {
+	return func(e *Film) {
+		e.Released = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,366 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(title).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasReleased filters to Film entities that have a value for Released, using
+// has(released).
+func (q *FilmQuery) HasReleased() *FilmQuery {
+	return q.Where(FilmWhere.HasReleased())
+}
+
+// NotReleased filters to Film entities that have no Released value.
+func (q *FilmQuery) NotReleased() *FilmQuery {
+	return q.Where(FilmWhere.NotReleased())
+}
+
+// HasAwards filters to Film entities that have a value for Awards, using
+// has(film_award).
+func (q *FilmQuery) HasAwards() *FilmQuery {
+	return q.Where(FilmWhere.HasAwards())
+}
+
+// NotAwards filters to Film entities that have no Awards value.
+func (q *FilmQuery) NotAwards() *FilmQuery {
+	return q.Where(FilmWhere.NotAwards())
+}
+
+// AwardsContains filters to Film entities whose Awards include any of the
+// Award nodes with the given uids, using uid_in(film_award, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) AwardsContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.AwardsContains(uids...))
+}
+
+// NameAllOfTerms filters to Film entities whose Name contains all of the terms.
+func (q *FilmQuery) NameAllOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Film entities whose Name contains any of the terms.
+func (q *FilmQuery) NameAnyOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAnyOfTerms(terms))
+}
+
+// ReleasedYearEquals filters to Film entities whose Released falls in year.
+func (q *FilmQuery) ReleasedYearEquals(year int) *FilmQuery {
+	return q.Where(FilmWhere.ReleasedYearEquals(year))
+}
+
+// ReleasedYearBetween filters to Film entities whose Released falls in the
+// years from through to, inclusive.
+func (q *FilmQuery) ReleasedYearBetween(from, to int) *FilmQuery {
+	return q.Where(FilmWhere.ReleasedYearBetween(from, to))
+}
+
+// ReleasedDateBetween filters to Film entities whose Released lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (q *FilmQuery) ReleasedDateBetween(from, to time.Time) *FilmQuery {
+	return q.Where(FilmWhere.ReleasedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithAwards makes GetByUID and Exec also fetch the Awards edge, with the
+// scalar predicates of each Award it leads to, in the same query.
+func (q *FilmQuery) WithAwards() *FilmQuery {
+	return q.withEdge("awards: film_award { " + awardSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasName matches Film entities that have a value for Name, using
+// has(title).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(title)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(title)"}
+}
+
+// HasReleased matches Film entities that have a value for Released, using
+// has(released).
+func (FilmConditions) HasReleased() Filter[Film] {
+	return Filter[Film]{expr: "has(released)"}
+}
+
+// NotReleased matches Film entities that have no Released value.
+func (FilmConditions) NotReleased() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(released)"}
+}
+
+// HasAwards matches Film entities that have a value for Awards, using
+// has(film_award).
+func (FilmConditions) HasAwards() Filter[Film] {
+	return Filter[Film]{expr: "has(film_award)"}
+}
+
+// NotAwards matches Film entities that have no Awards value.
+func (FilmConditions) NotAwards() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(film_award)"}
+}
+
+// AwardsContains matches Film entities whose Awards include any of the
+// Award nodes with the given uids, using uid_in(film_award, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) AwardsContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Awards: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(film_award, " + list + ")"}
+}
+
+// NameAllOfTerms matches Film entities whose Name contains all of the terms.
+func (FilmConditions) NameAllOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "allofterms(title, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Film entities whose Name contains any of the terms.
+func (FilmConditions) NameAnyOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "anyofterms(title, " + formatString(terms) + ")"}
+}
+
+// ReleasedYearEquals matches Film entities whose Released falls in year.
+func (c FilmConditions) ReleasedYearEquals(year int) Filter[Film] {
+	return c.ReleasedYearBetween(year, year)
+}
+
+// ReleasedYearBetween matches Film entities whose Released falls in the
+// years from through to, inclusive.
+func (c FilmConditions) ReleasedYearBetween(from, to int) Filter[Film] {
+	return c.ReleasedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// ReleasedDateBetween matches Film entities whose Released lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (FilmConditions) ReleasedDateBetween(from, to time.Time) Filter[Film] {
+	return Filter[Film]{expr: "between(released, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
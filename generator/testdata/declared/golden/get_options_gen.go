@@ -0,0 +1,25 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+// GetOption configures how a single entity is fetched.
+type GetOption interface {
+	applyGet(cfg *getConfig)
+}
+
+type getConfig struct {
+	depth int // 0 leaves edge expansion to modusgraph
+}
+
+type depthOption int
+
+func (d depthOption) applyGet(cfg *getConfig) {
+	cfg.depth = int(d)
+}
+
+// WithDepth expands edges n levels deep using an explicit selection. Entities
+// with a self-referential edge default to a depth of 1, so the edge's targets
+// carry only their UID and scalar fields.
+func WithDepth(n int) GetOption {
+	return depthOption(n)
+}
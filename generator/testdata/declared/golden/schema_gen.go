@@ -0,0 +1,29 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+// DQLSchema is the Dgraph schema for the declared data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+award_film: [uid] .
+awarded: datetime @index(day) .
+film_award: [uid] .
+name: string @index(hash, fulltext) .
+released: datetime @index(year) .
+title: string @index(term) .
+year: int @index(int) .
+
+type Award {
+	name
+	year
+	awarded
+	award_film
+}
+
+type Film {
+	title
+	released
+	film_award
+}
+`
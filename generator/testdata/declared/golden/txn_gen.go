@@ -0,0 +1,280 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package declared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Txn groups writes to several entities into a single Dgraph transaction.
+// Reads made through it see its own uncommitted writes. Every Txn must end
+// with Commit or Discard; calling Discard after Commit is a no-op, so
+// `defer txn.Discard(ctx)` is safe.
+type Txn struct {
+	txn     *dgo.Txn
+	cleanup func()
+	done    sync.Once
+	Award   *AwardTxn
+	Film    *FilmTxn
+}
+
+// NewTxn starts a transaction on the client's connection.
+func (c *Client) NewTxn(ctx context.Context) (*Txn, error) {
+	dg, cleanup, err := c.conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	t := &Txn{txn: dg.NewTxn(), cleanup: cleanup}
+	t.Award = &AwardTxn{txn: t}
+	t.Film = &FilmTxn{txn: t}
+	return t, nil
+}
+
+// DgraphTxn returns the underlying dgo transaction, for operations the
+// generated API does not cover.
+func (t *Txn) DgraphTxn() *dgo.Txn {
+	return t.txn
+}
+
+// Commit commits the transaction's writes.
+func (t *Txn) Commit(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Commit(ctx)
+}
+
+// Discard abandons the transaction's writes.
+func (t *Txn) Discard(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Discard(ctx)
+}
+
+// release returns the connection obtained by NewTxn, once.
+func (t *Txn) release() {
+	t.done.Do(t.cleanup)
+}
+
+// query runs a read-only DQL query inside the transaction.
+func (t *Txn) query(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	resp, err := t.txn.QueryWithVars(ctx, q, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// AwardTxn provides Award operations within a Txn.
+type AwardTxn struct {
+	txn *Txn
+}
+
+var _ AwardAPI = (*AwardTxn)(nil)
+
+// Get retrieves a single Award by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *AwardTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Award, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Award
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Award", awardSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Award type, seeing the transaction's own writes.
+func (t *AwardTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Award")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *AwardTxn) Add(ctx context.Context, v *Award) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Award"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *AwardTxn) Create(ctx context.Context, v *Award) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Award.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Award"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Award.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *AwardTxn) Update(ctx context.Context, v *Award) error {
+	if v.UID == "" {
+		return errors.New("Award.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Award with the given UID in the transaction.
+func (t *AwardTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Award entities with optional pagination.
+func (t *AwardTxn) List(ctx context.Context, opts ...PageOption) ([]Award, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Award entities matching the DQL filter expression, with
+// optional pagination.
+func (t *AwardTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Award, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Award
+	err := queryNodes(ctx, t.txn.query, "Award", filter, "", awardSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// FilmTxn provides Film operations within a Txn.
+type FilmTxn struct {
+	txn *Txn
+}
+
+var _ FilmAPI = (*FilmTxn)(nil)
+
+// Get retrieves a single Film by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *FilmTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Film", filmSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type, seeing the transaction's own writes.
+func (t *FilmTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Film")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *FilmTxn) Add(ctx context.Context, v *Film) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Film"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *FilmTxn) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Film"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *FilmTxn) Update(ctx context.Context, v *Film) error {
+	if v.UID == "" {
+		return errors.New("Film.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Film with the given UID in the transaction.
+func (t *FilmTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Film entities with optional pagination.
+func (t *FilmTxn) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Film entities matching the DQL filter expression, with
+// optional pagination.
+func (t *FilmTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := queryNodes(ctx, t.txn.query, "Film", filter, "", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
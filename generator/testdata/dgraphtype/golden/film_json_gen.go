@@ -0,0 +1,25 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package dgraphtype
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Genres json.RawMessage `json:"genres"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Genres, &v.Genres); err != nil {
+		return fmt.Errorf("Film.Genres: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package dgraphtype
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkGenreToMap measures converting a Genre to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkGenreToMap(b *testing.B) {
+	v := Genre{
+		UID:  "0x1",
+		Name: "Name",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkGenreQueryBuild measures building a Genre query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkGenreQueryBuild(b *testing.B) {
+	c := &GenreClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
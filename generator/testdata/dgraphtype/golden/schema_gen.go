@@ -0,0 +1,21 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package dgraphtype
+
+// DQLSchema is the Dgraph schema for the dgraphtype data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+genre: [uid] @reverse .
+name: string @index(hash) .
+
+type film {
+	name
+	genre
+}
+
+type Genre {
+	name
+	<~genre>
+}
+`
@@ -0,0 +1,497 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/matthewmcneely/modusgraph"
+)
+
+// ErrNotFound is returned when no node of the expected type has the requested UID.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotUnique is returned when more than one node has the value looked up by
+// a GetBy method of a unique field.
+var ErrNotUnique = errors.New("more than one match")
+
+// ErrRequired is wrapped by Validate errors when a required field is empty.
+var ErrRequired = errors.New("required field is empty")
+
+// mutate runs a single JSON mutation against conn and commits it immediately.
+// set and del are marshaled into the SetJson and DeleteJson payloads; either
+// may be nil. It returns the UIDs Dgraph assigned to blank nodes.
+func mutate(ctx context.Context, conn graphConn, set, del any) (map[string]string, error) {
+	dg, cleanup, err := conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	var uids map[string]string
+	err = retryWrite(ctx, conn, func() error {
+		var err error
+		uids, err = mutateIn(ctx, dg.NewTxn(), true, set, del)
+		return err
+	})
+	return uids, err
+}
+
+// mutateIn runs a single JSON mutation in txn, committing it when commitNow is
+// set. set and del are as for mutate.
+func mutateIn(ctx context.Context, txn *dgo.Txn, commitNow bool, set, del any) (map[string]string, error) {
+	var err error
+	mu := &api.Mutation{CommitNow: commitNow}
+	if set != nil {
+		if mu.SetJson, err = json.Marshal(set); err != nil {
+			return nil, err
+		}
+	}
+	if del != nil {
+		if mu.DeleteJson, err = json.Marshal(del); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := txn.Mutate(ctx, mu)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Uids, nil
+}
+
+// setFields runs a single mutation on the node uid that first removes every
+// value of the predicates in clear, then sets the predicates in fields to the
+// given values. It fails without writing if uid is not a UID, which would
+// otherwise create a new node, or if fields sets the uid itself.
+func setFields(ctx context.Context, conn graphConn, uid string, fields map[string]any, clear ...string) error {
+	if _, err := formatUIDs([]string{uid}); err != nil {
+		return err
+	}
+	if _, ok := fields["uid"]; ok {
+		return errors.New("the uid predicate cannot be set")
+	}
+	var set, del any
+	if len(fields) > 0 {
+		node := map[string]any{"uid": uid}
+		for predicate, value := range fields {
+			node[predicate] = value
+		}
+		set = node
+	}
+	if len(clear) > 0 {
+		node := map[string]any{"uid": uid}
+		for _, predicate := range clear {
+			node[predicate] = nil
+		}
+		del = node
+	}
+	if set == nil && del == nil {
+		return nil
+	}
+	_, err := mutate(ctx, conn, set, del)
+	return err
+}
+
+// linkNodes adds, or with remove deletes, the edges of predicate from each
+// node in from to each node in to, in a single mutation. It fails without
+// writing if any of them is not a UID.
+func linkNodes(ctx context.Context, conn graphConn, predicate string, from, to []string, remove bool) error {
+	if len(from) == 0 || len(to) == 0 {
+		return nil
+	}
+	if _, err := formatUIDs(to); err != nil {
+		return err
+	}
+	targets := make([]map[string]string, len(to))
+	for i, uid := range to {
+		targets[i] = map[string]string{"uid": uid}
+	}
+	return linkEach(ctx, conn, predicate, from, targets, remove)
+}
+
+// linkSingle points the single-node predicate of each node in from at the
+// node to, replacing the node it pointed at, or with remove deletes that
+// edge, in a single mutation. It fails without writing if any of them is not
+// a UID.
+func linkSingle(ctx context.Context, conn graphConn, predicate string, from []string, to string, remove bool) error {
+	if len(from) == 0 {
+		return nil
+	}
+	if _, err := formatUIDs([]string{to}); err != nil {
+		return err
+	}
+	return linkEach(ctx, conn, predicate, from, map[string]string{"uid": to}, remove)
+}
+
+// linkEach sets, or with remove deletes, predicate to value on each node in
+// from, in a single mutation.
+func linkEach(ctx context.Context, conn graphConn, predicate string, from []string, value any, remove bool) error {
+	if _, err := formatUIDs(from); err != nil {
+		return err
+	}
+	nodes := make([]map[string]any, len(from))
+	for i, uid := range from {
+		nodes[i] = map[string]any{"uid": uid, predicate: value}
+	}
+	var err error
+	if remove {
+		_, err = mutate(ctx, conn, nil, nodes)
+	} else {
+		_, err = mutate(ctx, conn, nodes, nil)
+	}
+	return err
+}
+
+// queryFunc runs a DQL query with variables and returns its JSON result, e.g.
+// graphConn.QueryRaw.
+type queryFunc func(ctx context.Context, query string, vars map[string]string) ([]byte, error)
+
+// getByUID fetches the node uid, which must have the given dgraph.type, using
+// an explicit DQL selection and decodes it into dst.
+func getByUID(ctx context.Context, conn graphConn, uid, dgraphType, selection string, dst any) error {
+	return getByUIDWith(ctx, conn.QueryRaw, uid, dgraphType, selection, dst)
+}
+
+// getByUIDWith is getByUID running its query through query.
+func getByUIDWith(ctx context.Context, query queryFunc, uid, dgraphType, selection string, dst any) error {
+	q := `query q($uid: string) {
+	q(func: uid($uid)) @filter(type(` + dgraphType + `)) { ` + selection + ` }
+}`
+	resp, err := query(ctx, q, map[string]string{"$uid": uid})
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Q []json.RawMessage `json:"q"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return err
+	}
+	if len(result.Q) == 0 {
+		return fmt.Errorf("%s %s: %w", dgraphType, uid, ErrNotFound)
+	}
+	return json.Unmarshal(result.Q[0], dst)
+}
+
+// getExpanded fetches the node uid, which must have the given dgraph.type,
+// with expand(_all_) and decodes it into dst. As expand(_all_) cannot alias
+// predicates, the ones in keys are renamed to the JSON key of the field that
+// holds them first.
+func getExpanded(ctx context.Context, query queryFunc, uid, dgraphType string, keys map[string]string, dst any) error {
+	var node map[string]json.RawMessage
+	if err := getByUIDWith(ctx, query, uid, dgraphType, "uid dgraph.type expand(_all_) { uid }", &node); err != nil {
+		return err
+	}
+	renamed := make(map[string]json.RawMessage, len(node))
+	for predicate, value := range node {
+		if key, ok := keys[predicate]; ok {
+			predicate = key
+		}
+		renamed[predicate] = value
+	}
+	data, err := json.Marshal(renamed)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// nodeExists reports whether the node uid exists with the given dgraph.type.
+func nodeExists(ctx context.Context, query queryFunc, uid, dgraphType string) (bool, error) {
+	var node struct {
+		UID string `json:"uid"`
+	}
+	err := getByUIDWith(ctx, query, uid, dgraphType, "uid", &node)
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// queryNodes decodes into dst the nodes that nodesQuery selects.
+func queryNodes(ctx context.Context, query queryFunc, dgraphType, filter, order, selection string, first, offset int, dst any) error {
+	return decodeNodes(ctx, query, nodesQuery(dgraphType, filter, order, selection, first, offset), dst)
+}
+
+// nodesQuery returns the DQL query for the nodes of the given dgraph.type
+// that match filter (if not empty), sorted by order (if not empty, e.g.
+// "orderasc: name"), and paged by first and offset, using an explicit DQL
+// selection.
+func nodesQuery(dgraphType, filter, order, selection string, first, offset int) string {
+	return "{\n\t" + nodesBlock("q", dgraphType, filter, order, selection, first, offset) + "\n}"
+}
+
+// nodesBlock returns the query block of nodesQuery under the given name.
+func nodesBlock(name, dgraphType, filter, order, selection string, first, offset int) string {
+	b := name + "(func: type(" + dgraphType + ")"
+	if order != "" {
+		b += ", " + order
+	}
+	if first > 0 {
+		b += ", first: " + strconv.Itoa(first)
+	}
+	if offset > 0 {
+		b += ", offset: " + strconv.Itoa(offset)
+	}
+	b += ")"
+	if filter != "" {
+		b += " @filter(" + filter + ")"
+	}
+	return b + " { " + selection + " }"
+}
+
+// queryNodesAndCount decodes into dst the nodes that nodesQuery selects and
+// returns the number of nodes that match before paging, in a single query.
+func queryNodesAndCount(ctx context.Context, query queryFunc, dgraphType, filter, order, selection string, first, offset int, dst any) (int, error) {
+	q := "{\n\t" + nodesBlock("q", dgraphType, filter, order, selection, first, offset) +
+		"\n\t" + nodesBlock("total", dgraphType, filter, "", "count(uid)", 0, 0) + "\n}"
+	resp, err := query(ctx, q, nil)
+	if err != nil {
+		return 0, err
+	}
+	var result struct {
+		Q     json.RawMessage `json:"q"`
+		Total []struct {
+			Count int `json:"count"`
+		} `json:"total"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return 0, err
+	}
+	if len(result.Q) > 0 {
+		if err := json.Unmarshal(result.Q, dst); err != nil {
+			return 0, err
+		}
+	}
+	if len(result.Total) == 0 {
+		return 0, nil
+	}
+	return result.Total[0].Count, nil
+}
+
+// pageNodes decodes into dst a page of first nodes of the given dgraph.type
+// in UID order, using an explicit DQL selection: those after the UID after,
+// or, if after is empty, those from offset on.
+func pageNodes(ctx context.Context, query queryFunc, dgraphType, selection, after string, first, offset int, dst any) error {
+	q := "{\n\tq(func: type(" + dgraphType + "), first: " + strconv.Itoa(first)
+	if after != "" {
+		if _, err := formatUIDs([]string{after}); err != nil {
+			return err
+		}
+		q += ", after: " + after
+	} else if offset > 0 {
+		q += ", offset: " + strconv.Itoa(offset)
+	}
+	return decodeNodes(ctx, query, q+") { "+selection+" }\n}", dst)
+}
+
+// decodeNodes runs the DQL query q, whose block is named q, and decodes the
+// nodes it returns into dst.
+func decodeNodes(ctx context.Context, query queryFunc, q string, dst any) error {
+	resp, err := query(ctx, q, nil)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Q json.RawMessage `json:"q"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return err
+	}
+	if len(result.Q) == 0 {
+		return nil
+	}
+	return json.Unmarshal(result.Q, dst)
+}
+
+// graphConn is the part of modusgraph.Client that the generated code uses. A
+// modusgraph.Client is one, and so is the dgoConn of NewClientWithDgo.
+type graphConn interface {
+	Insert(ctx context.Context, obj any) error
+	Upsert(ctx context.Context, obj any, predicates ...string) error
+	Update(ctx context.Context, obj any) error
+	Get(ctx context.Context, obj any, uid string) error
+	Query(ctx context.Context, model any) *modusgraph.Query
+	Delete(ctx context.Context, uids []string) error
+	Close()
+	QueryRaw(ctx context.Context, query string, vars map[string]string) ([]byte, error)
+	DgraphClient() (*dgo.Dgraph, func(), error)
+}
+
+// dgoConn is the graphConn of NewClientWithDgo, which runs every operation of
+// the generated code over a bare *dgo.Dgraph as raw DQL queries, JSON
+// mutations, and upsert blocks. It implements each method itself, so that one
+// added to graphConn fails to compile until it does.
+type dgoConn struct {
+	dg *dgo.Dgraph
+}
+
+var _ graphConn = (*dgoConn)(nil)
+
+func (c *dgoConn) QueryRaw(ctx context.Context, query string, vars map[string]string) ([]byte, error) {
+	resp, err := c.dg.NewReadOnlyTxn().QueryWithVars(ctx, query, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+func (c *dgoConn) DgraphClient() (*dgo.Dgraph, func(), error) {
+	return c.dg, func() {}, nil
+}
+
+// Close does nothing, as the caller of NewClientWithDgo owns the connection.
+func (c *dgoConn) Close() {}
+
+// Insert adds obj, an entity without a UID, as a new node of its Types and
+// sets its UID.
+func (c *dgoConn) Insert(ctx context.Context, obj any) error {
+	e, ok := obj.(Entity)
+	if !ok {
+		return fmt.Errorf("insert %T: not an entity", obj)
+	}
+	if e.GetUID() != "" {
+		return fmt.Errorf("insert %T: UID is already set to %s", obj, e.GetUID())
+	}
+	node, err := typedNode(e, "_:node")
+	if err != nil {
+		return err
+	}
+	uids, err := mutate(ctx, c, node, nil)
+	if err != nil {
+		return err
+	}
+	e.SetUID(uids["node"])
+	return nil
+}
+
+// Update sets the predicates of obj, an entity with a UID, on its node.
+func (c *dgoConn) Update(ctx context.Context, obj any) error {
+	e, ok := obj.(Entity)
+	if !ok {
+		return fmt.Errorf("update %T: not an entity", obj)
+	}
+	if _, err := formatUIDs([]string{e.GetUID()}); err != nil {
+		return fmt.Errorf("update %T: %w", obj, err)
+	}
+	node, err := typedNode(e, e.GetUID())
+	if err != nil {
+		return err
+	}
+	_, err = mutate(ctx, c, node, nil)
+	return err
+}
+
+// Get decodes the node uid into obj with its scalar predicates, as
+// modusgraph's Get does.
+func (c *dgoConn) Get(ctx context.Context, obj any, uid string) error {
+	switch v := obj.(type) {
+	case *Film:
+		return getByUID(ctx, c, uid, "Film", filmSelection(0), v)
+	case *Studio:
+		return getByUID(ctx, c, uid, "Studio", studioSelection(0), v)
+	}
+	return fmt.Errorf("get %T: not an entity", obj)
+}
+
+// Delete removes every predicate of the nodes uids.
+func (c *dgoConn) Delete(ctx context.Context, uids []string) error {
+	if _, err := formatUIDs(uids); err != nil {
+		return err
+	}
+	nodes := make([]map[string]string, len(uids))
+	for i, uid := range uids {
+		nodes[i] = map[string]string{"uid": uid}
+	}
+	_, err := mutate(ctx, c, nil, nodes)
+	return err
+}
+
+// Query returns nil, as there is no modusgraph query builder over a bare
+// connection. The generated code checks for a dgoConn and runs its queries as
+// raw DQL instead.
+func (c *dgoConn) Query(ctx context.Context, model any) *modusgraph.Query {
+	return nil
+}
+
+// Upsert adds obj, an entity, as a new node of its Types, or updates the node
+// of its first type whose value of the first of predicates that obj sets is
+// the same, in a single upsert block, and sets obj's UID to that of the node.
+func (c *dgoConn) Upsert(ctx context.Context, obj any, predicates ...string) error {
+	e, ok := obj.(Entity)
+	if !ok {
+		return fmt.Errorf("upsert %T: not an entity", obj)
+	}
+	node, err := typedNode(e, "uid(node)")
+	if err != nil {
+		return err
+	}
+	var predicate, value string
+	for _, p := range predicates {
+		if v, ok := node[p]; ok && v != nil && fmt.Sprint(v) != "" {
+			predicate, value = p, fmt.Sprint(v)
+			break
+		}
+	}
+	if predicate == "" {
+		return fmt.Errorf("upsert %T: none of %v is set", obj, predicates)
+	}
+	set, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	mu := &api.Mutation{SetJson: set}
+	resp, err := c.dg.NewTxn().Do(ctx, &api.Request{
+		Query:     "query q($value: string) {\n\tq(func: eq(" + predicate + ", $value), first: 1) @filter(type(" + e.Types()[0] + ")) { node as uid }\n}",
+		Vars:      map[string]string{"$value": value},
+		Mutations: []*api.Mutation{mu},
+		CommitNow: true,
+	})
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Q []struct {
+			UID string `json:"uid"`
+		} `json:"q"`
+	}
+	if len(resp.Json) > 0 {
+		if err := json.Unmarshal(resp.Json, &result); err != nil {
+			return err
+		}
+	}
+	if len(result.Q) > 0 {
+		e.SetUID(result.Q[0].UID)
+		return nil
+	}
+	uid, ok := resp.Uids["uid(node)"]
+	if !ok {
+		return fmt.Errorf("upsert %T: no UID assigned", obj)
+	}
+	e.SetUID(uid)
+	return nil
+}
+
+// typedNode returns the JSON object of e with the given uid and its Types as
+// its dgraph.type, for a mutation. Numbers are kept as they were encoded.
+func typedNode(e Entity, uid string) (map[string]any, error) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var node map[string]any
+	if err := dec.Decode(&node); err != nil {
+		return nil, err
+	}
+	node["uid"] = uid
+	node["dgraph.type"] = e.Types()
+	return node, nil
+}
@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkFilmToMap measures converting a Film to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkFilmToMap(b *testing.B) {
+	v := Film{
+		Name: "Name",
+	}
+	v.UID = "0x1"
+	v.Label = "Label"
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkFilmQueryBuild measures building a Film query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkFilmQueryBuild(b *testing.B) {
+	c := &FilmClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
@@ -0,0 +1,63 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package embedded
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestFilmConformance adds a Film to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestFilmConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Film{
+		Name: "Name-" + suffix,
+	}
+	want.Created = time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+	want.Label = "Label-" + suffix
+	if err := client.Film.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Film.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Film.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if !time.Time(got.Created).Equal(time.Time(want.Created)) {
+		t.Errorf("Created = %v, want %v", got.Created, want.Created)
+	}
+	if got.Label != want.Label {
+		t.Errorf("Label = %v, want %v", got.Label, want.Label)
+	}
+	if got.Name != want.Name {
+		t.Errorf("Name = %v, want %v", got.Name, want.Name)
+	}
+}
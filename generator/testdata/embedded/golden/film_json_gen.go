@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		Created *time.Time `json:"created,omitempty"`
+	}{plain: plain(v)}
+	if !v.Created.IsZero() {
+		out.Created = &v.Created
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Created json.RawMessage `json:"created"`
+		Studios json.RawMessage `json:"studios"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Created, &v.Created); err != nil {
+		return fmt.Errorf("Film.Created: %w", err)
+	}
+	if err := decodeEdges(in.Studios, &v.Studios); err != nil {
+		return fmt.Errorf("Film.Studios: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import "time"
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmCreated sets the Created field on a Film.
+func WithFilmCreated(v time.Time) FilmOption {
+	return func(e *Film) {
+		e.Created = v
+	}
+}
+
+// WithFilmLabel sets the Label field on a Film.
+func WithFilmLabel(v string) FilmOption {
+	return func(e *Film) {
+		e.Label = v
+	}
+}
+
+// WithFilmName sets the Name field on a Film.
+func WithFilmName(v string) FilmOption {
+	return func(e *Film) {
+		e.Name = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
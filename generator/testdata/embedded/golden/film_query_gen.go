@@ -0,0 +1,432 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasCreated filters to Film entities that have a value for Created, using
+// has(created).
+func (q *FilmQuery) HasCreated() *FilmQuery {
+	return q.Where(FilmWhere.HasCreated())
+}
+
+// NotCreated filters to Film entities that have no Created value.
+func (q *FilmQuery) NotCreated() *FilmQuery {
+	return q.Where(FilmWhere.NotCreated())
+}
+
+// HasLabel filters to Film entities that have a value for Label, using
+// has(label).
+func (q *FilmQuery) HasLabel() *FilmQuery {
+	return q.Where(FilmWhere.HasLabel())
+}
+
+// NotLabel filters to Film entities that have no Label value.
+func (q *FilmQuery) NotLabel() *FilmQuery {
+	return q.Where(FilmWhere.NotLabel())
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(name).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasStudios filters to Film entities that have a value for Studios, using
+// has(studio).
+func (q *FilmQuery) HasStudios() *FilmQuery {
+	return q.Where(FilmWhere.HasStudios())
+}
+
+// NotStudios filters to Film entities that have no Studios value.
+func (q *FilmQuery) NotStudios() *FilmQuery {
+	return q.Where(FilmWhere.NotStudios())
+}
+
+// StudiosContains filters to Film entities whose Studios include any of the
+// Studio nodes with the given uids, using uid_in(studio, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) StudiosContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.StudiosContains(uids...))
+}
+
+// LabelGe filters to Film entities whose Label sorts at or after value.
+func (q *FilmQuery) LabelGe(value string) *FilmQuery {
+	return q.Where(FilmWhere.LabelGe(value))
+}
+
+// LabelLe filters to Film entities whose Label sorts at or before value.
+func (q *FilmQuery) LabelLe(value string) *FilmQuery {
+	return q.Where(FilmWhere.LabelLe(value))
+}
+
+// LabelBetween filters to Film entities whose Label sorts from from through to,
+// inclusive.
+func (q *FilmQuery) LabelBetween(from, to string) *FilmQuery {
+	return q.Where(FilmWhere.LabelBetween(from, to))
+}
+
+// NameGe filters to Film entities whose Name sorts at or after value.
+func (q *FilmQuery) NameGe(value string) *FilmQuery {
+	return q.Where(FilmWhere.NameGe(value))
+}
+
+// NameLe filters to Film entities whose Name sorts at or before value.
+func (q *FilmQuery) NameLe(value string) *FilmQuery {
+	return q.Where(FilmWhere.NameLe(value))
+}
+
+// NameBetween filters to Film entities whose Name sorts from from through to,
+// inclusive.
+func (q *FilmQuery) NameBetween(from, to string) *FilmQuery {
+	return q.Where(FilmWhere.NameBetween(from, to))
+}
+
+// CreatedYearEquals filters to Film entities whose Created falls in year.
+func (q *FilmQuery) CreatedYearEquals(year int) *FilmQuery {
+	return q.Where(FilmWhere.CreatedYearEquals(year))
+}
+
+// CreatedYearBetween filters to Film entities whose Created falls in the
+// years from through to, inclusive.
+func (q *FilmQuery) CreatedYearBetween(from, to int) *FilmQuery {
+	return q.Where(FilmWhere.CreatedYearBetween(from, to))
+}
+
+// CreatedDateBetween filters to Film entities whose Created lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *FilmQuery) CreatedDateBetween(from, to time.Time) *FilmQuery {
+	return q.Where(FilmWhere.CreatedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithStudios makes GetByUID and Exec also fetch the Studios edge, with the
+// scalar predicates of each Studio it leads to, in the same query.
+func (q *FilmQuery) WithStudios() *FilmQuery {
+	return q.withEdge("studios: studio { " + studioSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasCreated matches Film entities that have a value for Created, using
+// has(created).
+func (FilmConditions) HasCreated() Filter[Film] {
+	return Filter[Film]{expr: "has(created)"}
+}
+
+// NotCreated matches Film entities that have no Created value.
+func (FilmConditions) NotCreated() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(created)"}
+}
+
+// HasLabel matches Film entities that have a value for Label, using
+// has(label).
+func (FilmConditions) HasLabel() Filter[Film] {
+	return Filter[Film]{expr: "has(label)"}
+}
+
+// NotLabel matches Film entities that have no Label value.
+func (FilmConditions) NotLabel() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(label)"}
+}
+
+// HasName matches Film entities that have a value for Name, using
+// has(name).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(name)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(name)"}
+}
+
+// HasStudios matches Film entities that have a value for Studios, using
+// has(studio).
+func (FilmConditions) HasStudios() Filter[Film] {
+	return Filter[Film]{expr: "has(studio)"}
+}
+
+// NotStudios matches Film entities that have no Studios value.
+func (FilmConditions) NotStudios() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(studio)"}
+}
+
+// StudiosContains matches Film entities whose Studios include any of the
+// Studio nodes with the given uids, using uid_in(studio, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) StudiosContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Studios: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(studio, " + list + ")"}
+}
+
+// LabelGe matches Film entities whose Label sorts at or after value.
+func (FilmConditions) LabelGe(value string) Filter[Film] {
+	return Filter[Film]{expr: "ge(label, " + formatString(value) + ")"}
+}
+
+// LabelLe matches Film entities whose Label sorts at or before value.
+func (FilmConditions) LabelLe(value string) Filter[Film] {
+	return Filter[Film]{expr: "le(label, " + formatString(value) + ")"}
+}
+
+// LabelBetween matches Film entities whose Label sorts from from through to,
+// inclusive.
+func (FilmConditions) LabelBetween(from, to string) Filter[Film] {
+	return Filter[Film]{expr: "between(label, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// NameGe matches Film entities whose Name sorts at or after value.
+func (FilmConditions) NameGe(value string) Filter[Film] {
+	return Filter[Film]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Film entities whose Name sorts at or before value.
+func (FilmConditions) NameLe(value string) Filter[Film] {
+	return Filter[Film]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Film entities whose Name sorts from from through to,
+// inclusive.
+func (FilmConditions) NameBetween(from, to string) Filter[Film] {
+	return Filter[Film]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// CreatedYearEquals matches Film entities whose Created falls in year.
+func (c FilmConditions) CreatedYearEquals(year int) Filter[Film] {
+	return c.CreatedYearBetween(year, year)
+}
+
+// CreatedYearBetween matches Film entities whose Created falls in the
+// years from through to, inclusive.
+func (c FilmConditions) CreatedYearBetween(from, to int) Filter[Film] {
+	return c.CreatedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// CreatedDateBetween matches Film entities whose Created lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (FilmConditions) CreatedDateBetween(from, to time.Time) Filter[Film] {
+	return Filter[Film]{expr: "between(created, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
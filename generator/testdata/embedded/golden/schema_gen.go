@@ -0,0 +1,26 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+// DQLSchema is the Dgraph schema for the embedded data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+created: datetime @index(day) .
+label: string @index(exact) .
+name: string @index(exact) .
+studio: [uid] .
+
+type Film {
+	created
+	label
+	name
+	studio
+}
+
+type Studio {
+	created
+	label
+	name
+}
+`
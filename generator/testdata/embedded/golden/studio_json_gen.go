@@ -0,0 +1,41 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Studio in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Studio) MarshalJSON() ([]byte, error) {
+	type plain Studio
+	out := struct {
+		plain
+		Created *time.Time `json:"created,omitempty"`
+	}{plain: plain(v)}
+	if !v.Created.IsZero() {
+		out.Created = &v.Created
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Studio from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+func (v *Studio) UnmarshalJSON(data []byte) error {
+	type plain Studio
+	in := struct {
+		*plain
+		Created json.RawMessage `json:"created"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Created, &v.Created); err != nil {
+		return fmt.Errorf("Studio.Created: %w", err)
+	}
+	return nil
+}
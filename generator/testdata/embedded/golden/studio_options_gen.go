@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import "time"
+
+// StudioOption is a functional option for configuring Studio mutations.
+type StudioOption func(*Studio)
+
+// WithStudioCreated sets the Created field on a Studio.
+func WithStudioCreated(v time.Time) StudioOption {
+	return func(e *Studio) {
+		e.Created = v
+	}
+}
+
+// WithStudioLabel sets the Label field on a Studio.
+func WithStudioLabel(v string) StudioOption {
+	return func(e *Studio) {
+		e.Label = v
+	}
+}
+
+// WithStudioName sets the Name field on a Studio.
+func WithStudioName(v string) StudioOption {
+	return func(e *Studio) {
+		e.Name = v
+	}
+}
+
+// ApplyStudioOptions applies the given options to a Studio.
+func ApplyStudioOptions(e *Studio, opts ...StudioOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,368 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package embedded
+
+import (
+	"context"
+	"time"
+)
+
+// StudioQuery is a typed query builder for Studio entities.
+type StudioQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Studio entities.
+func (c *StudioClient) Query(ctx context.Context) *StudioQuery {
+	return &StudioQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *StudioQuery) Filter(f string) *StudioQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *StudioQuery) where(expr string) *StudioQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from StudioWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *StudioQuery) Where(f Filter[Studio]) *StudioQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasCreated filters to Studio entities that have a value for Created, using
+// has(created).
+func (q *StudioQuery) HasCreated() *StudioQuery {
+	return q.Where(StudioWhere.HasCreated())
+}
+
+// NotCreated filters to Studio entities that have no Created value.
+func (q *StudioQuery) NotCreated() *StudioQuery {
+	return q.Where(StudioWhere.NotCreated())
+}
+
+// HasLabel filters to Studio entities that have a value for Label, using
+// has(label).
+func (q *StudioQuery) HasLabel() *StudioQuery {
+	return q.Where(StudioWhere.HasLabel())
+}
+
+// NotLabel filters to Studio entities that have no Label value.
+func (q *StudioQuery) NotLabel() *StudioQuery {
+	return q.Where(StudioWhere.NotLabel())
+}
+
+// HasName filters to Studio entities that have a value for Name, using
+// has(name).
+func (q *StudioQuery) HasName() *StudioQuery {
+	return q.Where(StudioWhere.HasName())
+}
+
+// NotName filters to Studio entities that have no Name value.
+func (q *StudioQuery) NotName() *StudioQuery {
+	return q.Where(StudioWhere.NotName())
+}
+
+// LabelGe filters to Studio entities whose Label sorts at or after value.
+func (q *StudioQuery) LabelGe(value string) *StudioQuery {
+	return q.Where(StudioWhere.LabelGe(value))
+}
+
+// LabelLe filters to Studio entities whose Label sorts at or before value.
+func (q *StudioQuery) LabelLe(value string) *StudioQuery {
+	return q.Where(StudioWhere.LabelLe(value))
+}
+
+// LabelBetween filters to Studio entities whose Label sorts from from through to,
+// inclusive.
+func (q *StudioQuery) LabelBetween(from, to string) *StudioQuery {
+	return q.Where(StudioWhere.LabelBetween(from, to))
+}
+
+// NameGe filters to Studio entities whose Name sorts at or after value.
+func (q *StudioQuery) NameGe(value string) *StudioQuery {
+	return q.Where(StudioWhere.NameGe(value))
+}
+
+// NameLe filters to Studio entities whose Name sorts at or before value.
+func (q *StudioQuery) NameLe(value string) *StudioQuery {
+	return q.Where(StudioWhere.NameLe(value))
+}
+
+// NameBetween filters to Studio entities whose Name sorts from from through to,
+// inclusive.
+func (q *StudioQuery) NameBetween(from, to string) *StudioQuery {
+	return q.Where(StudioWhere.NameBetween(from, to))
+}
+
+// CreatedYearEquals filters to Studio entities whose Created falls in year.
+func (q *StudioQuery) CreatedYearEquals(year int) *StudioQuery {
+	return q.Where(StudioWhere.CreatedYearEquals(year))
+}
+
+// CreatedYearBetween filters to Studio entities whose Created falls in the
+// years from through to, inclusive.
+func (q *StudioQuery) CreatedYearBetween(from, to int) *StudioQuery {
+	return q.Where(StudioWhere.CreatedYearBetween(from, to))
+}
+
+// CreatedDateBetween filters to Studio entities whose Created lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *StudioQuery) CreatedDateBetween(from, to time.Time) *StudioQuery {
+	return q.Where(StudioWhere.CreatedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *StudioQuery) OrderAsc(field string) *StudioQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *StudioQuery) OrderDesc(field string) *StudioQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *StudioQuery) First(n int) *StudioQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *StudioQuery) Offset(n int) *StudioQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Studio and the edges added by the With methods.
+func (q *StudioQuery) selection() string {
+	s := studioSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *StudioQuery) dql() string {
+	return nodesQuery("Studio", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *StudioQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Studio with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Studio.
+func (q *StudioQuery) GetByUID(uid string) (*Studio, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Studio
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Studio", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *StudioQuery) Exec(dst *[]Studio) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Studio{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *StudioQuery) ExecAndCount(dst *[]Studio) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Studio", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Studio{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// StudioWhere builds the conditions on Studio fields that StudioQuery.Where takes.
+var StudioWhere StudioConditions
+
+// StudioConditions has a method for each typed filter of StudioQuery, returning it as a
+// Filter[Studio] to combine with And, Or, and Not.
+type StudioConditions struct{}
+
+// HasCreated matches Studio entities that have a value for Created, using
+// has(created).
+func (StudioConditions) HasCreated() Filter[Studio] {
+	return Filter[Studio]{expr: "has(created)"}
+}
+
+// NotCreated matches Studio entities that have no Created value.
+func (StudioConditions) NotCreated() Filter[Studio] {
+	return Filter[Studio]{expr: "NOT has(created)"}
+}
+
+// HasLabel matches Studio entities that have a value for Label, using
+// has(label).
+func (StudioConditions) HasLabel() Filter[Studio] {
+	return Filter[Studio]{expr: "has(label)"}
+}
+
+// NotLabel matches Studio entities that have no Label value.
+func (StudioConditions) NotLabel() Filter[Studio] {
+	return Filter[Studio]{expr: "NOT has(label)"}
+}
+
+// HasName matches Studio entities that have a value for Name, using
+// has(name).
+func (StudioConditions) HasName() Filter[Studio] {
+	return Filter[Studio]{expr: "has(name)"}
+}
+
+// NotName matches Studio entities that have no Name value.
+func (StudioConditions) NotName() Filter[Studio] {
+	return Filter[Studio]{expr: "NOT has(name)"}
+}
+
+// LabelGe matches Studio entities whose Label sorts at or after value.
+func (StudioConditions) LabelGe(value string) Filter[Studio] {
+	return Filter[Studio]{expr: "ge(label, " + formatString(value) + ")"}
+}
+
+// LabelLe matches Studio entities whose Label sorts at or before value.
+func (StudioConditions) LabelLe(value string) Filter[Studio] {
+	return Filter[Studio]{expr: "le(label, " + formatString(value) + ")"}
+}
+
+// LabelBetween matches Studio entities whose Label sorts from from through to,
+// inclusive.
+func (StudioConditions) LabelBetween(from, to string) Filter[Studio] {
+	return Filter[Studio]{expr: "between(label, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// NameGe matches Studio entities whose Name sorts at or after value.
+func (StudioConditions) NameGe(value string) Filter[Studio] {
+	return Filter[Studio]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Studio entities whose Name sorts at or before value.
+func (StudioConditions) NameLe(value string) Filter[Studio] {
+	return Filter[Studio]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Studio entities whose Name sorts from from through to,
+// inclusive.
+func (StudioConditions) NameBetween(from, to string) Filter[Studio] {
+	return Filter[Studio]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// CreatedYearEquals matches Studio entities whose Created falls in year.
+func (c StudioConditions) CreatedYearEquals(year int) Filter[Studio] {
+	return c.CreatedYearBetween(year, year)
+}
+
+// CreatedYearBetween matches Studio entities whose Created falls in the
+// years from through to, inclusive.
+func (c StudioConditions) CreatedYearBetween(from, to int) Filter[Studio] {
+	return c.CreatedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// CreatedDateBetween matches Studio entities whose Created lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (StudioConditions) CreatedDateBetween(from, to time.Time) Filter[Studio] {
+	return Filter[Studio]{expr: "between(created, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
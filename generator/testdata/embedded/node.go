@@ -0,0 +1,24 @@
+package embedded
+
+import "time"
+
+// Node is the base every entity embeds for its UID, DType, and Created time.
+type Node struct {
+	UID     string    `json:"uid,omitempty"`
+	DType   []string  `json:"dgraph.type,omitempty"`
+	Created time.Time `json:"created,omitempty" dgraph:"index=day"`
+	Label   string    `json:"label,omitempty" dgraph:"index=exact"`
+}
+
+// Film inherits its UID, DType, Created, and Label from Node.
+type Film struct {
+	Node
+	Name    string   `json:"name,omitempty" dgraph:"index=exact"`
+	Studios []Studio `json:"studios,omitempty" dgraph:"predicate=studio"`
+}
+
+// Studio is the target of Film's edge.
+type Studio struct {
+	Node
+	Name string `json:"name,omitempty" dgraph:"index=exact"`
+}
@@ -0,0 +1,19 @@
+package facets
+
+// Film expands its performances in billing order and counts them.
+type Film struct {
+	UID              string        `json:"uid,omitempty"`
+	DType            []string      `json:"dgraph.type,omitempty"`
+	Name             string        `json:"name,omitempty" dgraph:"index=term"`
+	Performances     []Performance `json:"performances,omitempty" dgraph:"predicate=performance reverse count orderasc=billing_order"`
+	PerformanceCount int           `json:"performanceCount,omitempty" dgraph:"count=performance"`
+}
+
+// Performance counts the films it appears in through the reverse edge.
+type Performance struct {
+	UID       string   `json:"uid,omitempty"`
+	DType     []string `json:"dgraph.type,omitempty"`
+	Character string   `json:"character,omitempty" dgraph:"index=exact"`
+	Films     []Film   `json:"films,omitempty" dgraph:"predicate=~performance reverse orderdesc=billing_order"`
+	FilmCount int      `json:"filmCount,omitempty" dgraph:"count=~performance"`
+}
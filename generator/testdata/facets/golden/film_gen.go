@@ -0,0 +1,262 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FilmAPI is the set of Film operations provided by FilmClient. Code
+// that depends on FilmAPI rather than *FilmClient can run against a test double.
+type FilmAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Film) error
+	Create(ctx context.Context, v *Film) (string, error)
+	Update(ctx context.Context, v *Film) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Film, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error)
+}
+
+// FilmClient provides typed CRUD operations for Film entities.
+//
+// Film expands its performances in billing order and counts them.
+type FilmClient struct {
+	conn graphConn
+}
+
+var _ FilmAPI = (*FilmClient)(nil)
+
+// Get retrieves a single Film by its UID.
+func (c *FilmClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Film", filmSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Film with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *FilmClient) GetExpanded(ctx context.Context, uid string) (*Film, error) {
+	var result Film
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Film", map[string]string{
+		"performance": "performances",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type. A node of another type does not count.
+func (c *FilmClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Film")
+}
+
+// Load populates v with the Film stored under uid, using c.Film.Get.
+func (v *Film) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Film.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadPerformances returns v.Performances, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Performances, with the scalar predicates of each Performance, is kept in
+// v.Performances, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Performances to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Performances may be loaded and empty, and
+// LoadPerformances queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadPerformances(ctx context.Context, c *Client) ([]Performance, error) {
+	if v.Performances != nil {
+		return v.Performances, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadPerformances: UID is empty")
+	}
+	var got Film
+	selection := "uid performances: performance @facets(orderasc: billing_order) { " + performanceSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Performances = got.Performances
+	if v.Performances == nil {
+		v.Performances = []Performance{}
+	}
+	return v.Performances, nil
+}
+
+var _ Entity = (*Film)(nil)
+
+// GetUID returns the Film's UID, empty until it has been added.
+func (v *Film) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Film's UID.
+func (v *Film) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Film's dgraph.type values: its DType, or
+// {"Film"} until Add sets it.
+func (v *Film) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Film"}
+}
+
+// String returns a one-line summary of the Film: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Film) String() string {
+	return fmt.Sprintf("Film(%s performances=%d)", v.UID, len(v.Performances))
+}
+
+// Add inserts a new Film into the database.
+func (c *FilmClient) Add(ctx context.Context, v *Film) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Film node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *FilmClient) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Film"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Film in the database. The UID field must be set.
+func (c *FilmClient) Update(ctx context.Context, v *Film) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Film with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *FilmClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Film.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Film.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Film with the given UID from the database.
+func (c *FilmClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddPerformances links the Film with the given UID to the Performance nodes with the
+// given UIDs through performance, keeping the Performances it has.
+func (c *FilmClient) AddPerformances(ctx context.Context, filmUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "performance", []string{filmUID}, performanceUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddPerformances: %w", err)
+	}
+	return nil
+}
+
+// RemovePerformances unlinks the Film with the given UID from the Performance nodes
+// with the given UIDs, deleting their performance edges.
+func (c *FilmClient) RemovePerformances(ctx context.Context, filmUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "performance", []string{filmUID}, performanceUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemovePerformances: %w", err)
+	}
+	return nil
+}
+
+// CountPerformances returns the number of Performances of the Film with the given UID, using
+// Dgraph's count(performance).
+func (c *FilmClient) CountPerformances(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Film", "performance")
+}
+
+// filmSelection returns the DQL selection for a Film: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func filmSelection(depth int) string {
+	s := "uid dgraph.type name performanceCount: count(performance)"
+	if depth > 0 {
+		s += " performances: performance @facets(orderasc: billing_order) { " + performanceSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Films with optional pagination.
+func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var films []Film
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// Find retrieves Films matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *FilmClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var films []Film
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Counts are left out, as Dgraph computes them.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		PerformanceCount *struct{} `json:"performanceCount,omitempty"`
+	}{plain: plain(v)}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Performances json.RawMessage `json:"performances"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Performances, &v.Performances); err != nil {
+		return fmt.Errorf("Film.Performances: %w", err)
+	}
+	return nil
+}
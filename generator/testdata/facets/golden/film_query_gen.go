@@ -0,0 +1,307 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(name).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasPerformances filters to Film entities that have a value for Performances, using
+// has(performance).
+func (q *FilmQuery) HasPerformances() *FilmQuery {
+	return q.Where(FilmWhere.HasPerformances())
+}
+
+// NotPerformances filters to Film entities that have no Performances value.
+func (q *FilmQuery) NotPerformances() *FilmQuery {
+	return q.Where(FilmWhere.NotPerformances())
+}
+
+// PerformancesContains filters to Film entities whose Performances include any of the
+// Performance nodes with the given uids, using uid_in(performance, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) PerformancesContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.PerformancesContains(uids...))
+}
+
+// NameAllOfTerms filters to Film entities whose Name contains all of the terms.
+func (q *FilmQuery) NameAllOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Film entities whose Name contains any of the terms.
+func (q *FilmQuery) NameAnyOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAnyOfTerms(terms))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithPerformances makes GetByUID and Exec also fetch the Performances edge, with the
+// scalar predicates of each Performance it leads to, in the same query.
+func (q *FilmQuery) WithPerformances() *FilmQuery {
+	return q.withEdge("performances: performance @facets(orderasc: billing_order) { " + performanceSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasName matches Film entities that have a value for Name, using
+// has(name).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(name)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(name)"}
+}
+
+// HasPerformances matches Film entities that have a value for Performances, using
+// has(performance).
+func (FilmConditions) HasPerformances() Filter[Film] {
+	return Filter[Film]{expr: "has(performance)"}
+}
+
+// NotPerformances matches Film entities that have no Performances value.
+func (FilmConditions) NotPerformances() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(performance)"}
+}
+
+// PerformancesContains matches Film entities whose Performances include any of the
+// Performance nodes with the given uids, using uid_in(performance, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) PerformancesContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Performances: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(performance, " + list + ")"}
+}
+
+// NameAllOfTerms matches Film entities whose Name contains all of the terms.
+func (FilmConditions) NameAllOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Film entities whose Name contains any of the terms.
+func (FilmConditions) NameAnyOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "anyofterms(name, " + formatString(terms) + ")"}
+}
@@ -0,0 +1,47 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkPerformanceToMap measures converting a Performance to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkPerformanceToMap(b *testing.B) {
+	v := Performance{
+		UID:       "0x1",
+		Character: "Character",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkPerformanceQueryBuild measures building a Performance query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkPerformanceQueryBuild(b *testing.B) {
+	c := &PerformanceClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
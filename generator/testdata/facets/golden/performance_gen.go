@@ -0,0 +1,270 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PerformanceAPI is the set of Performance operations provided by PerformanceClient. Code
+// that depends on PerformanceAPI rather than *PerformanceClient can run against a test double.
+type PerformanceAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Performance, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Performance) error
+	Create(ctx context.Context, v *Performance) (string, error)
+	Update(ctx context.Context, v *Performance) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Performance, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Performance, error)
+}
+
+// PerformanceClient provides typed CRUD operations for Performance entities.
+//
+// Performance counts the films it appears in through the reverse edge.
+type PerformanceClient struct {
+	conn graphConn
+}
+
+var _ PerformanceAPI = (*PerformanceClient)(nil)
+
+// Get retrieves a single Performance by its UID.
+func (c *PerformanceClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Performance, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Performance
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Performance", performanceSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Performance with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *PerformanceClient) GetExpanded(ctx context.Context, uid string) (*Performance, error) {
+	var result Performance
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Performance", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Performance type. A node of another type does not count.
+func (c *PerformanceClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Performance")
+}
+
+// Load populates v with the Performance stored under uid, using c.Performance.Get.
+func (v *Performance) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Performance.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Performance) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Performance.LoadFilms: UID is empty")
+	}
+	var got Performance
+	selection := "uid films: ~performance @facets(orderdesc: billing_order) { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Performance", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Performance)(nil)
+
+// GetUID returns the Performance's UID, empty until it has been added.
+func (v *Performance) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Performance's UID.
+func (v *Performance) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Performance's dgraph.type values: its DType, or
+// {"Performance"} until Add sets it.
+func (v *Performance) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Performance"}
+}
+
+// String returns a one-line summary of the Performance: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Performance) String() string {
+	return fmt.Sprintf("Performance(%s films=%d)", v.UID, len(v.Films))
+}
+
+// Add inserts a new Performance into the database.
+func (c *PerformanceClient) Add(ctx context.Context, v *Performance) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Performance node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *PerformanceClient) Create(ctx context.Context, v *Performance) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Performance.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Performance"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Performance.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Performance in the database. The UID field must be set.
+func (c *PerformanceClient) Update(ctx context.Context, v *Performance) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Performance with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *PerformanceClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Performance.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetCharacter sets the Character of the Performance with the given UID to value, touching
+// no other predicate.
+func (c *PerformanceClient) SetCharacter(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"character": value}); err != nil {
+		return fmt.Errorf("Performance.SetCharacter: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Performance with the given UID from the database.
+func (c *PerformanceClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddFilms links the Performance with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of performance, it adds the performance edge from each
+// Film to the Performance.
+func (c *PerformanceClient) AddFilms(ctx context.Context, performanceUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "performance", filmUIDs, []string{performanceUID}, false); err != nil {
+		return fmt.Errorf("Performance.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Performance with the given UID from the Film nodes
+// with the given UIDs, deleting the performance edge from each Film.
+func (c *PerformanceClient) RemoveFilms(ctx context.Context, performanceUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "performance", filmUIDs, []string{performanceUID}, true); err != nil {
+		return fmt.Errorf("Performance.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
+// performanceSelection returns the DQL selection for a Performance: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func performanceSelection(depth int) string {
+	s := "uid dgraph.type character filmCount: count(~performance)"
+	if depth > 0 {
+		s += " films: ~performance @facets(orderdesc: billing_order) { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Performances with optional pagination.
+func (c *PerformanceClient) List(ctx context.Context, opts ...PageOption) ([]Performance, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var performances []Performance
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&performances)
+	if err != nil {
+		return nil, err
+	}
+	return performances, nil
+}
+
+// Find retrieves Performances matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *PerformanceClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Performance, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var performances []Performance
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&performances)
+	if err != nil {
+		return nil, err
+	}
+	return performances, nil
+}
+
+// GetByCharacter retrieves the Performance entities whose Character is value, with optional
+// pagination.
+func (c *PerformanceClient) GetByCharacter(ctx context.Context, value string, opts ...PageOption) ([]Performance, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Performance
+	err := queryNodes(ctx, c.conn.QueryRaw, "Performance", "eq(character, "+formatString(value)+")", "", performanceSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
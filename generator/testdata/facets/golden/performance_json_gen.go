@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Performance in the form Dgraph expects:
+//   - Counts are left out, as Dgraph computes them.
+func (v Performance) MarshalJSON() ([]byte, error) {
+	type plain Performance
+	out := struct {
+		plain
+		FilmCount *struct{} `json:"filmCount,omitempty"`
+	}{plain: plain(v)}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Performance from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Performance) UnmarshalJSON(data []byte) error {
+	type plain Performance
+	in := struct {
+		*plain
+		Films json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("Performance.Films: %w", err)
+	}
+	return nil
+}
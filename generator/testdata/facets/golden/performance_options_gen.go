@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+// PerformanceOption is a functional option for configuring Performance mutations.
+type PerformanceOption func(*Performance)
+
+// WithPerformanceCharacter sets the Character field on a Performance.
+func WithPerformanceCharacter(v string) PerformanceOption {
+	return func(e *Performance) {
+		e.Character = v
+	}
+}
+
+// ApplyPerformanceOptions applies the given options to a Performance.
+func ApplyPerformanceOptions(e *Performance, opts ...PerformanceOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
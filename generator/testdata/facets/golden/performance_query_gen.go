@@ -0,0 +1,319 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PerformanceQuery is a typed query builder for Performance entities.
+type PerformanceQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Performance entities.
+func (c *PerformanceClient) Query(ctx context.Context) *PerformanceQuery {
+	return &PerformanceQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *PerformanceQuery) Filter(f string) *PerformanceQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *PerformanceQuery) where(expr string) *PerformanceQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PerformanceWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PerformanceQuery) Where(f Filter[Performance]) *PerformanceQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasCharacter filters to Performance entities that have a value for Character, using
+// has(character).
+func (q *PerformanceQuery) HasCharacter() *PerformanceQuery {
+	return q.Where(PerformanceWhere.HasCharacter())
+}
+
+// NotCharacter filters to Performance entities that have no Character value.
+func (q *PerformanceQuery) NotCharacter() *PerformanceQuery {
+	return q.Where(PerformanceWhere.NotCharacter())
+}
+
+// HasFilms filters to Performance entities that have a value for Films, using
+// has(~performance).
+func (q *PerformanceQuery) HasFilms() *PerformanceQuery {
+	return q.Where(PerformanceWhere.HasFilms())
+}
+
+// NotFilms filters to Performance entities that have no Films value.
+func (q *PerformanceQuery) NotFilms() *PerformanceQuery {
+	return q.Where(PerformanceWhere.NotFilms())
+}
+
+// FilmsContains filters to Performance entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~performance, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *PerformanceQuery) FilmsContains(uids ...string) *PerformanceQuery {
+	return q.Where(PerformanceWhere.FilmsContains(uids...))
+}
+
+// CharacterGe filters to Performance entities whose Character sorts at or after value.
+func (q *PerformanceQuery) CharacterGe(value string) *PerformanceQuery {
+	return q.Where(PerformanceWhere.CharacterGe(value))
+}
+
+// CharacterLe filters to Performance entities whose Character sorts at or before value.
+func (q *PerformanceQuery) CharacterLe(value string) *PerformanceQuery {
+	return q.Where(PerformanceWhere.CharacterLe(value))
+}
+
+// CharacterBetween filters to Performance entities whose Character sorts from from through to,
+// inclusive.
+func (q *PerformanceQuery) CharacterBetween(from, to string) *PerformanceQuery {
+	return q.Where(PerformanceWhere.CharacterBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *PerformanceQuery) OrderAsc(field string) *PerformanceQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *PerformanceQuery) OrderDesc(field string) *PerformanceQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *PerformanceQuery) First(n int) *PerformanceQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *PerformanceQuery) Offset(n int) *PerformanceQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *PerformanceQuery) withEdge(selection string) *PerformanceQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *PerformanceQuery) WithFilms() *PerformanceQuery {
+	return q.withEdge("films: ~performance @facets(orderdesc: billing_order) { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Performance and the edges added by the With methods.
+func (q *PerformanceQuery) selection() string {
+	s := performanceSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PerformanceQuery) dql() string {
+	return nodesQuery("Performance", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PerformanceQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Performance with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Performance.
+func (q *PerformanceQuery) GetByUID(uid string) (*Performance, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Performance
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Performance", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *PerformanceQuery) Exec(dst *[]Performance) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Performance{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *PerformanceQuery) ExecAndCount(dst *[]Performance) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("PerformanceQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Performance", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Performance{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PerformanceWhere builds the conditions on Performance fields that PerformanceQuery.Where takes.
+var PerformanceWhere PerformanceConditions
+
+// PerformanceConditions has a method for each typed filter of PerformanceQuery, returning it as a
+// Filter[Performance] to combine with And, Or, and Not.
+type PerformanceConditions struct{}
+
+// HasCharacter matches Performance entities that have a value for Character, using
+// has(character).
+func (PerformanceConditions) HasCharacter() Filter[Performance] {
+	return Filter[Performance]{expr: "has(character)"}
+}
+
+// NotCharacter matches Performance entities that have no Character value.
+func (PerformanceConditions) NotCharacter() Filter[Performance] {
+	return Filter[Performance]{expr: "NOT has(character)"}
+}
+
+// HasFilms matches Performance entities that have a value for Films, using
+// has(~performance).
+func (PerformanceConditions) HasFilms() Filter[Performance] {
+	return Filter[Performance]{expr: "has(~performance)"}
+}
+
+// NotFilms matches Performance entities that have no Films value.
+func (PerformanceConditions) NotFilms() Filter[Performance] {
+	return Filter[Performance]{expr: "NOT has(~performance)"}
+}
+
+// FilmsContains matches Performance entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~performance, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (PerformanceConditions) FilmsContains(uids ...string) Filter[Performance] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Performance]{err: fmt.Errorf("Performance.Films: %w", err)}
+	}
+	return Filter[Performance]{expr: "uid_in(~performance, " + list + ")"}
+}
+
+// CharacterGe matches Performance entities whose Character sorts at or after value.
+func (PerformanceConditions) CharacterGe(value string) Filter[Performance] {
+	return Filter[Performance]{expr: "ge(character, " + formatString(value) + ")"}
+}
+
+// CharacterLe matches Performance entities whose Character sorts at or before value.
+func (PerformanceConditions) CharacterLe(value string) Filter[Performance] {
+	return Filter[Performance]{expr: "le(character, " + formatString(value) + ")"}
+}
+
+// CharacterBetween matches Performance entities whose Character sorts from from through to,
+// inclusive.
+func (PerformanceConditions) CharacterBetween(from, to string) Filter[Performance] {
+	return Filter[Performance]{expr: "between(character, " + formatString(from) + ", " + formatString(to) + ")"}
+}
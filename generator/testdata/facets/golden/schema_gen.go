@@ -0,0 +1,22 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package facets
+
+// DQLSchema is the Dgraph schema for the facets data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+character: string @index(exact) .
+name: string @index(term) .
+performance: [uid] @reverse @count .
+
+type Film {
+	name
+	performance
+}
+
+type Performance {
+	character
+	<~performance>
+}
+`
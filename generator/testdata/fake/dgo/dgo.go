@@ -0,0 +1,60 @@
+// Package dgo is a fake of the dgo API that generated code uses. Its
+// transactions do nothing unless the Dgraph's MutateFunc, QueryFunc, and
+// DoFunc answer them.
+package dgo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+// ErrAborted is returned when a transaction is aborted in a conflict.
+var ErrAborted = errors.New("Transaction has been aborted. Please retry")
+
+// Dgraph is a fake Dgraph connection.
+type Dgraph struct {
+	// MutateFunc, if set, answers the Mutate calls of its transactions.
+	MutateFunc func(mu *api.Mutation) (*api.Response, error)
+	// QueryFunc, if set, answers the QueryWithVars calls of its transactions.
+	QueryFunc func(q string, vars map[string]string) (*api.Response, error)
+	// DoFunc, if set, answers the Do calls of its transactions, e.g. upserts.
+	DoFunc func(req *api.Request) (*api.Response, error)
+}
+
+// Txn is a fake transaction.
+type Txn struct {
+	mutate func(mu *api.Mutation) (*api.Response, error)
+	query  func(q string, vars map[string]string) (*api.Response, error)
+	do     func(req *api.Request) (*api.Response, error)
+}
+
+func (d *Dgraph) NewTxn() *Txn {
+	return &Txn{mutate: d.MutateFunc, query: d.QueryFunc, do: d.DoFunc}
+}
+func (d *Dgraph) NewReadOnlyTxn() *Txn { return &Txn{query: d.QueryFunc} }
+
+func (t *Txn) Mutate(ctx context.Context, mu *api.Mutation) (*api.Response, error) {
+	if t.mutate != nil {
+		return t.mutate(mu)
+	}
+	return &api.Response{}, nil
+}
+func (t *Txn) Query(ctx context.Context, q string) (*api.Response, error) {
+	return &api.Response{}, nil
+}
+func (t *Txn) QueryWithVars(ctx context.Context, q string, vars map[string]string) (*api.Response, error) {
+	if t.query != nil {
+		return t.query(q, vars)
+	}
+	return &api.Response{}, nil
+}
+func (t *Txn) Do(ctx context.Context, req *api.Request) (*api.Response, error) {
+	if t.do != nil {
+		return t.do(req)
+	}
+	return &api.Response{}, nil
+}
+func (t *Txn) Commit(ctx context.Context) error  { return nil }
+func (t *Txn) Discard(ctx context.Context) error { return nil }
@@ -0,0 +1,3 @@
+module github.com/dgraph-io/dgo/v250
+
+go 1.22
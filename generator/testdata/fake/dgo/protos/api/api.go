@@ -0,0 +1,24 @@
+// Package api is a fake of the dgo protocol types that generated code uses.
+package api
+
+// Mutation is a set of JSON mutations.
+type Mutation struct {
+	SetJson    []byte
+	DeleteJson []byte
+	CommitNow  bool
+	Cond       string
+}
+
+// Request is a query with mutations.
+type Request struct {
+	Query     string
+	Vars      map[string]string
+	Mutations []*Mutation
+	CommitNow bool
+}
+
+// Response is a query or mutation result.
+type Response struct {
+	Json []byte
+	Uids map[string]string
+}
@@ -0,0 +1,53 @@
+// Package modusgraph is a fake of the modusgraph API that generated code uses,
+// so tests can build generated packages without the real module. Its Query
+// matches nothing.
+package modusgraph
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Client is the subset of the modusgraph client used by generated code.
+type Client interface {
+	Insert(ctx context.Context, obj any) error
+	Upsert(ctx context.Context, obj any, predicates ...string) error
+	Update(ctx context.Context, obj any) error
+	Get(ctx context.Context, obj any, uid string) error
+	Query(ctx context.Context, model any) *Query
+	Delete(ctx context.Context, uids []string) error
+	Close()
+	QueryRaw(ctx context.Context, query string, vars map[string]string) ([]byte, error)
+	DgraphClient() (*dgo.Dgraph, func(), error)
+}
+
+// ClientOpt configures NewClient.
+type ClientOpt func()
+
+// NewClient returns a nil Client; tests pass their own to NewFromClient.
+func NewClient(uri string, opts ...ClientOpt) (Client, error) { return nil, nil }
+
+// WithAutoSchema is accepted and ignored.
+func WithAutoSchema(enable bool) ClientOpt { return func() {} }
+
+// Query is a query builder whose Nodes finds nothing unless NodesFunc is set.
+type Query struct {
+	// NodesFunc, if set, answers Nodes, e.g. with one page of results.
+	NodesFunc func(v any) error
+}
+
+func (q *Query) Filter(filter string) *Query      { return q }
+func (q *Query) First(n int) *Query               { return q }
+func (q *Query) Offset(n int) *Query              { return q }
+func (q *Query) After(uid string) *Query          { return q }
+func (q *Query) OrderAsc(pred string) *Query      { return q }
+func (q *Query) OrderDesc(pred string) *Query     { return q }
+func (q *Query) NodesAndCount(v any) (int, error) { return 0, nil }
+
+func (q *Query) Nodes(v any) error {
+	if q.NodesFunc != nil {
+		return q.NodesFunc(v)
+	}
+	return nil
+}
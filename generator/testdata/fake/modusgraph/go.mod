@@ -0,0 +1,5 @@
+module github.com/matthewmcneely/modusgraph
+
+go 1.22
+
+require github.com/dgraph-io/dgo/v250 v250.0.0
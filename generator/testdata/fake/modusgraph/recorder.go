@@ -0,0 +1,53 @@
+package modusgraph
+
+import (
+	"context"
+
+	"github.com/dgraph-io/dgo/v250"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+)
+
+// Recorder is a Client that records the raw queries and mutations it is sent
+// and answers them from its fields, for tests of generated code. Its other
+// methods are those of the embedded Client, which panic while it is nil.
+type Recorder struct {
+	Client
+
+	// Resp answers each QueryRaw, unless RespFunc is set and answers it.
+	Resp     string
+	RespFunc func(query string, vars map[string]string) (string, error)
+	// LastQuery and LastVars are those of the last QueryRaw, and Queries
+	// lists the queries of all of them.
+	LastQuery string
+	LastVars  map[string]string
+	Queries   []string
+
+	// Set and Del hold the set and delete JSON of each mutation, "" for
+	// none, and MutateResp, if set, answers them.
+	Set, Del   []string
+	MutateResp *api.Response
+}
+
+func (r *Recorder) QueryRaw(ctx context.Context, query string, vars map[string]string) ([]byte, error) {
+	r.LastQuery, r.LastVars = query, vars
+	r.Queries = append(r.Queries, query)
+	if r.RespFunc != nil {
+		resp, err := r.RespFunc(query, vars)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(resp), nil
+	}
+	return []byte(r.Resp), nil
+}
+
+func (r *Recorder) DgraphClient() (*dgo.Dgraph, func(), error) {
+	return &dgo.Dgraph{MutateFunc: func(mu *api.Mutation) (*api.Response, error) {
+		r.Set = append(r.Set, string(mu.SetJson))
+		r.Del = append(r.Del, string(mu.DeleteJson))
+		if r.MutateResp != nil {
+			return r.MutateResp, nil
+		}
+		return &api.Response{}, nil
+	}}, func() {}, nil
+}
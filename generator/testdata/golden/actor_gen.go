@@ -4,42 +4,224 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// ActorAPI is the set of Actor operations provided by ActorClient. Code
+// that depends on ActorAPI rather than *ActorClient can run against a test double.
+type ActorAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Actor, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Actor) error
+	Create(ctx context.Context, v *Actor) (string, error)
+	Update(ctx context.Context, v *Actor) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Actor, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Actor, error)
+}
+
 // ActorClient provides typed CRUD operations for Actor entities.
 type ActorClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ ActorAPI = (*ActorClient)(nil)
+
 // Get retrieves a single Actor by its UID.
-func (c *ActorClient) Get(ctx context.Context, uid string) (*Actor, error) {
+func (c *ActorClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Actor, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Actor
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Actor", actorSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Actor with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *ActorClient) GetExpanded(ctx context.Context, uid string) (*Actor, error) {
+	var result Actor
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Actor", map[string]string{
+		"actor.film": "films",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Actor type. A node of another type does not count.
+func (c *ActorClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Actor")
+}
+
+// Load populates v with the Actor stored under uid, using c.Actor.Get.
+func (v *Actor) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Actor.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Performance, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Actor) LoadFilms(ctx context.Context, c *Client) ([]Performance, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Actor.LoadFilms: UID is empty")
+	}
+	var got Actor
+	selection := "uid films: actor.film { " + performanceSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Actor", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Performance{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Actor)(nil)
+
+// GetUID returns the Actor's UID, empty until it has been added.
+func (v *Actor) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Actor's UID.
+func (v *Actor) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Actor's dgraph.type values: its DType, or
+// {"Actor"} until Add sets it.
+func (v *Actor) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Actor"}
+}
+
+// String returns a one-line summary of the Actor: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Actor) String() string {
+	return fmt.Sprintf("Actor(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new Actor into the database.
 func (c *ActorClient) Add(ctx context.Context, v *Actor) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Actor node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *ActorClient) Create(ctx context.Context, v *Actor) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Actor.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Actor"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Actor.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Actor in the database. The UID field must be set.
 func (c *ActorClient) Update(ctx context.Context, v *Actor) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Actor with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *ActorClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Actor.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Actor with the given UID to value, touching
+// no other predicate.
+func (c *ActorClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Actor.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Actor with the given UID from the database.
 func (c *ActorClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the Actor with the given UID to the Performance nodes with the
+// given UIDs through actor.film, keeping the Films it has.
+func (c *ActorClient) AddFilms(ctx context.Context, actorUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "actor.film", []string{actorUID}, performanceUIDs, false); err != nil {
+		return fmt.Errorf("Actor.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Actor with the given UID from the Performance nodes
+// with the given UIDs, deleting their actor.film edges.
+func (c *ActorClient) RemoveFilms(ctx context.Context, actorUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "actor.film", []string{actorUID}, performanceUIDs, true); err != nil {
+		return fmt.Errorf("Actor.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
+// CountFilms returns the number of Films of the Actor with the given UID, using
+// Dgraph's count(actor.film).
+func (c *ActorClient) CountFilms(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Actor", "actor.film")
+}
+
 // Search finds Actor entities whose Name matches term using fulltext search.
 func (c *ActorClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Actor, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Actor
 	q := c.conn.Query(ctx, Actor{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +236,115 @@ func (c *ActorClient) Search(ctx context.Context, term string, opts ...PageOptio
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Actor entities with optional pagination.
-func (c *ActorClient) List(ctx context.Context, opts ...PageOption) ([]Actor, error) {
+// SearchAllOfText finds Actor entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *ActorClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Actor, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Actor entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *ActorClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Actor, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Actor entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *ActorClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Actor, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *ActorClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Actor, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Actor
-	q := c.conn.Query(ctx, Actor{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Actor", actorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// actorSelection returns the DQL selection for an Actor: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func actorSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: actor.film { " + performanceSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Actors with optional pagination.
+func (c *ActorClient) List(ctx context.Context, opts ...PageOption) ([]Actor, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var actors []Actor
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&actors)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return actors, nil
+}
+
+// Find retrieves Actors matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *ActorClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Actor, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var actors []Actor
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&actors)
+	if err != nil {
+		return nil, err
+	}
+	return actors, nil
+}
+
+// GetByName retrieves the Actor entities whose Name is value, with optional
+// pagination.
+func (c *ActorClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Actor, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Actor
+	err := queryNodes(ctx, c.conn.QueryRaw, "Actor", "eq(name, "+formatString(value)+")", "", actorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Actor entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *ActorClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Actor, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Actor.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Actor
+	err = queryNodes(ctx, c.conn.QueryRaw, "Actor", "regexp(name, "+re+")", "", actorSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
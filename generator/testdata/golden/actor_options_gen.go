@@ -5,14 +5,14 @@ package movies
 // ActorOption is a functional option for configuring Actor mutations.
 type ActorOption func(*Actor)
 
-// WithActorName sets the Name field on a Actor.
+// WithActorName sets the Name field on an Actor.
 func WithActorName(v string) ActorOption {
 	return func(e *Actor) {
 		e.Name = v
 	}
 }
 
-// ApplyActorOptions applies the given options to a Actor.
+// ApplyActorOptions applies the given options to an Actor.
 func ApplyActorOptions(e *Actor, opts ...ActorOption) {
 	for _, opt := range opts {
 		opt(e)
@@ -4,19 +4,21 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
 // ActorQuery is a typed query builder for Actor entities.
 type ActorQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Actor entities.
@@ -30,6 +32,71 @@ func (q *ActorQuery) Filter(f string) *ActorQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *ActorQuery) where(expr string) *ActorQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from ActorWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *ActorQuery) Where(f Filter[Actor]) *ActorQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Actor entities that have a value for Name, using
+// has(name).
+func (q *ActorQuery) HasName() *ActorQuery {
+	return q.Where(ActorWhere.HasName())
+}
+
+// NotName filters to Actor entities that have no Name value.
+func (q *ActorQuery) NotName() *ActorQuery {
+	return q.Where(ActorWhere.NotName())
+}
+
+// HasFilms filters to Actor entities that have a value for Films, using
+// has(actor.film).
+func (q *ActorQuery) HasFilms() *ActorQuery {
+	return q.Where(ActorWhere.HasFilms())
+}
+
+// NotFilms filters to Actor entities that have no Films value.
+func (q *ActorQuery) NotFilms() *ActorQuery {
+	return q.Where(ActorWhere.NotFilms())
+}
+
+// FilmsContains filters to Actor entities whose Films include any of the
+// Performance nodes with the given uids, using uid_in(actor.film, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *ActorQuery) FilmsContains(uids ...string) *ActorQuery {
+	return q.Where(ActorWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to Actor entities whose Name contains all of the terms.
+func (q *ActorQuery) NameAllOfTerms(terms string) *ActorQuery {
+	return q.Where(ActorWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Actor entities whose Name contains any of the terms.
+func (q *ActorQuery) NameAnyOfTerms(terms string) *ActorQuery {
+	return q.Where(ActorWhere.NameAnyOfTerms(terms))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *ActorQuery) OrderAsc(field string) *ActorQuery {
 	q.orderBy = field
@@ -56,8 +123,81 @@ func (q *ActorQuery) Offset(n int) *ActorQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *ActorQuery) withEdge(selection string) *ActorQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Performance it leads to, in the same query.
+func (q *ActorQuery) WithFilms() *ActorQuery {
+	return q.withEdge("films: actor.film { " + performanceSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Actor and the edges added by the With methods.
+func (q *ActorQuery) selection() string {
+	s := actorSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *ActorQuery) dql() string {
+	return nodesQuery("Actor", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *ActorQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Actor with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Actor.
+func (q *ActorQuery) GetByUID(uid string) (*Actor, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Actor
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Actor", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *ActorQuery) Exec(dst *[]Actor) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Actor{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +215,21 @@ func (q *ActorQuery) Exec(dst *[]Actor) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *ActorQuery) ExecAndCount(dst *[]Actor) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("ActorQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Actor", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Actor{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +247,61 @@ func (q *ActorQuery) ExecAndCount(dst *[]Actor) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// ActorWhere builds the conditions on Actor fields that ActorQuery.Where takes.
+var ActorWhere ActorConditions
+
+// ActorConditions has a method for each typed filter of ActorQuery, returning it as a
+// Filter[Actor] to combine with And, Or, and Not.
+type ActorConditions struct{}
+
+// HasName matches Actor entities that have a value for Name, using
+// has(name).
+func (ActorConditions) HasName() Filter[Actor] {
+	return Filter[Actor]{expr: "has(name)"}
+}
+
+// NotName matches Actor entities that have no Name value.
+func (ActorConditions) NotName() Filter[Actor] {
+	return Filter[Actor]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Actor entities that have a value for Films, using
+// has(actor.film).
+func (ActorConditions) HasFilms() Filter[Actor] {
+	return Filter[Actor]{expr: "has(actor.film)"}
+}
+
+// NotFilms matches Actor entities that have no Films value.
+func (ActorConditions) NotFilms() Filter[Actor] {
+	return Filter[Actor]{expr: "NOT has(actor.film)"}
+}
+
+// FilmsContains matches Actor entities whose Films include any of the
+// Performance nodes with the given uids, using uid_in(actor.film, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (ActorConditions) FilmsContains(uids ...string) Filter[Actor] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Actor]{err: fmt.Errorf("Actor.Films: %w", err)}
+	}
+	return Filter[Actor]{expr: "uid_in(actor.film, " + list + ")"}
+}
+
+// NameAllOfTerms matches Actor entities whose Name contains all of the terms.
+func (ActorConditions) NameAllOfTerms(terms string) Filter[Actor] {
+	return Filter[Actor]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Actor entities whose Name contains any of the terms.
+func (ActorConditions) NameAnyOfTerms(terms string) Filter[Actor] {
+	return Filter[Actor]{expr: "anyofterms(name, " + formatString(terms) + ")"}
 }
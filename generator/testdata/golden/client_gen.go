@@ -3,12 +3,15 @@
 package movies
 
 import (
+	"github.com/dgraph-io/dgo/v250"
 	"github.com/matthewmcneely/modusgraph"
 )
 
-// Client provides typed access to the movies data model.
+// Client provides typed access to the movies data model. A Client holds no
+// mutable state of its own and is safe for concurrent use by multiple
+// goroutines, as its modusgraph.Client connection is.
 type Client struct {
-	conn          modusgraph.Client
+	conn          graphConn
 	Actor         *ActorClient
 	ContentRating *ContentRatingClient
 	Country       *CountryClient
@@ -29,8 +32,21 @@ func New(connStr string, opts ...modusgraph.ClientOpt) (*Client, error) {
 	return NewFromClient(conn), nil
 }
 
-// NewFromClient creates a new Client from an existing modusgraph.Client connection.
-func NewFromClient(conn modusgraph.Client) *Client {
+// NewFromClient creates a new Client from an existing modusgraph.Client
+// connection, configured by opts such as WithRetry and WithMetricsPrefix.
+func NewFromClient(conn modusgraph.Client, opts ...ClientOption) *Client {
+	var cfg clientConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.attempts > 1 || cfg.metrics != nil {
+		return newClient(&retryConn{graphConn: conn, attempts: cfg.attempts, baseDelay: cfg.baseDelay, metrics: cfg.metrics})
+	}
+	return newClient(conn)
+}
+
+// newClient returns a Client whose entity clients share conn.
+func newClient(conn graphConn) *Client {
 	return &Client{
 		conn:          conn,
 		Actor:         &ActorClient{conn: conn},
@@ -45,6 +61,18 @@ func NewFromClient(conn modusgraph.Client) *Client {
 	}
 }
 
+// NewClientWithDgo creates a new Client over dg, an existing Dgraph connection,
+// e.g. one made with custom gRPC interceptors. The caller keeps ownership of dg,
+// which Close leaves open. Every method works as it does over modusgraph, but
+// queries and mutations go over dg as raw DQL and JSON: List, the iterators,
+// the Stream methods, and a Query's Exec and ExecAndCount build their DQL
+// themselves, Search uses alloftext as its root function, and WithUpsert runs a
+// DQL upsert block that matches on the first upsert field that is set. There is no retrying, as
+// WithRetry is an option of NewFromClient.
+func NewClientWithDgo(dg *dgo.Dgraph) *Client {
+	return newClient(&dgoConn{dg: dg})
+}
+
 // Close releases all resources used by the client.
 func (c *Client) Close() {
 	c.conn.Close()
@@ -4,42 +4,217 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// ContentRatingAPI is the set of ContentRating operations provided by ContentRatingClient. Code
+// that depends on ContentRatingAPI rather than *ContentRatingClient can run against a test double.
+type ContentRatingAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*ContentRating, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *ContentRating) error
+	Create(ctx context.Context, v *ContentRating) (string, error)
+	Update(ctx context.Context, v *ContentRating) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]ContentRating, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]ContentRating, error)
+}
+
 // ContentRatingClient provides typed CRUD operations for ContentRating entities.
 type ContentRatingClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ ContentRatingAPI = (*ContentRatingClient)(nil)
+
 // Get retrieves a single ContentRating by its UID.
-func (c *ContentRatingClient) Get(ctx context.Context, uid string) (*ContentRating, error) {
+func (c *ContentRatingClient) Get(ctx context.Context, uid string, opts ...GetOption) (*ContentRating, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result ContentRating
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "ContentRating", contentRatingSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the ContentRating with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *ContentRatingClient) GetExpanded(ctx context.Context, uid string) (*ContentRating, error) {
+	var result ContentRating
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "ContentRating", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// ContentRating type. A node of another type does not count.
+func (c *ContentRatingClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "ContentRating")
+}
+
+// Load populates v with the ContentRating stored under uid, using c.ContentRating.Get.
+func (v *ContentRating) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.ContentRating.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *ContentRating) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("ContentRating.LoadFilms: UID is empty")
+	}
+	var got ContentRating
+	selection := "uid films: ~rated { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "ContentRating", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*ContentRating)(nil)
+
+// GetUID returns the ContentRating's UID, empty until it has been added.
+func (v *ContentRating) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the ContentRating's UID.
+func (v *ContentRating) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the ContentRating's dgraph.type values: its DType, or
+// {"ContentRating"} until Add sets it.
+func (v *ContentRating) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"ContentRating"}
+}
+
+// String returns a one-line summary of the ContentRating: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v ContentRating) String() string {
+	return fmt.Sprintf("ContentRating(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new ContentRating into the database.
 func (c *ContentRatingClient) Add(ctx context.Context, v *ContentRating) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new ContentRating node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *ContentRatingClient) Create(ctx context.Context, v *ContentRating) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("ContentRating.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"ContentRating"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("ContentRating.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing ContentRating in the database. The UID field must be set.
 func (c *ContentRatingClient) Update(ctx context.Context, v *ContentRating) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the ContentRating with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *ContentRatingClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("ContentRating.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the ContentRating with the given UID to value, touching
+// no other predicate.
+func (c *ContentRatingClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("ContentRating.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the ContentRating with the given UID from the database.
 func (c *ContentRatingClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the ContentRating with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of rated, it adds the rated edge from each
+// Film to the ContentRating.
+func (c *ContentRatingClient) AddFilms(ctx context.Context, contentRatingUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rated", filmUIDs, []string{contentRatingUID}, false); err != nil {
+		return fmt.Errorf("ContentRating.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the ContentRating with the given UID from the Film nodes
+// with the given UIDs, deleting the rated edge from each Film.
+func (c *ContentRatingClient) RemoveFilms(ctx context.Context, contentRatingUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rated", filmUIDs, []string{contentRatingUID}, true); err != nil {
+		return fmt.Errorf("ContentRating.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
 // Search finds ContentRating entities whose Name matches term using fulltext search.
 func (c *ContentRatingClient) Search(ctx context.Context, term string, opts ...PageOption) ([]ContentRating, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []ContentRating
 	q := c.conn.Query(ctx, ContentRating{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +229,115 @@ func (c *ContentRatingClient) Search(ctx context.Context, term string, opts ...P
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves ContentRating entities with optional pagination.
-func (c *ContentRatingClient) List(ctx context.Context, opts ...PageOption) ([]ContentRating, error) {
+// SearchAllOfText finds ContentRating entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *ContentRatingClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]ContentRating, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds ContentRating entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *ContentRatingClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]ContentRating, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds ContentRating entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *ContentRatingClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]ContentRating, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *ContentRatingClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]ContentRating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []ContentRating
-	q := c.conn.Query(ctx, ContentRating{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "ContentRating", contentRatingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// contentRatingSelection returns the DQL selection for a ContentRating: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func contentRatingSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: ~rated { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves ContentRatings with optional pagination.
+func (c *ContentRatingClient) List(ctx context.Context, opts ...PageOption) ([]ContentRating, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var contentRatings []ContentRating
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&contentRatings)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return contentRatings, nil
+}
+
+// Find retrieves ContentRatings matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *ContentRatingClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]ContentRating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var contentRatings []ContentRating
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&contentRatings)
+	if err != nil {
+		return nil, err
+	}
+	return contentRatings, nil
+}
+
+// GetByName retrieves the ContentRating entities whose Name is value, with optional
+// pagination.
+func (c *ContentRatingClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]ContentRating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []ContentRating
+	err := queryNodes(ctx, c.conn.QueryRaw, "ContentRating", "eq(name, "+formatString(value)+")", "", contentRatingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves ContentRating entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *ContentRatingClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]ContentRating, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("ContentRating.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []ContentRating
+	err = queryNodes(ctx, c.conn.QueryRaw, "ContentRating", "regexp(name, "+re+")", "", contentRatingSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
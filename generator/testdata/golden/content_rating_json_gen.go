@@ -0,0 +1,25 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a ContentRating from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *ContentRating) UnmarshalJSON(data []byte) error {
+	type plain ContentRating
+	in := struct {
+		*plain
+		Films json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("ContentRating.Films: %w", err)
+	}
+	return nil
+}
@@ -4,19 +4,21 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
 // ContentRatingQuery is a typed query builder for ContentRating entities.
 type ContentRatingQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for ContentRating entities.
@@ -30,6 +32,71 @@ func (q *ContentRatingQuery) Filter(f string) *ContentRatingQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *ContentRatingQuery) where(expr string) *ContentRatingQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from ContentRatingWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *ContentRatingQuery) Where(f Filter[ContentRating]) *ContentRatingQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to ContentRating entities that have a value for Name, using
+// has(name).
+func (q *ContentRatingQuery) HasName() *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.HasName())
+}
+
+// NotName filters to ContentRating entities that have no Name value.
+func (q *ContentRatingQuery) NotName() *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.NotName())
+}
+
+// HasFilms filters to ContentRating entities that have a value for Films, using
+// has(~rated).
+func (q *ContentRatingQuery) HasFilms() *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.HasFilms())
+}
+
+// NotFilms filters to ContentRating entities that have no Films value.
+func (q *ContentRatingQuery) NotFilms() *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.NotFilms())
+}
+
+// FilmsContains filters to ContentRating entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~rated, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *ContentRatingQuery) FilmsContains(uids ...string) *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to ContentRating entities whose Name contains all of the terms.
+func (q *ContentRatingQuery) NameAllOfTerms(terms string) *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to ContentRating entities whose Name contains any of the terms.
+func (q *ContentRatingQuery) NameAnyOfTerms(terms string) *ContentRatingQuery {
+	return q.Where(ContentRatingWhere.NameAnyOfTerms(terms))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *ContentRatingQuery) OrderAsc(field string) *ContentRatingQuery {
 	q.orderBy = field
@@ -56,8 +123,81 @@ func (q *ContentRatingQuery) Offset(n int) *ContentRatingQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *ContentRatingQuery) withEdge(selection string) *ContentRatingQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *ContentRatingQuery) WithFilms() *ContentRatingQuery {
+	return q.withEdge("films: ~rated { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a ContentRating and the edges added by the With methods.
+func (q *ContentRatingQuery) selection() string {
+	s := contentRatingSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *ContentRatingQuery) dql() string {
+	return nodesQuery("ContentRating", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *ContentRatingQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the ContentRating with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such ContentRating.
+func (q *ContentRatingQuery) GetByUID(uid string) (*ContentRating, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result ContentRating
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "ContentRating", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *ContentRatingQuery) Exec(dst *[]ContentRating) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, ContentRating{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +215,21 @@ func (q *ContentRatingQuery) Exec(dst *[]ContentRating) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *ContentRatingQuery) ExecAndCount(dst *[]ContentRating) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("ContentRatingQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "ContentRating", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, ContentRating{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +247,61 @@ func (q *ContentRatingQuery) ExecAndCount(dst *[]ContentRating) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// ContentRatingWhere builds the conditions on ContentRating fields that ContentRatingQuery.Where takes.
+var ContentRatingWhere ContentRatingConditions
+
+// ContentRatingConditions has a method for each typed filter of ContentRatingQuery, returning it as a
+// Filter[ContentRating] to combine with And, Or, and Not.
+type ContentRatingConditions struct{}
+
+// HasName matches ContentRating entities that have a value for Name, using
+// has(name).
+func (ContentRatingConditions) HasName() Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "has(name)"}
+}
+
+// NotName matches ContentRating entities that have no Name value.
+func (ContentRatingConditions) NotName() Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches ContentRating entities that have a value for Films, using
+// has(~rated).
+func (ContentRatingConditions) HasFilms() Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "has(~rated)"}
+}
+
+// NotFilms matches ContentRating entities that have no Films value.
+func (ContentRatingConditions) NotFilms() Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "NOT has(~rated)"}
+}
+
+// FilmsContains matches ContentRating entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~rated, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (ContentRatingConditions) FilmsContains(uids ...string) Filter[ContentRating] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[ContentRating]{err: fmt.Errorf("ContentRating.Films: %w", err)}
+	}
+	return Filter[ContentRating]{expr: "uid_in(~rated, " + list + ")"}
+}
+
+// NameAllOfTerms matches ContentRating entities whose Name contains all of the terms.
+func (ContentRatingConditions) NameAllOfTerms(terms string) Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches ContentRating entities whose Name contains any of the terms.
+func (ContentRatingConditions) NameAnyOfTerms(terms string) Filter[ContentRating] {
+	return Filter[ContentRating]{expr: "anyofterms(name, " + formatString(terms) + ")"}
 }
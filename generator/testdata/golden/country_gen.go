@@ -4,42 +4,217 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// CountryAPI is the set of Country operations provided by CountryClient. Code
+// that depends on CountryAPI rather than *CountryClient can run against a test double.
+type CountryAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Country, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Country) error
+	Create(ctx context.Context, v *Country) (string, error)
+	Update(ctx context.Context, v *Country) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Country, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Country, error)
+}
+
 // CountryClient provides typed CRUD operations for Country entities.
 type CountryClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ CountryAPI = (*CountryClient)(nil)
+
 // Get retrieves a single Country by its UID.
-func (c *CountryClient) Get(ctx context.Context, uid string) (*Country, error) {
+func (c *CountryClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Country, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Country
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Country", countrySelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Country with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *CountryClient) GetExpanded(ctx context.Context, uid string) (*Country, error) {
+	var result Country
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Country", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Country type. A node of another type does not count.
+func (c *CountryClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Country")
+}
+
+// Load populates v with the Country stored under uid, using c.Country.Get.
+func (v *Country) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Country.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Country) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Country.LoadFilms: UID is empty")
+	}
+	var got Country
+	selection := "uid films: ~country { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Country", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Country)(nil)
+
+// GetUID returns the Country's UID, empty until it has been added.
+func (v *Country) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Country's UID.
+func (v *Country) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Country's dgraph.type values: its DType, or
+// {"Country"} until Add sets it.
+func (v *Country) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Country"}
+}
+
+// String returns a one-line summary of the Country: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Country) String() string {
+	return fmt.Sprintf("Country(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new Country into the database.
 func (c *CountryClient) Add(ctx context.Context, v *Country) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Country node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *CountryClient) Create(ctx context.Context, v *Country) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Country.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Country"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Country.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Country in the database. The UID field must be set.
 func (c *CountryClient) Update(ctx context.Context, v *Country) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Country with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *CountryClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Country.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Country with the given UID to value, touching
+// no other predicate.
+func (c *CountryClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Country.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Country with the given UID from the database.
 func (c *CountryClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the Country with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of country, it adds the country edge from each
+// Film to the Country.
+func (c *CountryClient) AddFilms(ctx context.Context, countryUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "country", filmUIDs, []string{countryUID}, false); err != nil {
+		return fmt.Errorf("Country.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Country with the given UID from the Film nodes
+// with the given UIDs, deleting the country edge from each Film.
+func (c *CountryClient) RemoveFilms(ctx context.Context, countryUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "country", filmUIDs, []string{countryUID}, true); err != nil {
+		return fmt.Errorf("Country.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
 // Search finds Country entities whose Name matches term using fulltext search.
 func (c *CountryClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Country, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Country
 	q := c.conn.Query(ctx, Country{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +229,115 @@ func (c *CountryClient) Search(ctx context.Context, term string, opts ...PageOpt
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Country entities with optional pagination.
-func (c *CountryClient) List(ctx context.Context, opts ...PageOption) ([]Country, error) {
+// SearchAllOfText finds Country entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *CountryClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Country, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Country entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *CountryClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Country, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Country entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *CountryClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Country, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *CountryClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Country, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Country
-	q := c.conn.Query(ctx, Country{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Country", countrySelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// countrySelection returns the DQL selection for a Country: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func countrySelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: ~country { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Countries with optional pagination.
+func (c *CountryClient) List(ctx context.Context, opts ...PageOption) ([]Country, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var countries []Country
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&countries)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return countries, nil
+}
+
+// Find retrieves Countries matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *CountryClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Country, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var countries []Country
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&countries)
+	if err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// GetByName retrieves the Country entities whose Name is value, with optional
+// pagination.
+func (c *CountryClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Country, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Country
+	err := queryNodes(ctx, c.conn.QueryRaw, "Country", "eq(name, "+formatString(value)+")", "", countrySelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Country entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *CountryClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Country, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Country.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Country
+	err = queryNodes(ctx, c.conn.QueryRaw, "Country", "regexp(name, "+re+")", "", countrySelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
@@ -4,19 +4,21 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
 // CountryQuery is a typed query builder for Country entities.
 type CountryQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Country entities.
@@ -30,6 +32,71 @@ func (q *CountryQuery) Filter(f string) *CountryQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *CountryQuery) where(expr string) *CountryQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from CountryWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *CountryQuery) Where(f Filter[Country]) *CountryQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Country entities that have a value for Name, using
+// has(name).
+func (q *CountryQuery) HasName() *CountryQuery {
+	return q.Where(CountryWhere.HasName())
+}
+
+// NotName filters to Country entities that have no Name value.
+func (q *CountryQuery) NotName() *CountryQuery {
+	return q.Where(CountryWhere.NotName())
+}
+
+// HasFilms filters to Country entities that have a value for Films, using
+// has(~country).
+func (q *CountryQuery) HasFilms() *CountryQuery {
+	return q.Where(CountryWhere.HasFilms())
+}
+
+// NotFilms filters to Country entities that have no Films value.
+func (q *CountryQuery) NotFilms() *CountryQuery {
+	return q.Where(CountryWhere.NotFilms())
+}
+
+// FilmsContains filters to Country entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~country, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *CountryQuery) FilmsContains(uids ...string) *CountryQuery {
+	return q.Where(CountryWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to Country entities whose Name contains all of the terms.
+func (q *CountryQuery) NameAllOfTerms(terms string) *CountryQuery {
+	return q.Where(CountryWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Country entities whose Name contains any of the terms.
+func (q *CountryQuery) NameAnyOfTerms(terms string) *CountryQuery {
+	return q.Where(CountryWhere.NameAnyOfTerms(terms))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *CountryQuery) OrderAsc(field string) *CountryQuery {
 	q.orderBy = field
@@ -56,8 +123,81 @@ func (q *CountryQuery) Offset(n int) *CountryQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *CountryQuery) withEdge(selection string) *CountryQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *CountryQuery) WithFilms() *CountryQuery {
+	return q.withEdge("films: ~country { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Country and the edges added by the With methods.
+func (q *CountryQuery) selection() string {
+	s := countrySelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *CountryQuery) dql() string {
+	return nodesQuery("Country", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *CountryQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Country with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Country.
+func (q *CountryQuery) GetByUID(uid string) (*Country, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Country
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Country", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *CountryQuery) Exec(dst *[]Country) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Country{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +215,21 @@ func (q *CountryQuery) Exec(dst *[]Country) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *CountryQuery) ExecAndCount(dst *[]Country) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("CountryQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Country", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Country{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +247,61 @@ func (q *CountryQuery) ExecAndCount(dst *[]Country) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// CountryWhere builds the conditions on Country fields that CountryQuery.Where takes.
+var CountryWhere CountryConditions
+
+// CountryConditions has a method for each typed filter of CountryQuery, returning it as a
+// Filter[Country] to combine with And, Or, and Not.
+type CountryConditions struct{}
+
+// HasName matches Country entities that have a value for Name, using
+// has(name).
+func (CountryConditions) HasName() Filter[Country] {
+	return Filter[Country]{expr: "has(name)"}
+}
+
+// NotName matches Country entities that have no Name value.
+func (CountryConditions) NotName() Filter[Country] {
+	return Filter[Country]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Country entities that have a value for Films, using
+// has(~country).
+func (CountryConditions) HasFilms() Filter[Country] {
+	return Filter[Country]{expr: "has(~country)"}
+}
+
+// NotFilms matches Country entities that have no Films value.
+func (CountryConditions) NotFilms() Filter[Country] {
+	return Filter[Country]{expr: "NOT has(~country)"}
+}
+
+// FilmsContains matches Country entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~country, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (CountryConditions) FilmsContains(uids ...string) Filter[Country] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Country]{err: fmt.Errorf("Country.Films: %w", err)}
+	}
+	return Filter[Country]{expr: "uid_in(~country, " + list + ")"}
+}
+
+// NameAllOfTerms matches Country entities whose Name contains all of the terms.
+func (CountryConditions) NameAllOfTerms(terms string) Filter[Country] {
+	return Filter[Country]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Country entities whose Name contains any of the terms.
+func (CountryConditions) NameAnyOfTerms(terms string) Filter[Country] {
+	return Filter[Country]{expr: "anyofterms(name, " + formatString(terms) + ")"}
 }
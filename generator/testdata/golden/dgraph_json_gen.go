@@ -0,0 +1,114 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// datetimeLayouts are the formats in which Dgraph returns datetime values,
+// from the most to the least precise.
+var datetimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// decodeDatetime parses raw, a JSON string in one of layouts or of the
+// datetimeLayouts, into dst. A missing value leaves dst unchanged; null or ""
+// sets the zero time.
+func decodeDatetime(raw json.RawMessage, dst *time.Time, layouts ...string) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s *string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*dst = time.Time{}
+		return nil
+	}
+	for _, list := range [][]string{layouts, datetimeLayouts} {
+		for _, layout := range list {
+			if t, err := time.Parse(layout, *s); err == nil {
+				*dst = t
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unrecognized datetime %q", *s)
+}
+
+// decodeDatetimePtr is decodeDatetime for a *time.Time, which null sets to nil.
+func decodeDatetimePtr(raw json.RawMessage, dst **time.Time, layouts ...string) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if string(raw) == "null" {
+		*dst = nil
+		return nil
+	}
+	t := new(time.Time)
+	if err := decodeDatetime(raw, t, layouts...); err != nil {
+		return err
+	}
+	*dst = t
+	return nil
+}
+
+// geoPoint is a GeoJSON point, the form in which Dgraph stores geo values.
+type geoPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// encodeGeoPoint wraps [longitude, latitude] coordinates in a GeoJSON point,
+// or returns nil for no coordinates.
+func encodeGeoPoint(coords []float64) *geoPoint {
+	if len(coords) == 0 {
+		return nil
+	}
+	return &geoPoint{Type: "Point", Coordinates: coords}
+}
+
+// decodeGeoPoint decodes raw, a GeoJSON point or a bare coordinate array, into
+// dst. A missing value leaves dst unchanged; null sets it to nil.
+func decodeGeoPoint(raw json.RawMessage, dst *[]float64) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] != '{' {
+		return json.Unmarshal(raw, dst)
+	}
+	var p geoPoint
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return err
+	}
+	if p.Type != "Point" {
+		return fmt.Errorf("geo value is a %s, not a Point", p.Type)
+	}
+	*dst = p.Coordinates
+	return nil
+}
+
+// decodeEdges decodes raw, the value of an edge predicate, into dst, a pointer
+// to a slice of entities. Dgraph returns a single object rather than a list
+// for a predicate of type uid; it is decoded as a one-element slice.
+func decodeEdges(raw json.RawMessage, dst any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, dst)
+}
@@ -4,42 +4,224 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// DirectorAPI is the set of Director operations provided by DirectorClient. Code
+// that depends on DirectorAPI rather than *DirectorClient can run against a test double.
+type DirectorAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Director, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Director) error
+	Create(ctx context.Context, v *Director) (string, error)
+	Update(ctx context.Context, v *Director) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Director, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Director, error)
+}
+
 // DirectorClient provides typed CRUD operations for Director entities.
 type DirectorClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ DirectorAPI = (*DirectorClient)(nil)
+
 // Get retrieves a single Director by its UID.
-func (c *DirectorClient) Get(ctx context.Context, uid string) (*Director, error) {
+func (c *DirectorClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Director, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Director
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Director", directorSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Director with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *DirectorClient) GetExpanded(ctx context.Context, uid string) (*Director, error) {
+	var result Director
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Director", map[string]string{
+		"director.film": "films",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Director type. A node of another type does not count.
+func (c *DirectorClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Director")
+}
+
+// Load populates v with the Director stored under uid, using c.Director.Get.
+func (v *Director) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Director.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Director) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Director.LoadFilms: UID is empty")
+	}
+	var got Director
+	selection := "uid films: director.film { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Director", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Director)(nil)
+
+// GetUID returns the Director's UID, empty until it has been added.
+func (v *Director) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Director's UID.
+func (v *Director) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Director's dgraph.type values: its DType, or
+// {"Director"} until Add sets it.
+func (v *Director) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Director"}
+}
+
+// String returns a one-line summary of the Director: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Director) String() string {
+	return fmt.Sprintf("Director(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new Director into the database.
 func (c *DirectorClient) Add(ctx context.Context, v *Director) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Director node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *DirectorClient) Create(ctx context.Context, v *Director) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Director.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Director"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Director.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Director in the database. The UID field must be set.
 func (c *DirectorClient) Update(ctx context.Context, v *Director) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Director with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *DirectorClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Director.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Director with the given UID to value, touching
+// no other predicate.
+func (c *DirectorClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Director.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Director with the given UID from the database.
 func (c *DirectorClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the Director with the given UID to the Film nodes with the
+// given UIDs through director.film, keeping the Films it has.
+func (c *DirectorClient) AddFilms(ctx context.Context, directorUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "director.film", []string{directorUID}, filmUIDs, false); err != nil {
+		return fmt.Errorf("Director.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Director with the given UID from the Film nodes
+// with the given UIDs, deleting their director.film edges.
+func (c *DirectorClient) RemoveFilms(ctx context.Context, directorUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "director.film", []string{directorUID}, filmUIDs, true); err != nil {
+		return fmt.Errorf("Director.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
+// CountFilms returns the number of Films of the Director with the given UID, using
+// Dgraph's count(director.film).
+func (c *DirectorClient) CountFilms(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Director", "director.film")
+}
+
 // Search finds Director entities whose Name matches term using fulltext search.
 func (c *DirectorClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Director, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Director
 	q := c.conn.Query(ctx, Director{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +236,115 @@ func (c *DirectorClient) Search(ctx context.Context, term string, opts ...PageOp
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Director entities with optional pagination.
-func (c *DirectorClient) List(ctx context.Context, opts ...PageOption) ([]Director, error) {
+// SearchAllOfText finds Director entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *DirectorClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Director, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Director entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *DirectorClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Director, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Director entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *DirectorClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Director, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *DirectorClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Director, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Director
-	q := c.conn.Query(ctx, Director{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Director", directorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// directorSelection returns the DQL selection for a Director: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func directorSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: director.film { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Directors with optional pagination.
+func (c *DirectorClient) List(ctx context.Context, opts ...PageOption) ([]Director, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var directors []Director
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&directors)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return directors, nil
+}
+
+// Find retrieves Directors matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *DirectorClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Director, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var directors []Director
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&directors)
+	if err != nil {
+		return nil, err
+	}
+	return directors, nil
+}
+
+// GetByName retrieves the Director entities whose Name is value, with optional
+// pagination.
+func (c *DirectorClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Director, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Director
+	err := queryNodes(ctx, c.conn.QueryRaw, "Director", "eq(name, "+formatString(value)+")", "", directorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Director entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *DirectorClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Director, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Director.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Director
+	err = queryNodes(ctx, c.conn.QueryRaw, "Director", "regexp(name, "+re+")", "", directorSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
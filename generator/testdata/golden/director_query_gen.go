@@ -4,19 +4,21 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
 // DirectorQuery is a typed query builder for Director entities.
 type DirectorQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Director entities.
@@ -30,6 +32,71 @@ func (q *DirectorQuery) Filter(f string) *DirectorQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *DirectorQuery) where(expr string) *DirectorQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from DirectorWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *DirectorQuery) Where(f Filter[Director]) *DirectorQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Director entities that have a value for Name, using
+// has(name).
+func (q *DirectorQuery) HasName() *DirectorQuery {
+	return q.Where(DirectorWhere.HasName())
+}
+
+// NotName filters to Director entities that have no Name value.
+func (q *DirectorQuery) NotName() *DirectorQuery {
+	return q.Where(DirectorWhere.NotName())
+}
+
+// HasFilms filters to Director entities that have a value for Films, using
+// has(director.film).
+func (q *DirectorQuery) HasFilms() *DirectorQuery {
+	return q.Where(DirectorWhere.HasFilms())
+}
+
+// NotFilms filters to Director entities that have no Films value.
+func (q *DirectorQuery) NotFilms() *DirectorQuery {
+	return q.Where(DirectorWhere.NotFilms())
+}
+
+// FilmsContains filters to Director entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(director.film, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *DirectorQuery) FilmsContains(uids ...string) *DirectorQuery {
+	return q.Where(DirectorWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to Director entities whose Name contains all of the terms.
+func (q *DirectorQuery) NameAllOfTerms(terms string) *DirectorQuery {
+	return q.Where(DirectorWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Director entities whose Name contains any of the terms.
+func (q *DirectorQuery) NameAnyOfTerms(terms string) *DirectorQuery {
+	return q.Where(DirectorWhere.NameAnyOfTerms(terms))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *DirectorQuery) OrderAsc(field string) *DirectorQuery {
 	q.orderBy = field
@@ -56,8 +123,81 @@ func (q *DirectorQuery) Offset(n int) *DirectorQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *DirectorQuery) withEdge(selection string) *DirectorQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *DirectorQuery) WithFilms() *DirectorQuery {
+	return q.withEdge("films: director.film { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Director and the edges added by the With methods.
+func (q *DirectorQuery) selection() string {
+	s := directorSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *DirectorQuery) dql() string {
+	return nodesQuery("Director", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *DirectorQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Director with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Director.
+func (q *DirectorQuery) GetByUID(uid string) (*Director, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Director
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Director", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *DirectorQuery) Exec(dst *[]Director) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Director{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +215,21 @@ func (q *DirectorQuery) Exec(dst *[]Director) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *DirectorQuery) ExecAndCount(dst *[]Director) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("DirectorQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Director", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Director{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +247,61 @@ func (q *DirectorQuery) ExecAndCount(dst *[]Director) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// DirectorWhere builds the conditions on Director fields that DirectorQuery.Where takes.
+var DirectorWhere DirectorConditions
+
+// DirectorConditions has a method for each typed filter of DirectorQuery, returning it as a
+// Filter[Director] to combine with And, Or, and Not.
+type DirectorConditions struct{}
+
+// HasName matches Director entities that have a value for Name, using
+// has(name).
+func (DirectorConditions) HasName() Filter[Director] {
+	return Filter[Director]{expr: "has(name)"}
+}
+
+// NotName matches Director entities that have no Name value.
+func (DirectorConditions) NotName() Filter[Director] {
+	return Filter[Director]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Director entities that have a value for Films, using
+// has(director.film).
+func (DirectorConditions) HasFilms() Filter[Director] {
+	return Filter[Director]{expr: "has(director.film)"}
+}
+
+// NotFilms matches Director entities that have no Films value.
+func (DirectorConditions) NotFilms() Filter[Director] {
+	return Filter[Director]{expr: "NOT has(director.film)"}
+}
+
+// FilmsContains matches Director entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(director.film, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (DirectorConditions) FilmsContains(uids ...string) Filter[Director] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Director]{err: fmt.Errorf("Director.Films: %w", err)}
+	}
+	return Filter[Director]{expr: "uid_in(director.film, " + list + ")"}
+}
+
+// NameAllOfTerms matches Director entities whose Name contains all of the terms.
+func (DirectorConditions) NameAllOfTerms(terms string) Filter[Director] {
+	return Filter[Director]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Director entities whose Name contains any of the terms.
+func (DirectorConditions) NameAnyOfTerms(terms string) Filter[Director] {
+	return Filter[Director]{expr: "anyofterms(name, " + formatString(terms) + ")"}
 }
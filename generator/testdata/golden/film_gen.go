@@ -4,42 +4,433 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
+	"time"
 )
 
+// FilmAPI is the set of Film operations provided by FilmClient. Code
+// that depends on FilmAPI rather than *FilmClient can run against a test double.
+type FilmAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Film) error
+	Create(ctx context.Context, v *Film) (string, error)
+	Update(ctx context.Context, v *Film) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Film, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error)
+}
+
 // FilmClient provides typed CRUD operations for Film entities.
 type FilmClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ FilmAPI = (*FilmClient)(nil)
+
 // Get retrieves a single Film by its UID.
-func (c *FilmClient) Get(ctx context.Context, uid string) (*Film, error) {
+func (c *FilmClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Film
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Film", filmSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Film with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *FilmClient) GetExpanded(ctx context.Context, uid string) (*Film, error) {
+	var result Film
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Film", map[string]string{
+		"initial_release_date": "initialReleaseDate",
+		"genre":                "genres",
+		"country":              "countries",
+		"rating":               "ratings",
+		"rated":                "contentRatings",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type. A node of another type does not count.
+func (c *FilmClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Film")
+}
+
+// Load populates v with the Film stored under uid, using c.Film.Get.
+func (v *Film) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Film.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadGenres returns v.Genres, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Genres, with the scalar predicates of each Genre, is kept in
+// v.Genres, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Genres to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Genres may be loaded and empty, and
+// LoadGenres queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadGenres(ctx context.Context, c *Client) ([]Genre, error) {
+	if v.Genres != nil {
+		return v.Genres, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadGenres: UID is empty")
+	}
+	var got Film
+	selection := "uid genres: genre { " + genreSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Genres = got.Genres
+	if v.Genres == nil {
+		v.Genres = []Genre{}
+	}
+	return v.Genres, nil
+}
+
+// LoadCountries returns v.Countries, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Countries, with the scalar predicates of each Country, is kept in
+// v.Countries, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Countries to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Countries may be loaded and empty, and
+// LoadCountries queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadCountries(ctx context.Context, c *Client) ([]Country, error) {
+	if v.Countries != nil {
+		return v.Countries, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadCountries: UID is empty")
+	}
+	var got Film
+	selection := "uid countries: country { " + countrySelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Countries = got.Countries
+	if v.Countries == nil {
+		v.Countries = []Country{}
+	}
+	return v.Countries, nil
+}
+
+// LoadRatings returns v.Ratings, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Ratings, with the scalar predicates of each Rating, is kept in
+// v.Ratings, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Ratings to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Ratings may be loaded and empty, and
+// LoadRatings queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadRatings(ctx context.Context, c *Client) ([]Rating, error) {
+	if v.Ratings != nil {
+		return v.Ratings, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadRatings: UID is empty")
+	}
+	var got Film
+	selection := "uid ratings: rating { " + ratingSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Ratings = got.Ratings
+	if v.Ratings == nil {
+		v.Ratings = []Rating{}
+	}
+	return v.Ratings, nil
+}
+
+// LoadContentRatings returns v.ContentRatings, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched ContentRatings, with the scalar predicates of each ContentRating, is kept in
+// v.ContentRatings, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.ContentRatings to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.ContentRatings may be loaded and empty, and
+// LoadContentRatings queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadContentRatings(ctx context.Context, c *Client) ([]ContentRating, error) {
+	if v.ContentRatings != nil {
+		return v.ContentRatings, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadContentRatings: UID is empty")
+	}
+	var got Film
+	selection := "uid contentRatings: rated { " + contentRatingSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.ContentRatings = got.ContentRatings
+	if v.ContentRatings == nil {
+		v.ContentRatings = []ContentRating{}
+	}
+	return v.ContentRatings, nil
+}
+
+// LoadStarring returns v.Starring, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Starring, with the scalar predicates of each Performance, is kept in
+// v.Starring, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Starring to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Starring may be loaded and empty, and
+// LoadStarring queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadStarring(ctx context.Context, c *Client) ([]Performance, error) {
+	if v.Starring != nil {
+		return v.Starring, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadStarring: UID is empty")
+	}
+	var got Film
+	selection := "uid starring { " + performanceSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Starring = got.Starring
+	if v.Starring == nil {
+		v.Starring = []Performance{}
+	}
+	return v.Starring, nil
+}
+
+var _ Entity = (*Film)(nil)
+
+// GetUID returns the Film's UID, empty until it has been added.
+func (v *Film) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Film's UID.
+func (v *Film) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Film's dgraph.type values: its DType, or
+// {"Film"} until Add sets it.
+func (v *Film) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Film"}
+}
+
+// String returns a one-line summary of the Film: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Film) String() string {
+	return fmt.Sprintf("Film(%s %q genres=%d countries=%d ratings=%d contentRatings=%d starring=%d)", v.UID, v.Name, len(v.Genres), len(v.Countries), len(v.Ratings), len(v.ContentRatings), len(v.Starring))
+}
+
 // Add inserts a new Film into the database.
 func (c *FilmClient) Add(ctx context.Context, v *Film) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Film node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *FilmClient) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Film"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Film in the database. The UID field must be set.
 func (c *FilmClient) Update(ctx context.Context, v *Film) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Film with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *FilmClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Film.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Film.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetInitialReleaseDate sets the InitialReleaseDate of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetInitialReleaseDate(ctx context.Context, uid string, value time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"initial_release_date": value}); err != nil {
+		return fmt.Errorf("Film.SetInitialReleaseDate: %w", err)
+	}
+	return nil
+}
+
+// SetTagline sets the Tagline of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetTagline(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"tagline": value}); err != nil {
+		return fmt.Errorf("Film.SetTagline: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Film with the given UID from the database.
 func (c *FilmClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddGenres links the Film with the given UID to the Genre nodes with the
+// given UIDs through genre, keeping the Genres it has.
+func (c *FilmClient) AddGenres(ctx context.Context, filmUID string, genreUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", []string{filmUID}, genreUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddGenres: %w", err)
+	}
+	return nil
+}
+
+// RemoveGenres unlinks the Film with the given UID from the Genre nodes
+// with the given UIDs, deleting their genre edges.
+func (c *FilmClient) RemoveGenres(ctx context.Context, filmUID string, genreUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", []string{filmUID}, genreUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveGenres: %w", err)
+	}
+	return nil
+}
+
+// AddCountries links the Film with the given UID to the Country nodes with the
+// given UIDs through country, keeping the Countries it has.
+func (c *FilmClient) AddCountries(ctx context.Context, filmUID string, countryUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "country", []string{filmUID}, countryUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddCountries: %w", err)
+	}
+	return nil
+}
+
+// RemoveCountries unlinks the Film with the given UID from the Country nodes
+// with the given UIDs, deleting their country edges.
+func (c *FilmClient) RemoveCountries(ctx context.Context, filmUID string, countryUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "country", []string{filmUID}, countryUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveCountries: %w", err)
+	}
+	return nil
+}
+
+// AddRatings links the Film with the given UID to the Rating nodes with the
+// given UIDs through rating, keeping the Ratings it has.
+func (c *FilmClient) AddRatings(ctx context.Context, filmUID string, ratingUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rating", []string{filmUID}, ratingUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddRatings: %w", err)
+	}
+	return nil
+}
+
+// RemoveRatings unlinks the Film with the given UID from the Rating nodes
+// with the given UIDs, deleting their rating edges.
+func (c *FilmClient) RemoveRatings(ctx context.Context, filmUID string, ratingUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rating", []string{filmUID}, ratingUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveRatings: %w", err)
+	}
+	return nil
+}
+
+// AddContentRatings links the Film with the given UID to the ContentRating nodes with the
+// given UIDs through rated, keeping the ContentRatings it has.
+func (c *FilmClient) AddContentRatings(ctx context.Context, filmUID string, contentRatingUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rated", []string{filmUID}, contentRatingUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddContentRatings: %w", err)
+	}
+	return nil
+}
+
+// RemoveContentRatings unlinks the Film with the given UID from the ContentRating nodes
+// with the given UIDs, deleting their rated edges.
+func (c *FilmClient) RemoveContentRatings(ctx context.Context, filmUID string, contentRatingUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rated", []string{filmUID}, contentRatingUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveContentRatings: %w", err)
+	}
+	return nil
+}
+
+// AddStarring links the Film with the given UID to the Performance nodes with the
+// given UIDs through starring, keeping the Starring it has.
+func (c *FilmClient) AddStarring(ctx context.Context, filmUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "starring", []string{filmUID}, performanceUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddStarring: %w", err)
+	}
+	return nil
+}
+
+// RemoveStarring unlinks the Film with the given UID from the Performance nodes
+// with the given UIDs, deleting their starring edges.
+func (c *FilmClient) RemoveStarring(ctx context.Context, filmUID string, performanceUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "starring", []string{filmUID}, performanceUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveStarring: %w", err)
+	}
+	return nil
+}
+
+// CountGenres returns the number of Genres of the Film with the given UID, using
+// Dgraph's count(genre).
+func (c *FilmClient) CountGenres(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Film", "genre")
+}
+
+// CountStarring returns the number of Starring of the Film with the given UID, using
+// Dgraph's count(starring).
+func (c *FilmClient) CountStarring(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Film", "starring")
+}
+
 // Search finds Film entities whose Name matches term using fulltext search.
 func (c *FilmClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Film, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Film
 	q := c.conn.Query(ctx, Film{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +445,119 @@ func (c *FilmClient) Search(ctx context.Context, term string, opts ...PageOption
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Film entities with optional pagination.
-func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+// SearchAllOfText finds Film entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *FilmClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Film entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *FilmClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Film entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *FilmClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *FilmClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Film
-	q := c.conn.Query(ctx, Film{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Film", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// filmSelection returns the DQL selection for a Film: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func filmSelection(depth int) string {
+	s := "uid dgraph.type name initialReleaseDate: initial_release_date tagline"
+	if depth > 0 {
+		s += " genres: genre { " + genreSelection(depth-1) + " }"
+		s += " countries: country { " + countrySelection(depth-1) + " }"
+		s += " ratings: rating { " + ratingSelection(depth-1) + " }"
+		s += " contentRatings: rated { " + contentRatingSelection(depth-1) + " }"
+		s += " starring { " + performanceSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Films with optional pagination.
+func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var films []Film
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// Find retrieves Films matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *FilmClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var films []Film
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// GetByName retrieves the Film entities whose Name is value, with optional
+// pagination.
+func (c *FilmClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := queryNodes(ctx, c.conn.QueryRaw, "Film", "eq(name, "+formatString(value)+")", "", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Film entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *FilmClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Film, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Film.Name: %w", err)
 	}
-	err := q.Nodes(&results)
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err = queryNodes(ctx, c.conn.QueryRaw, "Film", "regexp(name, "+re+")", "", filmSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
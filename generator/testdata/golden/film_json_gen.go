@@ -0,0 +1,62 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		InitialReleaseDate *time.Time `json:"initialReleaseDate,omitempty"`
+	}{plain: plain(v)}
+	if !v.InitialReleaseDate.IsZero() {
+		out.InitialReleaseDate = &v.InitialReleaseDate
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		InitialReleaseDate json.RawMessage `json:"initialReleaseDate"`
+		Genres             json.RawMessage `json:"genres"`
+		Countries          json.RawMessage `json:"countries"`
+		Ratings            json.RawMessage `json:"ratings"`
+		ContentRatings     json.RawMessage `json:"contentRatings"`
+		Starring           json.RawMessage `json:"starring"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.InitialReleaseDate, &v.InitialReleaseDate); err != nil {
+		return fmt.Errorf("Film.InitialReleaseDate: %w", err)
+	}
+	if err := decodeEdges(in.Genres, &v.Genres); err != nil {
+		return fmt.Errorf("Film.Genres: %w", err)
+	}
+	if err := decodeEdges(in.Countries, &v.Countries); err != nil {
+		return fmt.Errorf("Film.Countries: %w", err)
+	}
+	if err := decodeEdges(in.Ratings, &v.Ratings); err != nil {
+		return fmt.Errorf("Film.Ratings: %w", err)
+	}
+	if err := decodeEdges(in.ContentRatings, &v.ContentRatings); err != nil {
+		return fmt.Errorf("Film.ContentRatings: %w", err)
+	}
+	if err := decodeEdges(in.Starring, &v.Starring); err != nil {
+		return fmt.Errorf("Film.Starring: %w", err)
+	}
+	return nil
+}
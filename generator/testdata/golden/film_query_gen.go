@@ -4,19 +4,22 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
+	"time"
 )
 
 // FilmQuery is a typed query builder for Film entities.
 type FilmQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Film entities.
@@ -30,6 +33,183 @@ func (q *FilmQuery) Filter(f string) *FilmQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(name).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasInitialReleaseDate filters to Film entities that have a value for InitialReleaseDate, using
+// has(initial_release_date).
+func (q *FilmQuery) HasInitialReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.HasInitialReleaseDate())
+}
+
+// NotInitialReleaseDate filters to Film entities that have no InitialReleaseDate value.
+func (q *FilmQuery) NotInitialReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.NotInitialReleaseDate())
+}
+
+// HasTagline filters to Film entities that have a value for Tagline, using
+// has(tagline).
+func (q *FilmQuery) HasTagline() *FilmQuery {
+	return q.Where(FilmWhere.HasTagline())
+}
+
+// NotTagline filters to Film entities that have no Tagline value.
+func (q *FilmQuery) NotTagline() *FilmQuery {
+	return q.Where(FilmWhere.NotTagline())
+}
+
+// HasGenres filters to Film entities that have a value for Genres, using
+// has(genre).
+func (q *FilmQuery) HasGenres() *FilmQuery {
+	return q.Where(FilmWhere.HasGenres())
+}
+
+// NotGenres filters to Film entities that have no Genres value.
+func (q *FilmQuery) NotGenres() *FilmQuery {
+	return q.Where(FilmWhere.NotGenres())
+}
+
+// HasCountries filters to Film entities that have a value for Countries, using
+// has(country).
+func (q *FilmQuery) HasCountries() *FilmQuery {
+	return q.Where(FilmWhere.HasCountries())
+}
+
+// NotCountries filters to Film entities that have no Countries value.
+func (q *FilmQuery) NotCountries() *FilmQuery {
+	return q.Where(FilmWhere.NotCountries())
+}
+
+// HasRatings filters to Film entities that have a value for Ratings, using
+// has(rating).
+func (q *FilmQuery) HasRatings() *FilmQuery {
+	return q.Where(FilmWhere.HasRatings())
+}
+
+// NotRatings filters to Film entities that have no Ratings value.
+func (q *FilmQuery) NotRatings() *FilmQuery {
+	return q.Where(FilmWhere.NotRatings())
+}
+
+// HasContentRatings filters to Film entities that have a value for ContentRatings, using
+// has(rated).
+func (q *FilmQuery) HasContentRatings() *FilmQuery {
+	return q.Where(FilmWhere.HasContentRatings())
+}
+
+// NotContentRatings filters to Film entities that have no ContentRatings value.
+func (q *FilmQuery) NotContentRatings() *FilmQuery {
+	return q.Where(FilmWhere.NotContentRatings())
+}
+
+// HasStarring filters to Film entities that have a value for Starring, using
+// has(starring).
+func (q *FilmQuery) HasStarring() *FilmQuery {
+	return q.Where(FilmWhere.HasStarring())
+}
+
+// NotStarring filters to Film entities that have no Starring value.
+func (q *FilmQuery) NotStarring() *FilmQuery {
+	return q.Where(FilmWhere.NotStarring())
+}
+
+// GenresContains filters to Film entities whose Genres include any of the
+// Genre nodes with the given uids, using uid_in(genre, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) GenresContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.GenresContains(uids...))
+}
+
+// CountriesContains filters to Film entities whose Countries include any of the
+// Country nodes with the given uids, using uid_in(country, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) CountriesContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.CountriesContains(uids...))
+}
+
+// RatingsContains filters to Film entities whose Ratings include any of the
+// Rating nodes with the given uids, using uid_in(rating, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) RatingsContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.RatingsContains(uids...))
+}
+
+// ContentRatingsContains filters to Film entities whose ContentRatings include any of the
+// ContentRating nodes with the given uids, using uid_in(rated, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) ContentRatingsContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.ContentRatingsContains(uids...))
+}
+
+// StarringContains filters to Film entities whose Starring include any of the
+// Performance nodes with the given uids, using uid_in(starring, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) StarringContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.StarringContains(uids...))
+}
+
+// NameAllOfTerms filters to Film entities whose Name contains all of the terms.
+func (q *FilmQuery) NameAllOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Film entities whose Name contains any of the terms.
+func (q *FilmQuery) NameAnyOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.NameAnyOfTerms(terms))
+}
+
+// InitialReleaseDateYearEquals filters to Film entities whose InitialReleaseDate falls in year.
+func (q *FilmQuery) InitialReleaseDateYearEquals(year int) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateYearEquals(year))
+}
+
+// InitialReleaseDateYearBetween filters to Film entities whose InitialReleaseDate falls in the
+// years from through to, inclusive.
+func (q *FilmQuery) InitialReleaseDateYearBetween(from, to int) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateYearBetween(from, to))
+}
+
+// InitialReleaseDateDateBetween filters to Film entities whose InitialReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (q *FilmQuery) InitialReleaseDateDateBetween(from, to time.Time) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateDateBetween(from, to))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
 	q.orderBy = field
@@ -56,8 +236,105 @@ func (q *FilmQuery) Offset(n int) *FilmQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithGenres makes GetByUID and Exec also fetch the Genres edge, with the
+// scalar predicates of each Genre it leads to, in the same query.
+func (q *FilmQuery) WithGenres() *FilmQuery {
+	return q.withEdge("genres: genre { " + genreSelection(0) + " }")
+}
+
+// WithCountries makes GetByUID and Exec also fetch the Countries edge, with the
+// scalar predicates of each Country it leads to, in the same query.
+func (q *FilmQuery) WithCountries() *FilmQuery {
+	return q.withEdge("countries: country { " + countrySelection(0) + " }")
+}
+
+// WithRatings makes GetByUID and Exec also fetch the Ratings edge, with the
+// scalar predicates of each Rating it leads to, in the same query.
+func (q *FilmQuery) WithRatings() *FilmQuery {
+	return q.withEdge("ratings: rating { " + ratingSelection(0) + " }")
+}
+
+// WithContentRatings makes GetByUID and Exec also fetch the ContentRatings edge, with the
+// scalar predicates of each ContentRating it leads to, in the same query.
+func (q *FilmQuery) WithContentRatings() *FilmQuery {
+	return q.withEdge("contentRatings: rated { " + contentRatingSelection(0) + " }")
+}
+
+// WithStarring makes GetByUID and Exec also fetch the Starring edge, with the
+// scalar predicates of each Performance it leads to, in the same query.
+func (q *FilmQuery) WithStarring() *FilmQuery {
+	return q.withEdge("starring { " + performanceSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Film{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +352,21 @@ func (q *FilmQuery) Exec(dst *[]Film) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Film{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +384,189 @@ func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasName matches Film entities that have a value for Name, using
+// has(name).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(name)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(name)"}
+}
+
+// HasInitialReleaseDate matches Film entities that have a value for InitialReleaseDate, using
+// has(initial_release_date).
+func (FilmConditions) HasInitialReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "has(initial_release_date)"}
+}
+
+// NotInitialReleaseDate matches Film entities that have no InitialReleaseDate value.
+func (FilmConditions) NotInitialReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(initial_release_date)"}
+}
+
+// HasTagline matches Film entities that have a value for Tagline, using
+// has(tagline).
+func (FilmConditions) HasTagline() Filter[Film] {
+	return Filter[Film]{expr: "has(tagline)"}
+}
+
+// NotTagline matches Film entities that have no Tagline value.
+func (FilmConditions) NotTagline() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(tagline)"}
+}
+
+// HasGenres matches Film entities that have a value for Genres, using
+// has(genre).
+func (FilmConditions) HasGenres() Filter[Film] {
+	return Filter[Film]{expr: "has(genre)"}
+}
+
+// NotGenres matches Film entities that have no Genres value.
+func (FilmConditions) NotGenres() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(genre)"}
+}
+
+// HasCountries matches Film entities that have a value for Countries, using
+// has(country).
+func (FilmConditions) HasCountries() Filter[Film] {
+	return Filter[Film]{expr: "has(country)"}
+}
+
+// NotCountries matches Film entities that have no Countries value.
+func (FilmConditions) NotCountries() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(country)"}
+}
+
+// HasRatings matches Film entities that have a value for Ratings, using
+// has(rating).
+func (FilmConditions) HasRatings() Filter[Film] {
+	return Filter[Film]{expr: "has(rating)"}
+}
+
+// NotRatings matches Film entities that have no Ratings value.
+func (FilmConditions) NotRatings() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(rating)"}
+}
+
+// HasContentRatings matches Film entities that have a value for ContentRatings, using
+// has(rated).
+func (FilmConditions) HasContentRatings() Filter[Film] {
+	return Filter[Film]{expr: "has(rated)"}
+}
+
+// NotContentRatings matches Film entities that have no ContentRatings value.
+func (FilmConditions) NotContentRatings() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(rated)"}
+}
+
+// HasStarring matches Film entities that have a value for Starring, using
+// has(starring).
+func (FilmConditions) HasStarring() Filter[Film] {
+	return Filter[Film]{expr: "has(starring)"}
+}
+
+// NotStarring matches Film entities that have no Starring value.
+func (FilmConditions) NotStarring() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(starring)"}
+}
+
+// GenresContains matches Film entities whose Genres include any of the
+// Genre nodes with the given uids, using uid_in(genre, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) GenresContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Genres: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(genre, " + list + ")"}
+}
+
+// CountriesContains matches Film entities whose Countries include any of the
+// Country nodes with the given uids, using uid_in(country, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) CountriesContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Countries: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(country, " + list + ")"}
+}
+
+// RatingsContains matches Film entities whose Ratings include any of the
+// Rating nodes with the given uids, using uid_in(rating, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) RatingsContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Ratings: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(rating, " + list + ")"}
+}
+
+// ContentRatingsContains matches Film entities whose ContentRatings include any of the
+// ContentRating nodes with the given uids, using uid_in(rated, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) ContentRatingsContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.ContentRatings: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(rated, " + list + ")"}
+}
+
+// StarringContains matches Film entities whose Starring include any of the
+// Performance nodes with the given uids, using uid_in(starring, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) StarringContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Starring: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(starring, " + list + ")"}
+}
+
+// NameAllOfTerms matches Film entities whose Name contains all of the terms.
+func (FilmConditions) NameAllOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Film entities whose Name contains any of the terms.
+func (FilmConditions) NameAnyOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "anyofterms(name, " + formatString(terms) + ")"}
+}
+
+// InitialReleaseDateYearEquals matches Film entities whose InitialReleaseDate falls in year.
+func (c FilmConditions) InitialReleaseDateYearEquals(year int) Filter[Film] {
+	return c.InitialReleaseDateYearBetween(year, year)
+}
+
+// InitialReleaseDateYearBetween matches Film entities whose InitialReleaseDate falls in the
+// years from through to, inclusive.
+func (c FilmConditions) InitialReleaseDateYearBetween(from, to int) Filter[Film] {
+	return c.InitialReleaseDateDateBetween(yearStart(from), yearEnd(to))
+}
+
+// InitialReleaseDateDateBetween matches Film entities whose InitialReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (FilmConditions) InitialReleaseDateDateBetween(from, to time.Time) Filter[Film] {
+	return Filter[Film]{expr: "between(initial_release_date, " + formatTime(from) + ", " + formatTime(to) + ")"}
 }
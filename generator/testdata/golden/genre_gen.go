@@ -4,42 +4,217 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// GenreAPI is the set of Genre operations provided by GenreClient. Code
+// that depends on GenreAPI rather than *GenreClient can run against a test double.
+type GenreAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Genre, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Genre) error
+	Create(ctx context.Context, v *Genre) (string, error)
+	Update(ctx context.Context, v *Genre) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Genre, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Genre, error)
+}
+
 // GenreClient provides typed CRUD operations for Genre entities.
 type GenreClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ GenreAPI = (*GenreClient)(nil)
+
 // Get retrieves a single Genre by its UID.
-func (c *GenreClient) Get(ctx context.Context, uid string) (*Genre, error) {
+func (c *GenreClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Genre, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Genre
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Genre", genreSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Genre with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *GenreClient) GetExpanded(ctx context.Context, uid string) (*Genre, error) {
+	var result Genre
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Genre", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Genre type. A node of another type does not count.
+func (c *GenreClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Genre")
+}
+
+// Load populates v with the Genre stored under uid, using c.Genre.Get.
+func (v *Genre) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Genre.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Genre) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Genre.LoadFilms: UID is empty")
+	}
+	var got Genre
+	selection := "uid films: ~genre { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Genre", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Genre)(nil)
+
+// GetUID returns the Genre's UID, empty until it has been added.
+func (v *Genre) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Genre's UID.
+func (v *Genre) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Genre's dgraph.type values: its DType, or
+// {"Genre"} until Add sets it.
+func (v *Genre) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Genre"}
+}
+
+// String returns a one-line summary of the Genre: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Genre) String() string {
+	return fmt.Sprintf("Genre(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new Genre into the database.
 func (c *GenreClient) Add(ctx context.Context, v *Genre) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Genre node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *GenreClient) Create(ctx context.Context, v *Genre) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Genre.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Genre"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Genre.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Genre in the database. The UID field must be set.
 func (c *GenreClient) Update(ctx context.Context, v *Genre) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Genre with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *GenreClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Genre.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Genre with the given UID to value, touching
+// no other predicate.
+func (c *GenreClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Genre.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Genre with the given UID from the database.
 func (c *GenreClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the Genre with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of genre, it adds the genre edge from each
+// Film to the Genre.
+func (c *GenreClient) AddFilms(ctx context.Context, genreUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", filmUIDs, []string{genreUID}, false); err != nil {
+		return fmt.Errorf("Genre.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Genre with the given UID from the Film nodes
+// with the given UIDs, deleting the genre edge from each Film.
+func (c *GenreClient) RemoveFilms(ctx context.Context, genreUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", filmUIDs, []string{genreUID}, true); err != nil {
+		return fmt.Errorf("Genre.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
 // Search finds Genre entities whose Name matches term using fulltext search.
 func (c *GenreClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Genre, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Genre
 	q := c.conn.Query(ctx, Genre{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +229,115 @@ func (c *GenreClient) Search(ctx context.Context, term string, opts ...PageOptio
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Genre entities with optional pagination.
-func (c *GenreClient) List(ctx context.Context, opts ...PageOption) ([]Genre, error) {
+// SearchAllOfText finds Genre entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *GenreClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Genre, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Genre entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *GenreClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Genre, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Genre entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *GenreClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Genre, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *GenreClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Genre
-	q := c.conn.Query(ctx, Genre{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Genre", genreSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// genreSelection returns the DQL selection for a Genre: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func genreSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: ~genre { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Genres with optional pagination.
+func (c *GenreClient) List(ctx context.Context, opts ...PageOption) ([]Genre, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var genres []Genre
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&genres)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return genres, nil
+}
+
+// Find retrieves Genres matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *GenreClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var genres []Genre
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&genres)
+	if err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+// GetByName retrieves the Genre entities whose Name is value, with optional
+// pagination.
+func (c *GenreClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Genre
+	err := queryNodes(ctx, c.conn.QueryRaw, "Genre", "eq(name, "+formatString(value)+")", "", genreSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Genre entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *GenreClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Genre, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Genre.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Genre
+	err = queryNodes(ctx, c.conn.QueryRaw, "Genre", "regexp(name, "+re+")", "", genreSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+// GetOption configures how a single entity is fetched.
+type GetOption interface {
+	applyGet(cfg *getConfig)
+}
+
+type getConfig struct {
+	depth int // 0 leaves edge expansion to modusgraph
+}
+
+type depthOption int
+
+func (d depthOption) applyGet(cfg *getConfig) {
+	cfg.depth = int(d)
+}
+
+// WithDepth expands edges n levels deep using an explicit selection. Entities
+// with a self-referential edge default to a depth of 1, so the edge's targets
+// carry only their UID and scalar fields.
+func WithDepth(n int) GetOption {
+	return depthOption(n)
+}
+
+// CreateOption configures how the Create method of an entity with upsert
+// fields creates it.
+type CreateOption interface {
+	applyCreate(cfg *createConfig)
+}
+
+type createConfig struct {
+	upsert bool // Match existing nodes on the entity's upsert fields
+}
+
+type upsertOption struct{}
+
+func (upsertOption) applyCreate(cfg *createConfig) {
+	cfg.upsert = true
+}
+
+// WithUpsert makes Create reuse the node that has the same values for the
+// entity's upsert fields, if there is one, instead of adding another. Only
+// the Create methods of entities with upsert fields take it.
+func WithUpsert() CreateOption {
+	return upsertOption{}
+}
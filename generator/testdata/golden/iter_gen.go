@@ -35,7 +35,7 @@ func (c *ActorClient) SearchIter(ctx context.Context, term string) iter.Seq2[Act
 	}
 }
 
-// ListIter returns an iterator over all Actor entities.
+// ListIter returns an iterator over all Actors.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *ActorClient) ListIter(ctx context.Context) iter.Seq2[Actor, error] {
 	return func(yield func(Actor, error) bool) {
@@ -63,6 +63,124 @@ func (c *ActorClient) ListIter(ctx context.Context) iter.Seq2[Actor, error] {
 	}
 }
 
+// ActorIterator streams Actor entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type ActorIterator struct {
+	client   *ActorClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Actor
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Actor entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *ActorClient) Iterator(opts ...PageOption) *ActorIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &ActorIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Actor entities after cursor,
+// a value previously returned by ActorIterator.Cursor.
+func (c *ActorClient) ResumeIterator(cursor string, opts ...PageOption) *ActorIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Actor, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *ActorIterator) Next(ctx context.Context) (*Actor, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Actor
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Actor", actorSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Actor{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *ActorIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Actor returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *ActorIterator) Cursor() string {
+	return it.after
+}
+
+// StreamActors sends all Actor entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *ActorClient) StreamActors(ctx context.Context, opts ...PageOption) (<-chan *Actor, <-chan error) {
+	out := make(chan *Actor)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over ContentRating entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *ContentRatingClient) SearchIter(ctx context.Context, term string) iter.Seq2[ContentRating, error] {
@@ -91,7 +209,7 @@ func (c *ContentRatingClient) SearchIter(ctx context.Context, term string) iter.
 	}
 }
 
-// ListIter returns an iterator over all ContentRating entities.
+// ListIter returns an iterator over all ContentRatings.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *ContentRatingClient) ListIter(ctx context.Context) iter.Seq2[ContentRating, error] {
 	return func(yield func(ContentRating, error) bool) {
@@ -119,6 +237,124 @@ func (c *ContentRatingClient) ListIter(ctx context.Context) iter.Seq2[ContentRat
 	}
 }
 
+// ContentRatingIterator streams ContentRating entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type ContentRatingIterator struct {
+	client   *ContentRatingClient
+	pageSize int
+	offset   int
+	after    string
+	page     []ContentRating
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all ContentRating entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *ContentRatingClient) Iterator(opts ...PageOption) *ContentRatingIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &ContentRatingIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the ContentRating entities after cursor,
+// a value previously returned by ContentRatingIterator.Cursor.
+func (c *ContentRatingClient) ResumeIterator(cursor string, opts ...PageOption) *ContentRatingIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next ContentRating, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *ContentRatingIterator) Next(ctx context.Context) (*ContentRating, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []ContentRating
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "ContentRating", contentRatingSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, ContentRating{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *ContentRatingIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last ContentRating returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *ContentRatingIterator) Cursor() string {
+	return it.after
+}
+
+// StreamContentRatings sends all ContentRating entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *ContentRatingClient) StreamContentRatings(ctx context.Context, opts ...PageOption) (<-chan *ContentRating, <-chan error) {
+	out := make(chan *ContentRating)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Country entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *CountryClient) SearchIter(ctx context.Context, term string) iter.Seq2[Country, error] {
@@ -147,7 +383,7 @@ func (c *CountryClient) SearchIter(ctx context.Context, term string) iter.Seq2[C
 	}
 }
 
-// ListIter returns an iterator over all Country entities.
+// ListIter returns an iterator over all Countries.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *CountryClient) ListIter(ctx context.Context) iter.Seq2[Country, error] {
 	return func(yield func(Country, error) bool) {
@@ -175,6 +411,124 @@ func (c *CountryClient) ListIter(ctx context.Context) iter.Seq2[Country, error]
 	}
 }
 
+// CountryIterator streams Country entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type CountryIterator struct {
+	client   *CountryClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Country
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Country entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *CountryClient) Iterator(opts ...PageOption) *CountryIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &CountryIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Country entities after cursor,
+// a value previously returned by CountryIterator.Cursor.
+func (c *CountryClient) ResumeIterator(cursor string, opts ...PageOption) *CountryIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Country, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *CountryIterator) Next(ctx context.Context) (*Country, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Country
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Country", countrySelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Country{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *CountryIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Country returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *CountryIterator) Cursor() string {
+	return it.after
+}
+
+// StreamCountries sends all Country entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *CountryClient) StreamCountries(ctx context.Context, opts ...PageOption) (<-chan *Country, <-chan error) {
+	out := make(chan *Country)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Director entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *DirectorClient) SearchIter(ctx context.Context, term string) iter.Seq2[Director, error] {
@@ -203,7 +557,7 @@ func (c *DirectorClient) SearchIter(ctx context.Context, term string) iter.Seq2[
 	}
 }
 
-// ListIter returns an iterator over all Director entities.
+// ListIter returns an iterator over all Directors.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *DirectorClient) ListIter(ctx context.Context) iter.Seq2[Director, error] {
 	return func(yield func(Director, error) bool) {
@@ -231,6 +585,124 @@ func (c *DirectorClient) ListIter(ctx context.Context) iter.Seq2[Director, error
 	}
 }
 
+// DirectorIterator streams Director entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type DirectorIterator struct {
+	client   *DirectorClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Director
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Director entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *DirectorClient) Iterator(opts ...PageOption) *DirectorIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &DirectorIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Director entities after cursor,
+// a value previously returned by DirectorIterator.Cursor.
+func (c *DirectorClient) ResumeIterator(cursor string, opts ...PageOption) *DirectorIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Director, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *DirectorIterator) Next(ctx context.Context) (*Director, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Director
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Director", directorSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Director{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *DirectorIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Director returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *DirectorIterator) Cursor() string {
+	return it.after
+}
+
+// StreamDirectors sends all Director entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *DirectorClient) StreamDirectors(ctx context.Context, opts ...PageOption) (<-chan *Director, <-chan error) {
+	out := make(chan *Director)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Film entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *FilmClient) SearchIter(ctx context.Context, term string) iter.Seq2[Film, error] {
@@ -259,7 +731,7 @@ func (c *FilmClient) SearchIter(ctx context.Context, term string) iter.Seq2[Film
 	}
 }
 
-// ListIter returns an iterator over all Film entities.
+// ListIter returns an iterator over all Films.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *FilmClient) ListIter(ctx context.Context) iter.Seq2[Film, error] {
 	return func(yield func(Film, error) bool) {
@@ -287,6 +759,124 @@ func (c *FilmClient) ListIter(ctx context.Context) iter.Seq2[Film, error] {
 	}
 }
 
+// FilmIterator streams Film entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type FilmIterator struct {
+	client   *FilmClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Film
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Film entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *FilmClient) Iterator(opts ...PageOption) *FilmIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &FilmIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Film entities after cursor,
+// a value previously returned by FilmIterator.Cursor.
+func (c *FilmClient) ResumeIterator(cursor string, opts ...PageOption) *FilmIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Film, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *FilmIterator) Next(ctx context.Context) (*Film, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Film
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Film", filmSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Film{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *FilmIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Film returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *FilmIterator) Cursor() string {
+	return it.after
+}
+
+// StreamFilms sends all Film entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *FilmClient) StreamFilms(ctx context.Context, opts ...PageOption) (<-chan *Film, <-chan error) {
+	out := make(chan *Film)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Genre entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *GenreClient) SearchIter(ctx context.Context, term string) iter.Seq2[Genre, error] {
@@ -315,7 +905,7 @@ func (c *GenreClient) SearchIter(ctx context.Context, term string) iter.Seq2[Gen
 	}
 }
 
-// ListIter returns an iterator over all Genre entities.
+// ListIter returns an iterator over all Genres.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *GenreClient) ListIter(ctx context.Context) iter.Seq2[Genre, error] {
 	return func(yield func(Genre, error) bool) {
@@ -343,6 +933,124 @@ func (c *GenreClient) ListIter(ctx context.Context) iter.Seq2[Genre, error] {
 	}
 }
 
+// GenreIterator streams Genre entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type GenreIterator struct {
+	client   *GenreClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Genre
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Genre entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *GenreClient) Iterator(opts ...PageOption) *GenreIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &GenreIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Genre entities after cursor,
+// a value previously returned by GenreIterator.Cursor.
+func (c *GenreClient) ResumeIterator(cursor string, opts ...PageOption) *GenreIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Genre, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *GenreIterator) Next(ctx context.Context) (*Genre, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Genre
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Genre", genreSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Genre{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *GenreIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Genre returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *GenreIterator) Cursor() string {
+	return it.after
+}
+
+// StreamGenres sends all Genre entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *GenreClient) StreamGenres(ctx context.Context, opts ...PageOption) (<-chan *Genre, <-chan error) {
+	out := make(chan *Genre)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Location entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *LocationClient) SearchIter(ctx context.Context, term string) iter.Seq2[Location, error] {
@@ -371,7 +1079,7 @@ func (c *LocationClient) SearchIter(ctx context.Context, term string) iter.Seq2[
 	}
 }
 
-// ListIter returns an iterator over all Location entities.
+// ListIter returns an iterator over all Locations.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *LocationClient) ListIter(ctx context.Context) iter.Seq2[Location, error] {
 	return func(yield func(Location, error) bool) {
@@ -399,7 +1107,125 @@ func (c *LocationClient) ListIter(ctx context.Context) iter.Seq2[Location, error
 	}
 }
 
-// ListIter returns an iterator over all Performance entities.
+// LocationIterator streams Location entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type LocationIterator struct {
+	client   *LocationClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Location
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Location entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *LocationClient) Iterator(opts ...PageOption) *LocationIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &LocationIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Location entities after cursor,
+// a value previously returned by LocationIterator.Cursor.
+func (c *LocationClient) ResumeIterator(cursor string, opts ...PageOption) *LocationIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Location, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *LocationIterator) Next(ctx context.Context) (*Location, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Location
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Location", locationSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Location{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *LocationIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Location returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *LocationIterator) Cursor() string {
+	return it.after
+}
+
+// StreamLocations sends all Location entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *LocationClient) StreamLocations(ctx context.Context, opts ...PageOption) (<-chan *Location, <-chan error) {
+	out := make(chan *Location)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
+// ListIter returns an iterator over all Performances.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *PerformanceClient) ListIter(ctx context.Context) iter.Seq2[Performance, error] {
 	return func(yield func(Performance, error) bool) {
@@ -427,6 +1253,124 @@ func (c *PerformanceClient) ListIter(ctx context.Context) iter.Seq2[Performance,
 	}
 }
 
+// PerformanceIterator streams Performance entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type PerformanceIterator struct {
+	client   *PerformanceClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Performance
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Performance entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *PerformanceClient) Iterator(opts ...PageOption) *PerformanceIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &PerformanceIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Performance entities after cursor,
+// a value previously returned by PerformanceIterator.Cursor.
+func (c *PerformanceClient) ResumeIterator(cursor string, opts ...PageOption) *PerformanceIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Performance, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *PerformanceIterator) Next(ctx context.Context) (*Performance, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Performance
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Performance", performanceSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Performance{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *PerformanceIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Performance returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *PerformanceIterator) Cursor() string {
+	return it.after
+}
+
+// StreamPerformances sends all Performance entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *PerformanceClient) StreamPerformances(ctx context.Context, opts ...PageOption) (<-chan *Performance, <-chan error) {
+	out := make(chan *Performance)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
 // SearchIter returns an iterator over Rating entities matching term.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *RatingClient) SearchIter(ctx context.Context, term string) iter.Seq2[Rating, error] {
@@ -455,7 +1399,7 @@ func (c *RatingClient) SearchIter(ctx context.Context, term string) iter.Seq2[Ra
 	}
 }
 
-// ListIter returns an iterator over all Rating entities.
+// ListIter returns an iterator over all Ratings.
 // It automatically pages through results using Go 1.23+ range-over-func.
 func (c *RatingClient) ListIter(ctx context.Context) iter.Seq2[Rating, error] {
 	return func(yield func(Rating, error) bool) {
@@ -482,3 +1426,121 @@ func (c *RatingClient) ListIter(ctx context.Context) iter.Seq2[Rating, error] {
 		}
 	}
 }
+
+// RatingIterator streams Rating entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type RatingIterator struct {
+	client   *RatingClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Rating
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Rating entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *RatingClient) Iterator(opts ...PageOption) *RatingIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &RatingIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Rating entities after cursor,
+// a value previously returned by RatingIterator.Cursor.
+func (c *RatingClient) ResumeIterator(cursor string, opts ...PageOption) *RatingIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Rating, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *RatingIterator) Next(ctx context.Context) (*Rating, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Rating
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Rating", ratingSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Rating{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *RatingIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Rating returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *RatingIterator) Cursor() string {
+	return it.after
+}
+
+// StreamRatings sends all Rating entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *RatingClient) StreamRatings(ctx context.Context, opts ...PageOption) (<-chan *Rating, <-chan error) {
+	out := make(chan *Rating)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
@@ -4,35 +4,182 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// LocationAPI is the set of Location operations provided by LocationClient. Code
+// that depends on LocationAPI rather than *LocationClient can run against a test double.
+type LocationAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Location, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Location) error
+	Create(ctx context.Context, v *Location, opts ...CreateOption) (string, error)
+	Update(ctx context.Context, v *Location) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Location, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Location, error)
+}
+
 // LocationClient provides typed CRUD operations for Location entities.
 type LocationClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ LocationAPI = (*LocationClient)(nil)
+
 // Get retrieves a single Location by its UID.
-func (c *LocationClient) Get(ctx context.Context, uid string) (*Location, error) {
+func (c *LocationClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Location, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Location
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Location", locationSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Location with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *LocationClient) GetExpanded(ctx context.Context, uid string) (*Location, error) {
+	var result Location
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Location", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Location type. A node of another type does not count.
+func (c *LocationClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Location")
+}
+
+// Load populates v with the Location stored under uid, using c.Location.Get.
+func (v *Location) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Location.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Location)(nil)
+
+// GetUID returns the Location's UID, empty until it has been added.
+func (v *Location) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Location's UID.
+func (v *Location) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Location's dgraph.type values: its DType, or
+// {"Location"} until Add sets it.
+func (v *Location) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Location"}
+}
+
+// String returns a one-line summary of the Location: its UID and Name.
+func (v Location) String() string {
+	return fmt.Sprintf("Location(%s %q)", v.UID, v.Name)
+}
+
 // Add inserts a new Location into the database.
 func (c *LocationClient) Add(ctx context.Context, v *Location) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Location node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+//
+// With WithUpsert, the node with the same Email, if there is one, is
+// updated and its UID returned instead.
+func (c *LocationClient) Create(ctx context.Context, v *Location, opts ...CreateOption) (string, error) {
+	var cfg createConfig
+	for _, opt := range opts {
+		opt.applyCreate(&cfg)
+	}
+	if v.UID != "" {
+		return "", fmt.Errorf("Location.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Location"}
+	}
+	if cfg.upsert {
+		if err := c.conn.Upsert(ctx, v, "email"); err != nil {
+			return "", err
+		}
+		return v.UID, nil
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Location.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Location in the database. The UID field must be set.
 func (c *LocationClient) Update(ctx context.Context, v *Location) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Location with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *LocationClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Location.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Location with the given UID to value, touching
+// no other predicate.
+func (c *LocationClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Location.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetEmail sets the Email of the Location with the given UID to value, touching
+// no other predicate.
+func (c *LocationClient) SetEmail(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"email": value}); err != nil {
+		return fmt.Errorf("Location.SetEmail: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Location with the given UID from the database.
 func (c *LocationClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
@@ -40,6 +187,9 @@ func (c *LocationClient) Delete(ctx context.Context, uid string) error {
 
 // Search finds Location entities whose Name matches term using fulltext search.
 func (c *LocationClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Location, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Location
 	q := c.conn.Query(ctx, Location{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +204,129 @@ func (c *LocationClient) Search(ctx context.Context, term string, opts ...PageOp
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Location entities with optional pagination.
-func (c *LocationClient) List(ctx context.Context, opts ...PageOption) ([]Location, error) {
+// SearchAllOfText finds Location entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *LocationClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Location, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Location entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *LocationClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Location, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Location entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *LocationClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Location, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *LocationClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Location, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Location
-	q := c.conn.Query(ctx, Location{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Location", locationSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// locationSelection returns the DQL selection for a Location: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func locationSelection(depth int) string {
+	s := "uid dgraph.type name loc email"
+	return s
+}
+
+// List retrieves Locations with optional pagination.
+func (c *LocationClient) List(ctx context.Context, opts ...PageOption) ([]Location, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var locations []Location
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&locations)
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// Find retrieves Locations matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *LocationClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Location, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
 	}
-	err := q.Nodes(&results)
+	var locations []Location
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&locations)
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// GetByName retrieves the Location entities whose Name is value, with optional
+// pagination.
+func (c *LocationClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Location, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Location
+	err := queryNodes(ctx, c.conn.QueryRaw, "Location", "eq(name, "+formatString(value)+")", "", locationSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// GetByEmail retrieves the Location whose Email is value. The error wraps ErrNotFound
+// if there is none, and ErrNotUnique if there is more than one.
+func (c *LocationClient) GetByEmail(ctx context.Context, value string) (*Location, error) {
+	var results []Location
+	err := queryNodes(ctx, c.conn.QueryRaw, "Location", "eq(email, "+formatString(value)+")", "", locationSelection(1), 2, 0, &results)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, fmt.Errorf("Location with Email %q: %w", value, ErrNotFound)
+	case 1:
+		return &results[0], nil
+	}
+	return nil, fmt.Errorf("Location with Email %q: %w", value, ErrNotUnique)
+}
+
+// NameRegexp retrieves Location entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *LocationClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Location, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Location.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Location
+	err = queryNodes(ctx, c.conn.QueryRaw, "Location", "regexp(name, "+re+")", "", locationSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
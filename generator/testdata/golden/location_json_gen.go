@@ -0,0 +1,37 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Location in the form Dgraph expects:
+//   - Geo coordinates are GeoJSON points.
+func (v Location) MarshalJSON() ([]byte, error) {
+	type plain Location
+	out := struct {
+		plain
+		Loc *geoPoint `json:"loc,omitempty"`
+	}{plain: plain(v)}
+	out.Loc = encodeGeoPoint(v.Loc)
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Location from a Dgraph query result:
+//   - Geo coordinates may be GeoJSON points or bare coordinate arrays.
+func (v *Location) UnmarshalJSON(data []byte) error {
+	type plain Location
+	in := struct {
+		*plain
+		Loc json.RawMessage `json:"loc"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeGeoPoint(in.Loc, &v.Loc); err != nil {
+		return fmt.Errorf("Location.Loc: %w", err)
+	}
+	return nil
+}
@@ -4,19 +4,19 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
 )
 
 // LocationQuery is a typed query builder for Location entities.
 type LocationQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Location entities.
@@ -30,6 +30,106 @@ func (q *LocationQuery) Filter(f string) *LocationQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *LocationQuery) where(expr string) *LocationQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from LocationWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *LocationQuery) Where(f Filter[Location]) *LocationQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Location entities that have a value for Name, using
+// has(name).
+func (q *LocationQuery) HasName() *LocationQuery {
+	return q.Where(LocationWhere.HasName())
+}
+
+// NotName filters to Location entities that have no Name value.
+func (q *LocationQuery) NotName() *LocationQuery {
+	return q.Where(LocationWhere.NotName())
+}
+
+// HasLoc filters to Location entities that have a value for Loc, using
+// has(loc).
+func (q *LocationQuery) HasLoc() *LocationQuery {
+	return q.Where(LocationWhere.HasLoc())
+}
+
+// NotLoc filters to Location entities that have no Loc value.
+func (q *LocationQuery) NotLoc() *LocationQuery {
+	return q.Where(LocationWhere.NotLoc())
+}
+
+// HasEmail filters to Location entities that have a value for Email, using
+// has(email).
+func (q *LocationQuery) HasEmail() *LocationQuery {
+	return q.Where(LocationWhere.HasEmail())
+}
+
+// NotEmail filters to Location entities that have no Email value.
+func (q *LocationQuery) NotEmail() *LocationQuery {
+	return q.Where(LocationWhere.NotEmail())
+}
+
+// LocNear filters to Location entities whose Loc lies within distMeters of (lat, lng).
+func (q *LocationQuery) LocNear(lat, lng, distMeters float64) *LocationQuery {
+	return q.Where(LocationWhere.LocNear(lat, lng, distMeters))
+}
+
+// LocWithin filters to Location entities whose Loc lies within polygon.
+func (q *LocationQuery) LocWithin(polygon GeoPolygon) *LocationQuery {
+	return q.Where(LocationWhere.LocWithin(polygon))
+}
+
+// LocContains filters to Location entities whose Loc contains point.
+func (q *LocationQuery) LocContains(point GeoPoint) *LocationQuery {
+	return q.Where(LocationWhere.LocContains(point))
+}
+
+// NameAllOfTerms filters to Location entities whose Name contains all of the terms.
+func (q *LocationQuery) NameAllOfTerms(terms string) *LocationQuery {
+	return q.Where(LocationWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Location entities whose Name contains any of the terms.
+func (q *LocationQuery) NameAnyOfTerms(terms string) *LocationQuery {
+	return q.Where(LocationWhere.NameAnyOfTerms(terms))
+}
+
+// EmailGe filters to Location entities whose Email sorts at or after value.
+func (q *LocationQuery) EmailGe(value string) *LocationQuery {
+	return q.Where(LocationWhere.EmailGe(value))
+}
+
+// EmailLe filters to Location entities whose Email sorts at or before value.
+func (q *LocationQuery) EmailLe(value string) *LocationQuery {
+	return q.Where(LocationWhere.EmailLe(value))
+}
+
+// EmailBetween filters to Location entities whose Email sorts from from through to,
+// inclusive.
+func (q *LocationQuery) EmailBetween(from, to string) *LocationQuery {
+	return q.Where(LocationWhere.EmailBetween(from, to))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *LocationQuery) OrderAsc(field string) *LocationQuery {
 	q.orderBy = field
@@ -56,8 +156,63 @@ func (q *LocationQuery) Offset(n int) *LocationQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Location and the edges added by the With methods.
+func (q *LocationQuery) selection() string {
+	s := locationSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *LocationQuery) dql() string {
+	return nodesQuery("Location", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *LocationQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Location with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Location.
+func (q *LocationQuery) GetByUID(uid string) (*Location, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Location
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Location", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *LocationQuery) Exec(dst *[]Location) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Location{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +230,17 @@ func (q *LocationQuery) Exec(dst *[]Location) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
 // ExecAndCount executes the query and returns both the results and total count.
 func (q *LocationQuery) ExecAndCount(dst *[]Location) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Location", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Location{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +258,92 @@ func (q *LocationQuery) ExecAndCount(dst *[]Location) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// LocationWhere builds the conditions on Location fields that LocationQuery.Where takes.
+var LocationWhere LocationConditions
+
+// LocationConditions has a method for each typed filter of LocationQuery, returning it as a
+// Filter[Location] to combine with And, Or, and Not.
+type LocationConditions struct{}
+
+// HasName matches Location entities that have a value for Name, using
+// has(name).
+func (LocationConditions) HasName() Filter[Location] {
+	return Filter[Location]{expr: "has(name)"}
+}
+
+// NotName matches Location entities that have no Name value.
+func (LocationConditions) NotName() Filter[Location] {
+	return Filter[Location]{expr: "NOT has(name)"}
+}
+
+// HasLoc matches Location entities that have a value for Loc, using
+// has(loc).
+func (LocationConditions) HasLoc() Filter[Location] {
+	return Filter[Location]{expr: "has(loc)"}
+}
+
+// NotLoc matches Location entities that have no Loc value.
+func (LocationConditions) NotLoc() Filter[Location] {
+	return Filter[Location]{expr: "NOT has(loc)"}
+}
+
+// HasEmail matches Location entities that have a value for Email, using
+// has(email).
+func (LocationConditions) HasEmail() Filter[Location] {
+	return Filter[Location]{expr: "has(email)"}
+}
+
+// NotEmail matches Location entities that have no Email value.
+func (LocationConditions) NotEmail() Filter[Location] {
+	return Filter[Location]{expr: "NOT has(email)"}
+}
+
+// LocNear matches Location entities whose Loc lies within distMeters of (lat, lng).
+func (LocationConditions) LocNear(lat, lng, distMeters float64) Filter[Location] {
+	return Filter[Location]{expr: "near(loc, " + GeoPoint{Lat: lat, Lng: lng}.geoJSON() + ", " + formatFloat(distMeters) + ")"}
+}
+
+// LocWithin matches Location entities whose Loc lies within polygon.
+func (LocationConditions) LocWithin(polygon GeoPolygon) Filter[Location] {
+	return Filter[Location]{expr: "within(loc, " + polygon.geoJSON() + ")"}
+}
+
+// LocContains matches Location entities whose Loc contains point.
+func (LocationConditions) LocContains(point GeoPoint) Filter[Location] {
+	return Filter[Location]{expr: "contains(loc, " + point.geoJSON() + ")"}
+}
+
+// NameAllOfTerms matches Location entities whose Name contains all of the terms.
+func (LocationConditions) NameAllOfTerms(terms string) Filter[Location] {
+	return Filter[Location]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Location entities whose Name contains any of the terms.
+func (LocationConditions) NameAnyOfTerms(terms string) Filter[Location] {
+	return Filter[Location]{expr: "anyofterms(name, " + formatString(terms) + ")"}
+}
+
+// EmailGe matches Location entities whose Email sorts at or after value.
+func (LocationConditions) EmailGe(value string) Filter[Location] {
+	return Filter[Location]{expr: "ge(email, " + formatString(value) + ")"}
+}
+
+// EmailLe matches Location entities whose Email sorts at or before value.
+func (LocationConditions) EmailLe(value string) Filter[Location] {
+	return Filter[Location]{expr: "le(email, " + formatString(value) + ")"}
+}
+
+// EmailBetween matches Location entities whose Email sorts from from through to,
+// inclusive.
+func (LocationConditions) EmailBetween(from, to string) Filter[Location] {
+	return Filter[Location]{expr: "between(email, " + formatString(from) + ", " + formatString(to) + ")"}
 }
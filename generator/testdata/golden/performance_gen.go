@@ -4,58 +4,202 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// PerformanceAPI is the set of Performance operations provided by PerformanceClient. Code
+// that depends on PerformanceAPI rather than *PerformanceClient can run against a test double.
+type PerformanceAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Performance, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Performance) error
+	Create(ctx context.Context, v *Performance) (string, error)
+	Update(ctx context.Context, v *Performance) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Performance, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Performance, error)
+}
+
 // PerformanceClient provides typed CRUD operations for Performance entities.
 type PerformanceClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ PerformanceAPI = (*PerformanceClient)(nil)
+
 // Get retrieves a single Performance by its UID.
-func (c *PerformanceClient) Get(ctx context.Context, uid string) (*Performance, error) {
+func (c *PerformanceClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Performance, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Performance
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Performance", performanceSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Performance with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *PerformanceClient) GetExpanded(ctx context.Context, uid string) (*Performance, error) {
 	var result Performance
-	err := c.conn.Get(ctx, &result, uid)
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Performance", map[string]string{
+		"performance.character_note": "characterNote",
+	}, &result)
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// Exists reports whether a node with the given UID exists and has the
+// Performance type. A node of another type does not count.
+func (c *PerformanceClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Performance")
+}
+
+// Load populates v with the Performance stored under uid, using c.Performance.Get.
+func (v *Performance) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Performance.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Performance)(nil)
+
+// GetUID returns the Performance's UID, empty until it has been added.
+func (v *Performance) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Performance's UID.
+func (v *Performance) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Performance's dgraph.type values: its DType, or
+// {"Performance"} until Add sets it.
+func (v *Performance) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Performance"}
+}
+
+// String returns a one-line summary of the Performance: its UID.
+func (v Performance) String() string {
+	return fmt.Sprintf("Performance(%s)", v.UID)
+}
+
 // Add inserts a new Performance into the database.
 func (c *PerformanceClient) Add(ctx context.Context, v *Performance) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Performance node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *PerformanceClient) Create(ctx context.Context, v *Performance) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Performance.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Performance"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Performance.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Performance in the database. The UID field must be set.
 func (c *PerformanceClient) Update(ctx context.Context, v *Performance) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Performance with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *PerformanceClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Performance.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetCharacterNote sets the CharacterNote of the Performance with the given UID to value, touching
+// no other predicate.
+func (c *PerformanceClient) SetCharacterNote(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"performance.character_note": value}); err != nil {
+		return fmt.Errorf("Performance.SetCharacterNote: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Performance with the given UID from the database.
 func (c *PerformanceClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
-// List retrieves Performance entities with optional pagination.
+// performanceSelection returns the DQL selection for a Performance: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func performanceSelection(depth int) string {
+	s := "uid dgraph.type characterNote: performance.character_note"
+	return s
+}
+
+// List retrieves Performances with optional pagination.
 func (c *PerformanceClient) List(ctx context.Context, opts ...PageOption) ([]Performance, error) {
-	var results []Performance
-	q := c.conn.Query(ctx, Performance{}).
-		First(defaultPageSize)
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var performances []Performance
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&performances)
+	if err != nil {
+		return nil, err
+	}
+	return performances, nil
+}
+
+// Find retrieves Performances matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *PerformanceClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Performance, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
 	}
-	err := q.Nodes(&results)
+	var performances []Performance
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&performances)
 	if err != nil {
 		return nil, err
 	}
-	return results, nil
+	return performances, nil
 }
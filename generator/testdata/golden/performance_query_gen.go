@@ -4,19 +4,19 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
 )
 
 // PerformanceQuery is a typed query builder for Performance entities.
 type PerformanceQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Performance entities.
@@ -30,6 +30,43 @@ func (q *PerformanceQuery) Filter(f string) *PerformanceQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *PerformanceQuery) where(expr string) *PerformanceQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PerformanceWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PerformanceQuery) Where(f Filter[Performance]) *PerformanceQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasCharacterNote filters to Performance entities that have a value for CharacterNote, using
+// has(performance.character_note).
+func (q *PerformanceQuery) HasCharacterNote() *PerformanceQuery {
+	return q.Where(PerformanceWhere.HasCharacterNote())
+}
+
+// NotCharacterNote filters to Performance entities that have no CharacterNote value.
+func (q *PerformanceQuery) NotCharacterNote() *PerformanceQuery {
+	return q.Where(PerformanceWhere.NotCharacterNote())
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *PerformanceQuery) OrderAsc(field string) *PerformanceQuery {
 	q.orderBy = field
@@ -56,8 +93,63 @@ func (q *PerformanceQuery) Offset(n int) *PerformanceQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Performance and the edges added by the With methods.
+func (q *PerformanceQuery) selection() string {
+	s := performanceSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PerformanceQuery) dql() string {
+	return nodesQuery("Performance", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PerformanceQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Performance with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Performance.
+func (q *PerformanceQuery) GetByUID(uid string) (*Performance, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Performance
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Performance", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *PerformanceQuery) Exec(dst *[]Performance) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Performance{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +167,17 @@ func (q *PerformanceQuery) Exec(dst *[]Performance) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
 // ExecAndCount executes the query and returns both the results and total count.
 func (q *PerformanceQuery) ExecAndCount(dst *[]Performance) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Performance", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Performance{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +195,29 @@ func (q *PerformanceQuery) ExecAndCount(dst *[]Performance) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PerformanceWhere builds the conditions on Performance fields that PerformanceQuery.Where takes.
+var PerformanceWhere PerformanceConditions
+
+// PerformanceConditions has a method for each typed filter of PerformanceQuery, returning it as a
+// Filter[Performance] to combine with And, Or, and Not.
+type PerformanceConditions struct{}
+
+// HasCharacterNote matches Performance entities that have a value for CharacterNote, using
+// has(performance.character_note).
+func (PerformanceConditions) HasCharacterNote() Filter[Performance] {
+	return Filter[Performance]{expr: "has(performance.character_note)"}
+}
+
+// NotCharacterNote matches Performance entities that have no CharacterNote value.
+func (PerformanceConditions) NotCharacterNote() Filter[Performance] {
+	return Filter[Performance]{expr: "NOT has(performance.character_note)"}
 }
@@ -4,42 +4,217 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
+// RatingAPI is the set of Rating operations provided by RatingClient. Code
+// that depends on RatingAPI rather than *RatingClient can run against a test double.
+type RatingAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Rating, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Rating) error
+	Create(ctx context.Context, v *Rating) (string, error)
+	Update(ctx context.Context, v *Rating) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Rating, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Rating, error)
+}
+
 // RatingClient provides typed CRUD operations for Rating entities.
 type RatingClient struct {
-	conn modusgraph.Client
+	conn graphConn
 }
 
+var _ RatingAPI = (*RatingClient)(nil)
+
 // Get retrieves a single Rating by its UID.
-func (c *RatingClient) Get(ctx context.Context, uid string) (*Rating, error) {
+func (c *RatingClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Rating, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
 	var result Rating
-	err := c.conn.Get(ctx, &result, uid)
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Rating", ratingSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &result, nil
 }
 
+// GetExpanded retrieves the Rating with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *RatingClient) GetExpanded(ctx context.Context, uid string) (*Rating, error) {
+	var result Rating
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Rating", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Rating type. A node of another type does not count.
+func (c *RatingClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Rating")
+}
+
+// Load populates v with the Rating stored under uid, using c.Rating.Get.
+func (v *Rating) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Rating.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Rating) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Rating.LoadFilms: UID is empty")
+	}
+	var got Rating
+	selection := "uid films: ~rating { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Rating", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Rating)(nil)
+
+// GetUID returns the Rating's UID, empty until it has been added.
+func (v *Rating) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Rating's UID.
+func (v *Rating) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Rating's dgraph.type values: its DType, or
+// {"Rating"} until Add sets it.
+func (v *Rating) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Rating"}
+}
+
+// String returns a one-line summary of the Rating: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Rating) String() string {
+	return fmt.Sprintf("Rating(%s %q films=%d)", v.UID, v.Name, len(v.Films))
+}
+
 // Add inserts a new Rating into the database.
 func (c *RatingClient) Add(ctx context.Context, v *Rating) error {
 	return c.conn.Insert(ctx, v)
 }
 
+// Create inserts v, which must not have a UID yet, as a new Rating node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *RatingClient) Create(ctx context.Context, v *Rating) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Rating.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Rating"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Rating.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
 // Update modifies an existing Rating in the database. The UID field must be set.
 func (c *RatingClient) Update(ctx context.Context, v *Rating) error {
 	return c.conn.Update(ctx, v)
 }
 
+// UpdateFields sets the given predicates of the Rating with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *RatingClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Rating.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Rating with the given UID to value, touching
+// no other predicate.
+func (c *RatingClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Rating.SetName: %w", err)
+	}
+	return nil
+}
+
 // Delete removes the Rating with the given UID from the database.
 func (c *RatingClient) Delete(ctx context.Context, uid string) error {
 	return c.conn.Delete(ctx, []string{uid})
 }
 
+// AddFilms links the Rating with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of rating, it adds the rating edge from each
+// Film to the Rating.
+func (c *RatingClient) AddFilms(ctx context.Context, ratingUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rating", filmUIDs, []string{ratingUID}, false); err != nil {
+		return fmt.Errorf("Rating.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Rating with the given UID from the Film nodes
+// with the given UIDs, deleting the rating edge from each Film.
+func (c *RatingClient) RemoveFilms(ctx context.Context, ratingUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "rating", filmUIDs, []string{ratingUID}, true); err != nil {
+		return fmt.Errorf("Rating.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
 // Search finds Rating entities whose Name matches term using fulltext search.
 func (c *RatingClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Rating, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
 	var results []Rating
 	q := c.conn.Query(ctx, Rating{}).
 		Filter(`alloftext(name, "` + term + `")`).
@@ -54,29 +229,115 @@ func (c *RatingClient) Search(ctx context.Context, term string, opts ...PageOpti
 	if cfg.offset > 0 {
 		q = q.Offset(cfg.offset)
 	}
-	err := q.Nodes(&results)
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
 	if err != nil {
 		return nil, err
 	}
 	return results, nil
 }
 
-// List retrieves Rating entities with optional pagination.
-func (c *RatingClient) List(ctx context.Context, opts ...PageOption) ([]Rating, error) {
+// SearchAllOfText finds Rating entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *RatingClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Rating, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Rating entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *RatingClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Rating, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Rating entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *RatingClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Rating, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *RatingClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Rating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
 	var results []Rating
-	q := c.conn.Query(ctx, Rating{}).
-		First(defaultPageSize)
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Rating", ratingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ratingSelection returns the DQL selection for a Rating: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func ratingSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: ~rating { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Ratings with optional pagination.
+func (c *RatingClient) List(ctx context.Context, opts ...PageOption) ([]Rating, error) {
 	cfg := pageConfig{first: defaultPageSize}
 	for _, opt := range opts {
 		opt.applyPage(&cfg)
 	}
-	if cfg.first > 0 {
-		q = q.First(cfg.first)
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
 	}
-	if cfg.offset > 0 {
-		q = q.Offset(cfg.offset)
+	var ratings []Rating
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&ratings)
+	if err != nil {
+		return nil, err
 	}
-	err := q.Nodes(&results)
+	return ratings, nil
+}
+
+// Find retrieves Ratings matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *RatingClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Rating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var ratings []Rating
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&ratings)
+	if err != nil {
+		return nil, err
+	}
+	return ratings, nil
+}
+
+// GetByName retrieves the Rating entities whose Name is value, with optional
+// pagination.
+func (c *RatingClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Rating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Rating
+	err := queryNodes(ctx, c.conn.QueryRaw, "Rating", "eq(name, "+formatString(value)+")", "", ratingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// NameRegexp retrieves Rating entities whose Name matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *RatingClient) NameRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Rating, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Rating.Name: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Rating
+	err = queryNodes(ctx, c.conn.QueryRaw, "Rating", "regexp(name, "+re+")", "", ratingSelection(1), cfg.first, cfg.offset, &results)
 	if err != nil {
 		return nil, err
 	}
@@ -4,19 +4,21 @@ package movies
 
 import (
 	"context"
-
-	"github.com/matthewmcneely/modusgraph"
+	"errors"
+	"fmt"
 )
 
 // RatingQuery is a typed query builder for Rating entities.
 type RatingQuery struct {
-	conn      modusgraph.Client
+	conn      graphConn
 	ctx       context.Context
 	filter    string
 	first     int
 	offset    int
 	orderBy   string
 	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
 }
 
 // Query begins a new query for Rating entities.
@@ -30,6 +32,71 @@ func (q *RatingQuery) Filter(f string) *RatingQuery {
 	return q
 }
 
+// where ANDs expr onto the query's filter expression.
+func (q *RatingQuery) where(expr string) *RatingQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from RatingWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *RatingQuery) Where(f Filter[Rating]) *RatingQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Rating entities that have a value for Name, using
+// has(name).
+func (q *RatingQuery) HasName() *RatingQuery {
+	return q.Where(RatingWhere.HasName())
+}
+
+// NotName filters to Rating entities that have no Name value.
+func (q *RatingQuery) NotName() *RatingQuery {
+	return q.Where(RatingWhere.NotName())
+}
+
+// HasFilms filters to Rating entities that have a value for Films, using
+// has(~rating).
+func (q *RatingQuery) HasFilms() *RatingQuery {
+	return q.Where(RatingWhere.HasFilms())
+}
+
+// NotFilms filters to Rating entities that have no Films value.
+func (q *RatingQuery) NotFilms() *RatingQuery {
+	return q.Where(RatingWhere.NotFilms())
+}
+
+// FilmsContains filters to Rating entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~rating, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *RatingQuery) FilmsContains(uids ...string) *RatingQuery {
+	return q.Where(RatingWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to Rating entities whose Name contains all of the terms.
+func (q *RatingQuery) NameAllOfTerms(terms string) *RatingQuery {
+	return q.Where(RatingWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Rating entities whose Name contains any of the terms.
+func (q *RatingQuery) NameAnyOfTerms(terms string) *RatingQuery {
+	return q.Where(RatingWhere.NameAnyOfTerms(terms))
+}
+
 // OrderAsc sets ascending order on the given field.
 func (q *RatingQuery) OrderAsc(field string) *RatingQuery {
 	q.orderBy = field
@@ -56,8 +123,81 @@ func (q *RatingQuery) Offset(n int) *RatingQuery {
 	return q
 }
 
-// Exec executes the query and populates dst with the results.
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *RatingQuery) withEdge(selection string) *RatingQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *RatingQuery) WithFilms() *RatingQuery {
+	return q.withEdge("films: ~rating { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Rating and the edges added by the With methods.
+func (q *RatingQuery) selection() string {
+	s := ratingSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *RatingQuery) dql() string {
+	return nodesQuery("Rating", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *RatingQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Rating with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Rating.
+func (q *RatingQuery) GetByUID(uid string) (*Rating, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Rating
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Rating", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
 func (q *RatingQuery) Exec(dst *[]Rating) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
 	dq := q.conn.Query(q.ctx, Rating{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -75,11 +215,21 @@ func (q *RatingQuery) Exec(dst *[]Rating) error {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.Nodes(dst)
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
 }
 
-// ExecAndCount executes the query and returns both the results and total count.
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
 func (q *RatingQuery) ExecAndCount(dst *[]Rating) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("RatingQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Rating", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
 	dq := q.conn.Query(q.ctx, Rating{})
 	if q.filter != "" {
 		dq = dq.Filter(q.filter)
@@ -97,5 +247,61 @@ func (q *RatingQuery) ExecAndCount(dst *[]Rating) (int, error) {
 			dq = dq.OrderAsc(q.orderBy)
 		}
 	}
-	return dq.NodesAndCount(dst)
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// RatingWhere builds the conditions on Rating fields that RatingQuery.Where takes.
+var RatingWhere RatingConditions
+
+// RatingConditions has a method for each typed filter of RatingQuery, returning it as a
+// Filter[Rating] to combine with And, Or, and Not.
+type RatingConditions struct{}
+
+// HasName matches Rating entities that have a value for Name, using
+// has(name).
+func (RatingConditions) HasName() Filter[Rating] {
+	return Filter[Rating]{expr: "has(name)"}
+}
+
+// NotName matches Rating entities that have no Name value.
+func (RatingConditions) NotName() Filter[Rating] {
+	return Filter[Rating]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Rating entities that have a value for Films, using
+// has(~rating).
+func (RatingConditions) HasFilms() Filter[Rating] {
+	return Filter[Rating]{expr: "has(~rating)"}
+}
+
+// NotFilms matches Rating entities that have no Films value.
+func (RatingConditions) NotFilms() Filter[Rating] {
+	return Filter[Rating]{expr: "NOT has(~rating)"}
+}
+
+// FilmsContains matches Rating entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~rating, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (RatingConditions) FilmsContains(uids ...string) Filter[Rating] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Rating]{err: fmt.Errorf("Rating.Films: %w", err)}
+	}
+	return Filter[Rating]{expr: "uid_in(~rating, " + list + ")"}
+}
+
+// NameAllOfTerms matches Rating entities whose Name contains all of the terms.
+func (RatingConditions) NameAllOfTerms(terms string) Filter[Rating] {
+	return Filter[Rating]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Rating entities whose Name contains any of the terms.
+func (RatingConditions) NameAnyOfTerms(terms string) Filter[Rating] {
+	return Filter[Rating]{expr: "anyofterms(name, " + formatString(terms) + ")"}
 }
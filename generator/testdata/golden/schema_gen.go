@@ -0,0 +1,73 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+// DQLSchema is the Dgraph schema for the movies data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+actor.film: [uid] @count .
+country: [uid] @reverse .
+director.film: [uid] @reverse @count .
+email: string @index(exact) @upsert .
+genre: [uid] @reverse @count .
+initial_release_date: datetime @index(year) .
+loc: geo @index(geo) .
+name: string @index(hash, term, trigram, fulltext) .
+performance.character_note: string .
+rated: [uid] @reverse .
+rating: [uid] @reverse .
+starring: [uid] @count .
+tagline: string .
+
+type Actor {
+	name
+	actor.film
+}
+
+type ContentRating {
+	name
+	<~rated>
+}
+
+type Country {
+	name
+	<~country>
+}
+
+type Director {
+	name
+	director.film
+}
+
+type Film {
+	name
+	initial_release_date
+	tagline
+	genre
+	country
+	rating
+	rated
+	starring
+}
+
+type Genre {
+	name
+	<~genre>
+}
+
+type Location {
+	name
+	loc
+	email
+}
+
+type Performance {
+	performance.character_note
+}
+
+type Rating {
+	name
+	<~rating>
+}
+`
@@ -0,0 +1,1043 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Txn groups writes to several entities into a single Dgraph transaction.
+// Reads made through it see its own uncommitted writes. Every Txn must end
+// with Commit or Discard; calling Discard after Commit is a no-op, so
+// `defer txn.Discard(ctx)` is safe.
+type Txn struct {
+	txn           *dgo.Txn
+	cleanup       func()
+	done          sync.Once
+	Actor         *ActorTxn
+	ContentRating *ContentRatingTxn
+	Country       *CountryTxn
+	Director      *DirectorTxn
+	Film          *FilmTxn
+	Genre         *GenreTxn
+	Location      *LocationTxn
+	Performance   *PerformanceTxn
+	Rating        *RatingTxn
+}
+
+// NewTxn starts a transaction on the client's connection.
+func (c *Client) NewTxn(ctx context.Context) (*Txn, error) {
+	dg, cleanup, err := c.conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	t := &Txn{txn: dg.NewTxn(), cleanup: cleanup}
+	t.Actor = &ActorTxn{txn: t}
+	t.ContentRating = &ContentRatingTxn{txn: t}
+	t.Country = &CountryTxn{txn: t}
+	t.Director = &DirectorTxn{txn: t}
+	t.Film = &FilmTxn{txn: t}
+	t.Genre = &GenreTxn{txn: t}
+	t.Location = &LocationTxn{txn: t}
+	t.Performance = &PerformanceTxn{txn: t}
+	t.Rating = &RatingTxn{txn: t}
+	return t, nil
+}
+
+// DgraphTxn returns the underlying dgo transaction, for operations the
+// generated API does not cover.
+func (t *Txn) DgraphTxn() *dgo.Txn {
+	return t.txn
+}
+
+// Commit commits the transaction's writes.
+func (t *Txn) Commit(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Commit(ctx)
+}
+
+// Discard abandons the transaction's writes.
+func (t *Txn) Discard(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Discard(ctx)
+}
+
+// release returns the connection obtained by NewTxn, once.
+func (t *Txn) release() {
+	t.done.Do(t.cleanup)
+}
+
+// query runs a read-only DQL query inside the transaction.
+func (t *Txn) query(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	resp, err := t.txn.QueryWithVars(ctx, q, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// ActorTxn provides Actor operations within a Txn.
+type ActorTxn struct {
+	txn *Txn
+}
+
+var _ ActorAPI = (*ActorTxn)(nil)
+
+// Get retrieves a single Actor by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *ActorTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Actor, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Actor
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Actor", actorSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Actor type, seeing the transaction's own writes.
+func (t *ActorTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Actor")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *ActorTxn) Add(ctx context.Context, v *Actor) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Actor"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *ActorTxn) Create(ctx context.Context, v *Actor) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Actor.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Actor"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Actor.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *ActorTxn) Update(ctx context.Context, v *Actor) error {
+	if v.UID == "" {
+		return errors.New("Actor.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Actor with the given UID in the transaction.
+func (t *ActorTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Actor entities with optional pagination.
+func (t *ActorTxn) List(ctx context.Context, opts ...PageOption) ([]Actor, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Actor entities matching the DQL filter expression, with
+// optional pagination.
+func (t *ActorTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Actor, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Actor
+	err := queryNodes(ctx, t.txn.query, "Actor", filter, "", actorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ContentRatingTxn provides ContentRating operations within a Txn.
+type ContentRatingTxn struct {
+	txn *Txn
+}
+
+var _ ContentRatingAPI = (*ContentRatingTxn)(nil)
+
+// Get retrieves a single ContentRating by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *ContentRatingTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*ContentRating, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result ContentRating
+	if err := getByUIDWith(ctx, t.txn.query, uid, "ContentRating", contentRatingSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// ContentRating type, seeing the transaction's own writes.
+func (t *ContentRatingTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "ContentRating")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *ContentRatingTxn) Add(ctx context.Context, v *ContentRating) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"ContentRating"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *ContentRatingTxn) Create(ctx context.Context, v *ContentRating) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("ContentRating.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"ContentRating"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("ContentRating.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *ContentRatingTxn) Update(ctx context.Context, v *ContentRating) error {
+	if v.UID == "" {
+		return errors.New("ContentRating.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the ContentRating with the given UID in the transaction.
+func (t *ContentRatingTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves ContentRating entities with optional pagination.
+func (t *ContentRatingTxn) List(ctx context.Context, opts ...PageOption) ([]ContentRating, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves ContentRating entities matching the DQL filter expression, with
+// optional pagination.
+func (t *ContentRatingTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]ContentRating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []ContentRating
+	err := queryNodes(ctx, t.txn.query, "ContentRating", filter, "", contentRatingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// CountryTxn provides Country operations within a Txn.
+type CountryTxn struct {
+	txn *Txn
+}
+
+var _ CountryAPI = (*CountryTxn)(nil)
+
+// Get retrieves a single Country by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *CountryTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Country, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Country
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Country", countrySelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Country type, seeing the transaction's own writes.
+func (t *CountryTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Country")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *CountryTxn) Add(ctx context.Context, v *Country) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Country"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *CountryTxn) Create(ctx context.Context, v *Country) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Country.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Country"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Country.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *CountryTxn) Update(ctx context.Context, v *Country) error {
+	if v.UID == "" {
+		return errors.New("Country.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Country with the given UID in the transaction.
+func (t *CountryTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Country entities with optional pagination.
+func (t *CountryTxn) List(ctx context.Context, opts ...PageOption) ([]Country, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Country entities matching the DQL filter expression, with
+// optional pagination.
+func (t *CountryTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Country, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Country
+	err := queryNodes(ctx, t.txn.query, "Country", filter, "", countrySelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DirectorTxn provides Director operations within a Txn.
+type DirectorTxn struct {
+	txn *Txn
+}
+
+var _ DirectorAPI = (*DirectorTxn)(nil)
+
+// Get retrieves a single Director by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *DirectorTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Director, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Director
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Director", directorSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Director type, seeing the transaction's own writes.
+func (t *DirectorTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Director")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *DirectorTxn) Add(ctx context.Context, v *Director) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Director"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *DirectorTxn) Create(ctx context.Context, v *Director) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Director.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Director"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Director.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *DirectorTxn) Update(ctx context.Context, v *Director) error {
+	if v.UID == "" {
+		return errors.New("Director.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Director with the given UID in the transaction.
+func (t *DirectorTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Director entities with optional pagination.
+func (t *DirectorTxn) List(ctx context.Context, opts ...PageOption) ([]Director, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Director entities matching the DQL filter expression, with
+// optional pagination.
+func (t *DirectorTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Director, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Director
+	err := queryNodes(ctx, t.txn.query, "Director", filter, "", directorSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// FilmTxn provides Film operations within a Txn.
+type FilmTxn struct {
+	txn *Txn
+}
+
+var _ FilmAPI = (*FilmTxn)(nil)
+
+// Get retrieves a single Film by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *FilmTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Film", filmSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type, seeing the transaction's own writes.
+func (t *FilmTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Film")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *FilmTxn) Add(ctx context.Context, v *Film) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Film"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *FilmTxn) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Film"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *FilmTxn) Update(ctx context.Context, v *Film) error {
+	if v.UID == "" {
+		return errors.New("Film.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Film with the given UID in the transaction.
+func (t *FilmTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Film entities with optional pagination.
+func (t *FilmTxn) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Film entities matching the DQL filter expression, with
+// optional pagination.
+func (t *FilmTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := queryNodes(ctx, t.txn.query, "Film", filter, "", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// GenreTxn provides Genre operations within a Txn.
+type GenreTxn struct {
+	txn *Txn
+}
+
+var _ GenreAPI = (*GenreTxn)(nil)
+
+// Get retrieves a single Genre by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *GenreTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Genre, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Genre
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Genre", genreSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Genre type, seeing the transaction's own writes.
+func (t *GenreTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Genre")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *GenreTxn) Add(ctx context.Context, v *Genre) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Genre"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *GenreTxn) Create(ctx context.Context, v *Genre) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Genre.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Genre"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Genre.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *GenreTxn) Update(ctx context.Context, v *Genre) error {
+	if v.UID == "" {
+		return errors.New("Genre.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Genre with the given UID in the transaction.
+func (t *GenreTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Genre entities with optional pagination.
+func (t *GenreTxn) List(ctx context.Context, opts ...PageOption) ([]Genre, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Genre entities matching the DQL filter expression, with
+// optional pagination.
+func (t *GenreTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Genre
+	err := queryNodes(ctx, t.txn.query, "Genre", filter, "", genreSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// LocationTxn provides Location operations within a Txn.
+type LocationTxn struct {
+	txn *Txn
+}
+
+var _ LocationAPI = (*LocationTxn)(nil)
+
+// Get retrieves a single Location by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *LocationTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Location, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Location
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Location", locationSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Location type, seeing the transaction's own writes.
+func (t *LocationTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Location")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *LocationTxn) Add(ctx context.Context, v *Location) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Location"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. WithUpsert is not supported in a transaction.
+func (t *LocationTxn) Create(ctx context.Context, v *Location, opts ...CreateOption) (string, error) {
+	var cfg createConfig
+	for _, opt := range opts {
+		opt.applyCreate(&cfg)
+	}
+	if cfg.upsert {
+		return "", errors.New("Location.Create: WithUpsert is not supported in a Txn")
+	}
+	if v.UID != "" {
+		return "", fmt.Errorf("Location.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Location"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Location.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *LocationTxn) Update(ctx context.Context, v *Location) error {
+	if v.UID == "" {
+		return errors.New("Location.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Location with the given UID in the transaction.
+func (t *LocationTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Location entities with optional pagination.
+func (t *LocationTxn) List(ctx context.Context, opts ...PageOption) ([]Location, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Location entities matching the DQL filter expression, with
+// optional pagination.
+func (t *LocationTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Location, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Location
+	err := queryNodes(ctx, t.txn.query, "Location", filter, "", locationSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// PerformanceTxn provides Performance operations within a Txn.
+type PerformanceTxn struct {
+	txn *Txn
+}
+
+var _ PerformanceAPI = (*PerformanceTxn)(nil)
+
+// Get retrieves a single Performance by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *PerformanceTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Performance, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Performance
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Performance", performanceSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Performance type, seeing the transaction's own writes.
+func (t *PerformanceTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Performance")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *PerformanceTxn) Add(ctx context.Context, v *Performance) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Performance"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *PerformanceTxn) Create(ctx context.Context, v *Performance) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Performance.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Performance"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Performance.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *PerformanceTxn) Update(ctx context.Context, v *Performance) error {
+	if v.UID == "" {
+		return errors.New("Performance.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Performance with the given UID in the transaction.
+func (t *PerformanceTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Performance entities with optional pagination.
+func (t *PerformanceTxn) List(ctx context.Context, opts ...PageOption) ([]Performance, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Performance entities matching the DQL filter expression, with
+// optional pagination.
+func (t *PerformanceTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Performance, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Performance
+	err := queryNodes(ctx, t.txn.query, "Performance", filter, "", performanceSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// RatingTxn provides Rating operations within a Txn.
+type RatingTxn struct {
+	txn *Txn
+}
+
+var _ RatingAPI = (*RatingTxn)(nil)
+
+// Get retrieves a single Rating by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *RatingTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Rating, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Rating
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Rating", ratingSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Rating type, seeing the transaction's own writes.
+func (t *RatingTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Rating")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *RatingTxn) Add(ctx context.Context, v *Rating) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Rating"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *RatingTxn) Create(ctx context.Context, v *Rating) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Rating.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Rating"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Rating.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *RatingTxn) Update(ctx context.Context, v *Rating) error {
+	if v.UID == "" {
+		return errors.New("Rating.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Rating with the given UID in the transaction.
+func (t *RatingTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Rating entities with optional pagination.
+func (t *RatingTxn) List(ctx context.Context, opts ...PageOption) ([]Rating, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Rating entities matching the DQL filter expression, with
+// optional pagination.
+func (t *RatingTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Rating, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Rating
+	err := queryNodes(ctx, t.txn.query, "Rating", filter, "", ratingSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,59 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// geoPoint is a GeoJSON point, the form in which Dgraph stores geo values.
+type geoPoint struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+// encodeGeoPoint wraps [longitude, latitude] coordinates in a GeoJSON point,
+// or returns nil for no coordinates.
+func encodeGeoPoint(coords []float64) *geoPoint {
+	if len(coords) == 0 {
+		return nil
+	}
+	return &geoPoint{Type: "Point", Coordinates: coords}
+}
+
+// decodeGeoPoint decodes raw, a GeoJSON point or a bare coordinate array, into
+// dst. A missing value leaves dst unchanged; null sets it to nil.
+func decodeGeoPoint(raw json.RawMessage, dst *[]float64) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] != '{' {
+		return json.Unmarshal(raw, dst)
+	}
+	var p geoPoint
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return err
+	}
+	if p.Type != "Point" {
+		return fmt.Errorf("geo value is a %s, not a Point", p.Type)
+	}
+	*dst = p.Coordinates
+	return nil
+}
+
+// decodeEdges decodes raw, the value of an edge predicate, into dst, a pointer
+// to a slice of entities. Dgraph returns a single object rather than a list
+// for a predicate of type uid; it is decoded as a one-element slice.
+func decodeEdges(raw json.RawMessage, dst any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, dst)
+}
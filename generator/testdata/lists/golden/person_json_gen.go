@@ -0,0 +1,42 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Person in the form Dgraph expects:
+//   - Geo coordinates are GeoJSON points.
+func (v Person) MarshalJSON() ([]byte, error) {
+	type plain Person
+	out := struct {
+		plain
+		Home *geoPoint `json:"home,omitempty"`
+	}{plain: plain(v)}
+	out.Home = encodeGeoPoint(v.Home)
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Person from a Dgraph query result:
+//   - Geo coordinates may be GeoJSON points or bare coordinate arrays.
+//   - An edge may be a single object rather than a list.
+func (v *Person) UnmarshalJSON(data []byte) error {
+	type plain Person
+	in := struct {
+		*plain
+		Home json.RawMessage `json:"home"`
+		Tags json.RawMessage `json:"tags"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeGeoPoint(in.Home, &v.Home); err != nil {
+		return fmt.Errorf("Person.Home: %w", err)
+	}
+	if err := decodeEdges(in.Tags, &v.Tags); err != nil {
+		return fmt.Errorf("Person.Tags: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+// PersonOption is a functional option for configuring Person mutations.
+type PersonOption func(*Person)
+
+// WithPersonName sets the Name field on a Person.
+func WithPersonName(v string) PersonOption {
+	return func(e *Person) {
+		e.Name = v
+	}
+}
+
+// WithPersonAliases sets the Aliases field on a Person.
+func WithPersonAliases(v []string) PersonOption {
+	return func(e *Person) {
+		e.Aliases = v
+	}
+}
+
+// WithPersonScores sets the Scores field on a Person.
+func WithPersonScores(v []int) PersonOption {
+	return func(e *Person) {
+		e.Scores = v
+	}
+}
+
+// WithPersonAvatar sets the Avatar field on a Person.
+func WithPersonAvatar(v []byte) PersonOption {
+	return func(e *Person) {
+		e.Avatar = v
+	}
+}
+
+// WithPersonHome sets the Home field on a Person.
+func WithPersonHome(v []float64) PersonOption {
+	return func(e *Person) {
+		e.Home = v
+	}
+}
+
+// ApplyPersonOptions applies the given options to a Person.
+func ApplyPersonOptions(e *Person, opts ...PersonOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
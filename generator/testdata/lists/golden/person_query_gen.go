@@ -0,0 +1,457 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PersonQuery is a typed query builder for Person entities.
+type PersonQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Person entities.
+func (c *PersonClient) Query(ctx context.Context) *PersonQuery {
+	return &PersonQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *PersonQuery) Filter(f string) *PersonQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *PersonQuery) where(expr string) *PersonQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PersonWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PersonQuery) Where(f Filter[Person]) *PersonQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Person entities that have a value for Name, using
+// has(name).
+func (q *PersonQuery) HasName() *PersonQuery {
+	return q.Where(PersonWhere.HasName())
+}
+
+// NotName filters to Person entities that have no Name value.
+func (q *PersonQuery) NotName() *PersonQuery {
+	return q.Where(PersonWhere.NotName())
+}
+
+// HasAliases filters to Person entities that have a value for Aliases, using
+// has(aliases).
+func (q *PersonQuery) HasAliases() *PersonQuery {
+	return q.Where(PersonWhere.HasAliases())
+}
+
+// NotAliases filters to Person entities that have no Aliases value.
+func (q *PersonQuery) NotAliases() *PersonQuery {
+	return q.Where(PersonWhere.NotAliases())
+}
+
+// HasScores filters to Person entities that have a value for Scores, using
+// has(scores).
+func (q *PersonQuery) HasScores() *PersonQuery {
+	return q.Where(PersonWhere.HasScores())
+}
+
+// NotScores filters to Person entities that have no Scores value.
+func (q *PersonQuery) NotScores() *PersonQuery {
+	return q.Where(PersonWhere.NotScores())
+}
+
+// HasAvatar filters to Person entities that have a value for Avatar, using
+// has(avatar).
+func (q *PersonQuery) HasAvatar() *PersonQuery {
+	return q.Where(PersonWhere.HasAvatar())
+}
+
+// NotAvatar filters to Person entities that have no Avatar value.
+func (q *PersonQuery) NotAvatar() *PersonQuery {
+	return q.Where(PersonWhere.NotAvatar())
+}
+
+// HasHome filters to Person entities that have a value for Home, using
+// has(home).
+func (q *PersonQuery) HasHome() *PersonQuery {
+	return q.Where(PersonWhere.HasHome())
+}
+
+// NotHome filters to Person entities that have no Home value.
+func (q *PersonQuery) NotHome() *PersonQuery {
+	return q.Where(PersonWhere.NotHome())
+}
+
+// HasTags filters to Person entities that have a value for Tags, using
+// has(tags).
+func (q *PersonQuery) HasTags() *PersonQuery {
+	return q.Where(PersonWhere.HasTags())
+}
+
+// NotTags filters to Person entities that have no Tags value.
+func (q *PersonQuery) NotTags() *PersonQuery {
+	return q.Where(PersonWhere.NotTags())
+}
+
+// TagsContains filters to Person entities whose Tags include any of the
+// Tag nodes with the given uids, using uid_in(tags, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *PersonQuery) TagsContains(uids ...string) *PersonQuery {
+	return q.Where(PersonWhere.TagsContains(uids...))
+}
+
+// HomeNear filters to Person entities whose Home lies within distMeters of (lat, lng).
+func (q *PersonQuery) HomeNear(lat, lng, distMeters float64) *PersonQuery {
+	return q.Where(PersonWhere.HomeNear(lat, lng, distMeters))
+}
+
+// HomeWithin filters to Person entities whose Home lies within polygon.
+func (q *PersonQuery) HomeWithin(polygon GeoPolygon) *PersonQuery {
+	return q.Where(PersonWhere.HomeWithin(polygon))
+}
+
+// HomeContains filters to Person entities whose Home contains point.
+func (q *PersonQuery) HomeContains(point GeoPoint) *PersonQuery {
+	return q.Where(PersonWhere.HomeContains(point))
+}
+
+// AliasesAllOfTerms filters to Person entities whose Aliases contains all of the terms.
+func (q *PersonQuery) AliasesAllOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.AliasesAllOfTerms(terms))
+}
+
+// AliasesAnyOfTerms filters to Person entities whose Aliases contains any of the terms.
+func (q *PersonQuery) AliasesAnyOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.AliasesAnyOfTerms(terms))
+}
+
+// NameGe filters to Person entities whose Name sorts at or after value.
+func (q *PersonQuery) NameGe(value string) *PersonQuery {
+	return q.Where(PersonWhere.NameGe(value))
+}
+
+// NameLe filters to Person entities whose Name sorts at or before value.
+func (q *PersonQuery) NameLe(value string) *PersonQuery {
+	return q.Where(PersonWhere.NameLe(value))
+}
+
+// NameBetween filters to Person entities whose Name sorts from from through to,
+// inclusive.
+func (q *PersonQuery) NameBetween(from, to string) *PersonQuery {
+	return q.Where(PersonWhere.NameBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *PersonQuery) OrderAsc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *PersonQuery) OrderDesc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *PersonQuery) First(n int) *PersonQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *PersonQuery) Offset(n int) *PersonQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *PersonQuery) withEdge(selection string) *PersonQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithTags makes GetByUID and Exec also fetch the Tags edge, with the
+// scalar predicates of each Tag it leads to, in the same query.
+func (q *PersonQuery) WithTags() *PersonQuery {
+	return q.withEdge("tags { " + tagSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Person and the edges added by the With methods.
+func (q *PersonQuery) selection() string {
+	s := personSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PersonQuery) dql() string {
+	return nodesQuery("Person", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PersonQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Person with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Person.
+func (q *PersonQuery) GetByUID(uid string) (*Person, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Person
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Person", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *PersonQuery) Exec(dst *[]Person) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *PersonQuery) ExecAndCount(dst *[]Person) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("PersonQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Person", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PersonWhere builds the conditions on Person fields that PersonQuery.Where takes.
+var PersonWhere PersonConditions
+
+// PersonConditions has a method for each typed filter of PersonQuery, returning it as a
+// Filter[Person] to combine with And, Or, and Not.
+type PersonConditions struct{}
+
+// HasName matches Person entities that have a value for Name, using
+// has(name).
+func (PersonConditions) HasName() Filter[Person] {
+	return Filter[Person]{expr: "has(name)"}
+}
+
+// NotName matches Person entities that have no Name value.
+func (PersonConditions) NotName() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(name)"}
+}
+
+// HasAliases matches Person entities that have a value for Aliases, using
+// has(aliases).
+func (PersonConditions) HasAliases() Filter[Person] {
+	return Filter[Person]{expr: "has(aliases)"}
+}
+
+// NotAliases matches Person entities that have no Aliases value.
+func (PersonConditions) NotAliases() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(aliases)"}
+}
+
+// HasScores matches Person entities that have a value for Scores, using
+// has(scores).
+func (PersonConditions) HasScores() Filter[Person] {
+	return Filter[Person]{expr: "has(scores)"}
+}
+
+// NotScores matches Person entities that have no Scores value.
+func (PersonConditions) NotScores() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(scores)"}
+}
+
+// HasAvatar matches Person entities that have a value for Avatar, using
+// has(avatar).
+func (PersonConditions) HasAvatar() Filter[Person] {
+	return Filter[Person]{expr: "has(avatar)"}
+}
+
+// NotAvatar matches Person entities that have no Avatar value.
+func (PersonConditions) NotAvatar() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(avatar)"}
+}
+
+// HasHome matches Person entities that have a value for Home, using
+// has(home).
+func (PersonConditions) HasHome() Filter[Person] {
+	return Filter[Person]{expr: "has(home)"}
+}
+
+// NotHome matches Person entities that have no Home value.
+func (PersonConditions) NotHome() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(home)"}
+}
+
+// HasTags matches Person entities that have a value for Tags, using
+// has(tags).
+func (PersonConditions) HasTags() Filter[Person] {
+	return Filter[Person]{expr: "has(tags)"}
+}
+
+// NotTags matches Person entities that have no Tags value.
+func (PersonConditions) NotTags() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(tags)"}
+}
+
+// TagsContains matches Person entities whose Tags include any of the
+// Tag nodes with the given uids, using uid_in(tags, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (PersonConditions) TagsContains(uids ...string) Filter[Person] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Person]{err: fmt.Errorf("Person.Tags: %w", err)}
+	}
+	return Filter[Person]{expr: "uid_in(tags, " + list + ")"}
+}
+
+// HomeNear matches Person entities whose Home lies within distMeters of (lat, lng).
+func (PersonConditions) HomeNear(lat, lng, distMeters float64) Filter[Person] {
+	return Filter[Person]{expr: "near(home, " + GeoPoint{Lat: lat, Lng: lng}.geoJSON() + ", " + formatFloat(distMeters) + ")"}
+}
+
+// HomeWithin matches Person entities whose Home lies within polygon.
+func (PersonConditions) HomeWithin(polygon GeoPolygon) Filter[Person] {
+	return Filter[Person]{expr: "within(home, " + polygon.geoJSON() + ")"}
+}
+
+// HomeContains matches Person entities whose Home contains point.
+func (PersonConditions) HomeContains(point GeoPoint) Filter[Person] {
+	return Filter[Person]{expr: "contains(home, " + point.geoJSON() + ")"}
+}
+
+// AliasesAllOfTerms matches Person entities whose Aliases contains all of the terms.
+func (PersonConditions) AliasesAllOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "allofterms(aliases, " + formatString(terms) + ")"}
+}
+
+// AliasesAnyOfTerms matches Person entities whose Aliases contains any of the terms.
+func (PersonConditions) AliasesAnyOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "anyofterms(aliases, " + formatString(terms) + ")"}
+}
+
+// NameGe matches Person entities whose Name sorts at or after value.
+func (PersonConditions) NameGe(value string) Filter[Person] {
+	return Filter[Person]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Person entities whose Name sorts at or before value.
+func (PersonConditions) NameLe(value string) Filter[Person] {
+	return Filter[Person]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Person entities whose Name sorts from from through to,
+// inclusive.
+func (PersonConditions) NameBetween(from, to string) Filter[Person] {
+	return Filter[Person]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
@@ -0,0 +1,29 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+// DQLSchema is the Dgraph schema for the lists data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+aliases: [string] @index(term) .
+avatar: string .
+home: geo @index(geo) .
+label: string .
+name: string @index(exact) .
+scores: [int] .
+tags: [uid] .
+
+type Person {
+	name
+	aliases
+	scores
+	avatar
+	home
+	tags
+}
+
+type Tag {
+	label
+}
+`
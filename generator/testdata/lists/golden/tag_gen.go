@@ -0,0 +1,205 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TagAPI is the set of Tag operations provided by TagClient. Code
+// that depends on TagAPI rather than *TagClient can run against a test double.
+type TagAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Tag, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Tag) error
+	Create(ctx context.Context, v *Tag) (string, error)
+	Update(ctx context.Context, v *Tag) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Tag, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Tag, error)
+}
+
+// TagClient provides typed CRUD operations for Tag entities.
+//
+// Tag is the target of Person.Tags.
+type TagClient struct {
+	conn graphConn
+}
+
+var _ TagAPI = (*TagClient)(nil)
+
+// Get retrieves a single Tag by its UID.
+func (c *TagClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Tag, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Tag
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Tag", tagSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Tag with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *TagClient) GetExpanded(ctx context.Context, uid string) (*Tag, error) {
+	var result Tag
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Tag", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Tag type. A node of another type does not count.
+func (c *TagClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Tag")
+}
+
+// Load populates v with the Tag stored under uid, using c.Tag.Get.
+func (v *Tag) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Tag.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Tag)(nil)
+
+// GetUID returns the Tag's UID, empty until it has been added.
+func (v *Tag) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Tag's UID.
+func (v *Tag) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Tag's dgraph.type values: its DType, or
+// {"Tag"} until Add sets it.
+func (v *Tag) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Tag"}
+}
+
+// String returns a one-line summary of the Tag: its UID.
+func (v Tag) String() string {
+	return fmt.Sprintf("Tag(%s)", v.UID)
+}
+
+// Add inserts a new Tag into the database.
+func (c *TagClient) Add(ctx context.Context, v *Tag) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Tag node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *TagClient) Create(ctx context.Context, v *Tag) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Tag.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Tag"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Tag.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Tag in the database. The UID field must be set.
+func (c *TagClient) Update(ctx context.Context, v *Tag) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Tag with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *TagClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Tag.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetLabel sets the Label of the Tag with the given UID to value, touching
+// no other predicate.
+func (c *TagClient) SetLabel(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"label": value}); err != nil {
+		return fmt.Errorf("Tag.SetLabel: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Tag with the given UID from the database.
+func (c *TagClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// tagSelection returns the DQL selection for a Tag: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func tagSelection(depth int) string {
+	s := "uid dgraph.type label"
+	return s
+}
+
+// List retrieves Tags with optional pagination.
+func (c *TagClient) List(ctx context.Context, opts ...PageOption) ([]Tag, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var tags []Tag
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// Find retrieves Tags matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *TagClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Tag, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var tags []Tag
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&tags)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+// TagOption is a functional option for configuring Tag mutations.
+type TagOption func(*Tag)
+
+// WithTagLabel sets the Label field on a Tag.
+func WithTagLabel(v string) TagOption {
+	return func(e *Tag) {
+		e.Label = v
+	}
+}
+
+// ApplyTagOptions applies the given options to a Tag.
+func ApplyTagOptions(e *Tag, opts ...TagOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,223 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"context"
+)
+
+// TagQuery is a typed query builder for Tag entities.
+type TagQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Tag entities.
+func (c *TagClient) Query(ctx context.Context) *TagQuery {
+	return &TagQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *TagQuery) Filter(f string) *TagQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *TagQuery) where(expr string) *TagQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from TagWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *TagQuery) Where(f Filter[Tag]) *TagQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasLabel filters to Tag entities that have a value for Label, using
+// has(label).
+func (q *TagQuery) HasLabel() *TagQuery {
+	return q.Where(TagWhere.HasLabel())
+}
+
+// NotLabel filters to Tag entities that have no Label value.
+func (q *TagQuery) NotLabel() *TagQuery {
+	return q.Where(TagWhere.NotLabel())
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *TagQuery) OrderAsc(field string) *TagQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *TagQuery) OrderDesc(field string) *TagQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *TagQuery) First(n int) *TagQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *TagQuery) Offset(n int) *TagQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Tag and the edges added by the With methods.
+func (q *TagQuery) selection() string {
+	s := tagSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *TagQuery) dql() string {
+	return nodesQuery("Tag", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *TagQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Tag with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Tag.
+func (q *TagQuery) GetByUID(uid string) (*Tag, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Tag
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Tag", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *TagQuery) Exec(dst *[]Tag) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Tag{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *TagQuery) ExecAndCount(dst *[]Tag) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Tag", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Tag{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// TagWhere builds the conditions on Tag fields that TagQuery.Where takes.
+var TagWhere TagConditions
+
+// TagConditions has a method for each typed filter of TagQuery, returning it as a
+// Filter[Tag] to combine with And, Or, and Not.
+type TagConditions struct{}
+
+// HasLabel matches Tag entities that have a value for Label, using
+// has(label).
+func (TagConditions) HasLabel() Filter[Tag] {
+	return Filter[Tag]{expr: "has(label)"}
+}
+
+// NotLabel matches Tag entities that have no Label value.
+func (TagConditions) NotLabel() Filter[Tag] {
+	return Filter[Tag]{expr: "NOT has(label)"}
+}
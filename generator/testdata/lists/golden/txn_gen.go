@@ -0,0 +1,280 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package lists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Txn groups writes to several entities into a single Dgraph transaction.
+// Reads made through it see its own uncommitted writes. Every Txn must end
+// with Commit or Discard; calling Discard after Commit is a no-op, so
+// `defer txn.Discard(ctx)` is safe.
+type Txn struct {
+	txn     *dgo.Txn
+	cleanup func()
+	done    sync.Once
+	Person  *PersonTxn
+	Tag     *TagTxn
+}
+
+// NewTxn starts a transaction on the client's connection.
+func (c *Client) NewTxn(ctx context.Context) (*Txn, error) {
+	dg, cleanup, err := c.conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	t := &Txn{txn: dg.NewTxn(), cleanup: cleanup}
+	t.Person = &PersonTxn{txn: t}
+	t.Tag = &TagTxn{txn: t}
+	return t, nil
+}
+
+// DgraphTxn returns the underlying dgo transaction, for operations the
+// generated API does not cover.
+func (t *Txn) DgraphTxn() *dgo.Txn {
+	return t.txn
+}
+
+// Commit commits the transaction's writes.
+func (t *Txn) Commit(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Commit(ctx)
+}
+
+// Discard abandons the transaction's writes.
+func (t *Txn) Discard(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Discard(ctx)
+}
+
+// release returns the connection obtained by NewTxn, once.
+func (t *Txn) release() {
+	t.done.Do(t.cleanup)
+}
+
+// query runs a read-only DQL query inside the transaction.
+func (t *Txn) query(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	resp, err := t.txn.QueryWithVars(ctx, q, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// PersonTxn provides Person operations within a Txn.
+type PersonTxn struct {
+	txn *Txn
+}
+
+var _ PersonAPI = (*PersonTxn)(nil)
+
+// Get retrieves a single Person by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *PersonTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Person
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Person", personSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Person type, seeing the transaction's own writes.
+func (t *PersonTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Person")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *PersonTxn) Add(ctx context.Context, v *Person) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Person"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *PersonTxn) Create(ctx context.Context, v *Person) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Person.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Person"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Person.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *PersonTxn) Update(ctx context.Context, v *Person) error {
+	if v.UID == "" {
+		return errors.New("Person.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Person with the given UID in the transaction.
+func (t *PersonTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Person entities with optional pagination.
+func (t *PersonTxn) List(ctx context.Context, opts ...PageOption) ([]Person, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Person entities matching the DQL filter expression, with
+// optional pagination.
+func (t *PersonTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Person
+	err := queryNodes(ctx, t.txn.query, "Person", filter, "", personSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TagTxn provides Tag operations within a Txn.
+type TagTxn struct {
+	txn *Txn
+}
+
+var _ TagAPI = (*TagTxn)(nil)
+
+// Get retrieves a single Tag by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *TagTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Tag, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Tag
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Tag", tagSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Tag type, seeing the transaction's own writes.
+func (t *TagTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Tag")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *TagTxn) Add(ctx context.Context, v *Tag) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Tag"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *TagTxn) Create(ctx context.Context, v *Tag) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Tag.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Tag"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Tag.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *TagTxn) Update(ctx context.Context, v *Tag) error {
+	if v.UID == "" {
+		return errors.New("Tag.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Tag with the given UID in the transaction.
+func (t *TagTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Tag entities with optional pagination.
+func (t *TagTxn) List(ctx context.Context, opts ...PageOption) ([]Tag, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Tag entities matching the DQL filter expression, with
+// optional pagination.
+func (t *TagTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Tag, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Tag
+	err := queryNodes(ctx, t.txn.query, "Tag", filter, "", tagSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkPlaceToMap measures converting a Place to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkPlaceToMap(b *testing.B) {
+	v := Place{
+		UID: "0x1",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkPlaceQueryBuild measures building a Place query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkPlaceQueryBuild(b *testing.B) {
+	c := &PlaceClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PlaceAPI is the set of Place operations provided by PlaceClient. Code
+// that depends on PlaceAPI rather than *PlaceClient can run against a test double.
+type PlaceAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Place, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Place) error
+	Create(ctx context.Context, v *Place) (string, error)
+	Update(ctx context.Context, v *Place) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Place, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Place, error)
+}
+
+// PlaceClient provides typed CRUD operations for Place entities.
+//
+// Place stores its name once per language tag.
+type PlaceClient struct {
+	conn graphConn
+}
+
+var _ PlaceAPI = (*PlaceClient)(nil)
+
+// Get retrieves a single Place by its UID.
+func (c *PlaceClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Place, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Place
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Place", placeSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Place with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *PlaceClient) GetExpanded(ctx context.Context, uid string) (*Place, error) {
+	var result Place
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Place", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Place type. A node of another type does not count.
+func (c *PlaceClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Place")
+}
+
+// Load populates v with the Place stored under uid, using c.Place.Get.
+func (v *Place) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Place.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Place)(nil)
+
+// GetUID returns the Place's UID, empty until it has been added.
+func (v *Place) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Place's UID.
+func (v *Place) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Place's dgraph.type values: its DType, or
+// {"Place"} until Add sets it.
+func (v *Place) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Place"}
+}
+
+// String returns a one-line summary of the Place: its UID.
+func (v Place) String() string {
+	return fmt.Sprintf("Place(%s)", v.UID)
+}
+
+// Add inserts a new Place into the database.
+func (c *PlaceClient) Add(ctx context.Context, v *Place) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Place node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *PlaceClient) Create(ctx context.Context, v *Place) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Place.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Place"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Place.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Place in the database. The UID field must be set.
+func (c *PlaceClient) Update(ctx context.Context, v *Place) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Place with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *PlaceClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Place.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Place with the given UID from the database.
+func (c *PlaceClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// placeSelection returns the DQL selection for a Place: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func placeSelection(depth int) string {
+	s := "uid dgraph.type name@en name@fr name@pt-BR"
+	return s
+}
+
+// List retrieves Places with optional pagination.
+func (c *PlaceClient) List(ctx context.Context, opts ...PageOption) ([]Place, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var places []Place
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&places)
+	if err != nil {
+		return nil, err
+	}
+	return places, nil
+}
+
+// Find retrieves Places matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *PlaceClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Place, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var places []Place
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&places)
+	if err != nil {
+		return nil, err
+	}
+	return places, nil
+}
+
+// NameEn returns the "en" value of Name.
+func (v *Place) NameEn() string {
+	return v.Name["en"]
+}
+
+// SetNameEn sets the "en" value of Name.
+func (v *Place) SetNameEn(s string) {
+	if v.Name == nil {
+		v.Name = make(map[string]string)
+	}
+	v.Name["en"] = s
+}
+
+// NameFr returns the "fr" value of Name.
+func (v *Place) NameFr() string {
+	return v.Name["fr"]
+}
+
+// SetNameFr sets the "fr" value of Name.
+func (v *Place) SetNameFr(s string) {
+	if v.Name == nil {
+		v.Name = make(map[string]string)
+	}
+	v.Name["fr"] = s
+}
+
+// NamePtBR returns the "pt-BR" value of Name.
+func (v *Place) NamePtBR() string {
+	return v.Name["pt-BR"]
+}
+
+// SetNamePtBR sets the "pt-BR" value of Name.
+func (v *Place) SetNamePtBR(s string) {
+	if v.Name == nil {
+		v.Name = make(map[string]string)
+	}
+	v.Name["pt-BR"] = s
+}
@@ -0,0 +1,74 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Place in the form Dgraph expects:
+//   - Localized maps are one language-tagged predicate per locale, e.g.
+//     "name@en". A language that is not one of the field's locales is an
+//     error.
+func (v Place) MarshalJSON() ([]byte, error) {
+	type plain Place
+	out := struct {
+		plain
+		Name     *struct{} `json:"name,omitempty"`
+		NameEn   *string   `json:"name@en,omitempty"`
+		NameFr   *string   `json:"name@fr,omitempty"`
+		NamePtBR *string   `json:"name@pt-BR,omitempty"`
+	}{plain: plain(v)}
+	for locale := range v.Name {
+		switch locale {
+		case "en", "fr", "pt-BR":
+		default:
+			return nil, fmt.Errorf("Place.Name: %q is not one of its locales", locale)
+		}
+	}
+	if value, ok := v.Name["en"]; ok {
+		out.NameEn = &value
+	}
+	if value, ok := v.Name["fr"]; ok {
+		out.NameFr = &value
+	}
+	if value, ok := v.Name["pt-BR"]; ok {
+		out.NamePtBR = &value
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Place from a Dgraph query result:
+//   - Localized maps are filled from their language-tagged predicates.
+func (v *Place) UnmarshalJSON(data []byte) error {
+	type plain Place
+	in := struct {
+		*plain
+		NameEn   *string `json:"name@en"`
+		NameFr   *string `json:"name@fr"`
+		NamePtBR *string `json:"name@pt-BR"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if in.NameEn != nil {
+		if v.Name == nil {
+			v.Name = make(map[string]string)
+		}
+		v.Name["en"] = *in.NameEn
+	}
+	if in.NameFr != nil {
+		if v.Name == nil {
+			v.Name = make(map[string]string)
+		}
+		v.Name["fr"] = *in.NameFr
+	}
+	if in.NamePtBR != nil {
+		if v.Name == nil {
+			v.Name = make(map[string]string)
+		}
+		v.Name["pt-BR"] = *in.NamePtBR
+	}
+	return nil
+}
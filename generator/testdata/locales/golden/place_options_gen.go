@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+// PlaceOption is a functional option for configuring Place mutations.
+type PlaceOption func(*Place)
+
+// WithPlaceName sets the Name field on a Place.
+func WithPlaceName(v map[string]string) PlaceOption {
+	return func(e *Place) {
+		e.Name = v
+	}
+}
+
+// ApplyPlaceOptions applies the given options to a Place.
+func ApplyPlaceOptions(e *Place, opts ...PlaceOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
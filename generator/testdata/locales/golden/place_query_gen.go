@@ -0,0 +1,223 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+import (
+	"context"
+)
+
+// PlaceQuery is a typed query builder for Place entities.
+type PlaceQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Place entities.
+func (c *PlaceClient) Query(ctx context.Context) *PlaceQuery {
+	return &PlaceQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *PlaceQuery) Filter(f string) *PlaceQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *PlaceQuery) where(expr string) *PlaceQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PlaceWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PlaceQuery) Where(f Filter[Place]) *PlaceQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Place entities that have a value for Name, using
+// has(name).
+func (q *PlaceQuery) HasName() *PlaceQuery {
+	return q.Where(PlaceWhere.HasName())
+}
+
+// NotName filters to Place entities that have no Name value.
+func (q *PlaceQuery) NotName() *PlaceQuery {
+	return q.Where(PlaceWhere.NotName())
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *PlaceQuery) OrderAsc(field string) *PlaceQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *PlaceQuery) OrderDesc(field string) *PlaceQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *PlaceQuery) First(n int) *PlaceQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *PlaceQuery) Offset(n int) *PlaceQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Place and the edges added by the With methods.
+func (q *PlaceQuery) selection() string {
+	s := placeSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PlaceQuery) dql() string {
+	return nodesQuery("Place", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PlaceQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Place with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Place.
+func (q *PlaceQuery) GetByUID(uid string) (*Place, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Place
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Place", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *PlaceQuery) Exec(dst *[]Place) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Place{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *PlaceQuery) ExecAndCount(dst *[]Place) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Place", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Place{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PlaceWhere builds the conditions on Place fields that PlaceQuery.Where takes.
+var PlaceWhere PlaceConditions
+
+// PlaceConditions has a method for each typed filter of PlaceQuery, returning it as a
+// Filter[Place] to combine with And, Or, and Not.
+type PlaceConditions struct{}
+
+// HasName matches Place entities that have a value for Name, using
+// has(name).
+func (PlaceConditions) HasName() Filter[Place] {
+	return Filter[Place]{expr: "has(name)"}
+}
+
+// NotName matches Place entities that have no Name value.
+func (PlaceConditions) NotName() Filter[Place] {
+	return Filter[Place]{expr: "NOT has(name)"}
+}
@@ -0,0 +1,14 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package locales
+
+// DQLSchema is the Dgraph schema for the locales data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+name: string @lang .
+
+type Place {
+	name
+}
+`
@@ -0,0 +1,8 @@
+package locales
+
+// Place stores its name once per language tag.
+type Place struct {
+	UID   string            `json:"uid,omitempty"`
+	DType []string          `json:"dgraph.type,omitempty"`
+	Name  map[string]string `json:"name,omitempty" dgraph:"locales=en,fr,pt-BR"`
+}
@@ -0,0 +1,14 @@
+package maps
+
+// Attributes is a named map type.
+type Attributes map[string]string
+
+// Asset keeps arbitrary key/value metadata in map fields.
+type Asset struct {
+	UID    string            `json:"uid,omitempty"`
+	DType  []string          `json:"dgraph.type,omitempty"`
+	Name   string            `json:"name,omitempty" dgraph:"index=exact"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Scores map[string]int    `json:"scores"`
+	Attrs  Attributes        `json:"attrs,omitempty"`
+}
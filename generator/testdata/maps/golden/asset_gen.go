@@ -0,0 +1,259 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// AssetAPI is the set of Asset operations provided by AssetClient. Code
+// that depends on AssetAPI rather than *AssetClient can run against a test double.
+type AssetAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Asset, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Asset) error
+	Create(ctx context.Context, v *Asset) (string, error)
+	Update(ctx context.Context, v *Asset) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Asset, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Asset, error)
+}
+
+// AssetClient provides typed CRUD operations for Asset entities.
+//
+// Asset keeps arbitrary key/value metadata in map fields.
+type AssetClient struct {
+	conn graphConn
+}
+
+var _ AssetAPI = (*AssetClient)(nil)
+
+// Get retrieves a single Asset by its UID.
+func (c *AssetClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Asset, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Asset
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Asset", assetSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Asset with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *AssetClient) GetExpanded(ctx context.Context, uid string) (*Asset, error) {
+	var result Asset
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Asset", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Asset type. A node of another type does not count.
+func (c *AssetClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Asset")
+}
+
+// Load populates v with the Asset stored under uid, using c.Asset.Get.
+func (v *Asset) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Asset.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Asset)(nil)
+
+// GetUID returns the Asset's UID, empty until it has been added.
+func (v *Asset) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Asset's UID.
+func (v *Asset) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Asset's dgraph.type values: its DType, or
+// {"Asset"} until Add sets it.
+func (v *Asset) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Asset"}
+}
+
+// String returns a one-line summary of the Asset: its UID.
+func (v Asset) String() string {
+	return fmt.Sprintf("Asset(%s)", v.UID)
+}
+
+// Add inserts a new Asset into the database.
+func (c *AssetClient) Add(ctx context.Context, v *Asset) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Asset node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *AssetClient) Create(ctx context.Context, v *Asset) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Asset.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Asset"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Asset.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Asset in the database. The UID field must be set.
+func (c *AssetClient) Update(ctx context.Context, v *Asset) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Asset with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *AssetClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Asset.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Asset with the given UID to value, touching
+// no other predicate.
+func (c *AssetClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Asset.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Asset with the given UID from the database.
+func (c *AssetClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// assetSelection returns the DQL selection for an Asset: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func assetSelection(depth int) string {
+	s := "uid dgraph.type name labels scores attrs"
+	return s
+}
+
+// List retrieves Assets with optional pagination.
+func (c *AssetClient) List(ctx context.Context, opts ...PageOption) ([]Asset, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var assets []Asset
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&assets)
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// Find retrieves Assets matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *AssetClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Asset, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var assets []Asset
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&assets)
+	if err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// GetByName retrieves the Asset entities whose Name is value, with optional
+// pagination.
+func (c *AssetClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Asset, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Asset
+	err := queryNodes(ctx, c.conn.QueryRaw, "Asset", "eq(name, "+formatString(value)+")", "", assetSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// LabelsValue returns the value stored under key in Labels.
+func (v *Asset) LabelsValue(key string) string {
+	return v.Labels[key]
+}
+
+// SetLabelsValue stores value under key in Labels, creating the map if needed.
+func (v *Asset) SetLabelsValue(key string, value string) {
+	if v.Labels == nil {
+		v.Labels = make(map[string]string)
+	}
+	v.Labels[key] = value
+}
+
+// ScoresValue returns the value stored under key in Scores.
+func (v *Asset) ScoresValue(key string) int {
+	return v.Scores[key]
+}
+
+// SetScoresValue stores value under key in Scores, creating the map if needed.
+func (v *Asset) SetScoresValue(key string, value int) {
+	if v.Scores == nil {
+		v.Scores = make(map[string]int)
+	}
+	v.Scores[key] = value
+}
+
+// AttrsValue returns the value stored under key in Attrs.
+func (v *Asset) AttrsValue(key string) string {
+	return v.Attrs[key]
+}
+
+// SetAttrsValue stores value under key in Attrs, creating the map if needed.
+func (v *Asset) SetAttrsValue(key string, value string) {
+	if v.Attrs == nil {
+		v.Attrs = make(Attributes)
+	}
+	v.Attrs[key] = value
+}
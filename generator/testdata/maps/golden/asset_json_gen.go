@@ -0,0 +1,57 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes an Asset in the form Dgraph expects:
+//   - Maps are JSON text in a string. An empty map is left out if its json
+//     tag has omitempty, and encoded as null otherwise.
+func (v Asset) MarshalJSON() ([]byte, error) {
+	type plain Asset
+	out := struct {
+		plain
+		Labels *string `json:"labels,omitempty"`
+		Scores *string `json:"scores"`
+		Attrs  *string `json:"attrs,omitempty"`
+	}{plain: plain(v)}
+	var err error
+	if out.Labels, err = encodeMap(v.Labels); err != nil {
+		return nil, fmt.Errorf("Asset.Labels: %w", err)
+	}
+	if out.Scores, err = encodeMap(v.Scores); err != nil {
+		return nil, fmt.Errorf("Asset.Scores: %w", err)
+	}
+	if out.Attrs, err = encodeMap(v.Attrs); err != nil {
+		return nil, fmt.Errorf("Asset.Attrs: %w", err)
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an Asset from a Dgraph query result:
+//   - Maps may be JSON text in a string or plain JSON objects.
+func (v *Asset) UnmarshalJSON(data []byte) error {
+	type plain Asset
+	in := struct {
+		*plain
+		Labels json.RawMessage `json:"labels"`
+		Scores json.RawMessage `json:"scores"`
+		Attrs  json.RawMessage `json:"attrs"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeMap(in.Labels, &v.Labels); err != nil {
+		return fmt.Errorf("Asset.Labels: %w", err)
+	}
+	if err := decodeMap(in.Scores, &v.Scores); err != nil {
+		return fmt.Errorf("Asset.Scores: %w", err)
+	}
+	if err := decodeMap(in.Attrs, &v.Attrs); err != nil {
+		return fmt.Errorf("Asset.Attrs: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+// AssetOption is a functional option for configuring Asset mutations.
+type AssetOption func(*Asset)
+
+// WithAssetName sets the Name field on an Asset.
+func WithAssetName(v string) AssetOption {
+	return func(e *Asset) {
+		e.Name = v
+	}
+}
+
+// WithAssetLabels sets the Labels field on an Asset.
+func WithAssetLabels(v map[string]string) AssetOption {
+	return func(e *Asset) {
+		e.Labels = v
+	}
+}
+
+// WithAssetScores sets the Scores field on an Asset.
+func WithAssetScores(v map[string]int) AssetOption {
+	return func(e *Asset) {
+		e.Scores = v
+	}
+}
+
+// WithAssetAttrs sets the Attrs field on an Asset.
+func WithAssetAttrs(v Attributes) AssetOption {
+	return func(e *Asset) {
+		e.Attrs = v
+	}
+}
+
+// ApplyAssetOptions applies the given options to an Asset.
+func ApplyAssetOptions(e *Asset, opts ...AssetOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,321 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+import (
+	"context"
+)
+
+// AssetQuery is a typed query builder for Asset entities.
+type AssetQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Asset entities.
+func (c *AssetClient) Query(ctx context.Context) *AssetQuery {
+	return &AssetQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *AssetQuery) Filter(f string) *AssetQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *AssetQuery) where(expr string) *AssetQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from AssetWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *AssetQuery) Where(f Filter[Asset]) *AssetQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Asset entities that have a value for Name, using
+// has(name).
+func (q *AssetQuery) HasName() *AssetQuery {
+	return q.Where(AssetWhere.HasName())
+}
+
+// NotName filters to Asset entities that have no Name value.
+func (q *AssetQuery) NotName() *AssetQuery {
+	return q.Where(AssetWhere.NotName())
+}
+
+// HasLabels filters to Asset entities that have a value for Labels, using
+// has(labels).
+func (q *AssetQuery) HasLabels() *AssetQuery {
+	return q.Where(AssetWhere.HasLabels())
+}
+
+// NotLabels filters to Asset entities that have no Labels value.
+func (q *AssetQuery) NotLabels() *AssetQuery {
+	return q.Where(AssetWhere.NotLabels())
+}
+
+// HasScores filters to Asset entities that have a value for Scores, using
+// has(scores).
+func (q *AssetQuery) HasScores() *AssetQuery {
+	return q.Where(AssetWhere.HasScores())
+}
+
+// NotScores filters to Asset entities that have no Scores value.
+func (q *AssetQuery) NotScores() *AssetQuery {
+	return q.Where(AssetWhere.NotScores())
+}
+
+// HasAttrs filters to Asset entities that have a value for Attrs, using
+// has(attrs).
+func (q *AssetQuery) HasAttrs() *AssetQuery {
+	return q.Where(AssetWhere.HasAttrs())
+}
+
+// NotAttrs filters to Asset entities that have no Attrs value.
+func (q *AssetQuery) NotAttrs() *AssetQuery {
+	return q.Where(AssetWhere.NotAttrs())
+}
+
+// NameGe filters to Asset entities whose Name sorts at or after value.
+func (q *AssetQuery) NameGe(value string) *AssetQuery {
+	return q.Where(AssetWhere.NameGe(value))
+}
+
+// NameLe filters to Asset entities whose Name sorts at or before value.
+func (q *AssetQuery) NameLe(value string) *AssetQuery {
+	return q.Where(AssetWhere.NameLe(value))
+}
+
+// NameBetween filters to Asset entities whose Name sorts from from through to,
+// inclusive.
+func (q *AssetQuery) NameBetween(from, to string) *AssetQuery {
+	return q.Where(AssetWhere.NameBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *AssetQuery) OrderAsc(field string) *AssetQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *AssetQuery) OrderDesc(field string) *AssetQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *AssetQuery) First(n int) *AssetQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *AssetQuery) Offset(n int) *AssetQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Asset and the edges added by the With methods.
+func (q *AssetQuery) selection() string {
+	s := assetSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *AssetQuery) dql() string {
+	return nodesQuery("Asset", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *AssetQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Asset with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Asset.
+func (q *AssetQuery) GetByUID(uid string) (*Asset, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Asset
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Asset", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *AssetQuery) Exec(dst *[]Asset) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Asset{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *AssetQuery) ExecAndCount(dst *[]Asset) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Asset", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Asset{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// AssetWhere builds the conditions on Asset fields that AssetQuery.Where takes.
+var AssetWhere AssetConditions
+
+// AssetConditions has a method for each typed filter of AssetQuery, returning it as a
+// Filter[Asset] to combine with And, Or, and Not.
+type AssetConditions struct{}
+
+// HasName matches Asset entities that have a value for Name, using
+// has(name).
+func (AssetConditions) HasName() Filter[Asset] {
+	return Filter[Asset]{expr: "has(name)"}
+}
+
+// NotName matches Asset entities that have no Name value.
+func (AssetConditions) NotName() Filter[Asset] {
+	return Filter[Asset]{expr: "NOT has(name)"}
+}
+
+// HasLabels matches Asset entities that have a value for Labels, using
+// has(labels).
+func (AssetConditions) HasLabels() Filter[Asset] {
+	return Filter[Asset]{expr: "has(labels)"}
+}
+
+// NotLabels matches Asset entities that have no Labels value.
+func (AssetConditions) NotLabels() Filter[Asset] {
+	return Filter[Asset]{expr: "NOT has(labels)"}
+}
+
+// HasScores matches Asset entities that have a value for Scores, using
+// has(scores).
+func (AssetConditions) HasScores() Filter[Asset] {
+	return Filter[Asset]{expr: "has(scores)"}
+}
+
+// NotScores matches Asset entities that have no Scores value.
+func (AssetConditions) NotScores() Filter[Asset] {
+	return Filter[Asset]{expr: "NOT has(scores)"}
+}
+
+// HasAttrs matches Asset entities that have a value for Attrs, using
+// has(attrs).
+func (AssetConditions) HasAttrs() Filter[Asset] {
+	return Filter[Asset]{expr: "has(attrs)"}
+}
+
+// NotAttrs matches Asset entities that have no Attrs value.
+func (AssetConditions) NotAttrs() Filter[Asset] {
+	return Filter[Asset]{expr: "NOT has(attrs)"}
+}
+
+// NameGe matches Asset entities whose Name sorts at or after value.
+func (AssetConditions) NameGe(value string) Filter[Asset] {
+	return Filter[Asset]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Asset entities whose Name sorts at or before value.
+func (AssetConditions) NameLe(value string) Filter[Asset] {
+	return Filter[Asset]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Asset entities whose Name sorts from from through to,
+// inclusive.
+func (AssetConditions) NameBetween(from, to string) Filter[Asset] {
+	return Filter[Asset]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
@@ -0,0 +1,44 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// encodeMap encodes m as JSON text, the form in which map fields are stored,
+// or returns nil for an empty map.
+func encodeMap[M ~map[K]V, K comparable, V any](m M) (*string, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	s := string(data)
+	return &s, nil
+}
+
+// decodeMap decodes raw, a JSON string holding JSON text or a plain JSON
+// object, into dst, replacing its contents. A missing value leaves dst
+// unchanged; null or "" sets it to nil.
+func decodeMap[M ~map[K]V, K comparable, V any](raw json.RawMessage, dst *M) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return err
+		}
+		raw = []byte(s)
+	}
+	*dst = nil
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, dst)
+}
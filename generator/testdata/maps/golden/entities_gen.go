@@ -0,0 +1,14 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+// Entity is implemented by a pointer to every entity struct in the package,
+// for code that handles all entity types alike, e.g. logging or auditing.
+type Entity interface {
+	// GetUID returns the node's UID, empty until the node has been added.
+	GetUID() string
+	// SetUID sets the node's UID.
+	SetUID(uid string)
+	// Types returns the node's dgraph.type values.
+	Types() []string
+}
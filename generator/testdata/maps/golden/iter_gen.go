@@ -0,0 +1,154 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+import (
+	"context"
+	"iter"
+)
+
+// ListIter returns an iterator over all Assets.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *AssetClient) ListIter(ctx context.Context) iter.Seq2[Asset, error] {
+	return func(yield func(Asset, error) bool) {
+		offset := 0
+		for {
+			results, err := c.List(ctx, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Asset
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// AssetIterator streams Asset entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type AssetIterator struct {
+	client   *AssetClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Asset
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Asset entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *AssetClient) Iterator(opts ...PageOption) *AssetIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &AssetIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Asset entities after cursor,
+// a value previously returned by AssetIterator.Cursor.
+func (c *AssetClient) ResumeIterator(cursor string, opts ...PageOption) *AssetIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Asset, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *AssetIterator) Next(ctx context.Context) (*Asset, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Asset
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Asset", assetSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Asset{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *AssetIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Asset returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *AssetIterator) Cursor() string {
+	return it.after
+}
+
+// StreamAssets sends all Asset entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *AssetClient) StreamAssets(ctx context.Context, opts ...PageOption) (<-chan *Asset, <-chan error) {
+	out := make(chan *Asset)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
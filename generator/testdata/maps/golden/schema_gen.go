@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package maps
+
+// DQLSchema is the Dgraph schema for the maps data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+attrs: string .
+labels: string .
+name: string @index(exact) .
+scores: string .
+
+type Asset {
+	name
+	labels
+	scores
+	attrs
+}
+`
@@ -0,0 +1,416 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MockClient is an in-memory stand-in for Client, for unit tests that run
+// without Dgraph. Each entity's nodes are kept in a map keyed by UID. Find
+// understands eq() filters on hash- and exact-indexed predicates, joined by AND.
+// Like Client, it is safe for concurrent use, and it stores and returns copies,
+// so callers may change the values they pass in and get back.
+type MockClient struct {
+	Person *MockPersonClient
+	Team   *MockTeamClient
+}
+
+// NewMockClient returns a MockClient with no stored nodes.
+func NewMockClient() *MockClient {
+	uids := &mockUIDs{}
+	return &MockClient{
+		Person: &MockPersonClient{uids: uids, nodes: make(map[string]Person)},
+		Team:   &MockTeamClient{uids: uids, nodes: make(map[string]Team)},
+	}
+}
+
+// mockUIDs allocates UIDs shared by all entities of a MockClient.
+type mockUIDs struct {
+	mu   sync.Mutex
+	last uint64
+}
+
+func (u *mockUIDs) next() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.last++
+	return fmt.Sprintf("0x%x", u.last)
+}
+
+// mockCond is a single eq(predicate, value) term of a filter.
+type mockCond struct {
+	predicate string
+	value     string
+}
+
+var mockEq = regexp.MustCompile(`^eq\(\s*([^,\s]+)\s*,\s*(.+?)\s*\)$`)
+
+// parseMockFilter splits filter into its eq() terms. Terms may be joined by AND
+// and wrapped in parentheses; anything else is rejected.
+func parseMockFilter(filter string) ([]mockCond, error) {
+	terms, err := splitMockFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	var conds []mockCond
+	for _, term := range terms {
+		// Drop the grouping parentheses that Query's where() adds around terms.
+		term = strings.TrimLeft(term, "( ")
+		for unquotedCount(term, ')') > unquotedCount(term, '(') && strings.HasSuffix(term, ")") {
+			term = strings.TrimSpace(strings.TrimSuffix(term, ")"))
+		}
+		m := mockEq.FindStringSubmatch(term)
+		if m == nil {
+			return nil, fmt.Errorf("mock: unsupported filter %q", term)
+		}
+		value := m[2]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		conds = append(conds, mockCond{predicate: m[1], value: value})
+	}
+	return conds, nil
+}
+
+// splitMockFilter splits filter at each AND outside its quoted strings, so
+// that a value such as "Fast AND Furious" stays in one term.
+func splitMockFilter(filter string) ([]string, error) {
+	var terms []string
+	start, quoted := 0, false
+	for i := 0; i < len(filter); i++ {
+		switch {
+		case quoted && filter[i] == '\\':
+			i++
+		case filter[i] == '"':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(filter[i:], " AND "):
+			terms = append(terms, filter[start:i])
+			i += len(" AND ") - 1
+			start = i + 1
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("mock: unterminated string in filter %q", filter)
+	}
+	return append(terms, filter[start:]), nil
+}
+
+// unquotedCount returns the number of times c occurs in s outside its quoted
+// strings.
+func unquotedCount(s string, c byte) int {
+	n, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quoted && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && s[i] == c:
+			n++
+		}
+	}
+	return n
+}
+
+// mockCopy returns a deep copy of v made by a JSON round trip, as a value
+// stored in Dgraph would be, so that the copy shares no slices with v.
+func mockCopy[T any](v T) (T, error) {
+	var c T
+	data, err := json.Marshal(v)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// mockPage returns copies of the nodes that satisfy keep, ordered by UID and
+// paged by opts.
+func mockPage[T any](nodes map[string]T, keep func(T) bool, opts []PageOption) ([]T, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	uids := make([]string, 0, len(nodes))
+	for uid, v := range nodes {
+		if keep(v) {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		a, _ := strconv.ParseUint(strings.TrimPrefix(uids[i], "0x"), 16, 64)
+		b, _ := strconv.ParseUint(strings.TrimPrefix(uids[j], "0x"), 16, 64)
+		return a < b
+	})
+	if cfg.offset >= len(uids) {
+		return nil, nil
+	}
+	uids = uids[cfg.offset:]
+	if cfg.first > 0 && cfg.first < len(uids) {
+		uids = uids[:cfg.first]
+	}
+	results := make([]T, len(uids))
+	for i, uid := range uids {
+		v, err := mockCopy(nodes[uid])
+		if err != nil {
+			return nil, err
+		}
+		results[i] = v
+	}
+	return results, nil
+}
+
+// MockPersonClient is an in-memory PersonAPI.
+type MockPersonClient struct {
+	uids  *mockUIDs
+	mu    sync.Mutex
+	nodes map[string]Person
+}
+
+var _ PersonAPI = (*MockPersonClient)(nil)
+
+// Get returns the stored Person with the given UID, or ErrNotFound.
+func (c *MockPersonClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.nodes[uid]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	v, err := mockCopy(v)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Exists reports whether a Person with the given UID is stored.
+func (c *MockPersonClient) Exists(ctx context.Context, uid string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.nodes[uid]
+	return ok, nil
+}
+
+// Add stores v, assigning it a UID if it has none.
+func (c *MockPersonClient) Add(ctx context.Context, v *Person) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	if v.UID == "" {
+		v.UID = c.uids.next()
+	}
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Create stores v, which must not have a UID yet, and returns the UID it
+// assigns. WithUpsert is accepted, but as with Add no existing node is reused.
+func (c *MockPersonClient) Create(ctx context.Context, v *Person, opts ...CreateOption) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Person.Create: UID is already set to %s", v.UID)
+	}
+	if err := c.Add(ctx, v); err != nil {
+		return "", err
+	}
+	return v.UID, nil
+}
+
+// Update replaces the stored Person with v, or returns ErrNotFound.
+func (c *MockPersonClient) Update(ctx context.Context, v *Person) error {
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.nodes[v.UID]; !ok {
+		return ErrNotFound
+	}
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Delete removes the Person with the given UID, if stored.
+func (c *MockPersonClient) Delete(ctx context.Context, uid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.nodes, uid)
+	return nil
+}
+
+// List returns the stored People in UID order with optional pagination.
+func (c *MockPersonClient) List(ctx context.Context, opts ...PageOption) ([]Person, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(Person) bool { return true }, opts)
+}
+
+// Find returns the stored People matching filter, which may only use
+// eq() on hash- or exact-indexed predicates.
+func (c *MockPersonClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error) {
+	conds, err := parseMockFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conds {
+		if _, ok := mockPersonValue(Person{}, cond.predicate); !ok {
+			return nil, fmt.Errorf("mock: Person has no hash or exact index on %q", cond.predicate)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(v Person) bool {
+		for _, cond := range conds {
+			if got, _ := mockPersonValue(v, cond.predicate); got != cond.value {
+				return false
+			}
+		}
+		return true
+	}, opts)
+}
+
+// mockPersonValue returns the value of the eq()-filterable predicate on v as
+// a string, and false if the predicate has no hash or exact index.
+func mockPersonValue(v Person, predicate string) (string, bool) {
+	switch predicate {
+	case "name":
+		return fmt.Sprint(v.Name), true
+	case "email":
+		return fmt.Sprint(v.Email), true
+	}
+	return "", false
+}
+
+// MockTeamClient is an in-memory TeamAPI.
+type MockTeamClient struct {
+	uids  *mockUIDs
+	mu    sync.Mutex
+	nodes map[string]Team
+}
+
+var _ TeamAPI = (*MockTeamClient)(nil)
+
+// Get returns the stored Team with the given UID, or ErrNotFound.
+func (c *MockTeamClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Team, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.nodes[uid]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	v, err := mockCopy(v)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Exists reports whether a Team with the given UID is stored.
+func (c *MockTeamClient) Exists(ctx context.Context, uid string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.nodes[uid]
+	return ok, nil
+}
+
+// Add stores v, assigning it a UID if it has none.
+func (c *MockTeamClient) Add(ctx context.Context, v *Team) error {
+	if v.UID == "" {
+		v.UID = c.uids.next()
+	}
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Create stores v, which must not have a UID yet, and returns the UID it
+// assigns.
+func (c *MockTeamClient) Create(ctx context.Context, v *Team) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Team.Create: UID is already set to %s", v.UID)
+	}
+	if err := c.Add(ctx, v); err != nil {
+		return "", err
+	}
+	return v.UID, nil
+}
+
+// Update replaces the stored Team with v, or returns ErrNotFound.
+func (c *MockTeamClient) Update(ctx context.Context, v *Team) error {
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.nodes[v.UID]; !ok {
+		return ErrNotFound
+	}
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Delete removes the Team with the given UID, if stored.
+func (c *MockTeamClient) Delete(ctx context.Context, uid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.nodes, uid)
+	return nil
+}
+
+// List returns the stored Teams in UID order with optional pagination.
+func (c *MockTeamClient) List(ctx context.Context, opts ...PageOption) ([]Team, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(Team) bool { return true }, opts)
+}
+
+// Find returns the stored Teams matching filter, which may only use
+// eq() on hash- or exact-indexed predicates.
+func (c *MockTeamClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Team, error) {
+	conds, err := parseMockFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conds {
+		if _, ok := mockTeamValue(Team{}, cond.predicate); !ok {
+			return nil, fmt.Errorf("mock: Team has no hash or exact index on %q", cond.predicate)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(v Team) bool {
+		for _, cond := range conds {
+			if got, _ := mockTeamValue(v, cond.predicate); got != cond.value {
+				return false
+			}
+		}
+		return true
+	}, opts)
+}
+
+// mockTeamValue returns the value of the eq()-filterable predicate on v as
+// a string, and false if the predicate has no hash or exact index.
+func mockTeamValue(v Team, predicate string) (string, bool) {
+	return "", false
+}
@@ -0,0 +1,49 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkPersonToMap measures converting a Person to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkPersonToMap(b *testing.B) {
+	v := Person{
+		UID:   "0x1",
+		Name:  "Name",
+		Email: "Email",
+		Bio:   "Bio",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkPersonQueryBuild measures building a Person query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkPersonQueryBuild(b *testing.B) {
+	c := &PersonClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package mock
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestPersonConformance adds a Person to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestPersonConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Person{
+		Name:  "Name-" + suffix,
+		Email: "Email-" + suffix,
+		Age:   7,
+		Bio:   "Bio-" + suffix,
+	}
+	if err := client.Person.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Person.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Person.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if got.Name != want.Name {
+		t.Errorf("Name = %v, want %v", got.Name, want.Name)
+	}
+	if got.Email != want.Email {
+		t.Errorf("Email = %v, want %v", got.Email, want.Email)
+	}
+	if got.Age != want.Age {
+		t.Errorf("Age = %v, want %v", got.Age, want.Age)
+	}
+	if got.Bio != want.Bio {
+		t.Errorf("Bio = %v, want %v", got.Bio, want.Bio)
+	}
+}
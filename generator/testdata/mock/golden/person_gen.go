@@ -0,0 +1,419 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PersonAPI is the set of Person operations provided by PersonClient. Code
+// that depends on PersonAPI rather than *PersonClient can run against a test double.
+type PersonAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Person) error
+	Create(ctx context.Context, v *Person, opts ...CreateOption) (string, error)
+	Update(ctx context.Context, v *Person) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Person, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error)
+}
+
+// PersonClient provides typed CRUD operations for Person entities.
+//
+// Person has hash- and exact-indexed predicates that MockClient can filter on.
+type PersonClient struct {
+	conn graphConn
+}
+
+var _ PersonAPI = (*PersonClient)(nil)
+
+// Get retrieves a single Person by its UID.
+func (c *PersonClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Person
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Person", personSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Person with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *PersonClient) GetExpanded(ctx context.Context, uid string) (*Person, error) {
+	var result Person
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Person", map[string]string{
+		"team": "teams",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Person type. A node of another type does not count.
+func (c *PersonClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Person")
+}
+
+// Load populates v with the Person stored under uid, using c.Person.Get.
+func (v *Person) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Person.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadTeams returns v.Teams, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Teams, with the scalar predicates of each Team, is kept in
+// v.Teams, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Teams to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Teams may be loaded and empty, and
+// LoadTeams queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Person) LoadTeams(ctx context.Context, c *Client) ([]Team, error) {
+	if v.Teams != nil {
+		return v.Teams, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Person.LoadTeams: UID is empty")
+	}
+	var got Person
+	selection := "uid teams: team { " + teamSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Person", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Teams = got.Teams
+	if v.Teams == nil {
+		v.Teams = []Team{}
+	}
+	return v.Teams, nil
+}
+
+var _ Entity = (*Person)(nil)
+
+// GetUID returns the Person's UID, empty until it has been added.
+func (v *Person) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Person's UID.
+func (v *Person) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Person's dgraph.type values: its DType, or
+// {"Person"} until Add sets it.
+func (v *Person) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Person"}
+}
+
+// String returns a one-line summary of the Person: its UID, Bio, and the number of
+// entities on each edge, which are not expanded.
+func (v Person) String() string {
+	return fmt.Sprintf("Person(%s %q teams=%d)", v.UID, v.Bio, len(v.Teams))
+}
+
+// Add inserts a new Person into the database. It fails without writing
+// if Validate reports a required field as empty.
+func (c *PersonClient) Add(ctx context.Context, v *Person) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Person node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it. It fails without writing if Validate reports a
+// required field as empty.
+//
+// With WithUpsert, the node with the same Email, if there is one, is
+// updated and its UID returned instead.
+func (c *PersonClient) Create(ctx context.Context, v *Person, opts ...CreateOption) (string, error) {
+	var cfg createConfig
+	for _, opt := range opts {
+		opt.applyCreate(&cfg)
+	}
+	if v.UID != "" {
+		return "", fmt.Errorf("Person.Create: UID is already set to %s", v.UID)
+	}
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Person"}
+	}
+	if cfg.upsert {
+		if err := c.conn.Upsert(ctx, v, "email"); err != nil {
+			return "", err
+		}
+		return v.UID, nil
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Person.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Validate returns an error wrapping ErrRequired for the first required field
+// that holds its zero value.
+func (v *Person) Validate() error {
+	if v.Email == "" {
+		return fmt.Errorf("%w: Person.Email", ErrRequired)
+	}
+	return nil
+}
+
+// Update modifies an existing Person in the database. The UID field must be set.
+func (c *PersonClient) Update(ctx context.Context, v *Person) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Person with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *PersonClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Person.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Person with the given UID to value, touching
+// no other predicate.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (c *PersonClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Person.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetEmail sets the Email of the Person with the given UID to value, touching
+// no other predicate.
+//
+// Email identifies the person.
+func (c *PersonClient) SetEmail(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"email": value}); err != nil {
+		return fmt.Errorf("Person.SetEmail: %w", err)
+	}
+	return nil
+}
+
+// SetAge sets the Age of the Person with the given UID to value, touching
+// no other predicate.
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func (c *PersonClient) SetAge(ctx context.Context, uid string, value int) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"age": value}); err != nil {
+		return fmt.Errorf("Person.SetAge: %w", err)
+	}
+	return nil
+}
+
+// SetBio sets the Bio of the Person with the given UID to value, touching
+// no other predicate.
+//
+// Deprecated: search Name instead, which is term-indexed
+func (c *PersonClient) SetBio(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"bio": value}); err != nil {
+		return fmt.Errorf("Person.SetBio: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Person with the given UID from the database.
+func (c *PersonClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddTeams links the Person with the given UID to the Team nodes with the
+// given UIDs through team, keeping the Teams it has.
+func (c *PersonClient) AddTeams(ctx context.Context, personUID string, teamUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "team", []string{personUID}, teamUIDs, false); err != nil {
+		return fmt.Errorf("Person.AddTeams: %w", err)
+	}
+	return nil
+}
+
+// RemoveTeams unlinks the Person with the given UID from the Team nodes
+// with the given UIDs, deleting their team edges.
+func (c *PersonClient) RemoveTeams(ctx context.Context, personUID string, teamUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "team", []string{personUID}, teamUIDs, true); err != nil {
+		return fmt.Errorf("Person.RemoveTeams: %w", err)
+	}
+	return nil
+}
+
+// Search finds Person entities whose Bio matches term using fulltext search.
+func (c *PersonClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Person, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "bio", term, opts)
+	}
+	var results []Person
+	q := c.conn.Query(ctx, Person{}).
+		Filter(`alloftext(bio, "` + term + `")`).
+		First(defaultPageSize)
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first > 0 {
+		q = q.First(cfg.first)
+	}
+	if cfg.offset > 0 {
+		q = q.Offset(cfg.offset)
+	}
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SearchAllOfText finds Person entities whose Bio contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *PersonClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Person, error) {
+	return c.searchText(ctx, "alloftext", "bio", terms, opts)
+}
+
+// SearchAnyOfText finds Person entities whose Bio contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *PersonClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Person, error) {
+	return c.searchText(ctx, "anyoftext", "bio", terms, opts)
+}
+
+// SearchBio finds Person entities whose Bio contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+//
+// Deprecated: search Name instead, which is term-indexed
+func (c *PersonClient) SearchBio(ctx context.Context, terms string, opts ...PageOption) ([]Person, error) {
+	return c.searchText(ctx, "alloftext", "bio", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *PersonClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Person
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Person", personSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// personSelection returns the DQL selection for a Person: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func personSelection(depth int) string {
+	s := "uid dgraph.type name email age bio"
+	if depth > 0 {
+		s += " teams: team { " + teamSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves People with optional pagination.
+func (c *PersonClient) List(ctx context.Context, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var people []Person
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&people)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// Find retrieves People matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *PersonClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var people []Person
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&people)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// GetByName retrieves the Person entities whose Name is value, with optional
+// pagination.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (c *PersonClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Person
+	err := queryNodes(ctx, c.conn.QueryRaw, "Person", "eq(name, "+formatString(value)+")", "", personSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// GetByEmail retrieves the Person whose Email is value. The error wraps ErrNotFound
+// if there is none, and ErrNotUnique if there is more than one.
+//
+// Email identifies the person.
+func (c *PersonClient) GetByEmail(ctx context.Context, value string) (*Person, error) {
+	var results []Person
+	err := queryNodes(ctx, c.conn.QueryRaw, "Person", "eq(email, "+formatString(value)+")", "", personSelection(1), 2, 0, &results)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, fmt.Errorf("Person with Email %q: %w", value, ErrNotFound)
+	case 1:
+		return &results[0], nil
+	}
+	return nil, fmt.Errorf("Person with Email %q: %w", value, ErrNotUnique)
+}
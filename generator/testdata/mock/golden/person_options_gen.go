@@ -0,0 +1,51 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+// PersonOption is a functional option for configuring Person mutations.
+type PersonOption func(*Person)
+
+// WithPersonName sets the Name field on a Person.
+//
+// Name is how the person is addressed, not necessarily unique.
+func WithPersonName(v string) PersonOption {
+	return func(e *Person) {
+		e.Name = v
+	}
+}
+
+// WithPersonEmail sets the Email field on a Person.
+//
+// Email identifies the person.
+func WithPersonEmail(v string) PersonOption {
+	return func(e *Person) {
+		e.Email = v
+	}
+}
+
+// WithPersonAge sets the Age field on a Person.
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func WithPersonAge(v int) PersonOption {
+	return func(e *Person) {
+		e.Age = v
+	}
+}
+
+// WithPersonBio sets the Bio field on a Person.
+//
+// Deprecated: search Name instead, which is term-indexed
+func WithPersonBio(v string) PersonOption {
+	return func(e *Person) {
+		e.Bio = v
+	}
+}
+
+// ApplyPersonOptions applies the given options to a Person.
+func ApplyPersonOptions(e *Person, opts ...PersonOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
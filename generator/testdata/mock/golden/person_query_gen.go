@@ -0,0 +1,465 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PersonQuery is a typed query builder for Person entities.
+type PersonQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Person entities.
+func (c *PersonClient) Query(ctx context.Context) *PersonQuery {
+	return &PersonQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *PersonQuery) Filter(f string) *PersonQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *PersonQuery) where(expr string) *PersonQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from PersonWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *PersonQuery) Where(f Filter[Person]) *PersonQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Person entities that have a value for Name, using
+// has(name).
+//
+// Name is how the person is addressed, not necessarily unique.
+func (q *PersonQuery) HasName() *PersonQuery {
+	return q.Where(PersonWhere.HasName())
+}
+
+// NotName filters to Person entities that have no Name value.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (q *PersonQuery) NotName() *PersonQuery {
+	return q.Where(PersonWhere.NotName())
+}
+
+// HasEmail filters to Person entities that have a value for Email, using
+// has(email).
+//
+// Email identifies the person.
+func (q *PersonQuery) HasEmail() *PersonQuery {
+	return q.Where(PersonWhere.HasEmail())
+}
+
+// NotEmail filters to Person entities that have no Email value.
+//
+// Email identifies the person.
+func (q *PersonQuery) NotEmail() *PersonQuery {
+	return q.Where(PersonWhere.NotEmail())
+}
+
+// HasAge filters to Person entities that have a value for Age, using
+// has(age).
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func (q *PersonQuery) HasAge() *PersonQuery {
+	return q.Where(PersonWhere.HasAge())
+}
+
+// NotAge filters to Person entities that have no Age value.
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func (q *PersonQuery) NotAge() *PersonQuery {
+	return q.Where(PersonWhere.NotAge())
+}
+
+// HasBio filters to Person entities that have a value for Bio, using
+// has(bio).
+//
+// Deprecated: search Name instead, which is term-indexed
+func (q *PersonQuery) HasBio() *PersonQuery {
+	return q.Where(PersonWhere.HasBio())
+}
+
+// NotBio filters to Person entities that have no Bio value.
+//
+// Deprecated: search Name instead, which is term-indexed
+func (q *PersonQuery) NotBio() *PersonQuery {
+	return q.Where(PersonWhere.NotBio())
+}
+
+// HasTeams filters to Person entities that have a value for Teams, using
+// has(team).
+func (q *PersonQuery) HasTeams() *PersonQuery {
+	return q.Where(PersonWhere.HasTeams())
+}
+
+// NotTeams filters to Person entities that have no Teams value.
+func (q *PersonQuery) NotTeams() *PersonQuery {
+	return q.Where(PersonWhere.NotTeams())
+}
+
+// TeamsContains filters to Person entities whose Teams include any of the
+// Team nodes with the given uids, using uid_in(team, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *PersonQuery) TeamsContains(uids ...string) *PersonQuery {
+	return q.Where(PersonWhere.TeamsContains(uids...))
+}
+
+// NameAllOfTerms filters to Person entities whose Name contains all of the terms.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (q *PersonQuery) NameAllOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Person entities whose Name contains any of the terms.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (q *PersonQuery) NameAnyOfTerms(terms string) *PersonQuery {
+	return q.Where(PersonWhere.NameAnyOfTerms(terms))
+}
+
+// EmailGe filters to Person entities whose Email sorts at or after value.
+//
+// Email identifies the person.
+func (q *PersonQuery) EmailGe(value string) *PersonQuery {
+	return q.Where(PersonWhere.EmailGe(value))
+}
+
+// EmailLe filters to Person entities whose Email sorts at or before value.
+//
+// Email identifies the person.
+func (q *PersonQuery) EmailLe(value string) *PersonQuery {
+	return q.Where(PersonWhere.EmailLe(value))
+}
+
+// EmailBetween filters to Person entities whose Email sorts from from through to,
+// inclusive.
+//
+// Email identifies the person.
+func (q *PersonQuery) EmailBetween(from, to string) *PersonQuery {
+	return q.Where(PersonWhere.EmailBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *PersonQuery) OrderAsc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *PersonQuery) OrderDesc(field string) *PersonQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *PersonQuery) First(n int) *PersonQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *PersonQuery) Offset(n int) *PersonQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *PersonQuery) withEdge(selection string) *PersonQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithTeams makes GetByUID and Exec also fetch the Teams edge, with the
+// scalar predicates of each Team it leads to, in the same query.
+func (q *PersonQuery) WithTeams() *PersonQuery {
+	return q.withEdge("teams: team { " + teamSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Person and the edges added by the With methods.
+func (q *PersonQuery) selection() string {
+	s := personSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *PersonQuery) dql() string {
+	return nodesQuery("Person", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *PersonQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Person with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Person.
+func (q *PersonQuery) GetByUID(uid string) (*Person, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Person
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Person", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *PersonQuery) Exec(dst *[]Person) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *PersonQuery) ExecAndCount(dst *[]Person) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("PersonQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Person", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Person{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// PersonWhere builds the conditions on Person fields that PersonQuery.Where takes.
+var PersonWhere PersonConditions
+
+// PersonConditions has a method for each typed filter of PersonQuery, returning it as a
+// Filter[Person] to combine with And, Or, and Not.
+type PersonConditions struct{}
+
+// HasName matches Person entities that have a value for Name, using
+// has(name).
+//
+// Name is how the person is addressed, not necessarily unique.
+func (PersonConditions) HasName() Filter[Person] {
+	return Filter[Person]{expr: "has(name)"}
+}
+
+// NotName matches Person entities that have no Name value.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (PersonConditions) NotName() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(name)"}
+}
+
+// HasEmail matches Person entities that have a value for Email, using
+// has(email).
+//
+// Email identifies the person.
+func (PersonConditions) HasEmail() Filter[Person] {
+	return Filter[Person]{expr: "has(email)"}
+}
+
+// NotEmail matches Person entities that have no Email value.
+//
+// Email identifies the person.
+func (PersonConditions) NotEmail() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(email)"}
+}
+
+// HasAge matches Person entities that have a value for Age, using
+// has(age).
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func (PersonConditions) HasAge() Filter[Person] {
+	return Filter[Person]{expr: "has(age)"}
+}
+
+// NotAge matches Person entities that have no Age value.
+//
+// Age in whole years.
+//
+// Deprecated: Age is deprecated.
+func (PersonConditions) NotAge() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(age)"}
+}
+
+// HasBio matches Person entities that have a value for Bio, using
+// has(bio).
+//
+// Deprecated: search Name instead, which is term-indexed
+func (PersonConditions) HasBio() Filter[Person] {
+	return Filter[Person]{expr: "has(bio)"}
+}
+
+// NotBio matches Person entities that have no Bio value.
+//
+// Deprecated: search Name instead, which is term-indexed
+func (PersonConditions) NotBio() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(bio)"}
+}
+
+// HasTeams matches Person entities that have a value for Teams, using
+// has(team).
+func (PersonConditions) HasTeams() Filter[Person] {
+	return Filter[Person]{expr: "has(team)"}
+}
+
+// NotTeams matches Person entities that have no Teams value.
+func (PersonConditions) NotTeams() Filter[Person] {
+	return Filter[Person]{expr: "NOT has(team)"}
+}
+
+// TeamsContains matches Person entities whose Teams include any of the
+// Team nodes with the given uids, using uid_in(team, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (PersonConditions) TeamsContains(uids ...string) Filter[Person] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Person]{err: fmt.Errorf("Person.Teams: %w", err)}
+	}
+	return Filter[Person]{expr: "uid_in(team, " + list + ")"}
+}
+
+// NameAllOfTerms matches Person entities whose Name contains all of the terms.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (PersonConditions) NameAllOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Person entities whose Name contains any of the terms.
+//
+// Name is how the person is addressed, not necessarily unique.
+func (PersonConditions) NameAnyOfTerms(terms string) Filter[Person] {
+	return Filter[Person]{expr: "anyofterms(name, " + formatString(terms) + ")"}
+}
+
+// EmailGe matches Person entities whose Email sorts at or after value.
+//
+// Email identifies the person.
+func (PersonConditions) EmailGe(value string) Filter[Person] {
+	return Filter[Person]{expr: "ge(email, " + formatString(value) + ")"}
+}
+
+// EmailLe matches Person entities whose Email sorts at or before value.
+//
+// Email identifies the person.
+func (PersonConditions) EmailLe(value string) Filter[Person] {
+	return Filter[Person]{expr: "le(email, " + formatString(value) + ")"}
+}
+
+// EmailBetween matches Person entities whose Email sorts from from through to,
+// inclusive.
+//
+// Email identifies the person.
+func (PersonConditions) EmailBetween(from, to string) Filter[Person] {
+	return Filter[Person]{expr: "between(email, " + formatString(from) + ", " + formatString(to) + ")"}
+}
@@ -0,0 +1,27 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+// DQLSchema is the Dgraph schema for the mock data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+age: int @index(int) .
+bio: string @index(fulltext) .
+email: string @index(exact) @upsert .
+label: string @index(term) .
+name: string @index(hash, term) .
+team: [uid] .
+
+type Person {
+	name
+	email
+	age
+	bio
+	team
+}
+
+type Team {
+	label
+}
+`
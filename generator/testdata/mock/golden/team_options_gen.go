@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+// TeamOption is a functional option for configuring Team mutations.
+type TeamOption func(*Team)
+
+// WithTeamLabel sets the Label field on a Team.
+func WithTeamLabel(v string) TeamOption {
+	return func(e *Team) {
+		e.Label = v
+	}
+}
+
+// ApplyTeamOptions applies the given options to a Team.
+func ApplyTeamOptions(e *Team, opts ...TeamOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,243 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+)
+
+// TeamQuery is a typed query builder for Team entities.
+type TeamQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Team entities.
+func (c *TeamClient) Query(ctx context.Context) *TeamQuery {
+	return &TeamQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *TeamQuery) Filter(f string) *TeamQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *TeamQuery) where(expr string) *TeamQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from TeamWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *TeamQuery) Where(f Filter[Team]) *TeamQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasLabel filters to Team entities that have a value for Label, using
+// has(label).
+func (q *TeamQuery) HasLabel() *TeamQuery {
+	return q.Where(TeamWhere.HasLabel())
+}
+
+// NotLabel filters to Team entities that have no Label value.
+func (q *TeamQuery) NotLabel() *TeamQuery {
+	return q.Where(TeamWhere.NotLabel())
+}
+
+// LabelAllOfTerms filters to Team entities whose Label contains all of the terms.
+func (q *TeamQuery) LabelAllOfTerms(terms string) *TeamQuery {
+	return q.Where(TeamWhere.LabelAllOfTerms(terms))
+}
+
+// LabelAnyOfTerms filters to Team entities whose Label contains any of the terms.
+func (q *TeamQuery) LabelAnyOfTerms(terms string) *TeamQuery {
+	return q.Where(TeamWhere.LabelAnyOfTerms(terms))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *TeamQuery) OrderAsc(field string) *TeamQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *TeamQuery) OrderDesc(field string) *TeamQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *TeamQuery) First(n int) *TeamQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *TeamQuery) Offset(n int) *TeamQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Team and the edges added by the With methods.
+func (q *TeamQuery) selection() string {
+	s := teamSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *TeamQuery) dql() string {
+	return nodesQuery("Team", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *TeamQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Team with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Team.
+func (q *TeamQuery) GetByUID(uid string) (*Team, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Team
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Team", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *TeamQuery) Exec(dst *[]Team) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Team{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *TeamQuery) ExecAndCount(dst *[]Team) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Team", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Team{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// TeamWhere builds the conditions on Team fields that TeamQuery.Where takes.
+var TeamWhere TeamConditions
+
+// TeamConditions has a method for each typed filter of TeamQuery, returning it as a
+// Filter[Team] to combine with And, Or, and Not.
+type TeamConditions struct{}
+
+// HasLabel matches Team entities that have a value for Label, using
+// has(label).
+func (TeamConditions) HasLabel() Filter[Team] {
+	return Filter[Team]{expr: "has(label)"}
+}
+
+// NotLabel matches Team entities that have no Label value.
+func (TeamConditions) NotLabel() Filter[Team] {
+	return Filter[Team]{expr: "NOT has(label)"}
+}
+
+// LabelAllOfTerms matches Team entities whose Label contains all of the terms.
+func (TeamConditions) LabelAllOfTerms(terms string) Filter[Team] {
+	return Filter[Team]{expr: "allofterms(label, " + formatString(terms) + ")"}
+}
+
+// LabelAnyOfTerms matches Team entities whose Label contains any of the terms.
+func (TeamConditions) LabelAnyOfTerms(terms string) Filter[Team] {
+	return Filter[Team]{expr: "anyofterms(label, " + formatString(terms) + ")"}
+}
@@ -0,0 +1,21 @@
+package mock
+
+// Person has hash- and exact-indexed predicates that MockClient can filter on.
+type Person struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	// Name is how the person is addressed, not necessarily unique.
+	Name string `json:"name,omitempty" dgraph:"index=hash,term"`
+	// Email identifies the person.
+	Email string `json:"email,omitempty" dgraph:"index=exact,upsert,required"`
+	Age   int    `json:"age,omitempty" dgraph:"index=int deprecated"` // Age in whole years.
+	Bio   string `json:"bio,omitempty" dgraph:"index=fulltext deprecated=search Name instead, which is term-indexed"`
+	Teams []Team `json:"teams,omitempty" dgraph:"predicate=team"`
+}
+
+// Team has no equality-indexed predicates.
+type Team struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Label string   `json:"label,omitempty" dgraph:"index=term"`
+}
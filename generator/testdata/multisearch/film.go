@@ -0,0 +1,11 @@
+package multisearch
+
+// Film has several fulltext fields. Name is the primary one although Tagline
+// is declared first.
+type Film struct {
+	UID      string   `json:"uid,omitempty"`
+	DType    []string `json:"dgraph.type,omitempty"`
+	Tagline  string   `json:"tagline,omitempty" dgraph:"index=fulltext"`
+	Name     string   `json:"name,omitempty" dgraph:"index=hash,fulltext"`
+	Synopsis string   `json:"synopsis,omitempty" dgraph:"predicate=film_synopsis index=fulltext"`
+}
@@ -0,0 +1,34 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package multisearch
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmTagline sets the Tagline field on a Film.
+func WithFilmTagline(v string) FilmOption {
+	return func(e *Film) {
+		e.Tagline = v
+	}
+}
+
+// WithFilmName sets the Name field on a Film.
+func WithFilmName(v string) FilmOption {
+	return func(e *Film) {
+		e.Name = v
+	}
+}
+
+// WithFilmSynopsis sets the Synopsis field on a Film.
+func WithFilmSynopsis(v string) FilmOption {
+	return func(e *Film) {
+		e.Synopsis = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
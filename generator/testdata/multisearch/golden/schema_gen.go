@@ -0,0 +1,18 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package multisearch
+
+// DQLSchema is the Dgraph schema for the multisearch data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+film_synopsis: string @index(fulltext) .
+name: string @index(hash, fulltext) .
+tagline: string @index(fulltext) .
+
+type Film {
+	tagline
+	name
+	film_synopsis
+}
+`
@@ -0,0 +1,11 @@
+package nulls
+
+import "database/sql"
+
+// Entry holds its sql.Null* values behind pointers, nil when never scanned.
+type Entry struct {
+	UID    string          `json:"uid,omitempty"`
+	DType  []string        `json:"dgraph.type,omitempty"`
+	Memo   *sql.NullString `json:"memo,omitempty" dgraph:"index=hash"`
+	Posted *sql.NullTime   `json:"posted,omitempty"`
+}
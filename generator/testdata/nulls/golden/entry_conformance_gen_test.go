@@ -0,0 +1,43 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package nulls
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+// TestEntryConformance adds an Entry to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestEntryConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+	want := Entry{}
+	if err := client.Entry.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Entry.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	if _, err := client.Entry.Get(ctx, want.UID); err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+}
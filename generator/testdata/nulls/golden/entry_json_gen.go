@@ -0,0 +1,53 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+)
+
+// MarshalJSON encodes an Entry in the form Dgraph expects:
+//   - sql.Null* fields are plain JSON values. A field that is not Valid, or
+//     is a nil pointer, is left out if its json tag has omitempty, and
+//     encoded as null otherwise.
+func (v Entry) MarshalJSON() ([]byte, error) {
+	type plain Entry
+	out := struct {
+		plain
+		Memo   *string    `json:"memo,omitempty"`
+		Posted *time.Time `json:"posted,omitempty"`
+	}{plain: plain(v)}
+	if v.Memo != nil && v.Memo.Valid {
+		out.Memo = &v.Memo.String
+	}
+	if v.Posted != nil && v.Posted.Valid {
+		out.Posted = &v.Posted.Time
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an Entry from a Dgraph query result:
+//   - sql.Null* fields are Valid when their JSON value is present and not
+//     null, and pointers to them are nil otherwise.
+func (v *Entry) UnmarshalJSON(data []byte) error {
+	type plain Entry
+	in := struct {
+		*plain
+		Memo   *string    `json:"memo"`
+		Posted *time.Time `json:"posted"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	v.Memo = nil
+	if in.Memo != nil {
+		v.Memo = &sql.NullString{String: *in.Memo, Valid: true}
+	}
+	v.Posted = nil
+	if in.Posted != nil {
+		v.Posted = &sql.NullTime{Time: *in.Posted, Valid: true}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import "database/sql"
+
+// EntryOption is a functional option for configuring Entry mutations.
+type EntryOption func(*Entry)
+
+// WithEntryMemo sets the Memo field on an Entry.
+func WithEntryMemo(v *sql.NullString) EntryOption {
+	return func(e *Entry) {
+		e.Memo = v
+	}
+}
+
+// WithEntryPosted sets the Posted field on an Entry.
+func WithEntryPosted(v *sql.NullTime) EntryOption {
+	return func(e *Entry) {
+		e.Posted = v
+	}
+}
+
+// ApplyEntryOptions applies the given options to an Entry.
+func ApplyEntryOptions(e *Entry, opts ...EntryOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
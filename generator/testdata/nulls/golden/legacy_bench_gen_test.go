@@ -0,0 +1,47 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkLegacyToMap measures converting a Legacy to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkLegacyToMap(b *testing.B) {
+	v := Legacy{
+		UID:  "0x1",
+		Note: "Note",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkLegacyQueryBuild measures building a Legacy query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkLegacyQueryBuild(b *testing.B) {
+	c := &LegacyClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
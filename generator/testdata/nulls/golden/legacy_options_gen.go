@@ -0,0 +1,57 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import "database/sql"
+
+// LegacyOption is a functional option for configuring Legacy mutations.
+type LegacyOption func(*Legacy)
+
+// WithLegacyTitle sets the Title field on a Legacy.
+func WithLegacyTitle(v sql.NullString) LegacyOption {
+	return func(e *Legacy) {
+		e.Title = v
+	}
+}
+
+// WithLegacyCount sets the Count field on a Legacy.
+func WithLegacyCount(v sql.NullInt64) LegacyOption {
+	return func(e *Legacy) {
+		e.Count = v
+	}
+}
+
+// WithLegacyScore sets the Score field on a Legacy.
+func WithLegacyScore(v sql.NullFloat64) LegacyOption {
+	return func(e *Legacy) {
+		e.Score = v
+	}
+}
+
+// WithLegacyActive sets the Active field on a Legacy.
+func WithLegacyActive(v sql.NullBool) LegacyOption {
+	return func(e *Legacy) {
+		e.Active = v
+	}
+}
+
+// WithLegacySeen sets the Seen field on a Legacy.
+func WithLegacySeen(v sql.NullTime) LegacyOption {
+	return func(e *Legacy) {
+		e.Seen = v
+	}
+}
+
+// WithLegacyNote sets the Note field on a Legacy.
+func WithLegacyNote(v string) LegacyOption {
+	return func(e *Legacy) {
+		e.Note = v
+	}
+}
+
+// ApplyLegacyOptions applies the given options to a Legacy.
+func ApplyLegacyOptions(e *Legacy, opts ...LegacyOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
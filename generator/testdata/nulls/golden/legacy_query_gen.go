@@ -0,0 +1,333 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import (
+	"context"
+)
+
+// LegacyQuery is a typed query builder for Legacy entities.
+type LegacyQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Legacy entities.
+func (c *LegacyClient) Query(ctx context.Context) *LegacyQuery {
+	return &LegacyQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *LegacyQuery) Filter(f string) *LegacyQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *LegacyQuery) where(expr string) *LegacyQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from LegacyWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *LegacyQuery) Where(f Filter[Legacy]) *LegacyQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasTitle filters to Legacy entities that have a value for Title, using
+// has(title).
+func (q *LegacyQuery) HasTitle() *LegacyQuery {
+	return q.Where(LegacyWhere.HasTitle())
+}
+
+// NotTitle filters to Legacy entities that have no Title value.
+func (q *LegacyQuery) NotTitle() *LegacyQuery {
+	return q.Where(LegacyWhere.NotTitle())
+}
+
+// HasCount filters to Legacy entities that have a value for Count, using
+// has(count).
+func (q *LegacyQuery) HasCount() *LegacyQuery {
+	return q.Where(LegacyWhere.HasCount())
+}
+
+// NotCount filters to Legacy entities that have no Count value.
+func (q *LegacyQuery) NotCount() *LegacyQuery {
+	return q.Where(LegacyWhere.NotCount())
+}
+
+// HasScore filters to Legacy entities that have a value for Score, using
+// has(score).
+func (q *LegacyQuery) HasScore() *LegacyQuery {
+	return q.Where(LegacyWhere.HasScore())
+}
+
+// NotScore filters to Legacy entities that have no Score value.
+func (q *LegacyQuery) NotScore() *LegacyQuery {
+	return q.Where(LegacyWhere.NotScore())
+}
+
+// HasActive filters to Legacy entities that have a value for Active, using
+// has(active).
+func (q *LegacyQuery) HasActive() *LegacyQuery {
+	return q.Where(LegacyWhere.HasActive())
+}
+
+// NotActive filters to Legacy entities that have no Active value.
+func (q *LegacyQuery) NotActive() *LegacyQuery {
+	return q.Where(LegacyWhere.NotActive())
+}
+
+// HasSeen filters to Legacy entities that have a value for Seen, using
+// has(seen).
+func (q *LegacyQuery) HasSeen() *LegacyQuery {
+	return q.Where(LegacyWhere.HasSeen())
+}
+
+// NotSeen filters to Legacy entities that have no Seen value.
+func (q *LegacyQuery) NotSeen() *LegacyQuery {
+	return q.Where(LegacyWhere.NotSeen())
+}
+
+// HasNote filters to Legacy entities that have a value for Note, using
+// has(note).
+func (q *LegacyQuery) HasNote() *LegacyQuery {
+	return q.Where(LegacyWhere.HasNote())
+}
+
+// NotNote filters to Legacy entities that have no Note value.
+func (q *LegacyQuery) NotNote() *LegacyQuery {
+	return q.Where(LegacyWhere.NotNote())
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *LegacyQuery) OrderAsc(field string) *LegacyQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *LegacyQuery) OrderDesc(field string) *LegacyQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *LegacyQuery) First(n int) *LegacyQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *LegacyQuery) Offset(n int) *LegacyQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Legacy and the edges added by the With methods.
+func (q *LegacyQuery) selection() string {
+	s := legacySelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *LegacyQuery) dql() string {
+	return nodesQuery("Legacy", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *LegacyQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Legacy with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Legacy.
+func (q *LegacyQuery) GetByUID(uid string) (*Legacy, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Legacy
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Legacy", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *LegacyQuery) Exec(dst *[]Legacy) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Legacy{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *LegacyQuery) ExecAndCount(dst *[]Legacy) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Legacy", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Legacy{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// LegacyWhere builds the conditions on Legacy fields that LegacyQuery.Where takes.
+var LegacyWhere LegacyConditions
+
+// LegacyConditions has a method for each typed filter of LegacyQuery, returning it as a
+// Filter[Legacy] to combine with And, Or, and Not.
+type LegacyConditions struct{}
+
+// HasTitle matches Legacy entities that have a value for Title, using
+// has(title).
+func (LegacyConditions) HasTitle() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(title)"}
+}
+
+// NotTitle matches Legacy entities that have no Title value.
+func (LegacyConditions) NotTitle() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(title)"}
+}
+
+// HasCount matches Legacy entities that have a value for Count, using
+// has(count).
+func (LegacyConditions) HasCount() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(count)"}
+}
+
+// NotCount matches Legacy entities that have no Count value.
+func (LegacyConditions) NotCount() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(count)"}
+}
+
+// HasScore matches Legacy entities that have a value for Score, using
+// has(score).
+func (LegacyConditions) HasScore() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(score)"}
+}
+
+// NotScore matches Legacy entities that have no Score value.
+func (LegacyConditions) NotScore() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(score)"}
+}
+
+// HasActive matches Legacy entities that have a value for Active, using
+// has(active).
+func (LegacyConditions) HasActive() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(active)"}
+}
+
+// NotActive matches Legacy entities that have no Active value.
+func (LegacyConditions) NotActive() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(active)"}
+}
+
+// HasSeen matches Legacy entities that have a value for Seen, using
+// has(seen).
+func (LegacyConditions) HasSeen() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(seen)"}
+}
+
+// NotSeen matches Legacy entities that have no Seen value.
+func (LegacyConditions) NotSeen() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(seen)"}
+}
+
+// HasNote matches Legacy entities that have a value for Note, using
+// has(note).
+func (LegacyConditions) HasNote() Filter[Legacy] {
+	return Filter[Legacy]{expr: "has(note)"}
+}
+
+// NotNote matches Legacy entities that have no Note value.
+func (LegacyConditions) NotNote() Filter[Legacy] {
+	return Filter[Legacy]{expr: "NOT has(note)"}
+}
@@ -0,0 +1,31 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+// DQLSchema is the Dgraph schema for the nulls data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+active: bool .
+count: int .
+memo: string @index(hash) .
+note: string .
+posted: datetime .
+score: float .
+seen: datetime @index(day) .
+title: string @index(hash) .
+
+type Entry {
+	memo
+	posted
+}
+
+type Legacy {
+	title
+	count
+	score
+	active
+	seen
+	note
+}
+`
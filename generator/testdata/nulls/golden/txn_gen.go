@@ -0,0 +1,288 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package nulls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Txn groups writes to several entities into a single Dgraph transaction.
+// Reads made through it see its own uncommitted writes. Every Txn must end
+// with Commit or Discard; calling Discard after Commit is a no-op, so
+// `defer txn.Discard(ctx)` is safe.
+type Txn struct {
+	txn     *dgo.Txn
+	cleanup func()
+	done    sync.Once
+	Entry   *EntryTxn
+	Legacy  *LegacyTxn
+}
+
+// NewTxn starts a transaction on the client's connection.
+func (c *Client) NewTxn(ctx context.Context) (*Txn, error) {
+	dg, cleanup, err := c.conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	t := &Txn{txn: dg.NewTxn(), cleanup: cleanup}
+	t.Entry = &EntryTxn{txn: t}
+	t.Legacy = &LegacyTxn{txn: t}
+	return t, nil
+}
+
+// DgraphTxn returns the underlying dgo transaction, for operations the
+// generated API does not cover.
+func (t *Txn) DgraphTxn() *dgo.Txn {
+	return t.txn
+}
+
+// Commit commits the transaction's writes.
+func (t *Txn) Commit(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Commit(ctx)
+}
+
+// Discard abandons the transaction's writes.
+func (t *Txn) Discard(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Discard(ctx)
+}
+
+// release returns the connection obtained by NewTxn, once.
+func (t *Txn) release() {
+	t.done.Do(t.cleanup)
+}
+
+// query runs a read-only DQL query inside the transaction.
+func (t *Txn) query(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	resp, err := t.txn.QueryWithVars(ctx, q, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// EntryTxn provides Entry operations within a Txn.
+type EntryTxn struct {
+	txn *Txn
+}
+
+var _ EntryAPI = (*EntryTxn)(nil)
+
+// Get retrieves a single Entry by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *EntryTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Entry, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Entry
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Entry", entrySelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Entry type, seeing the transaction's own writes.
+func (t *EntryTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Entry")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *EntryTxn) Add(ctx context.Context, v *Entry) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Entry"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *EntryTxn) Create(ctx context.Context, v *Entry) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Entry.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Entry"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Entry.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *EntryTxn) Update(ctx context.Context, v *Entry) error {
+	if v.UID == "" {
+		return errors.New("Entry.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Entry with the given UID in the transaction.
+func (t *EntryTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Entry entities with optional pagination.
+func (t *EntryTxn) List(ctx context.Context, opts ...PageOption) ([]Entry, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Entry entities matching the DQL filter expression, with
+// optional pagination.
+func (t *EntryTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Entry, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Entry
+	err := queryNodes(ctx, t.txn.query, "Entry", filter, "", entrySelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// LegacyTxn provides Legacy operations within a Txn.
+type LegacyTxn struct {
+	txn *Txn
+}
+
+var _ LegacyAPI = (*LegacyTxn)(nil)
+
+// Get retrieves a single Legacy by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *LegacyTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Legacy, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Legacy
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Legacy", legacySelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Legacy type, seeing the transaction's own writes.
+func (t *LegacyTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Legacy")
+}
+
+// Add inserts v in the transaction and sets its UID. It fails without writing if
+// Validate reports a required field as empty.
+func (t *LegacyTxn) Add(ctx context.Context, v *Legacy) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Legacy"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. It fails without writing if Validate reports a
+// required field as empty.
+func (t *LegacyTxn) Create(ctx context.Context, v *Legacy) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Legacy.Create: UID is already set to %s", v.UID)
+	}
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Legacy"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Legacy.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *LegacyTxn) Update(ctx context.Context, v *Legacy) error {
+	if v.UID == "" {
+		return errors.New("Legacy.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Legacy with the given UID in the transaction.
+func (t *LegacyTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Legacy entities with optional pagination.
+func (t *LegacyTxn) List(ctx context.Context, opts ...PageOption) ([]Legacy, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Legacy entities matching the DQL filter expression, with
+// optional pagination.
+func (t *LegacyTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Legacy, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Legacy
+	err := queryNodes(ctx, t.txn.query, "Legacy", filter, "", legacySelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
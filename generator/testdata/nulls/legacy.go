@@ -0,0 +1,15 @@
+package nulls
+
+import "database/sql"
+
+// Legacy mirrors a row scanned from a SQL database.
+type Legacy struct {
+	UID    string          `json:"uid,omitempty"`
+	DType  []string        `json:"dgraph.type,omitempty"`
+	Title  sql.NullString  `json:"title,omitempty" dgraph:"index=hash required"`
+	Count  sql.NullInt64   `json:"count"`
+	Score  sql.NullFloat64 `json:"score,omitempty"`
+	Active sql.NullBool    `json:"active,omitempty"`
+	Seen   sql.NullTime    `json:"seen,omitempty" dgraph:"index=day"`
+	Note   string          `json:"note,omitempty"`
+}
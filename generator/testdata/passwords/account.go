@@ -0,0 +1,10 @@
+package passwords
+
+// Account keeps a password that is checked with checkpwd and never read. Its
+// JSON key has no omitempty, so an empty Password is encoded.
+type Account struct {
+	UID      string   `json:"uid,omitempty"`
+	DType    []string `json:"dgraph.type,omitempty"`
+	Email    string   `json:"email,omitempty" dgraph:"index=exact upsert"`
+	Password string   `json:"password" dgraph:"type=password"`
+}
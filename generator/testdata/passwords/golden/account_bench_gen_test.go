@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package passwords
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkAccountToMap measures converting an Account to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkAccountToMap(b *testing.B) {
+	v := Account{
+		UID:      "0x1",
+		Email:    "Email",
+		Password: "Password",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkAccountQueryBuild measures building an Account query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkAccountQueryBuild(b *testing.B) {
+	c := &AccountClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package passwords
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// AccountAPI is the set of Account operations provided by AccountClient. Code
+// that depends on AccountAPI rather than *AccountClient can run against a test double.
+type AccountAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Account, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Account) error
+	Create(ctx context.Context, v *Account, opts ...CreateOption) (string, error)
+	Update(ctx context.Context, v *Account) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Account, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Account, error)
+}
+
+// AccountClient provides typed CRUD operations for Account entities.
+//
+// Account keeps a password that is checked with checkpwd and never read. Its
+// JSON key has no omitempty, so an empty Password is encoded.
+type AccountClient struct {
+	conn graphConn
+}
+
+var _ AccountAPI = (*AccountClient)(nil)
+
+// Get retrieves a single Account by its UID.
+func (c *AccountClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Account, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Account
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Account", accountSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Account with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *AccountClient) GetExpanded(ctx context.Context, uid string) (*Account, error) {
+	var result Account
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Account", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Account type. A node of another type does not count.
+func (c *AccountClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Account")
+}
+
+// Load populates v with the Account stored under uid, using c.Account.Get.
+func (v *Account) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Account.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Account)(nil)
+
+// GetUID returns the Account's UID, empty until it has been added.
+func (v *Account) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Account's UID.
+func (v *Account) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Account's dgraph.type values: its DType, or
+// {"Account"} until Add sets it.
+func (v *Account) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Account"}
+}
+
+// String returns a one-line summary of the Account: its UID.
+func (v Account) String() string {
+	return fmt.Sprintf("Account(%s)", v.UID)
+}
+
+// Add inserts a new Account into the database.
+func (c *AccountClient) Add(ctx context.Context, v *Account) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Account node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+//
+// With WithUpsert, the node with the same Email, if there is one, is
+// updated and its UID returned instead.
+func (c *AccountClient) Create(ctx context.Context, v *Account, opts ...CreateOption) (string, error) {
+	var cfg createConfig
+	for _, opt := range opts {
+		opt.applyCreate(&cfg)
+	}
+	if v.UID != "" {
+		return "", fmt.Errorf("Account.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Account"}
+	}
+	if cfg.upsert {
+		if err := c.conn.Upsert(ctx, v, "email"); err != nil {
+			return "", err
+		}
+		return v.UID, nil
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Account.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Account in the database. The UID field must be set.
+//
+// An empty Password is left out, keeping the stored password, as Get
+// never reads it and updating what Get returned must not overwrite it.
+func (c *AccountClient) Update(ctx context.Context, v *Account) error {
+	var unset []string
+	if v.Password == "" {
+		unset = append(unset, "password")
+	}
+	if len(unset) > 0 {
+		if _, err := formatUIDs([]string{v.UID}); err != nil {
+			return fmt.Errorf("Account.Update: %w", err)
+		}
+		node, err := typedNode(v, v.UID)
+		if err != nil {
+			return err
+		}
+		for _, key := range unset {
+			delete(node, key)
+		}
+		_, err = mutate(ctx, c.conn, node, nil)
+		return err
+	}
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Account with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *AccountClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Account.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetEmail sets the Email of the Account with the given UID to value, touching
+// no other predicate.
+func (c *AccountClient) SetEmail(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"email": value}); err != nil {
+		return fmt.Errorf("Account.SetEmail: %w", err)
+	}
+	return nil
+}
+
+// SetPassword sets the Password of the Account with the given UID to value, touching
+// no other predicate.
+func (c *AccountClient) SetPassword(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"password": value}); err != nil {
+		return fmt.Errorf("Account.SetPassword: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Account with the given UID from the database.
+func (c *AccountClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// CheckPassword reports whether plaintext matches the Password of the Account with the given
+// UID, using Dgraph's checkpwd. The stored hash is never read.
+func (c *AccountClient) CheckPassword(ctx context.Context, uid, plaintext string) (bool, error) {
+	return checkPassword(ctx, c.conn.QueryRaw, uid, "Account", "password", plaintext)
+}
+
+// accountSelection returns the DQL selection for an Account: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func accountSelection(depth int) string {
+	s := "uid dgraph.type email"
+	return s
+}
+
+// List retrieves Accounts with optional pagination.
+func (c *AccountClient) List(ctx context.Context, opts ...PageOption) ([]Account, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var accounts []Account
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&accounts)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// Find retrieves Accounts matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *AccountClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Account, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var accounts []Account
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&accounts)
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// GetByEmail retrieves the Account whose Email is value. The error wraps ErrNotFound
+// if there is none, and ErrNotUnique if there is more than one.
+func (c *AccountClient) GetByEmail(ctx context.Context, value string) (*Account, error) {
+	var results []Account
+	err := queryNodes(ctx, c.conn.QueryRaw, "Account", "eq(email, "+formatString(value)+")", "", accountSelection(1), 2, 0, &results)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, fmt.Errorf("Account with Email %q: %w", value, ErrNotFound)
+	case 1:
+		return &results[0], nil
+	}
+	return nil, fmt.Errorf("Account with Email %q: %w", value, ErrNotUnique)
+}
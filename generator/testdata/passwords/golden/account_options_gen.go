@@ -0,0 +1,27 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package passwords
+
+// AccountOption is a functional option for configuring Account mutations.
+type AccountOption func(*Account)
+
+// WithAccountEmail sets the Email field on an Account.
+func WithAccountEmail(v string) AccountOption {
+	return func(e *Account) {
+		e.Email = v
+	}
+}
+
+// WithAccountPassword sets the Password field on an Account.
+func WithAccountPassword(v string) AccountOption {
+	return func(e *Account) {
+		e.Password = v
+	}
+}
+
+// ApplyAccountOptions applies the given options to an Account.
+func ApplyAccountOptions(e *Account, opts ...AccountOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
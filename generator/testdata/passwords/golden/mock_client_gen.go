@@ -0,0 +1,295 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package passwords
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MockClient is an in-memory stand-in for Client, for unit tests that run
+// without Dgraph. Each entity's nodes are kept in a map keyed by UID. Find
+// understands eq() filters on hash- and exact-indexed predicates, joined by AND.
+// Like Client, it is safe for concurrent use, and it stores and returns copies,
+// so callers may change the values they pass in and get back.
+type MockClient struct {
+	Account *MockAccountClient
+}
+
+// NewMockClient returns a MockClient with no stored nodes.
+func NewMockClient() *MockClient {
+	uids := &mockUIDs{}
+	return &MockClient{
+		Account: &MockAccountClient{uids: uids, nodes: make(map[string]Account)},
+	}
+}
+
+// mockUIDs allocates UIDs shared by all entities of a MockClient.
+type mockUIDs struct {
+	mu   sync.Mutex
+	last uint64
+}
+
+func (u *mockUIDs) next() string {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.last++
+	return fmt.Sprintf("0x%x", u.last)
+}
+
+// mockCond is a single eq(predicate, value) term of a filter.
+type mockCond struct {
+	predicate string
+	value     string
+}
+
+var mockEq = regexp.MustCompile(`^eq\(\s*([^,\s]+)\s*,\s*(.+?)\s*\)$`)
+
+// parseMockFilter splits filter into its eq() terms. Terms may be joined by AND
+// and wrapped in parentheses; anything else is rejected.
+func parseMockFilter(filter string) ([]mockCond, error) {
+	terms, err := splitMockFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	var conds []mockCond
+	for _, term := range terms {
+		// Drop the grouping parentheses that Query's where() adds around terms.
+		term = strings.TrimLeft(term, "( ")
+		for unquotedCount(term, ')') > unquotedCount(term, '(') && strings.HasSuffix(term, ")") {
+			term = strings.TrimSpace(strings.TrimSuffix(term, ")"))
+		}
+		m := mockEq.FindStringSubmatch(term)
+		if m == nil {
+			return nil, fmt.Errorf("mock: unsupported filter %q", term)
+		}
+		value := m[2]
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		conds = append(conds, mockCond{predicate: m[1], value: value})
+	}
+	return conds, nil
+}
+
+// splitMockFilter splits filter at each AND outside its quoted strings, so
+// that a value such as "Fast AND Furious" stays in one term.
+func splitMockFilter(filter string) ([]string, error) {
+	var terms []string
+	start, quoted := 0, false
+	for i := 0; i < len(filter); i++ {
+		switch {
+		case quoted && filter[i] == '\\':
+			i++
+		case filter[i] == '"':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(filter[i:], " AND "):
+			terms = append(terms, filter[start:i])
+			i += len(" AND ") - 1
+			start = i + 1
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("mock: unterminated string in filter %q", filter)
+	}
+	return append(terms, filter[start:]), nil
+}
+
+// unquotedCount returns the number of times c occurs in s outside its quoted
+// strings.
+func unquotedCount(s string, c byte) int {
+	n, quoted := 0, false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quoted && s[i] == '\\':
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && s[i] == c:
+			n++
+		}
+	}
+	return n
+}
+
+// mockCopy returns a deep copy of v made by a JSON round trip, as a value
+// stored in Dgraph would be, so that the copy shares no slices with v.
+func mockCopy[T any](v T) (T, error) {
+	var c T
+	data, err := json.Marshal(v)
+	if err != nil {
+		return c, err
+	}
+	err = json.Unmarshal(data, &c)
+	return c, err
+}
+
+// mockPage returns copies of the nodes that satisfy keep, ordered by UID and
+// paged by opts.
+func mockPage[T any](nodes map[string]T, keep func(T) bool, opts []PageOption) ([]T, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	uids := make([]string, 0, len(nodes))
+	for uid, v := range nodes {
+		if keep(v) {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		a, _ := strconv.ParseUint(strings.TrimPrefix(uids[i], "0x"), 16, 64)
+		b, _ := strconv.ParseUint(strings.TrimPrefix(uids[j], "0x"), 16, 64)
+		return a < b
+	})
+	if cfg.offset >= len(uids) {
+		return nil, nil
+	}
+	uids = uids[cfg.offset:]
+	if cfg.first > 0 && cfg.first < len(uids) {
+		uids = uids[:cfg.first]
+	}
+	results := make([]T, len(uids))
+	for i, uid := range uids {
+		v, err := mockCopy(nodes[uid])
+		if err != nil {
+			return nil, err
+		}
+		results[i] = v
+	}
+	return results, nil
+}
+
+// MockAccountClient is an in-memory AccountAPI.
+type MockAccountClient struct {
+	uids  *mockUIDs
+	mu    sync.Mutex
+	nodes map[string]Account
+}
+
+var _ AccountAPI = (*MockAccountClient)(nil)
+
+// Get returns the stored Account with the given UID, or ErrNotFound.
+func (c *MockAccountClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Account, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.nodes[uid]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	v, err := mockCopy(v)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Exists reports whether an Account with the given UID is stored.
+func (c *MockAccountClient) Exists(ctx context.Context, uid string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.nodes[uid]
+	return ok, nil
+}
+
+// Add stores v, assigning it a UID if it has none.
+func (c *MockAccountClient) Add(ctx context.Context, v *Account) error {
+	if v.UID == "" {
+		v.UID = c.uids.next()
+	}
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Create stores v, which must not have a UID yet, and returns the UID it
+// assigns. WithUpsert is accepted, but as with Add no existing node is reused.
+func (c *MockAccountClient) Create(ctx context.Context, v *Account, opts ...CreateOption) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Account.Create: UID is already set to %s", v.UID)
+	}
+	if err := c.Add(ctx, v); err != nil {
+		return "", err
+	}
+	return v.UID, nil
+}
+
+// Update replaces the stored Account with v, or returns ErrNotFound.
+// An empty Password keeps the stored one, as AccountClient.Update does.
+func (c *MockAccountClient) Update(ctx context.Context, v *Account) error {
+	stored, err := mockCopy(*v)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	old, ok := c.nodes[v.UID]
+	if !ok {
+		return ErrNotFound
+	}
+	if v.Password == "" {
+		stored.Password = old.Password
+	}
+	c.nodes[v.UID] = stored
+	return nil
+}
+
+// Delete removes the Account with the given UID, if stored.
+func (c *MockAccountClient) Delete(ctx context.Context, uid string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.nodes, uid)
+	return nil
+}
+
+// List returns the stored Accounts in UID order with optional pagination.
+func (c *MockAccountClient) List(ctx context.Context, opts ...PageOption) ([]Account, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(Account) bool { return true }, opts)
+}
+
+// Find returns the stored Accounts matching filter, which may only use
+// eq() on hash- or exact-indexed predicates.
+func (c *MockAccountClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Account, error) {
+	conds, err := parseMockFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conds {
+		if _, ok := mockAccountValue(Account{}, cond.predicate); !ok {
+			return nil, fmt.Errorf("mock: Account has no hash or exact index on %q", cond.predicate)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return mockPage(c.nodes, func(v Account) bool {
+		for _, cond := range conds {
+			if got, _ := mockAccountValue(v, cond.predicate); got != cond.value {
+				return false
+			}
+		}
+		return true
+	}, opts)
+}
+
+// mockAccountValue returns the value of the eq()-filterable predicate on v as
+// a string, and false if the predicate has no hash or exact index.
+func mockAccountValue(v Account, predicate string) (string, bool) {
+	switch predicate {
+	case "email":
+		return fmt.Sprint(v.Email), true
+	}
+	return "", false
+}
@@ -0,0 +1,16 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package passwords
+
+// DQLSchema is the Dgraph schema for the passwords data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+email: string @index(exact) @upsert .
+password: password .
+
+type Account {
+	email
+	password
+}
+`
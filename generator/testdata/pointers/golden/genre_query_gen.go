@@ -0,0 +1,307 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package pointers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GenreQuery is a typed query builder for Genre entities.
+type GenreQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Genre entities.
+func (c *GenreClient) Query(ctx context.Context) *GenreQuery {
+	return &GenreQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *GenreQuery) Filter(f string) *GenreQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *GenreQuery) where(expr string) *GenreQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from GenreWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *GenreQuery) Where(f Filter[Genre]) *GenreQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Genre entities that have a value for Name, using
+// has(name).
+func (q *GenreQuery) HasName() *GenreQuery {
+	return q.Where(GenreWhere.HasName())
+}
+
+// NotName filters to Genre entities that have no Name value.
+func (q *GenreQuery) NotName() *GenreQuery {
+	return q.Where(GenreWhere.NotName())
+}
+
+// HasFilms filters to Genre entities that have a value for Films, using
+// has(~genre).
+func (q *GenreQuery) HasFilms() *GenreQuery {
+	return q.Where(GenreWhere.HasFilms())
+}
+
+// NotFilms filters to Genre entities that have no Films value.
+func (q *GenreQuery) NotFilms() *GenreQuery {
+	return q.Where(GenreWhere.NotFilms())
+}
+
+// FilmsContains filters to Genre entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~genre, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *GenreQuery) FilmsContains(uids ...string) *GenreQuery {
+	return q.Where(GenreWhere.FilmsContains(uids...))
+}
+
+// NameAllOfTerms filters to Genre entities whose Name contains all of the terms.
+func (q *GenreQuery) NameAllOfTerms(terms string) *GenreQuery {
+	return q.Where(GenreWhere.NameAllOfTerms(terms))
+}
+
+// NameAnyOfTerms filters to Genre entities whose Name contains any of the terms.
+func (q *GenreQuery) NameAnyOfTerms(terms string) *GenreQuery {
+	return q.Where(GenreWhere.NameAnyOfTerms(terms))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *GenreQuery) OrderAsc(field string) *GenreQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *GenreQuery) OrderDesc(field string) *GenreQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *GenreQuery) First(n int) *GenreQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *GenreQuery) Offset(n int) *GenreQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *GenreQuery) withEdge(selection string) *GenreQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *GenreQuery) WithFilms() *GenreQuery {
+	return q.withEdge("films: ~genre { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Genre and the edges added by the With methods.
+func (q *GenreQuery) selection() string {
+	s := genreSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *GenreQuery) dql() string {
+	return nodesQuery("Genre", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *GenreQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Genre with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Genre.
+func (q *GenreQuery) GetByUID(uid string) (*Genre, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Genre
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Genre", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *GenreQuery) Exec(dst *[]Genre) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Genre{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *GenreQuery) ExecAndCount(dst *[]Genre) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("GenreQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Genre", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Genre{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// GenreWhere builds the conditions on Genre fields that GenreQuery.Where takes.
+var GenreWhere GenreConditions
+
+// GenreConditions has a method for each typed filter of GenreQuery, returning it as a
+// Filter[Genre] to combine with And, Or, and Not.
+type GenreConditions struct{}
+
+// HasName matches Genre entities that have a value for Name, using
+// has(name).
+func (GenreConditions) HasName() Filter[Genre] {
+	return Filter[Genre]{expr: "has(name)"}
+}
+
+// NotName matches Genre entities that have no Name value.
+func (GenreConditions) NotName() Filter[Genre] {
+	return Filter[Genre]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Genre entities that have a value for Films, using
+// has(~genre).
+func (GenreConditions) HasFilms() Filter[Genre] {
+	return Filter[Genre]{expr: "has(~genre)"}
+}
+
+// NotFilms matches Genre entities that have no Films value.
+func (GenreConditions) NotFilms() Filter[Genre] {
+	return Filter[Genre]{expr: "NOT has(~genre)"}
+}
+
+// FilmsContains matches Genre entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~genre, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (GenreConditions) FilmsContains(uids ...string) Filter[Genre] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Genre]{err: fmt.Errorf("Genre.Films: %w", err)}
+	}
+	return Filter[Genre]{expr: "uid_in(~genre, " + list + ")"}
+}
+
+// NameAllOfTerms matches Genre entities whose Name contains all of the terms.
+func (GenreConditions) NameAllOfTerms(terms string) Filter[Genre] {
+	return Filter[Genre]{expr: "allofterms(name, " + formatString(terms) + ")"}
+}
+
+// NameAnyOfTerms matches Genre entities whose Name contains any of the terms.
+func (GenreConditions) NameAnyOfTerms(terms string) Filter[Genre] {
+	return Filter[Genre]{expr: "anyofterms(name, " + formatString(terms) + ")"}
+}
@@ -0,0 +1,21 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package pointers
+
+// DQLSchema is the Dgraph schema for the pointers data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+genre: [uid] @reverse @count .
+name: string @index(term) .
+
+type Film {
+	name
+	genre
+}
+
+type Genre {
+	name
+	<~genre>
+}
+`
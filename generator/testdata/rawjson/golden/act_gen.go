@@ -0,0 +1,230 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ActAPI is the set of Act operations provided by ActClient. Code
+// that depends on ActAPI rather than *ActClient can run against a test double.
+type ActAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Act, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Act) error
+	Create(ctx context.Context, v *Act) (string, error)
+	Update(ctx context.Context, v *Act) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Act, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Act, error)
+}
+
+// ActClient provides typed CRUD operations for Act entities.
+//
+// Act is a performer booked at a venue.
+type ActClient struct {
+	conn graphConn
+}
+
+var _ ActAPI = (*ActClient)(nil)
+
+// Get retrieves a single Act by its UID.
+func (c *ActClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Act, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Act
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Act", actSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Act with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *ActClient) GetExpanded(ctx context.Context, uid string) (*Act, error) {
+	var result Act
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Act", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Act type. A node of another type does not count.
+func (c *ActClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Act")
+}
+
+// Load populates v with the Act stored under uid, using c.Act.Get.
+func (v *Act) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Act.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Act)(nil)
+
+// GetUID returns the Act's UID, empty until it has been added.
+func (v *Act) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Act's UID.
+func (v *Act) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Act's dgraph.type values: its DType, or
+// {"Act"} until Add sets it.
+func (v *Act) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Act"}
+}
+
+// String returns a one-line summary of the Act: its UID.
+func (v Act) String() string {
+	return fmt.Sprintf("Act(%s)", v.UID)
+}
+
+// Add inserts a new Act into the database.
+func (c *ActClient) Add(ctx context.Context, v *Act) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Act node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *ActClient) Create(ctx context.Context, v *Act) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Act.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Act"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Act.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Act in the database. The UID field must be set.
+func (c *ActClient) Update(ctx context.Context, v *Act) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Act with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *ActClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Act.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Act with the given UID to value, touching
+// no other predicate.
+func (c *ActClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Act.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetStart sets the Start of the Act with the given UID to value, touching
+// no other predicate.
+func (c *ActClient) SetStart(ctx context.Context, uid string, value time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"start": value}); err != nil {
+		return fmt.Errorf("Act.SetStart: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Act with the given UID from the database.
+func (c *ActClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// actSelection returns the DQL selection for an Act: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func actSelection(depth int) string {
+	s := "uid dgraph.type name start"
+	return s
+}
+
+// List retrieves Acts with optional pagination.
+func (c *ActClient) List(ctx context.Context, opts ...PageOption) ([]Act, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var acts []Act
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&acts)
+	if err != nil {
+		return nil, err
+	}
+	return acts, nil
+}
+
+// Find retrieves Acts matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *ActClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Act, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var acts []Act
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&acts)
+	if err != nil {
+		return nil, err
+	}
+	return acts, nil
+}
+
+// GetByName retrieves the Act entities whose Name is value, with optional
+// pagination.
+func (c *ActClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Act, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Act
+	err := queryNodes(ctx, c.conn.QueryRaw, "Act", "eq(name, "+formatString(value)+")", "", actSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
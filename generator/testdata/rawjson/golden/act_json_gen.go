@@ -0,0 +1,26 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes an Act from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+func (v *Act) UnmarshalJSON(data []byte) error {
+	type plain Act
+	in := struct {
+		*plain
+		Start json.RawMessage `json:"start"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Start, &v.Start); err != nil {
+		return fmt.Errorf("Act.Start: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import "time"
+
+// ActOption is a functional option for configuring Act mutations.
+type ActOption func(*Act)
+
+// WithActName sets the Name field on an Act.
+func WithActName(v string) ActOption {
+	return func(e *Act) {
+		e.Name = v
+	}
+}
+
+// WithActStart sets the Start field on an Act.
+func WithActStart(v time.Time) ActOption {
+	return func(e *Act) {
+		e.Start = v
+	}
+}
+
+// ApplyActOptions applies the given options to an Act.
+func ApplyActOptions(e *Act, opts ...ActOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
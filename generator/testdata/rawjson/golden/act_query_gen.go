@@ -0,0 +1,245 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"context"
+)
+
+// ActQuery is a typed query builder for Act entities.
+type ActQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Act entities.
+func (c *ActClient) Query(ctx context.Context) *ActQuery {
+	return &ActQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *ActQuery) Filter(f string) *ActQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *ActQuery) where(expr string) *ActQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from ActWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *ActQuery) Where(f Filter[Act]) *ActQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Act entities that have a value for Name, using
+// has(name).
+func (q *ActQuery) HasName() *ActQuery {
+	return q.Where(ActWhere.HasName())
+}
+
+// NotName filters to Act entities that have no Name value.
+func (q *ActQuery) NotName() *ActQuery {
+	return q.Where(ActWhere.NotName())
+}
+
+// HasStart filters to Act entities that have a value for Start, using
+// has(start).
+func (q *ActQuery) HasStart() *ActQuery {
+	return q.Where(ActWhere.HasStart())
+}
+
+// NotStart filters to Act entities that have no Start value.
+func (q *ActQuery) NotStart() *ActQuery {
+	return q.Where(ActWhere.NotStart())
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *ActQuery) OrderAsc(field string) *ActQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *ActQuery) OrderDesc(field string) *ActQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *ActQuery) First(n int) *ActQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *ActQuery) Offset(n int) *ActQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Act and the edges added by the With methods.
+func (q *ActQuery) selection() string {
+	s := actSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *ActQuery) dql() string {
+	return nodesQuery("Act", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *ActQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Act with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Act.
+func (q *ActQuery) GetByUID(uid string) (*Act, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Act
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Act", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *ActQuery) Exec(dst *[]Act) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Act{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *ActQuery) ExecAndCount(dst *[]Act) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Act", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Act{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// ActWhere builds the conditions on Act fields that ActQuery.Where takes.
+var ActWhere ActConditions
+
+// ActConditions has a method for each typed filter of ActQuery, returning it as a
+// Filter[Act] to combine with And, Or, and Not.
+type ActConditions struct{}
+
+// HasName matches Act entities that have a value for Name, using
+// has(name).
+func (ActConditions) HasName() Filter[Act] {
+	return Filter[Act]{expr: "has(name)"}
+}
+
+// NotName matches Act entities that have no Name value.
+func (ActConditions) NotName() Filter[Act] {
+	return Filter[Act]{expr: "NOT has(name)"}
+}
+
+// HasStart matches Act entities that have a value for Start, using
+// has(start).
+func (ActConditions) HasStart() Filter[Act] {
+	return Filter[Act]{expr: "has(start)"}
+}
+
+// NotStart matches Act entities that have no Start value.
+func (ActConditions) NotStart() Filter[Act] {
+	return Filter[Act]{expr: "NOT has(start)"}
+}
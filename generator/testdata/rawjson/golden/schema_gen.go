@@ -0,0 +1,28 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+// DQLSchema is the Dgraph schema for the rawjson data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+closed: datetime .
+loc: geo @index(geo) .
+name: string @index(hash) .
+opened: datetime @index(year) .
+start: datetime .
+venue.act: [uid] .
+
+type Act {
+	name
+	start
+}
+
+type Venue {
+	name
+	opened
+	closed
+	loc
+	venue.act
+}
+`
@@ -0,0 +1,280 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/dgraph-io/dgo/v250"
+)
+
+// Txn groups writes to several entities into a single Dgraph transaction.
+// Reads made through it see its own uncommitted writes. Every Txn must end
+// with Commit or Discard; calling Discard after Commit is a no-op, so
+// `defer txn.Discard(ctx)` is safe.
+type Txn struct {
+	txn     *dgo.Txn
+	cleanup func()
+	done    sync.Once
+	Act     *ActTxn
+	Venue   *VenueTxn
+}
+
+// NewTxn starts a transaction on the client's connection.
+func (c *Client) NewTxn(ctx context.Context) (*Txn, error) {
+	dg, cleanup, err := c.conn.DgraphClient()
+	if err != nil {
+		return nil, err
+	}
+	t := &Txn{txn: dg.NewTxn(), cleanup: cleanup}
+	t.Act = &ActTxn{txn: t}
+	t.Venue = &VenueTxn{txn: t}
+	return t, nil
+}
+
+// DgraphTxn returns the underlying dgo transaction, for operations the
+// generated API does not cover.
+func (t *Txn) DgraphTxn() *dgo.Txn {
+	return t.txn
+}
+
+// Commit commits the transaction's writes.
+func (t *Txn) Commit(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Commit(ctx)
+}
+
+// Discard abandons the transaction's writes.
+func (t *Txn) Discard(ctx context.Context) error {
+	defer t.release()
+	return t.txn.Discard(ctx)
+}
+
+// release returns the connection obtained by NewTxn, once.
+func (t *Txn) release() {
+	t.done.Do(t.cleanup)
+}
+
+// query runs a read-only DQL query inside the transaction.
+func (t *Txn) query(ctx context.Context, q string, vars map[string]string) ([]byte, error) {
+	resp, err := t.txn.QueryWithVars(ctx, q, vars)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Json, nil
+}
+
+// ActTxn provides Act operations within a Txn.
+type ActTxn struct {
+	txn *Txn
+}
+
+var _ ActAPI = (*ActTxn)(nil)
+
+// Get retrieves a single Act by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *ActTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Act, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Act
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Act", actSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Act type, seeing the transaction's own writes.
+func (t *ActTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Act")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *ActTxn) Add(ctx context.Context, v *Act) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Act"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *ActTxn) Create(ctx context.Context, v *Act) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Act.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Act"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Act.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *ActTxn) Update(ctx context.Context, v *Act) error {
+	if v.UID == "" {
+		return errors.New("Act.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Act with the given UID in the transaction.
+func (t *ActTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Act entities with optional pagination.
+func (t *ActTxn) List(ctx context.Context, opts ...PageOption) ([]Act, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Act entities matching the DQL filter expression, with
+// optional pagination.
+func (t *ActTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Act, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Act
+	err := queryNodes(ctx, t.txn.query, "Act", filter, "", actSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// VenueTxn provides Venue operations within a Txn.
+type VenueTxn struct {
+	txn *Txn
+}
+
+var _ VenueAPI = (*VenueTxn)(nil)
+
+// Get retrieves a single Venue by its UID, with edges expanded one level
+// deep unless WithDepth says otherwise.
+func (t *VenueTxn) Get(ctx context.Context, uid string, opts ...GetOption) (*Venue, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Venue
+	if err := getByUIDWith(ctx, t.txn.query, uid, "Venue", venueSelection(cfg.depth), &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Venue type, seeing the transaction's own writes.
+func (t *VenueTxn) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, t.txn.query, uid, "Venue")
+}
+
+// Add inserts v in the transaction and sets its UID.
+func (t *VenueTxn) Add(ctx context.Context, v *Venue) error {
+	node := *v
+	if node.UID == "" {
+		node.UID = "_:node"
+	}
+	if len(node.DType) == 0 {
+		node.DType = []string{"Venue"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return err
+	}
+	if uid, ok := uids["node"]; ok {
+		node.UID = uid
+	}
+	v.UID, v.DType = node.UID, node.DType
+	return nil
+}
+
+// Create inserts v, which must not have a UID yet, in the transaction and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType.
+func (t *VenueTxn) Create(ctx context.Context, v *Venue) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Venue.Create: UID is already set to %s", v.UID)
+	}
+	node := *v
+	node.UID = "_:node"
+	if len(node.DType) == 0 {
+		node.DType = []string{"Venue"}
+	}
+	uids, err := mutateIn(ctx, t.txn.txn, false, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Venue.Create: no UID assigned")
+	}
+	v.UID, v.DType = uid, node.DType
+	return uid, nil
+}
+
+// Update writes v's fields in the transaction. The UID field must be set.
+func (t *VenueTxn) Update(ctx context.Context, v *Venue) error {
+	if v.UID == "" {
+		return errors.New("Venue.Update: UID is empty")
+	}
+	_, err := mutateIn(ctx, t.txn.txn, false, v, nil)
+	return err
+}
+
+// Delete removes the Venue with the given UID in the transaction.
+func (t *VenueTxn) Delete(ctx context.Context, uid string) error {
+	_, err := mutateIn(ctx, t.txn.txn, false, nil, map[string]string{"uid": uid})
+	return err
+}
+
+// List retrieves Venue entities with optional pagination.
+func (t *VenueTxn) List(ctx context.Context, opts ...PageOption) ([]Venue, error) {
+	return t.Find(ctx, "", opts...)
+}
+
+// Find retrieves Venue entities matching the DQL filter expression, with
+// optional pagination.
+func (t *VenueTxn) Find(ctx context.Context, filter string, opts ...PageOption) ([]Venue, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Venue
+	err := queryNodes(ctx, t.txn.query, "Venue", filter, "", venueSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
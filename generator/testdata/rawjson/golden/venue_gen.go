@@ -0,0 +1,291 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VenueAPI is the set of Venue operations provided by VenueClient. Code
+// that depends on VenueAPI rather than *VenueClient can run against a test double.
+type VenueAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Venue, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Venue) error
+	Create(ctx context.Context, v *Venue) (string, error)
+	Update(ctx context.Context, v *Venue) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Venue, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Venue, error)
+}
+
+// VenueClient provides typed CRUD operations for Venue entities.
+//
+// Venue exercises the values Dgraph returns in shapes encoding/json does not
+// decode into plain struct fields: datetimes, geo points, and edges.
+type VenueClient struct {
+	conn graphConn
+}
+
+var _ VenueAPI = (*VenueClient)(nil)
+
+// Get retrieves a single Venue by its UID.
+func (c *VenueClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Venue, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Venue
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Venue", venueSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Venue with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *VenueClient) GetExpanded(ctx context.Context, uid string) (*Venue, error) {
+	var result Venue
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Venue", map[string]string{
+		"venue.act": "acts",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Venue type. A node of another type does not count.
+func (c *VenueClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Venue")
+}
+
+// Load populates v with the Venue stored under uid, using c.Venue.Get.
+func (v *Venue) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Venue.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadActs returns v.Acts, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Acts, with the scalar predicates of each Act, is kept in
+// v.Acts, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Acts to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Acts may be loaded and empty, and
+// LoadActs queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Venue) LoadActs(ctx context.Context, c *Client) ([]Act, error) {
+	if v.Acts != nil {
+		return v.Acts, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Venue.LoadActs: UID is empty")
+	}
+	var got Venue
+	selection := "uid acts: venue.act { " + actSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Venue", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Acts = got.Acts
+	if v.Acts == nil {
+		v.Acts = []Act{}
+	}
+	return v.Acts, nil
+}
+
+var _ Entity = (*Venue)(nil)
+
+// GetUID returns the Venue's UID, empty until it has been added.
+func (v *Venue) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Venue's UID.
+func (v *Venue) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Venue's dgraph.type values: its DType, or
+// {"Venue"} until Add sets it.
+func (v *Venue) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Venue"}
+}
+
+// String returns a one-line summary of the Venue: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Venue) String() string {
+	return fmt.Sprintf("Venue(%s acts=%d)", v.UID, len(v.Acts))
+}
+
+// Add inserts a new Venue into the database.
+func (c *VenueClient) Add(ctx context.Context, v *Venue) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Venue node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *VenueClient) Create(ctx context.Context, v *Venue) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Venue.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Venue"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Venue.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Venue in the database. The UID field must be set.
+func (c *VenueClient) Update(ctx context.Context, v *Venue) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Venue with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *VenueClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Venue.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Venue with the given UID to value, touching
+// no other predicate.
+func (c *VenueClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Venue.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetOpened sets the Opened of the Venue with the given UID to value, touching
+// no other predicate.
+func (c *VenueClient) SetOpened(ctx context.Context, uid string, value time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"opened": value}); err != nil {
+		return fmt.Errorf("Venue.SetOpened: %w", err)
+	}
+	return nil
+}
+
+// SetClosed sets the Closed of the Venue with the given UID to value, touching
+// no other predicate.
+func (c *VenueClient) SetClosed(ctx context.Context, uid string, value *time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"closed": value}); err != nil {
+		return fmt.Errorf("Venue.SetClosed: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Venue with the given UID from the database.
+func (c *VenueClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddActs links the Venue with the given UID to the Act nodes with the
+// given UIDs through venue.act, keeping the Acts it has.
+func (c *VenueClient) AddActs(ctx context.Context, venueUID string, actUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "venue.act", []string{venueUID}, actUIDs, false); err != nil {
+		return fmt.Errorf("Venue.AddActs: %w", err)
+	}
+	return nil
+}
+
+// RemoveActs unlinks the Venue with the given UID from the Act nodes
+// with the given UIDs, deleting their venue.act edges.
+func (c *VenueClient) RemoveActs(ctx context.Context, venueUID string, actUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "venue.act", []string{venueUID}, actUIDs, true); err != nil {
+		return fmt.Errorf("Venue.RemoveActs: %w", err)
+	}
+	return nil
+}
+
+// venueSelection returns the DQL selection for a Venue: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func venueSelection(depth int) string {
+	s := "uid dgraph.type name opened closed loc"
+	if depth > 0 {
+		s += " acts: venue.act { " + actSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Venues with optional pagination.
+func (c *VenueClient) List(ctx context.Context, opts ...PageOption) ([]Venue, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var venues []Venue
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&venues)
+	if err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// Find retrieves Venues matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *VenueClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Venue, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var venues []Venue
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&venues)
+	if err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+// GetByName retrieves the Venue entities whose Name is value, with optional
+// pagination.
+func (c *VenueClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Venue, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Venue
+	err := queryNodes(ctx, c.conn.QueryRaw, "Venue", "eq(name, "+formatString(value)+")", "", venueSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Venue in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+//   - Geo coordinates are GeoJSON points.
+func (v Venue) MarshalJSON() ([]byte, error) {
+	type plain Venue
+	out := struct {
+		plain
+		Opened *time.Time `json:"opened,omitempty"`
+		Loc    *geoPoint  `json:"loc,omitempty"`
+	}{plain: plain(v)}
+	if !v.Opened.IsZero() {
+		out.Opened = &v.Opened
+	}
+	out.Loc = encodeGeoPoint(v.Loc)
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Venue from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - Geo coordinates may be GeoJSON points or bare coordinate arrays.
+//   - An edge may be a single object rather than a list.
+func (v *Venue) UnmarshalJSON(data []byte) error {
+	type plain Venue
+	in := struct {
+		*plain
+		Opened json.RawMessage `json:"opened"`
+		Closed json.RawMessage `json:"closed"`
+		Loc    json.RawMessage `json:"loc"`
+		Acts   json.RawMessage `json:"acts"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Opened, &v.Opened); err != nil {
+		return fmt.Errorf("Venue.Opened: %w", err)
+	}
+	if err := decodeDatetimePtr(in.Closed, &v.Closed); err != nil {
+		return fmt.Errorf("Venue.Closed: %w", err)
+	}
+	if err := decodeGeoPoint(in.Loc, &v.Loc); err != nil {
+		return fmt.Errorf("Venue.Loc: %w", err)
+	}
+	if err := decodeEdges(in.Acts, &v.Acts); err != nil {
+		return fmt.Errorf("Venue.Acts: %w", err)
+	}
+	return nil
+}
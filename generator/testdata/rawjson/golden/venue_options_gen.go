@@ -0,0 +1,43 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import "time"
+
+// VenueOption is a functional option for configuring Venue mutations.
+type VenueOption func(*Venue)
+
+// WithVenueName sets the Name field on a Venue.
+func WithVenueName(v string) VenueOption {
+	return func(e *Venue) {
+		e.Name = v
+	}
+}
+
+// WithVenueOpened sets the Opened field on a Venue.
+func WithVenueOpened(v time.Time) VenueOption {
+	return func(e *Venue) {
+		e.Opened = v
+	}
+}
+
+// WithVenueClosed sets the Closed field on a Venue.
+func WithVenueClosed(v *time.Time) VenueOption {
+	return func(e *Venue) {
+		e.Closed = v
+	}
+}
+
+// WithVenueLoc sets the Loc field on a Venue.
+func WithVenueLoc(v []float64) VenueOption {
+	return func(e *Venue) {
+		e.Loc = v
+	}
+}
+
+// ApplyVenueOptions applies the given options to a Venue.
+func ApplyVenueOptions(e *Venue, opts ...VenueOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,420 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package rawjson
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// VenueQuery is a typed query builder for Venue entities.
+type VenueQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Venue entities.
+func (c *VenueClient) Query(ctx context.Context) *VenueQuery {
+	return &VenueQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *VenueQuery) Filter(f string) *VenueQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *VenueQuery) where(expr string) *VenueQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from VenueWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *VenueQuery) Where(f Filter[Venue]) *VenueQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Venue entities that have a value for Name, using
+// has(name).
+func (q *VenueQuery) HasName() *VenueQuery {
+	return q.Where(VenueWhere.HasName())
+}
+
+// NotName filters to Venue entities that have no Name value.
+func (q *VenueQuery) NotName() *VenueQuery {
+	return q.Where(VenueWhere.NotName())
+}
+
+// HasOpened filters to Venue entities that have a value for Opened, using
+// has(opened).
+func (q *VenueQuery) HasOpened() *VenueQuery {
+	return q.Where(VenueWhere.HasOpened())
+}
+
+// NotOpened filters to Venue entities that have no Opened value.
+func (q *VenueQuery) NotOpened() *VenueQuery {
+	return q.Where(VenueWhere.NotOpened())
+}
+
+// HasClosed filters to Venue entities that have a value for Closed, using
+// has(closed).
+func (q *VenueQuery) HasClosed() *VenueQuery {
+	return q.Where(VenueWhere.HasClosed())
+}
+
+// NotClosed filters to Venue entities that have no Closed value.
+func (q *VenueQuery) NotClosed() *VenueQuery {
+	return q.Where(VenueWhere.NotClosed())
+}
+
+// HasLoc filters to Venue entities that have a value for Loc, using
+// has(loc).
+func (q *VenueQuery) HasLoc() *VenueQuery {
+	return q.Where(VenueWhere.HasLoc())
+}
+
+// NotLoc filters to Venue entities that have no Loc value.
+func (q *VenueQuery) NotLoc() *VenueQuery {
+	return q.Where(VenueWhere.NotLoc())
+}
+
+// HasActs filters to Venue entities that have a value for Acts, using
+// has(venue.act).
+func (q *VenueQuery) HasActs() *VenueQuery {
+	return q.Where(VenueWhere.HasActs())
+}
+
+// NotActs filters to Venue entities that have no Acts value.
+func (q *VenueQuery) NotActs() *VenueQuery {
+	return q.Where(VenueWhere.NotActs())
+}
+
+// ActsContains filters to Venue entities whose Acts include any of the
+// Act nodes with the given uids, using uid_in(venue.act, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *VenueQuery) ActsContains(uids ...string) *VenueQuery {
+	return q.Where(VenueWhere.ActsContains(uids...))
+}
+
+// LocNear filters to Venue entities whose Loc lies within distMeters of (lat, lng).
+func (q *VenueQuery) LocNear(lat, lng, distMeters float64) *VenueQuery {
+	return q.Where(VenueWhere.LocNear(lat, lng, distMeters))
+}
+
+// LocWithin filters to Venue entities whose Loc lies within polygon.
+func (q *VenueQuery) LocWithin(polygon GeoPolygon) *VenueQuery {
+	return q.Where(VenueWhere.LocWithin(polygon))
+}
+
+// LocContains filters to Venue entities whose Loc contains point.
+func (q *VenueQuery) LocContains(point GeoPoint) *VenueQuery {
+	return q.Where(VenueWhere.LocContains(point))
+}
+
+// OpenedYearEquals filters to Venue entities whose Opened falls in year.
+func (q *VenueQuery) OpenedYearEquals(year int) *VenueQuery {
+	return q.Where(VenueWhere.OpenedYearEquals(year))
+}
+
+// OpenedYearBetween filters to Venue entities whose Opened falls in the
+// years from through to, inclusive.
+func (q *VenueQuery) OpenedYearBetween(from, to int) *VenueQuery {
+	return q.Where(VenueWhere.OpenedYearBetween(from, to))
+}
+
+// OpenedDateBetween filters to Venue entities whose Opened lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (q *VenueQuery) OpenedDateBetween(from, to time.Time) *VenueQuery {
+	return q.Where(VenueWhere.OpenedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *VenueQuery) OrderAsc(field string) *VenueQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *VenueQuery) OrderDesc(field string) *VenueQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *VenueQuery) First(n int) *VenueQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *VenueQuery) Offset(n int) *VenueQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *VenueQuery) withEdge(selection string) *VenueQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithActs makes GetByUID and Exec also fetch the Acts edge, with the
+// scalar predicates of each Act it leads to, in the same query.
+func (q *VenueQuery) WithActs() *VenueQuery {
+	return q.withEdge("acts: venue.act { " + actSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Venue and the edges added by the With methods.
+func (q *VenueQuery) selection() string {
+	s := venueSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *VenueQuery) dql() string {
+	return nodesQuery("Venue", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *VenueQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Venue with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Venue.
+func (q *VenueQuery) GetByUID(uid string) (*Venue, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Venue
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Venue", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *VenueQuery) Exec(dst *[]Venue) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Venue{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *VenueQuery) ExecAndCount(dst *[]Venue) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("VenueQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Venue", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Venue{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// VenueWhere builds the conditions on Venue fields that VenueQuery.Where takes.
+var VenueWhere VenueConditions
+
+// VenueConditions has a method for each typed filter of VenueQuery, returning it as a
+// Filter[Venue] to combine with And, Or, and Not.
+type VenueConditions struct{}
+
+// HasName matches Venue entities that have a value for Name, using
+// has(name).
+func (VenueConditions) HasName() Filter[Venue] {
+	return Filter[Venue]{expr: "has(name)"}
+}
+
+// NotName matches Venue entities that have no Name value.
+func (VenueConditions) NotName() Filter[Venue] {
+	return Filter[Venue]{expr: "NOT has(name)"}
+}
+
+// HasOpened matches Venue entities that have a value for Opened, using
+// has(opened).
+func (VenueConditions) HasOpened() Filter[Venue] {
+	return Filter[Venue]{expr: "has(opened)"}
+}
+
+// NotOpened matches Venue entities that have no Opened value.
+func (VenueConditions) NotOpened() Filter[Venue] {
+	return Filter[Venue]{expr: "NOT has(opened)"}
+}
+
+// HasClosed matches Venue entities that have a value for Closed, using
+// has(closed).
+func (VenueConditions) HasClosed() Filter[Venue] {
+	return Filter[Venue]{expr: "has(closed)"}
+}
+
+// NotClosed matches Venue entities that have no Closed value.
+func (VenueConditions) NotClosed() Filter[Venue] {
+	return Filter[Venue]{expr: "NOT has(closed)"}
+}
+
+// HasLoc matches Venue entities that have a value for Loc, using
+// has(loc).
+func (VenueConditions) HasLoc() Filter[Venue] {
+	return Filter[Venue]{expr: "has(loc)"}
+}
+
+// NotLoc matches Venue entities that have no Loc value.
+func (VenueConditions) NotLoc() Filter[Venue] {
+	return Filter[Venue]{expr: "NOT has(loc)"}
+}
+
+// HasActs matches Venue entities that have a value for Acts, using
+// has(venue.act).
+func (VenueConditions) HasActs() Filter[Venue] {
+	return Filter[Venue]{expr: "has(venue.act)"}
+}
+
+// NotActs matches Venue entities that have no Acts value.
+func (VenueConditions) NotActs() Filter[Venue] {
+	return Filter[Venue]{expr: "NOT has(venue.act)"}
+}
+
+// ActsContains matches Venue entities whose Acts include any of the
+// Act nodes with the given uids, using uid_in(venue.act, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (VenueConditions) ActsContains(uids ...string) Filter[Venue] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Venue]{err: fmt.Errorf("Venue.Acts: %w", err)}
+	}
+	return Filter[Venue]{expr: "uid_in(venue.act, " + list + ")"}
+}
+
+// LocNear matches Venue entities whose Loc lies within distMeters of (lat, lng).
+func (VenueConditions) LocNear(lat, lng, distMeters float64) Filter[Venue] {
+	return Filter[Venue]{expr: "near(loc, " + GeoPoint{Lat: lat, Lng: lng}.geoJSON() + ", " + formatFloat(distMeters) + ")"}
+}
+
+// LocWithin matches Venue entities whose Loc lies within polygon.
+func (VenueConditions) LocWithin(polygon GeoPolygon) Filter[Venue] {
+	return Filter[Venue]{expr: "within(loc, " + polygon.geoJSON() + ")"}
+}
+
+// LocContains matches Venue entities whose Loc contains point.
+func (VenueConditions) LocContains(point GeoPoint) Filter[Venue] {
+	return Filter[Venue]{expr: "contains(loc, " + point.geoJSON() + ")"}
+}
+
+// OpenedYearEquals matches Venue entities whose Opened falls in year.
+func (c VenueConditions) OpenedYearEquals(year int) Filter[Venue] {
+	return c.OpenedYearBetween(year, year)
+}
+
+// OpenedYearBetween matches Venue entities whose Opened falls in the
+// years from through to, inclusive.
+func (c VenueConditions) OpenedYearBetween(from, to int) Filter[Venue] {
+	return c.OpenedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// OpenedDateBetween matches Venue entities whose Opened lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (VenueConditions) OpenedDateBetween(from, to time.Time) Filter[Venue] {
+	return Filter[Venue]{expr: "between(opened, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
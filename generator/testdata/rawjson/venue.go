@@ -0,0 +1,23 @@
+package rawjson
+
+import "time"
+
+// Venue exercises the values Dgraph returns in shapes encoding/json does not
+// decode into plain struct fields: datetimes, geo points, and edges.
+type Venue struct {
+	UID    string     `json:"uid,omitempty"`
+	DType  []string   `json:"dgraph.type,omitempty"`
+	Name   string     `json:"name,omitempty" dgraph:"index=hash"`
+	Opened time.Time  `json:"opened,omitempty" dgraph:"index=year"`
+	Closed *time.Time `json:"closed,omitempty"`
+	Loc    []float64  `json:"loc,omitempty" dgraph:"index=geo type=geo"`
+	Acts   []Act      `json:"acts,omitempty" dgraph:"predicate=venue.act"`
+}
+
+// Act is a performer booked at a venue.
+type Act struct {
+	UID   string    `json:"uid,omitempty"`
+	DType []string  `json:"dgraph.type,omitempty"`
+	Name  string    `json:"name,omitempty" dgraph:"index=hash"`
+	Start time.Time `json:"start"`
+}
@@ -0,0 +1,34 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package readonly
+
+// RatingOption is a functional option for configuring Rating mutations.
+type RatingOption func(*Rating)
+
+// WithRatingSource sets the Source field on a Rating.
+func WithRatingSource(v string) RatingOption {
+	return func(e *Rating) {
+		e.Source = v
+	}
+}
+
+// WithRatingScore sets the Score field on a Rating.
+func WithRatingScore(v float64) RatingOption {
+	return func(e *Rating) {
+		e.Score = v
+	}
+}
+
+// WithRatingTags sets the Tags field on a Rating.
+func WithRatingTags(v []string) RatingOption {
+	return func(e *Rating) {
+		e.Tags = v
+	}
+}
+
+// ApplyRatingOptions applies the given options to a Rating.
+func ApplyRatingOptions(e *Rating, opts ...RatingOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package readonly
+
+// DQLSchema is the Dgraph schema for the readonly data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+film_rating: [uid] @reverse .
+name: string @index(exact, fulltext) .
+score: float @index(float) .
+source: string @index(exact) @upsert .
+tags: [string] @index(exact) .
+
+type Film {
+	name
+	film_rating
+}
+
+type Rating {
+	source
+	score
+	tags
+	<~film_rating>
+}
+`
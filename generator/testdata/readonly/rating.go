@@ -0,0 +1,30 @@
+package readonly
+
+// Film is written through the generated client as usual.
+type Film struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Name    string   `json:"name,omitempty" dgraph:"index=exact,fulltext"`
+	Ratings []Rating `json:"ratings,omitempty" dgraph:"predicate=film_rating"`
+}
+
+// Rating is maintained by another service; this package only reads it.
+//
+//dgraph:readonly
+type Rating struct {
+	UID    string   `json:"uid,omitempty"`
+	DType  []string `json:"dgraph.type,omitempty"`
+	Source string   `json:"source,omitempty" dgraph:"index=exact upsert"`
+	Score  float64  `json:"score,omitempty" dgraph:"index=float"`
+	Tags   []string `json:"tags,omitempty" dgraph:"index=exact"`
+	Films  []Film   `json:"films,omitempty" dgraph:"predicate=~film_rating reverse"`
+}
+
+// RatingSummary is the result of an aggregate query, not a node of its own.
+//
+//dgraph:generate=false
+type RatingSummary struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Average float64  `json:"average,omitempty"`
+}
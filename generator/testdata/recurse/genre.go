@@ -0,0 +1,19 @@
+package recurse
+
+// Genre is a node in a genre taxonomy: its subgenres are genres too, and its
+// parent is the reverse of their edge.
+type Genre struct {
+	UID       string   `json:"uid,omitempty"`
+	DType     []string `json:"dgraph.type,omitempty"`
+	Name      string   `json:"name,omitempty" dgraph:"index=hash"`
+	Subgenres []Genre  `json:"subgenres,omitempty" dgraph:"predicate=subgenre reverse"`
+	Parent    *Genre   `json:"parent,omitempty" dgraph:"predicate=~subgenre reverse"`
+	Films     []Film   `json:"films,omitempty" dgraph:"predicate=genre.film"`
+}
+
+// Film belongs to genres; its edge is not self-referential.
+type Film struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+}
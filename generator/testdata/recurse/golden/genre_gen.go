@@ -0,0 +1,366 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package recurse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// GenreAPI is the set of Genre operations provided by GenreClient. Code
+// that depends on GenreAPI rather than *GenreClient can run against a test double.
+type GenreAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Genre, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Genre) error
+	Create(ctx context.Context, v *Genre) (string, error)
+	Update(ctx context.Context, v *Genre) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Genre, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Genre, error)
+}
+
+// GenreClient provides typed CRUD operations for Genre entities.
+//
+// Genre is a node in a genre taxonomy: its subgenres are genres too, and its
+// parent is the reverse of their edge.
+type GenreClient struct {
+	conn graphConn
+}
+
+var _ GenreAPI = (*GenreClient)(nil)
+
+// Get retrieves a single Genre by its UID. Its self-referential edges are
+// expanded one level deep unless WithDepth says otherwise.
+func (c *GenreClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Genre, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Genre
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Genre", genreSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Genre with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *GenreClient) GetExpanded(ctx context.Context, uid string) (*Genre, error) {
+	var result Genre
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Genre", map[string]string{
+		"subgenre":   "subgenres",
+		"genre.film": "films",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Genre type. A node of another type does not count.
+func (c *GenreClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Genre")
+}
+
+// Load populates v with the Genre stored under uid, using c.Genre.Get.
+func (v *Genre) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Genre.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadSubgenres returns v.Subgenres, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Subgenres, with the scalar predicates of each Genre, is kept in
+// v.Subgenres, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Subgenres to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Subgenres may be loaded and empty, and
+// LoadSubgenres queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Genre) LoadSubgenres(ctx context.Context, c *Client) ([]Genre, error) {
+	if v.Subgenres != nil {
+		return v.Subgenres, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Genre.LoadSubgenres: UID is empty")
+	}
+	var got Genre
+	selection := "uid subgenres: subgenre { " + genreSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Genre", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Subgenres = got.Subgenres
+	if v.Subgenres == nil {
+		v.Subgenres = []Genre{}
+	}
+	return v.Subgenres, nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Genre) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Genre.LoadFilms: UID is empty")
+	}
+	var got Genre
+	selection := "uid films: genre.film { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Genre", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Genre)(nil)
+
+// GetUID returns the Genre's UID, empty until it has been added.
+func (v *Genre) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Genre's UID.
+func (v *Genre) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Genre's dgraph.type values: its DType, or
+// {"Genre"} until Add sets it.
+func (v *Genre) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Genre"}
+}
+
+// String returns a one-line summary of the Genre: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Genre) String() string {
+	return fmt.Sprintf("Genre(%s subgenres=%d parent=%d films=%d)", v.UID, len(v.Subgenres), nodeCount(v.Parent), len(v.Films))
+}
+
+// Add inserts a new Genre into the database.
+func (c *GenreClient) Add(ctx context.Context, v *Genre) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Genre node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *GenreClient) Create(ctx context.Context, v *Genre) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Genre.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Genre"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Genre.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Genre in the database. The UID field must be set.
+func (c *GenreClient) Update(ctx context.Context, v *Genre) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Genre with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *GenreClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Genre.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Genre with the given UID to value, touching
+// no other predicate.
+func (c *GenreClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Genre.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Genre with the given UID from the database.
+func (c *GenreClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddSubgenres links the Genre with the given UID to the Genre nodes with the
+// given UIDs through subgenre, keeping the Subgenres it has.
+func (c *GenreClient) AddSubgenres(ctx context.Context, genreUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "subgenre", []string{genreUID}, targetUIDs, false); err != nil {
+		return fmt.Errorf("Genre.AddSubgenres: %w", err)
+	}
+	return nil
+}
+
+// RemoveSubgenres unlinks the Genre with the given UID from the Genre nodes
+// with the given UIDs, deleting their subgenre edges.
+func (c *GenreClient) RemoveSubgenres(ctx context.Context, genreUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "subgenre", []string{genreUID}, targetUIDs, true); err != nil {
+		return fmt.Errorf("Genre.RemoveSubgenres: %w", err)
+	}
+	return nil
+}
+
+// AddParent links the Genre with the given UID to the Genre nodes with the
+// given UIDs. As Parent is the reverse of subgenre, it adds the subgenre edge from each
+// Genre to the Genre.
+func (c *GenreClient) AddParent(ctx context.Context, genreUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "subgenre", targetUIDs, []string{genreUID}, false); err != nil {
+		return fmt.Errorf("Genre.AddParent: %w", err)
+	}
+	return nil
+}
+
+// RemoveParent unlinks the Genre with the given UID from the Genre nodes
+// with the given UIDs, deleting the subgenre edge from each Genre.
+func (c *GenreClient) RemoveParent(ctx context.Context, genreUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "subgenre", targetUIDs, []string{genreUID}, true); err != nil {
+		return fmt.Errorf("Genre.RemoveParent: %w", err)
+	}
+	return nil
+}
+
+// AddFilms links the Genre with the given UID to the Film nodes with the
+// given UIDs through genre.film, keeping the Films it has.
+func (c *GenreClient) AddFilms(ctx context.Context, genreUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre.film", []string{genreUID}, filmUIDs, false); err != nil {
+		return fmt.Errorf("Genre.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Genre with the given UID from the Film nodes
+// with the given UIDs, deleting their genre.film edges.
+func (c *GenreClient) RemoveFilms(ctx context.Context, genreUID string, filmUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre.film", []string{genreUID}, filmUIDs, true); err != nil {
+		return fmt.Errorf("Genre.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
+// RecurseSubgenres retrieves the Genre with UID rootUID and follows its Subgenres edge
+// recursively, depth levels deep, using @recurse over subgenre. The
+// Genre entities found carry their scalar fields; other edges are not expanded.
+// depth must be from 1 to 16, and a node reached twice is not expanded
+// again.
+func (c *GenreClient) RecurseSubgenres(ctx context.Context, rootUID string, depth int) (*Genre, error) {
+	var result Genre
+	if err := recurseFrom(ctx, c.conn.QueryRaw, rootUID, "Genre", "uid dgraph.type name subgenres: subgenre", depth, &result); err != nil {
+		return nil, fmt.Errorf("Genre.RecurseSubgenres: %w", err)
+	}
+	return &result, nil
+}
+
+// RecurseParent retrieves the Genre with UID rootUID and follows its Parent edge
+// recursively, depth levels deep, using @recurse over ~subgenre. The
+// Genre entities found carry their scalar fields; other edges are not expanded.
+// depth must be from 1 to 16, and a node reached twice is not expanded
+// again.
+func (c *GenreClient) RecurseParent(ctx context.Context, rootUID string, depth int) (*Genre, error) {
+	var result Genre
+	if err := recurseFrom(ctx, c.conn.QueryRaw, rootUID, "Genre", "uid dgraph.type name parent: ~subgenre", depth, &result); err != nil {
+		return nil, fmt.Errorf("Genre.RecurseParent: %w", err)
+	}
+	return &result, nil
+}
+
+// genreSelection returns the DQL selection for a Genre: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func genreSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " subgenres: subgenre { " + genreSelection(depth-1) + " }"
+		s += " parent: ~subgenre { " + genreSelection(depth-1) + " }"
+		s += " films: genre.film { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Genres with optional pagination.
+func (c *GenreClient) List(ctx context.Context, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var genres []Genre
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&genres)
+	if err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+// Find retrieves Genres matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *GenreClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var genres []Genre
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&genres)
+	if err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+// GetByName retrieves the Genre entities whose Name is value, with optional
+// pagination.
+func (c *GenreClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Genre, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Genre
+	err := queryNodes(ctx, c.conn.QueryRaw, "Genre", "eq(name, "+formatString(value)+")", "", genreSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
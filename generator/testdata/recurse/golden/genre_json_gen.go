@@ -0,0 +1,33 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package recurse
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a Genre from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Genre) UnmarshalJSON(data []byte) error {
+	type plain Genre
+	in := struct {
+		*plain
+		Subgenres json.RawMessage `json:"subgenres"`
+		Parent    json.RawMessage `json:"parent"`
+		Films     json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdges(in.Subgenres, &v.Subgenres); err != nil {
+		return fmt.Errorf("Genre.Subgenres: %w", err)
+	}
+	if err := decodeEdge(in.Parent, &v.Parent); err != nil {
+		return fmt.Errorf("Genre.Parent: %w", err)
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("Genre.Films: %w", err)
+	}
+	return nil
+}
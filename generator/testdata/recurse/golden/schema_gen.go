@@ -0,0 +1,23 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package recurse
+
+// DQLSchema is the Dgraph schema for the recurse data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+genre.film: [uid] .
+name: string @index(hash) .
+subgenre: [uid] @reverse .
+
+type Film {
+	name
+}
+
+type Genre {
+	name
+	subgenre
+	<~subgenre>
+	genre.film
+}
+`
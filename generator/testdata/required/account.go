@@ -0,0 +1,18 @@
+package required
+
+import "time"
+
+// Handle is a named string type.
+type Handle string
+
+// Account marks fields of several kinds as required.
+type Account struct {
+	UID      string    `json:"uid,omitempty"`
+	DType    []string  `json:"dgraph.type,omitempty"`
+	Email    string    `json:"email,omitempty" dgraph:"index=exact,upsert,required"`
+	Handle   Handle    `json:"handle,omitempty" dgraph:"required"`
+	Age      int       `json:"age,omitempty" dgraph:"required"`
+	Joined   time.Time `json:"joined,omitempty" dgraph:"index=day required"`
+	Roles    []string  `json:"roles,omitempty" dgraph:"required"`
+	Nickname string    `json:"nickname,omitempty"`
+}
@@ -0,0 +1,72 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package required
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestAccountConformance adds an Account to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestAccountConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Account{
+		Email:    "Email-" + suffix,
+		Handle:   Handle("Handle-" + suffix),
+		Age:      7,
+		Joined:   time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+		Roles:    []string{"Roles-" + suffix},
+		Nickname: "Nickname-" + suffix,
+	}
+	if err := client.Account.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Account.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Account.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if got.Email != want.Email {
+		t.Errorf("Email = %v, want %v", got.Email, want.Email)
+	}
+	if got.Handle != want.Handle {
+		t.Errorf("Handle = %v, want %v", got.Handle, want.Handle)
+	}
+	if got.Age != want.Age {
+		t.Errorf("Age = %v, want %v", got.Age, want.Age)
+	}
+	if !time.Time(got.Joined).Equal(time.Time(want.Joined)) {
+		t.Errorf("Joined = %v, want %v", got.Joined, want.Joined)
+	}
+	if got.Nickname != want.Nickname {
+		t.Errorf("Nickname = %v, want %v", got.Nickname, want.Nickname)
+	}
+}
@@ -0,0 +1,41 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package required
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes an Account in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Account) MarshalJSON() ([]byte, error) {
+	type plain Account
+	out := struct {
+		plain
+		Joined *time.Time `json:"joined,omitempty"`
+	}{plain: plain(v)}
+	if !v.Joined.IsZero() {
+		out.Joined = &v.Joined
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an Account from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+func (v *Account) UnmarshalJSON(data []byte) error {
+	type plain Account
+	in := struct {
+		*plain
+		Joined json.RawMessage `json:"joined"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Joined, &v.Joined); err != nil {
+		return fmt.Errorf("Account.Joined: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package required
+
+import "time"
+
+// AccountOption is a functional option for configuring Account mutations.
+type AccountOption func(*Account)
+
+// WithAccountEmail sets the Email field on an Account.
+func WithAccountEmail(v string) AccountOption {
+	return func(e *Account) {
+		e.Email = v
+	}
+}
+
+// WithAccountHandle sets the Handle field on an Account.
+func WithAccountHandle(v Handle) AccountOption {
+	return func(e *Account) {
+		e.Handle = v
+	}
+}
+
+// WithAccountAge sets the Age field on an Account.
+func WithAccountAge(v int) AccountOption {
+	return func(e *Account) {
+		e.Age = v
+	}
+}
+
+// WithAccountJoined sets the Joined field on an Account.
+func WithAccountJoined(v time.Time) AccountOption {
+	return func(e *Account) {
+		e.Joined = v
+	}
+}
+
+// WithAccountRoles sets the Roles field on an Account.
+func WithAccountRoles(v []string) AccountOption {
+	return func(e *Account) {
+		e.Roles = v
+	}
+}
+
+// WithAccountNickname sets the Nickname field on an Account.
+func WithAccountNickname(v string) AccountOption {
+	return func(e *Account) {
+		e.Nickname = v
+	}
+}
+
+// ApplyAccountOptions applies the given options to an Account.
+func ApplyAccountOptions(e *Account, opts ...AccountOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,402 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package required
+
+import (
+	"context"
+	"time"
+)
+
+// AccountQuery is a typed query builder for Account entities.
+type AccountQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Account entities.
+func (c *AccountClient) Query(ctx context.Context) *AccountQuery {
+	return &AccountQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *AccountQuery) Filter(f string) *AccountQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *AccountQuery) where(expr string) *AccountQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from AccountWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *AccountQuery) Where(f Filter[Account]) *AccountQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasEmail filters to Account entities that have a value for Email, using
+// has(email).
+func (q *AccountQuery) HasEmail() *AccountQuery {
+	return q.Where(AccountWhere.HasEmail())
+}
+
+// NotEmail filters to Account entities that have no Email value.
+func (q *AccountQuery) NotEmail() *AccountQuery {
+	return q.Where(AccountWhere.NotEmail())
+}
+
+// HasHandle filters to Account entities that have a value for Handle, using
+// has(handle).
+func (q *AccountQuery) HasHandle() *AccountQuery {
+	return q.Where(AccountWhere.HasHandle())
+}
+
+// NotHandle filters to Account entities that have no Handle value.
+func (q *AccountQuery) NotHandle() *AccountQuery {
+	return q.Where(AccountWhere.NotHandle())
+}
+
+// HasAge filters to Account entities that have a value for Age, using
+// has(age).
+func (q *AccountQuery) HasAge() *AccountQuery {
+	return q.Where(AccountWhere.HasAge())
+}
+
+// NotAge filters to Account entities that have no Age value.
+func (q *AccountQuery) NotAge() *AccountQuery {
+	return q.Where(AccountWhere.NotAge())
+}
+
+// HasJoined filters to Account entities that have a value for Joined, using
+// has(joined).
+func (q *AccountQuery) HasJoined() *AccountQuery {
+	return q.Where(AccountWhere.HasJoined())
+}
+
+// NotJoined filters to Account entities that have no Joined value.
+func (q *AccountQuery) NotJoined() *AccountQuery {
+	return q.Where(AccountWhere.NotJoined())
+}
+
+// HasRoles filters to Account entities that have a value for Roles, using
+// has(roles).
+func (q *AccountQuery) HasRoles() *AccountQuery {
+	return q.Where(AccountWhere.HasRoles())
+}
+
+// NotRoles filters to Account entities that have no Roles value.
+func (q *AccountQuery) NotRoles() *AccountQuery {
+	return q.Where(AccountWhere.NotRoles())
+}
+
+// HasNickname filters to Account entities that have a value for Nickname, using
+// has(nickname).
+func (q *AccountQuery) HasNickname() *AccountQuery {
+	return q.Where(AccountWhere.HasNickname())
+}
+
+// NotNickname filters to Account entities that have no Nickname value.
+func (q *AccountQuery) NotNickname() *AccountQuery {
+	return q.Where(AccountWhere.NotNickname())
+}
+
+// EmailGe filters to Account entities whose Email sorts at or after value.
+func (q *AccountQuery) EmailGe(value string) *AccountQuery {
+	return q.Where(AccountWhere.EmailGe(value))
+}
+
+// EmailLe filters to Account entities whose Email sorts at or before value.
+func (q *AccountQuery) EmailLe(value string) *AccountQuery {
+	return q.Where(AccountWhere.EmailLe(value))
+}
+
+// EmailBetween filters to Account entities whose Email sorts from from through to,
+// inclusive.
+func (q *AccountQuery) EmailBetween(from, to string) *AccountQuery {
+	return q.Where(AccountWhere.EmailBetween(from, to))
+}
+
+// JoinedYearEquals filters to Account entities whose Joined falls in year.
+func (q *AccountQuery) JoinedYearEquals(year int) *AccountQuery {
+	return q.Where(AccountWhere.JoinedYearEquals(year))
+}
+
+// JoinedYearBetween filters to Account entities whose Joined falls in the
+// years from through to, inclusive.
+func (q *AccountQuery) JoinedYearBetween(from, to int) *AccountQuery {
+	return q.Where(AccountWhere.JoinedYearBetween(from, to))
+}
+
+// JoinedDateBetween filters to Account entities whose Joined lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *AccountQuery) JoinedDateBetween(from, to time.Time) *AccountQuery {
+	return q.Where(AccountWhere.JoinedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *AccountQuery) OrderAsc(field string) *AccountQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *AccountQuery) OrderDesc(field string) *AccountQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *AccountQuery) First(n int) *AccountQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *AccountQuery) Offset(n int) *AccountQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Account and the edges added by the With methods.
+func (q *AccountQuery) selection() string {
+	s := accountSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *AccountQuery) dql() string {
+	return nodesQuery("Account", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *AccountQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Account with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Account.
+func (q *AccountQuery) GetByUID(uid string) (*Account, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Account
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Account", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *AccountQuery) Exec(dst *[]Account) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Account{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *AccountQuery) ExecAndCount(dst *[]Account) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Account", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Account{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// AccountWhere builds the conditions on Account fields that AccountQuery.Where takes.
+var AccountWhere AccountConditions
+
+// AccountConditions has a method for each typed filter of AccountQuery, returning it as a
+// Filter[Account] to combine with And, Or, and Not.
+type AccountConditions struct{}
+
+// HasEmail matches Account entities that have a value for Email, using
+// has(email).
+func (AccountConditions) HasEmail() Filter[Account] {
+	return Filter[Account]{expr: "has(email)"}
+}
+
+// NotEmail matches Account entities that have no Email value.
+func (AccountConditions) NotEmail() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(email)"}
+}
+
+// HasHandle matches Account entities that have a value for Handle, using
+// has(handle).
+func (AccountConditions) HasHandle() Filter[Account] {
+	return Filter[Account]{expr: "has(handle)"}
+}
+
+// NotHandle matches Account entities that have no Handle value.
+func (AccountConditions) NotHandle() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(handle)"}
+}
+
+// HasAge matches Account entities that have a value for Age, using
+// has(age).
+func (AccountConditions) HasAge() Filter[Account] {
+	return Filter[Account]{expr: "has(age)"}
+}
+
+// NotAge matches Account entities that have no Age value.
+func (AccountConditions) NotAge() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(age)"}
+}
+
+// HasJoined matches Account entities that have a value for Joined, using
+// has(joined).
+func (AccountConditions) HasJoined() Filter[Account] {
+	return Filter[Account]{expr: "has(joined)"}
+}
+
+// NotJoined matches Account entities that have no Joined value.
+func (AccountConditions) NotJoined() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(joined)"}
+}
+
+// HasRoles matches Account entities that have a value for Roles, using
+// has(roles).
+func (AccountConditions) HasRoles() Filter[Account] {
+	return Filter[Account]{expr: "has(roles)"}
+}
+
+// NotRoles matches Account entities that have no Roles value.
+func (AccountConditions) NotRoles() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(roles)"}
+}
+
+// HasNickname matches Account entities that have a value for Nickname, using
+// has(nickname).
+func (AccountConditions) HasNickname() Filter[Account] {
+	return Filter[Account]{expr: "has(nickname)"}
+}
+
+// NotNickname matches Account entities that have no Nickname value.
+func (AccountConditions) NotNickname() Filter[Account] {
+	return Filter[Account]{expr: "NOT has(nickname)"}
+}
+
+// EmailGe matches Account entities whose Email sorts at or after value.
+func (AccountConditions) EmailGe(value string) Filter[Account] {
+	return Filter[Account]{expr: "ge(email, " + formatString(value) + ")"}
+}
+
+// EmailLe matches Account entities whose Email sorts at or before value.
+func (AccountConditions) EmailLe(value string) Filter[Account] {
+	return Filter[Account]{expr: "le(email, " + formatString(value) + ")"}
+}
+
+// EmailBetween matches Account entities whose Email sorts from from through to,
+// inclusive.
+func (AccountConditions) EmailBetween(from, to string) Filter[Account] {
+	return Filter[Account]{expr: "between(email, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// JoinedYearEquals matches Account entities whose Joined falls in year.
+func (c AccountConditions) JoinedYearEquals(year int) Filter[Account] {
+	return c.JoinedYearBetween(year, year)
+}
+
+// JoinedYearBetween matches Account entities whose Joined falls in the
+// years from through to, inclusive.
+func (c AccountConditions) JoinedYearBetween(from, to int) Filter[Account] {
+	return c.JoinedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// JoinedDateBetween matches Account entities whose Joined lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (AccountConditions) JoinedDateBetween(from, to time.Time) Filter[Account] {
+	return Filter[Account]{expr: "between(joined, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
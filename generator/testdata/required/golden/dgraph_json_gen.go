@@ -0,0 +1,63 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package required
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// datetimeLayouts are the formats in which Dgraph returns datetime values,
+// from the most to the least precise.
+var datetimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// decodeDatetime parses raw, a JSON string in one of layouts or of the
+// datetimeLayouts, into dst. A missing value leaves dst unchanged; null or ""
+// sets the zero time.
+func decodeDatetime(raw json.RawMessage, dst *time.Time, layouts ...string) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s *string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	if s == nil || *s == "" {
+		*dst = time.Time{}
+		return nil
+	}
+	for _, list := range [][]string{layouts, datetimeLayouts} {
+		for _, layout := range list {
+			if t, err := time.Parse(layout, *s); err == nil {
+				*dst = t
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unrecognized datetime %q", *s)
+}
+
+// decodeDatetimePtr is decodeDatetime for a *time.Time, which null sets to nil.
+func decodeDatetimePtr(raw json.RawMessage, dst **time.Time, layouts ...string) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if string(raw) == "null" {
+		*dst = nil
+		return nil
+	}
+	t := new(time.Time)
+	if err := decodeDatetime(raw, t, layouts...); err != nil {
+		return err
+	}
+	*dst = t
+	return nil
+}
@@ -0,0 +1,24 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package required
+
+// DQLSchema is the Dgraph schema for the required data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+age: int .
+email: string @index(exact) @upsert .
+handle: string .
+joined: datetime @index(day) .
+nickname: string .
+roles: [string] .
+
+type Account {
+	email
+	handle
+	age
+	joined
+	roles
+	nickname
+}
+`
@@ -0,0 +1,68 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package scaffold
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestFilmConformance adds a Film to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestFilmConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Film{
+		Title:    "Title-" + suffix,
+		Year:     7,
+		Rating:   1.5,
+		Released: true,
+		Genres:   []string{"Genres-" + suffix},
+	}
+	if err := client.Film.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Film.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Film.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if got.Title != want.Title {
+		t.Errorf("Title = %v, want %v", got.Title, want.Title)
+	}
+	if got.Year != want.Year {
+		t.Errorf("Year = %v, want %v", got.Year, want.Year)
+	}
+	if got.Rating != want.Rating {
+		t.Errorf("Rating = %v, want %v", got.Rating, want.Rating)
+	}
+	if got.Released != want.Released {
+		t.Errorf("Released = %v, want %v", got.Released, want.Released)
+	}
+}
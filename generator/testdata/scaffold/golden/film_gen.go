@@ -0,0 +1,285 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FilmAPI is the set of Film operations provided by FilmClient. Code
+// that depends on FilmAPI rather than *FilmClient can run against a test double.
+type FilmAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Film) error
+	Create(ctx context.Context, v *Film) (string, error)
+	Update(ctx context.Context, v *Film) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Film, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error)
+}
+
+// FilmClient provides typed CRUD operations for Film entities.
+//
+// Film has only scalars that the test scaffolding can give values.
+type FilmClient struct {
+	conn graphConn
+}
+
+var _ FilmAPI = (*FilmClient)(nil)
+
+// Get retrieves a single Film by its UID.
+func (c *FilmClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Film", filmSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Film with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *FilmClient) GetExpanded(ctx context.Context, uid string) (*Film, error) {
+	var result Film
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Film", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type. A node of another type does not count.
+func (c *FilmClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Film")
+}
+
+// Load populates v with the Film stored under uid, using c.Film.Get.
+func (v *Film) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Film.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Film)(nil)
+
+// GetUID returns the Film's UID, empty until it has been added.
+func (v *Film) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Film's UID.
+func (v *Film) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Film's dgraph.type values: its DType, or
+// {"Film"} until Add sets it.
+func (v *Film) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Film"}
+}
+
+// String returns a one-line summary of the Film: its UID.
+func (v Film) String() string {
+	return fmt.Sprintf("Film(%s)", v.UID)
+}
+
+// Add inserts a new Film into the database. It fails without writing
+// if Validate reports a required field as empty.
+func (c *FilmClient) Add(ctx context.Context, v *Film) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Film node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it. It fails without writing if Validate reports a
+// required field as empty.
+func (c *FilmClient) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Film"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Validate returns an error wrapping ErrRequired for the first required field
+// that holds its zero value.
+func (v *Film) Validate() error {
+	if v.Title == "" {
+		return fmt.Errorf("%w: Film.Title", ErrRequired)
+	}
+	return nil
+}
+
+// Update modifies an existing Film in the database. The UID field must be set.
+func (c *FilmClient) Update(ctx context.Context, v *Film) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Film with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *FilmClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Film.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetTitle sets the Title of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetTitle(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"title": value}); err != nil {
+		return fmt.Errorf("Film.SetTitle: %w", err)
+	}
+	return nil
+}
+
+// SetYear sets the Year of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetYear(ctx context.Context, uid string, value int) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"year": value}); err != nil {
+		return fmt.Errorf("Film.SetYear: %w", err)
+	}
+	return nil
+}
+
+// SetRating sets the Rating of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetRating(ctx context.Context, uid string, value float64) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"rating": value}); err != nil {
+		return fmt.Errorf("Film.SetRating: %w", err)
+	}
+	return nil
+}
+
+// SetReleased sets the Released of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetReleased(ctx context.Context, uid string, value bool) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"released": value}); err != nil {
+		return fmt.Errorf("Film.SetReleased: %w", err)
+	}
+	return nil
+}
+
+// SetGenres replaces the Genres list of the Film with the given UID by values,
+// touching no other predicate. Use AddGenres to append to it instead.
+func (c *FilmClient) SetGenres(ctx context.Context, uid string, values []string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"genres": values}, "genres"); err != nil {
+		return fmt.Errorf("Film.SetGenres: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Film with the given UID from the database.
+func (c *FilmClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddGenres appends values to the Genres list of the Film with the given UID.
+func (c *FilmClient) AddGenres(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, map[string]any{"uid": uid, "genres": values}, nil)
+	return err
+}
+
+// RemoveGenres removes values from the Genres list of the Film with the given UID.
+func (c *FilmClient) RemoveGenres(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, nil, map[string]any{"uid": uid, "genres": values})
+	return err
+}
+
+// filmSelection returns the DQL selection for a Film: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func filmSelection(depth int) string {
+	s := "uid dgraph.type title year rating released genres"
+	return s
+}
+
+// List retrieves Films with optional pagination.
+func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var films []Film
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// Find retrieves Films matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *FilmClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var films []Film
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// GetByTitle retrieves the Film entities whose Title is value, with optional
+// pagination.
+func (c *FilmClient) GetByTitle(ctx context.Context, value string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := queryNodes(ctx, c.conn.QueryRaw, "Film", "eq(title, "+formatString(value)+")", "", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
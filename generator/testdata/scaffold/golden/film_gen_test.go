@@ -0,0 +1,63 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// sampleFilm returns a Film with a value in each scalar field, for
+// the test cases below.
+func sampleFilm() Film {
+	const suffix = "sample"
+	v := Film{
+		Title:    "Title-" + suffix,
+		Year:     7,
+		Rating:   1.5,
+		Released: true,
+		Genres:   []string{"Genres-" + suffix},
+	}
+	// TODO: set the edges and other fields that a test case needs.
+	return v
+}
+
+// TestFilmRoundTrip creates each case's Film with the mock client,
+// gets it back by UID, and deletes it. Add cases, and assertions on what Get
+// returns, as the entity's behavior warrants.
+func TestFilmRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Film
+	}{
+		{name: "sample", v: sampleFilm()},
+		// TODO: add cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := NewMockClient()
+
+			uid, err := client.Film.Create(ctx, &tt.v)
+			if err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			got, err := client.Film.Get(ctx, uid)
+			if err != nil {
+				t.Fatalf("Get(%s): %v", uid, err)
+			}
+			if got.UID != uid {
+				t.Errorf("Get(%s).UID = %s", uid, got.UID)
+			}
+			// TODO: assert on the fields of got.
+
+			if err := client.Film.Delete(ctx, uid); err != nil {
+				t.Fatalf("Delete(%s): %v", uid, err)
+			}
+			if _, err := client.Film.Get(ctx, uid); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get(%s) after Delete = %v, want ErrNotFound", uid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmTitle sets the Title field on a Film.
+func WithFilmTitle(v string) FilmOption {
+	return func(e *Film) {
+		e.Title = v
+	}
+}
+
+// WithFilmYear sets the Year field on a Film.
+func WithFilmYear(v int) FilmOption {
+	return func(e *Film) {
+		e.Year = v
+	}
+}
+
+// WithFilmRating sets the Rating field on a Film.
+func WithFilmRating(v float64) FilmOption {
+	return func(e *Film) {
+		e.Rating = v
+	}
+}
+
+// WithFilmReleased sets the Released field on a Film.
+func WithFilmReleased(v bool) FilmOption {
+	return func(e *Film) {
+		e.Released = v
+	}
+}
+
+// WithFilmGenres sets the Genres field on a Film.
+func WithFilmGenres(v []string) FilmOption {
+	return func(e *Film) {
+		e.Genres = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+import (
+	"context"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasTitle filters to Film entities that have a value for Title, using
+// has(title).
+func (q *FilmQuery) HasTitle() *FilmQuery {
+	return q.Where(FilmWhere.HasTitle())
+}
+
+// NotTitle filters to Film entities that have no Title value.
+func (q *FilmQuery) NotTitle() *FilmQuery {
+	return q.Where(FilmWhere.NotTitle())
+}
+
+// HasYear filters to Film entities that have a value for Year, using
+// has(year).
+func (q *FilmQuery) HasYear() *FilmQuery {
+	return q.Where(FilmWhere.HasYear())
+}
+
+// NotYear filters to Film entities that have no Year value.
+func (q *FilmQuery) NotYear() *FilmQuery {
+	return q.Where(FilmWhere.NotYear())
+}
+
+// HasRating filters to Film entities that have a value for Rating, using
+// has(rating).
+func (q *FilmQuery) HasRating() *FilmQuery {
+	return q.Where(FilmWhere.HasRating())
+}
+
+// NotRating filters to Film entities that have no Rating value.
+func (q *FilmQuery) NotRating() *FilmQuery {
+	return q.Where(FilmWhere.NotRating())
+}
+
+// HasReleased filters to Film entities that have a value for Released, using
+// has(released).
+func (q *FilmQuery) HasReleased() *FilmQuery {
+	return q.Where(FilmWhere.HasReleased())
+}
+
+// NotReleased filters to Film entities that have no Released value.
+func (q *FilmQuery) NotReleased() *FilmQuery {
+	return q.Where(FilmWhere.NotReleased())
+}
+
+// HasGenres filters to Film entities that have a value for Genres, using
+// has(genres).
+func (q *FilmQuery) HasGenres() *FilmQuery {
+	return q.Where(FilmWhere.HasGenres())
+}
+
+// NotGenres filters to Film entities that have no Genres value.
+func (q *FilmQuery) NotGenres() *FilmQuery {
+	return q.Where(FilmWhere.NotGenres())
+}
+
+// TitleGe filters to Film entities whose Title sorts at or after value.
+func (q *FilmQuery) TitleGe(value string) *FilmQuery {
+	return q.Where(FilmWhere.TitleGe(value))
+}
+
+// TitleLe filters to Film entities whose Title sorts at or before value.
+func (q *FilmQuery) TitleLe(value string) *FilmQuery {
+	return q.Where(FilmWhere.TitleLe(value))
+}
+
+// TitleBetween filters to Film entities whose Title sorts from from through to,
+// inclusive.
+func (q *FilmQuery) TitleBetween(from, to string) *FilmQuery {
+	return q.Where(FilmWhere.TitleBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasTitle matches Film entities that have a value for Title, using
+// has(title).
+func (FilmConditions) HasTitle() Filter[Film] {
+	return Filter[Film]{expr: "has(title)"}
+}
+
+// NotTitle matches Film entities that have no Title value.
+func (FilmConditions) NotTitle() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(title)"}
+}
+
+// HasYear matches Film entities that have a value for Year, using
+// has(year).
+func (FilmConditions) HasYear() Filter[Film] {
+	return Filter[Film]{expr: "has(year)"}
+}
+
+// NotYear matches Film entities that have no Year value.
+func (FilmConditions) NotYear() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(year)"}
+}
+
+// HasRating matches Film entities that have a value for Rating, using
+// has(rating).
+func (FilmConditions) HasRating() Filter[Film] {
+	return Filter[Film]{expr: "has(rating)"}
+}
+
+// NotRating matches Film entities that have no Rating value.
+func (FilmConditions) NotRating() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(rating)"}
+}
+
+// HasReleased matches Film entities that have a value for Released, using
+// has(released).
+func (FilmConditions) HasReleased() Filter[Film] {
+	return Filter[Film]{expr: "has(released)"}
+}
+
+// NotReleased matches Film entities that have no Released value.
+func (FilmConditions) NotReleased() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(released)"}
+}
+
+// HasGenres matches Film entities that have a value for Genres, using
+// has(genres).
+func (FilmConditions) HasGenres() Filter[Film] {
+	return Filter[Film]{expr: "has(genres)"}
+}
+
+// NotGenres matches Film entities that have no Genres value.
+func (FilmConditions) NotGenres() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(genres)"}
+}
+
+// TitleGe matches Film entities whose Title sorts at or after value.
+func (FilmConditions) TitleGe(value string) Filter[Film] {
+	return Filter[Film]{expr: "ge(title, " + formatString(value) + ")"}
+}
+
+// TitleLe matches Film entities whose Title sorts at or before value.
+func (FilmConditions) TitleLe(value string) Filter[Film] {
+	return Filter[Film]{expr: "le(title, " + formatString(value) + ")"}
+}
+
+// TitleBetween matches Film entities whose Title sorts from from through to,
+// inclusive.
+func (FilmConditions) TitleBetween(from, to string) Filter[Film] {
+	return Filter[Film]{expr: "between(title, " + formatString(from) + ", " + formatString(to) + ")"}
+}
@@ -0,0 +1,31 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+// DQLSchema is the Dgraph schema for the scaffold data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+founded: datetime @index(year) .
+genres: [string] @index(exact) .
+name: string @index(exact) .
+produced: [uid] .
+rating: float .
+released: bool .
+title: string @index(exact) .
+year: int @index(int) .
+
+type Film {
+	title
+	year
+	rating
+	released
+	genres
+}
+
+type Studio {
+	name
+	founded
+	produced
+}
+`
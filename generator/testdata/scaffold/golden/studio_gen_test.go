@@ -0,0 +1,62 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// sampleStudio returns a Studio with a value in each scalar field, for
+// the test cases below.
+func sampleStudio() Studio {
+	const suffix = "sample"
+	v := Studio{
+		Name:    "Name-" + suffix,
+		Founded: time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+	}
+	// TODO: set the edges and other fields that a test case needs.
+	return v
+}
+
+// TestStudioRoundTrip creates each case's Studio with the mock client,
+// gets it back by UID, and deletes it. Add cases, and assertions on what Get
+// returns, as the entity's behavior warrants.
+func TestStudioRoundTrip(t *testing.T) {
+	t.Skip("TODO: set Films in sampleStudio, which Validate requires")
+	tests := []struct {
+		name string
+		v    Studio
+	}{
+		{name: "sample", v: sampleStudio()},
+		// TODO: add cases.
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := NewMockClient()
+
+			uid, err := client.Studio.Create(ctx, &tt.v)
+			if err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+			got, err := client.Studio.Get(ctx, uid)
+			if err != nil {
+				t.Fatalf("Get(%s): %v", uid, err)
+			}
+			if got.UID != uid {
+				t.Errorf("Get(%s).UID = %s", uid, got.UID)
+			}
+			// TODO: assert on the fields of got.
+
+			if err := client.Studio.Delete(ctx, uid); err != nil {
+				t.Fatalf("Delete(%s): %v", uid, err)
+			}
+			if _, err := client.Studio.Get(ctx, uid); !errors.Is(err, ErrNotFound) {
+				t.Errorf("Get(%s) after Delete = %v, want ErrNotFound", uid, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package scaffold
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Studio in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Studio) MarshalJSON() ([]byte, error) {
+	type plain Studio
+	out := struct {
+		plain
+		Founded *time.Time `json:"founded,omitempty"`
+	}{plain: plain(v)}
+	if !v.Founded.IsZero() {
+		out.Founded = &v.Founded
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Studio from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Studio) UnmarshalJSON(data []byte) error {
+	type plain Studio
+	in := struct {
+		*plain
+		Founded json.RawMessage `json:"founded"`
+		Films   json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Founded, &v.Founded); err != nil {
+		return fmt.Errorf("Studio.Founded: %w", err)
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("Studio.Films: %w", err)
+	}
+	return nil
+}
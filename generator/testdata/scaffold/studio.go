@@ -0,0 +1,23 @@
+package scaffold
+
+import "time"
+
+// Studio requires an edge, which the test scaffolding cannot fill in.
+type Studio struct {
+	UID     string    `json:"uid,omitempty"`
+	DType   []string  `json:"dgraph.type,omitempty"`
+	Name    string    `json:"name,omitempty" dgraph:"index=exact,required"`
+	Founded time.Time `json:"founded,omitempty" dgraph:"index=year"`
+	Films   []Film    `json:"films,omitempty" dgraph:"predicate=produced,required"`
+}
+
+// Film has only scalars that the test scaffolding can give values.
+type Film struct {
+	UID      string   `json:"uid,omitempty"`
+	DType    []string `json:"dgraph.type,omitempty"`
+	Title    string   `json:"title,omitempty" dgraph:"index=exact,required"`
+	Year     int      `json:"year,omitempty" dgraph:"index=int"`
+	Rating   float64  `json:"rating,omitempty"`
+	Released bool     `json:"released,omitempty"`
+	Genres   []string `json:"genres,omitempty" dgraph:"index=exact"`
+}
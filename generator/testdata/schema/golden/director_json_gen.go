@@ -0,0 +1,42 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes a Director in the form Dgraph expects:
+//   - Geo coordinates are GeoJSON points.
+func (v Director) MarshalJSON() ([]byte, error) {
+	type plain Director
+	out := struct {
+		plain
+		Location *geoPoint `json:"location,omitempty"`
+	}{plain: plain(v)}
+	out.Location = encodeGeoPoint(v.Location)
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Director from a Dgraph query result:
+//   - Geo coordinates may be GeoJSON points or bare coordinate arrays.
+//   - An edge may be a single object rather than a list.
+func (v *Director) UnmarshalJSON(data []byte) error {
+	type plain Director
+	in := struct {
+		*plain
+		Location json.RawMessage `json:"location"`
+		Films    json.RawMessage `json:"films"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeGeoPoint(in.Location, &v.Location); err != nil {
+		return fmt.Errorf("Director.Location: %w", err)
+	}
+	if err := decodeEdges(in.Films, &v.Films); err != nil {
+		return fmt.Errorf("Director.Films: %w", err)
+	}
+	return nil
+}
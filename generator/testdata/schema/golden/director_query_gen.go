@@ -0,0 +1,371 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// DirectorQuery is a typed query builder for Director entities.
+type DirectorQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Director entities.
+func (c *DirectorClient) Query(ctx context.Context) *DirectorQuery {
+	return &DirectorQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *DirectorQuery) Filter(f string) *DirectorQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *DirectorQuery) where(expr string) *DirectorQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from DirectorWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *DirectorQuery) Where(f Filter[Director]) *DirectorQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Director entities that have a value for Name, using
+// has(name).
+func (q *DirectorQuery) HasName() *DirectorQuery {
+	return q.Where(DirectorWhere.HasName())
+}
+
+// NotName filters to Director entities that have no Name value.
+func (q *DirectorQuery) NotName() *DirectorQuery {
+	return q.Where(DirectorWhere.NotName())
+}
+
+// HasLocation filters to Director entities that have a value for Location, using
+// has(location).
+func (q *DirectorQuery) HasLocation() *DirectorQuery {
+	return q.Where(DirectorWhere.HasLocation())
+}
+
+// NotLocation filters to Director entities that have no Location value.
+func (q *DirectorQuery) NotLocation() *DirectorQuery {
+	return q.Where(DirectorWhere.NotLocation())
+}
+
+// HasFilms filters to Director entities that have a value for Films, using
+// has(~film.director).
+func (q *DirectorQuery) HasFilms() *DirectorQuery {
+	return q.Where(DirectorWhere.HasFilms())
+}
+
+// NotFilms filters to Director entities that have no Films value.
+func (q *DirectorQuery) NotFilms() *DirectorQuery {
+	return q.Where(DirectorWhere.NotFilms())
+}
+
+// FilmsContains filters to Director entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~film.director, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *DirectorQuery) FilmsContains(uids ...string) *DirectorQuery {
+	return q.Where(DirectorWhere.FilmsContains(uids...))
+}
+
+// LocationNear filters to Director entities whose Location lies within distMeters of (lat, lng).
+func (q *DirectorQuery) LocationNear(lat, lng, distMeters float64) *DirectorQuery {
+	return q.Where(DirectorWhere.LocationNear(lat, lng, distMeters))
+}
+
+// LocationWithin filters to Director entities whose Location lies within polygon.
+func (q *DirectorQuery) LocationWithin(polygon GeoPolygon) *DirectorQuery {
+	return q.Where(DirectorWhere.LocationWithin(polygon))
+}
+
+// LocationContains filters to Director entities whose Location contains point.
+func (q *DirectorQuery) LocationContains(point GeoPoint) *DirectorQuery {
+	return q.Where(DirectorWhere.LocationContains(point))
+}
+
+// NameGe filters to Director entities whose Name sorts at or after value.
+func (q *DirectorQuery) NameGe(value string) *DirectorQuery {
+	return q.Where(DirectorWhere.NameGe(value))
+}
+
+// NameLe filters to Director entities whose Name sorts at or before value.
+func (q *DirectorQuery) NameLe(value string) *DirectorQuery {
+	return q.Where(DirectorWhere.NameLe(value))
+}
+
+// NameBetween filters to Director entities whose Name sorts from from through to,
+// inclusive.
+func (q *DirectorQuery) NameBetween(from, to string) *DirectorQuery {
+	return q.Where(DirectorWhere.NameBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *DirectorQuery) OrderAsc(field string) *DirectorQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *DirectorQuery) OrderDesc(field string) *DirectorQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *DirectorQuery) First(n int) *DirectorQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *DirectorQuery) Offset(n int) *DirectorQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *DirectorQuery) withEdge(selection string) *DirectorQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *DirectorQuery) WithFilms() *DirectorQuery {
+	return q.withEdge("films: ~film.director { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Director and the edges added by the With methods.
+func (q *DirectorQuery) selection() string {
+	s := directorSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *DirectorQuery) dql() string {
+	return nodesQuery("director", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *DirectorQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Director with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Director.
+func (q *DirectorQuery) GetByUID(uid string) (*Director, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Director
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "director", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *DirectorQuery) Exec(dst *[]Director) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Director{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *DirectorQuery) ExecAndCount(dst *[]Director) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("DirectorQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "director", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Director{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// DirectorWhere builds the conditions on Director fields that DirectorQuery.Where takes.
+var DirectorWhere DirectorConditions
+
+// DirectorConditions has a method for each typed filter of DirectorQuery, returning it as a
+// Filter[Director] to combine with And, Or, and Not.
+type DirectorConditions struct{}
+
+// HasName matches Director entities that have a value for Name, using
+// has(name).
+func (DirectorConditions) HasName() Filter[Director] {
+	return Filter[Director]{expr: "has(name)"}
+}
+
+// NotName matches Director entities that have no Name value.
+func (DirectorConditions) NotName() Filter[Director] {
+	return Filter[Director]{expr: "NOT has(name)"}
+}
+
+// HasLocation matches Director entities that have a value for Location, using
+// has(location).
+func (DirectorConditions) HasLocation() Filter[Director] {
+	return Filter[Director]{expr: "has(location)"}
+}
+
+// NotLocation matches Director entities that have no Location value.
+func (DirectorConditions) NotLocation() Filter[Director] {
+	return Filter[Director]{expr: "NOT has(location)"}
+}
+
+// HasFilms matches Director entities that have a value for Films, using
+// has(~film.director).
+func (DirectorConditions) HasFilms() Filter[Director] {
+	return Filter[Director]{expr: "has(~film.director)"}
+}
+
+// NotFilms matches Director entities that have no Films value.
+func (DirectorConditions) NotFilms() Filter[Director] {
+	return Filter[Director]{expr: "NOT has(~film.director)"}
+}
+
+// FilmsContains matches Director entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~film.director, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (DirectorConditions) FilmsContains(uids ...string) Filter[Director] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Director]{err: fmt.Errorf("Director.Films: %w", err)}
+	}
+	return Filter[Director]{expr: "uid_in(~film.director, " + list + ")"}
+}
+
+// LocationNear matches Director entities whose Location lies within distMeters of (lat, lng).
+func (DirectorConditions) LocationNear(lat, lng, distMeters float64) Filter[Director] {
+	return Filter[Director]{expr: "near(location, " + GeoPoint{Lat: lat, Lng: lng}.geoJSON() + ", " + formatFloat(distMeters) + ")"}
+}
+
+// LocationWithin matches Director entities whose Location lies within polygon.
+func (DirectorConditions) LocationWithin(polygon GeoPolygon) Filter[Director] {
+	return Filter[Director]{expr: "within(location, " + polygon.geoJSON() + ")"}
+}
+
+// LocationContains matches Director entities whose Location contains point.
+func (DirectorConditions) LocationContains(point GeoPoint) Filter[Director] {
+	return Filter[Director]{expr: "contains(location, " + point.geoJSON() + ")"}
+}
+
+// NameGe matches Director entities whose Name sorts at or after value.
+func (DirectorConditions) NameGe(value string) Filter[Director] {
+	return Filter[Director]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Director entities whose Name sorts at or before value.
+func (DirectorConditions) NameLe(value string) Filter[Director] {
+	return Filter[Director]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Director entities whose Name sorts from from through to,
+// inclusive.
+func (DirectorConditions) NameBetween(from, to string) Filter[Director] {
+	return Filter[Director]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
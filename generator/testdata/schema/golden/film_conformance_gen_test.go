@@ -0,0 +1,76 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+//go:build integration
+
+package movies
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestFilmConformance adds a Film to the Dgraph at DGRAPH_ADDR,
+// gets it back by UID, checks that its scalar fields survived the round trip,
+// and deletes it. It is skipped when DGRAPH_ADDR is unset.
+func TestFilmConformance(t *testing.T) {
+	addr := os.Getenv("DGRAPH_ADDR")
+	if addr == "" {
+		t.Skip("DGRAPH_ADDR is not set")
+	}
+	client, err := New(addr)
+	if err != nil {
+		t.Fatalf("New(%q): %v", addr, err)
+	}
+	defer client.Close()
+	ctx := context.Background()
+
+	// The suffix keeps string values unique across runs, for upsert and
+	// unique predicates.
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	want := Film{
+		Name:               "Name-" + suffix,
+		InitialReleaseDate: time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC),
+		Tagline:            "Tagline-" + suffix,
+		Rating:             1.5,
+		Runtime:            7,
+		Color:              true,
+		Aka:                []string{"Aka-" + suffix},
+	}
+	if err := client.Film.Add(ctx, &want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want.UID == "" {
+		t.Fatal("Add did not set the UID")
+	}
+	t.Cleanup(func() {
+		if err := client.Film.Delete(ctx, want.UID); err != nil {
+			t.Errorf("Delete(%s): %v", want.UID, err)
+		}
+	})
+
+	got, err := client.Film.Get(ctx, want.UID)
+	if err != nil {
+		t.Fatalf("Get(%s): %v", want.UID, err)
+	}
+	if got.Name != want.Name {
+		t.Errorf("Name = %v, want %v", got.Name, want.Name)
+	}
+	if !time.Time(got.InitialReleaseDate).Equal(time.Time(want.InitialReleaseDate)) {
+		t.Errorf("InitialReleaseDate = %v, want %v", got.InitialReleaseDate, want.InitialReleaseDate)
+	}
+	if got.Tagline != want.Tagline {
+		t.Errorf("Tagline = %v, want %v", got.Tagline, want.Tagline)
+	}
+	if got.Rating != want.Rating {
+		t.Errorf("Rating = %v, want %v", got.Rating, want.Rating)
+	}
+	if got.Runtime != want.Runtime {
+		t.Errorf("Runtime = %v, want %v", got.Runtime, want.Runtime)
+	}
+	if got.Color != want.Color {
+		t.Errorf("Color = %v, want %v", got.Color, want.Color)
+	}
+}
@@ -0,0 +1,476 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Film is declared by a //modusGraphGen:entity block or a Dgraph schema type.
+type Film struct {
+	UID                string    `json:"uid,omitempty"`
+	DType              []string  `json:"dgraph.type,omitempty"`
+	Name               string    `json:"name,omitempty" dgraph:"predicate=name index=exact,fulltext upsert"`
+	InitialReleaseDate time.Time `json:"initial_release_date,omitempty" dgraph:"predicate=initial_release_date index=year"`
+	Tagline            string    `json:"tagline,omitempty" dgraph:"predicate=tagline"`
+	Rating             float64   `json:"rating,omitempty" dgraph:"predicate=rating index=float"`
+	Runtime            int64     `json:"runtime,omitempty" dgraph:"predicate=runtime"`
+	Color              bool      `json:"color,omitempty" dgraph:"predicate=color"`
+	Aka                []string  `json:"aka,omitempty" dgraph:"predicate=aka index=term"`
+	Genre              []Genre   `json:"genre,omitempty" dgraph:"predicate=genre reverse count"`
+	Director           *Director `json:"film.director,omitempty" dgraph:"predicate=film.director reverse"`
+	Embedding          []float32 `json:"embedding,omitempty" dgraph:"predicate=embedding index=hnsw metric=cosine"`
+}
+
+// FilmAPI is the set of Film operations provided by FilmClient. Code
+// that depends on FilmAPI rather than *FilmClient can run against a test double.
+type FilmAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Film) error
+	Create(ctx context.Context, v *Film, opts ...CreateOption) (string, error)
+	Update(ctx context.Context, v *Film) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Film, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error)
+}
+
+// FilmClient provides typed CRUD operations for Film entities.
+type FilmClient struct {
+	conn graphConn
+}
+
+var _ FilmAPI = (*FilmClient)(nil)
+
+// Get retrieves a single Film by its UID.
+func (c *FilmClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Film", filmSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Film with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *FilmClient) GetExpanded(ctx context.Context, uid string) (*Film, error) {
+	var result Film
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Film", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type. A node of another type does not count.
+func (c *FilmClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Film")
+}
+
+// Load populates v with the Film stored under uid, using c.Film.Get.
+func (v *Film) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Film.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadGenre returns v.Genre, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Genre, with the scalar predicates of each Genre, is kept in
+// v.Genre, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Genre to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Genre may be loaded and empty, and
+// LoadGenre queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Film) LoadGenre(ctx context.Context, c *Client) ([]Genre, error) {
+	if v.Genre != nil {
+		return v.Genre, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Film.LoadGenre: UID is empty")
+	}
+	var got Film
+	selection := "uid genre { " + genreSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Film", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Genre = got.Genre
+	if v.Genre == nil {
+		v.Genre = []Genre{}
+	}
+	return v.Genre, nil
+}
+
+var _ Entity = (*Film)(nil)
+
+// GetUID returns the Film's UID, empty until it has been added.
+func (v *Film) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Film's UID.
+func (v *Film) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Film's dgraph.type values: its DType, or
+// {"Film"} until Add sets it.
+func (v *Film) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Film"}
+}
+
+// String returns a one-line summary of the Film: its UID, Name, and the number of
+// entities on each edge, which are not expanded.
+func (v Film) String() string {
+	return fmt.Sprintf("Film(%s %q genre=%d director=%d)", v.UID, v.Name, len(v.Genre), nodeCount(v.Director))
+}
+
+// Add inserts a new Film into the database.
+func (c *FilmClient) Add(ctx context.Context, v *Film) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Film node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+//
+// With WithUpsert, the node with the same Name, if there is one, is
+// updated and its UID returned instead.
+func (c *FilmClient) Create(ctx context.Context, v *Film, opts ...CreateOption) (string, error) {
+	var cfg createConfig
+	for _, opt := range opts {
+		opt.applyCreate(&cfg)
+	}
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Film"}
+	}
+	if cfg.upsert {
+		if err := c.conn.Upsert(ctx, v, "name"); err != nil {
+			return "", err
+		}
+		return v.UID, nil
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Film in the database. The UID field must be set.
+func (c *FilmClient) Update(ctx context.Context, v *Film) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Film with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *FilmClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Film.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Film.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetInitialReleaseDate sets the InitialReleaseDate of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetInitialReleaseDate(ctx context.Context, uid string, value time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"initial_release_date": value}); err != nil {
+		return fmt.Errorf("Film.SetInitialReleaseDate: %w", err)
+	}
+	return nil
+}
+
+// SetTagline sets the Tagline of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetTagline(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"tagline": value}); err != nil {
+		return fmt.Errorf("Film.SetTagline: %w", err)
+	}
+	return nil
+}
+
+// SetRating sets the Rating of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetRating(ctx context.Context, uid string, value float64) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"rating": value}); err != nil {
+		return fmt.Errorf("Film.SetRating: %w", err)
+	}
+	return nil
+}
+
+// SetRuntime sets the Runtime of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetRuntime(ctx context.Context, uid string, value int64) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"runtime": value}); err != nil {
+		return fmt.Errorf("Film.SetRuntime: %w", err)
+	}
+	return nil
+}
+
+// SetColor sets the Color of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetColor(ctx context.Context, uid string, value bool) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"color": value}); err != nil {
+		return fmt.Errorf("Film.SetColor: %w", err)
+	}
+	return nil
+}
+
+// SetAka replaces the Aka list of the Film with the given UID by values,
+// touching no other predicate. Use AddAka to append to it instead.
+func (c *FilmClient) SetAka(ctx context.Context, uid string, values []string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"aka": values}, "aka"); err != nil {
+		return fmt.Errorf("Film.SetAka: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Film with the given UID from the database.
+func (c *FilmClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddAka appends values to the Aka list of the Film with the given UID.
+func (c *FilmClient) AddAka(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, map[string]any{"uid": uid, "aka": values}, nil)
+	return err
+}
+
+// RemoveAka removes values from the Aka list of the Film with the given UID.
+func (c *FilmClient) RemoveAka(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, nil, map[string]any{"uid": uid, "aka": values})
+	return err
+}
+
+// AddGenre links the Film with the given UID to the Genre nodes with the
+// given UIDs through genre, keeping the Genre it has.
+func (c *FilmClient) AddGenre(ctx context.Context, filmUID string, genreUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", []string{filmUID}, genreUIDs, false); err != nil {
+		return fmt.Errorf("Film.AddGenre: %w", err)
+	}
+	return nil
+}
+
+// RemoveGenre unlinks the Film with the given UID from the Genre nodes
+// with the given UIDs, deleting their genre edges.
+func (c *FilmClient) RemoveGenre(ctx context.Context, filmUID string, genreUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "genre", []string{filmUID}, genreUIDs, true); err != nil {
+		return fmt.Errorf("Film.RemoveGenre: %w", err)
+	}
+	return nil
+}
+
+// SetDirector links the Film with the given UID to the Director with UID
+// directorUID through film.director, replacing the Director it had.
+func (c *FilmClient) SetDirector(ctx context.Context, filmUID, directorUID string) error {
+	_, err := formatUIDs([]string{directorUID})
+	if err == nil {
+		err = setFields(ctx, c.conn, filmUID, map[string]any{"film.director": map[string]string{"uid": directorUID}}, "film.director")
+	}
+	if err != nil {
+		return fmt.Errorf("Film.SetDirector: %w", err)
+	}
+	return nil
+}
+
+// RemoveDirector unlinks the Film with the given UID from its Director, if any.
+func (c *FilmClient) RemoveDirector(ctx context.Context, filmUID string) error {
+	if err := setFields(ctx, c.conn, filmUID, nil, "film.director"); err != nil {
+		return fmt.Errorf("Film.RemoveDirector: %w", err)
+	}
+	return nil
+}
+
+// CountGenre returns the number of Genre of the Film with the given UID, using
+// Dgraph's count(genre).
+func (c *FilmClient) CountGenre(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Film", "genre")
+}
+
+// Search finds Film entities whose Name matches term using fulltext search.
+func (c *FilmClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Film, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "name", term, opts)
+	}
+	var results []Film
+	q := c.conn.Query(ctx, Film{}).
+		Filter(`alloftext(name, "` + term + `")`).
+		First(defaultPageSize)
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first > 0 {
+		q = q.First(cfg.first)
+	}
+	if cfg.offset > 0 {
+		q = q.Offset(cfg.offset)
+	}
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SearchAllOfText finds Film entities whose Name contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *FilmClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// SearchAnyOfText finds Film entities whose Name contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *FilmClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "anyoftext", "name", terms, opts)
+}
+
+// SearchName finds Film entities whose Name contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *FilmClient) SearchName(ctx context.Context, terms string, opts ...PageOption) ([]Film, error) {
+	return c.searchText(ctx, "alloftext", "name", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *FilmClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Film", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// filmSelection returns the DQL selection for a Film: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func filmSelection(depth int) string {
+	s := "uid dgraph.type name initial_release_date tagline rating runtime color aka embedding"
+	if depth > 0 {
+		s += " genre { " + genreSelection(depth-1) + " }"
+		s += " film.director { " + directorSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Films with optional pagination.
+func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var films []Film
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// Find retrieves Films matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *FilmClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var films []Film
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// GetByName retrieves the Film whose Name is value. The error wraps ErrNotFound
+// if there is none, and ErrNotUnique if there is more than one.
+func (c *FilmClient) GetByName(ctx context.Context, value string) (*Film, error) {
+	var results []Film
+	err := queryNodes(ctx, c.conn.QueryRaw, "Film", "eq(name, "+formatString(value)+")", "", filmSelection(1), 2, 0, &results)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, fmt.Errorf("Film with Name %q: %w", value, ErrNotFound)
+	case 1:
+		return &results[0], nil
+	}
+	return nil, fmt.Errorf("Film with Name %q: %w", value, ErrNotUnique)
+}
+
+// FilmMatch is a Film found by a SimilarTo method, with its distance from the
+// query vector.
+type FilmMatch struct {
+	Film
+	Distance float64
+}
+
+// SimilarToEmbedding retrieves the topK Film entities whose Embedding is nearest to vec,
+// using Dgraph's similar_to over its hnsw index, nearest first. Each match carries its
+// cosine distance from vec.
+func (c *FilmClient) SimilarToEmbedding(ctx context.Context, vec []float32, topK int) ([]FilmMatch, error) {
+	var nodes []Film
+	err := similarNodes(ctx, c.conn.QueryRaw, "Film", "embedding", vec, topK, filmSelection(1), &nodes)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]FilmMatch, len(nodes))
+	for i, n := range nodes {
+		matches[i] = FilmMatch{Film: n, Distance: vectorDistance("cosine", n.Embedding, vec)}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Distance < matches[j].Distance })
+	return matches, nil
+}
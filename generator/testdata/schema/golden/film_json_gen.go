@@ -0,0 +1,50 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		InitialReleaseDate *time.Time `json:"initial_release_date,omitempty"`
+	}{plain: plain(v)}
+	if !v.InitialReleaseDate.IsZero() {
+		out.InitialReleaseDate = &v.InitialReleaseDate
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		InitialReleaseDate json.RawMessage `json:"initial_release_date"`
+		Genre              json.RawMessage `json:"genre"`
+		Director           json.RawMessage `json:"film.director"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.InitialReleaseDate, &v.InitialReleaseDate); err != nil {
+		return fmt.Errorf("Film.InitialReleaseDate: %w", err)
+	}
+	if err := decodeEdges(in.Genre, &v.Genre); err != nil {
+		return fmt.Errorf("Film.Genre: %w", err)
+	}
+	if err := decodeEdge(in.Director, &v.Director); err != nil {
+		return fmt.Errorf("Film.Director: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import "time"
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmName sets the Name field on a Film.
+func WithFilmName(v string) FilmOption {
+	return func(e *Film) {
+		e.Name = v
+	}
+}
+
+// WithFilmInitialReleaseDate sets the InitialReleaseDate field on a Film.
+func WithFilmInitialReleaseDate(v time.Time) FilmOption {
+	return func(e *Film) {
+		e.InitialReleaseDate = v
+	}
+}
+
+// WithFilmTagline sets the Tagline field on a Film.
+func WithFilmTagline(v string) FilmOption {
+	return func(e *Film) {
+		e.Tagline = v
+	}
+}
+
+// WithFilmRating sets the Rating field on a Film.
+func WithFilmRating(v float64) FilmOption {
+	return func(e *Film) {
+		e.Rating = v
+	}
+}
+
+// WithFilmRuntime sets the Runtime field on a Film.
+func WithFilmRuntime(v int64) FilmOption {
+	return func(e *Film) {
+		e.Runtime = v
+	}
+}
+
+// WithFilmColor sets the Color field on a Film.
+func WithFilmColor(v bool) FilmOption {
+	return func(e *Film) {
+		e.Color = v
+	}
+}
+
+// WithFilmAka sets the Aka field on a Film.
+func WithFilmAka(v []string) FilmOption {
+	return func(e *Film) {
+		e.Aka = v
+	}
+}
+
+// WithFilmEmbedding sets the Embedding field on a Film.
+func WithFilmEmbedding(v []float32) FilmOption {
+	return func(e *Film) {
+		e.Embedding = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
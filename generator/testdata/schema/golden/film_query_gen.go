@@ -0,0 +1,576 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(name).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasInitialReleaseDate filters to Film entities that have a value for InitialReleaseDate, using
+// has(initial_release_date).
+func (q *FilmQuery) HasInitialReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.HasInitialReleaseDate())
+}
+
+// NotInitialReleaseDate filters to Film entities that have no InitialReleaseDate value.
+func (q *FilmQuery) NotInitialReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.NotInitialReleaseDate())
+}
+
+// HasTagline filters to Film entities that have a value for Tagline, using
+// has(tagline).
+func (q *FilmQuery) HasTagline() *FilmQuery {
+	return q.Where(FilmWhere.HasTagline())
+}
+
+// NotTagline filters to Film entities that have no Tagline value.
+func (q *FilmQuery) NotTagline() *FilmQuery {
+	return q.Where(FilmWhere.NotTagline())
+}
+
+// HasRating filters to Film entities that have a value for Rating, using
+// has(rating).
+func (q *FilmQuery) HasRating() *FilmQuery {
+	return q.Where(FilmWhere.HasRating())
+}
+
+// NotRating filters to Film entities that have no Rating value.
+func (q *FilmQuery) NotRating() *FilmQuery {
+	return q.Where(FilmWhere.NotRating())
+}
+
+// HasRuntime filters to Film entities that have a value for Runtime, using
+// has(runtime).
+func (q *FilmQuery) HasRuntime() *FilmQuery {
+	return q.Where(FilmWhere.HasRuntime())
+}
+
+// NotRuntime filters to Film entities that have no Runtime value.
+func (q *FilmQuery) NotRuntime() *FilmQuery {
+	return q.Where(FilmWhere.NotRuntime())
+}
+
+// HasColor filters to Film entities that have a value for Color, using
+// has(color).
+func (q *FilmQuery) HasColor() *FilmQuery {
+	return q.Where(FilmWhere.HasColor())
+}
+
+// NotColor filters to Film entities that have no Color value.
+func (q *FilmQuery) NotColor() *FilmQuery {
+	return q.Where(FilmWhere.NotColor())
+}
+
+// HasAka filters to Film entities that have a value for Aka, using
+// has(aka).
+func (q *FilmQuery) HasAka() *FilmQuery {
+	return q.Where(FilmWhere.HasAka())
+}
+
+// NotAka filters to Film entities that have no Aka value.
+func (q *FilmQuery) NotAka() *FilmQuery {
+	return q.Where(FilmWhere.NotAka())
+}
+
+// HasGenre filters to Film entities that have a value for Genre, using
+// has(genre).
+func (q *FilmQuery) HasGenre() *FilmQuery {
+	return q.Where(FilmWhere.HasGenre())
+}
+
+// NotGenre filters to Film entities that have no Genre value.
+func (q *FilmQuery) NotGenre() *FilmQuery {
+	return q.Where(FilmWhere.NotGenre())
+}
+
+// HasDirector filters to Film entities that have a value for Director, using
+// has(film.director).
+func (q *FilmQuery) HasDirector() *FilmQuery {
+	return q.Where(FilmWhere.HasDirector())
+}
+
+// NotDirector filters to Film entities that have no Director value.
+func (q *FilmQuery) NotDirector() *FilmQuery {
+	return q.Where(FilmWhere.NotDirector())
+}
+
+// HasEmbedding filters to Film entities that have a value for Embedding, using
+// has(embedding).
+func (q *FilmQuery) HasEmbedding() *FilmQuery {
+	return q.Where(FilmWhere.HasEmbedding())
+}
+
+// NotEmbedding filters to Film entities that have no Embedding value.
+func (q *FilmQuery) NotEmbedding() *FilmQuery {
+	return q.Where(FilmWhere.NotEmbedding())
+}
+
+// GenreContains filters to Film entities whose Genre include any of the
+// Genre nodes with the given uids, using uid_in(genre, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) GenreContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.GenreContains(uids...))
+}
+
+// DirectorContains filters to Film entities whose Director include any of the
+// Director nodes with the given uids, using uid_in(film.director, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) DirectorContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.DirectorContains(uids...))
+}
+
+// AkaAllOfTerms filters to Film entities whose Aka contains all of the terms.
+func (q *FilmQuery) AkaAllOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.AkaAllOfTerms(terms))
+}
+
+// AkaAnyOfTerms filters to Film entities whose Aka contains any of the terms.
+func (q *FilmQuery) AkaAnyOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.AkaAnyOfTerms(terms))
+}
+
+// NameGe filters to Film entities whose Name sorts at or after value.
+func (q *FilmQuery) NameGe(value string) *FilmQuery {
+	return q.Where(FilmWhere.NameGe(value))
+}
+
+// NameLe filters to Film entities whose Name sorts at or before value.
+func (q *FilmQuery) NameLe(value string) *FilmQuery {
+	return q.Where(FilmWhere.NameLe(value))
+}
+
+// NameBetween filters to Film entities whose Name sorts from from through to,
+// inclusive.
+func (q *FilmQuery) NameBetween(from, to string) *FilmQuery {
+	return q.Where(FilmWhere.NameBetween(from, to))
+}
+
+// InitialReleaseDateYearEquals filters to Film entities whose InitialReleaseDate falls in year.
+func (q *FilmQuery) InitialReleaseDateYearEquals(year int) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateYearEquals(year))
+}
+
+// InitialReleaseDateYearBetween filters to Film entities whose InitialReleaseDate falls in the
+// years from through to, inclusive.
+func (q *FilmQuery) InitialReleaseDateYearBetween(from, to int) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateYearBetween(from, to))
+}
+
+// InitialReleaseDateDateBetween filters to Film entities whose InitialReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (q *FilmQuery) InitialReleaseDateDateBetween(from, to time.Time) *FilmQuery {
+	return q.Where(FilmWhere.InitialReleaseDateDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithGenre makes GetByUID and Exec also fetch the Genre edge, with the
+// scalar predicates of each Genre it leads to, in the same query.
+func (q *FilmQuery) WithGenre() *FilmQuery {
+	return q.withEdge("genre { " + genreSelection(0) + " }")
+}
+
+// WithDirector makes GetByUID and Exec also fetch the Director edge, with the
+// scalar predicates of each Director it leads to, in the same query.
+func (q *FilmQuery) WithDirector() *FilmQuery {
+	return q.withEdge("film.director { " + directorSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasName matches Film entities that have a value for Name, using
+// has(name).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(name)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(name)"}
+}
+
+// HasInitialReleaseDate matches Film entities that have a value for InitialReleaseDate, using
+// has(initial_release_date).
+func (FilmConditions) HasInitialReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "has(initial_release_date)"}
+}
+
+// NotInitialReleaseDate matches Film entities that have no InitialReleaseDate value.
+func (FilmConditions) NotInitialReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(initial_release_date)"}
+}
+
+// HasTagline matches Film entities that have a value for Tagline, using
+// has(tagline).
+func (FilmConditions) HasTagline() Filter[Film] {
+	return Filter[Film]{expr: "has(tagline)"}
+}
+
+// NotTagline matches Film entities that have no Tagline value.
+func (FilmConditions) NotTagline() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(tagline)"}
+}
+
+// HasRating matches Film entities that have a value for Rating, using
+// has(rating).
+func (FilmConditions) HasRating() Filter[Film] {
+	return Filter[Film]{expr: "has(rating)"}
+}
+
+// NotRating matches Film entities that have no Rating value.
+func (FilmConditions) NotRating() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(rating)"}
+}
+
+// HasRuntime matches Film entities that have a value for Runtime, using
+// has(runtime).
+func (FilmConditions) HasRuntime() Filter[Film] {
+	return Filter[Film]{expr: "has(runtime)"}
+}
+
+// NotRuntime matches Film entities that have no Runtime value.
+func (FilmConditions) NotRuntime() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(runtime)"}
+}
+
+// HasColor matches Film entities that have a value for Color, using
+// has(color).
+func (FilmConditions) HasColor() Filter[Film] {
+	return Filter[Film]{expr: "has(color)"}
+}
+
+// NotColor matches Film entities that have no Color value.
+func (FilmConditions) NotColor() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(color)"}
+}
+
+// HasAka matches Film entities that have a value for Aka, using
+// has(aka).
+func (FilmConditions) HasAka() Filter[Film] {
+	return Filter[Film]{expr: "has(aka)"}
+}
+
+// NotAka matches Film entities that have no Aka value.
+func (FilmConditions) NotAka() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(aka)"}
+}
+
+// HasGenre matches Film entities that have a value for Genre, using
+// has(genre).
+func (FilmConditions) HasGenre() Filter[Film] {
+	return Filter[Film]{expr: "has(genre)"}
+}
+
+// NotGenre matches Film entities that have no Genre value.
+func (FilmConditions) NotGenre() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(genre)"}
+}
+
+// HasDirector matches Film entities that have a value for Director, using
+// has(film.director).
+func (FilmConditions) HasDirector() Filter[Film] {
+	return Filter[Film]{expr: "has(film.director)"}
+}
+
+// NotDirector matches Film entities that have no Director value.
+func (FilmConditions) NotDirector() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(film.director)"}
+}
+
+// HasEmbedding matches Film entities that have a value for Embedding, using
+// has(embedding).
+func (FilmConditions) HasEmbedding() Filter[Film] {
+	return Filter[Film]{expr: "has(embedding)"}
+}
+
+// NotEmbedding matches Film entities that have no Embedding value.
+func (FilmConditions) NotEmbedding() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(embedding)"}
+}
+
+// GenreContains matches Film entities whose Genre include any of the
+// Genre nodes with the given uids, using uid_in(genre, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) GenreContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Genre: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(genre, " + list + ")"}
+}
+
+// DirectorContains matches Film entities whose Director include any of the
+// Director nodes with the given uids, using uid_in(film.director, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) DirectorContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Director: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(film.director, " + list + ")"}
+}
+
+// AkaAllOfTerms matches Film entities whose Aka contains all of the terms.
+func (FilmConditions) AkaAllOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "allofterms(aka, " + formatString(terms) + ")"}
+}
+
+// AkaAnyOfTerms matches Film entities whose Aka contains any of the terms.
+func (FilmConditions) AkaAnyOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "anyofterms(aka, " + formatString(terms) + ")"}
+}
+
+// NameGe matches Film entities whose Name sorts at or after value.
+func (FilmConditions) NameGe(value string) Filter[Film] {
+	return Filter[Film]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Film entities whose Name sorts at or before value.
+func (FilmConditions) NameLe(value string) Filter[Film] {
+	return Filter[Film]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Film entities whose Name sorts from from through to,
+// inclusive.
+func (FilmConditions) NameBetween(from, to string) Filter[Film] {
+	return Filter[Film]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// InitialReleaseDateYearEquals matches Film entities whose InitialReleaseDate falls in year.
+func (c FilmConditions) InitialReleaseDateYearEquals(year int) Filter[Film] {
+	return c.InitialReleaseDateYearBetween(year, year)
+}
+
+// InitialReleaseDateYearBetween matches Film entities whose InitialReleaseDate falls in the
+// years from through to, inclusive.
+func (c FilmConditions) InitialReleaseDateYearBetween(from, to int) Filter[Film] {
+	return c.InitialReleaseDateDateBetween(yearStart(from), yearEnd(to))
+}
+
+// InitialReleaseDateDateBetween matches Film entities whose InitialReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// year index only narrows the candidates Dgraph compares.
+func (FilmConditions) InitialReleaseDateDateBetween(from, to time.Time) Filter[Film] {
+	return Filter[Film]{expr: "between(initial_release_date, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
@@ -0,0 +1,530 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+import (
+	"context"
+	"iter"
+)
+
+// SearchIter returns an iterator over Director entities matching term.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *DirectorClient) SearchIter(ctx context.Context, term string) iter.Seq2[Director, error] {
+	return func(yield func(Director, error) bool) {
+		offset := 0
+		for {
+			results, err := c.Search(ctx, term, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Director
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// ListIter returns an iterator over all Directors.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *DirectorClient) ListIter(ctx context.Context) iter.Seq2[Director, error] {
+	return func(yield func(Director, error) bool) {
+		offset := 0
+		for {
+			results, err := c.List(ctx, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Director
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// DirectorIterator streams Director entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type DirectorIterator struct {
+	client   *DirectorClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Director
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Director entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *DirectorClient) Iterator(opts ...PageOption) *DirectorIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &DirectorIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Director entities after cursor,
+// a value previously returned by DirectorIterator.Cursor.
+func (c *DirectorClient) ResumeIterator(cursor string, opts ...PageOption) *DirectorIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Director, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *DirectorIterator) Next(ctx context.Context) (*Director, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Director
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "director", directorSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Director{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *DirectorIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Director returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *DirectorIterator) Cursor() string {
+	return it.after
+}
+
+// StreamDirectors sends all Director entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *DirectorClient) StreamDirectors(ctx context.Context, opts ...PageOption) (<-chan *Director, <-chan error) {
+	out := make(chan *Director)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
+// SearchIter returns an iterator over Film entities matching term.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *FilmClient) SearchIter(ctx context.Context, term string) iter.Seq2[Film, error] {
+	return func(yield func(Film, error) bool) {
+		offset := 0
+		for {
+			results, err := c.Search(ctx, term, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Film
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// ListIter returns an iterator over all Films.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *FilmClient) ListIter(ctx context.Context) iter.Seq2[Film, error] {
+	return func(yield func(Film, error) bool) {
+		offset := 0
+		for {
+			results, err := c.List(ctx, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Film
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// FilmIterator streams Film entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type FilmIterator struct {
+	client   *FilmClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Film
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Film entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *FilmClient) Iterator(opts ...PageOption) *FilmIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &FilmIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Film entities after cursor,
+// a value previously returned by FilmIterator.Cursor.
+func (c *FilmClient) ResumeIterator(cursor string, opts ...PageOption) *FilmIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Film, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *FilmIterator) Next(ctx context.Context) (*Film, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Film
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Film", filmSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Film{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *FilmIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Film returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *FilmIterator) Cursor() string {
+	return it.after
+}
+
+// StreamFilms sends all Film entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *FilmClient) StreamFilms(ctx context.Context, opts ...PageOption) (<-chan *Film, <-chan error) {
+	out := make(chan *Film)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
+
+// SearchIter returns an iterator over Genre entities matching term.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *GenreClient) SearchIter(ctx context.Context, term string) iter.Seq2[Genre, error] {
+	return func(yield func(Genre, error) bool) {
+		offset := 0
+		for {
+			results, err := c.Search(ctx, term, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Genre
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// ListIter returns an iterator over all Genres.
+// It automatically pages through results using Go 1.23+ range-over-func.
+func (c *GenreClient) ListIter(ctx context.Context) iter.Seq2[Genre, error] {
+	return func(yield func(Genre, error) bool) {
+		offset := 0
+		for {
+			results, err := c.List(ctx, First(defaultPageSize), Offset(offset))
+			if err != nil {
+				var zero Genre
+				yield(zero, err)
+				return
+			}
+			if len(results) == 0 {
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < defaultPageSize {
+				return
+			}
+			offset += len(results)
+		}
+	}
+}
+
+// GenreIterator streams Genre entities in UID order. It fetches one page
+// at a time using first and after(uid), so a scan of any size holds at most
+// one page in memory.
+type GenreIterator struct {
+	client   *GenreClient
+	pageSize int
+	offset   int
+	after    string
+	page     []Genre
+	pos      int
+	done     bool
+	err      error
+}
+
+// Iterator returns an iterator over all Genre entities. First sets the page
+// size (default 50); Offset skips nodes before the first page.
+func (c *GenreClient) Iterator(opts ...PageOption) *GenreIterator {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	return &GenreIterator{client: c, pageSize: cfg.first, offset: cfg.offset}
+}
+
+// ResumeIterator returns an iterator over the Genre entities after cursor,
+// a value previously returned by GenreIterator.Cursor.
+func (c *GenreClient) ResumeIterator(cursor string, opts ...PageOption) *GenreIterator {
+	it := c.Iterator(opts...)
+	it.after, it.offset = cursor, 0
+	return it
+}
+
+// Next returns the next Genre, fetching another page when the current one
+// is exhausted. It returns false at the end of the scan or when a query fails;
+// Err tells the two apart.
+func (it *GenreIterator) Next(ctx context.Context) (*Genre, bool) {
+	if it.pos == len(it.page) {
+		if it.done || it.err != nil {
+			return nil, false
+		}
+		var page []Genre
+		var err error
+		if _, bare := it.client.conn.(*dgoConn); bare {
+			err = pageNodes(ctx, it.client.conn.QueryRaw, "Genre", genreSelection(0), it.after, it.pageSize, it.offset, &page)
+		} else {
+			q := it.client.conn.Query(ctx, Genre{}).First(it.pageSize)
+			if it.after != "" {
+				q = q.After(it.after)
+			} else if it.offset > 0 {
+				q = q.Offset(it.offset)
+			}
+			err = retryRead(ctx, it.client.conn, func() error { return q.Nodes(&page) })
+		}
+		if err != nil {
+			it.err = err
+			return nil, false
+		}
+		it.page, it.pos = page, 0
+		it.done = len(page) < it.pageSize
+		if len(page) == 0 {
+			return nil, false
+		}
+	}
+	v := &it.page[it.pos]
+	it.pos++
+	it.after = v.UID
+	return v, true
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *GenreIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the UID of the last Genre returned by Next, from which
+// ResumeIterator continues the scan.
+func (it *GenreIterator) Cursor() string {
+	return it.after
+}
+
+// StreamGenres sends all Genre entities on the first channel, paging through them
+// like Iterator, which opts configure. Both channels are closed when the scan
+// ends; before that, the second receives the error that stopped it, if any,
+// including ctx's when ctx is done. Cancel ctx to stop reading early, so that
+// the goroutine sending the entities exits.
+func (c *GenreClient) StreamGenres(ctx context.Context, opts ...PageOption) (<-chan *Genre, <-chan error) {
+	out := make(chan *Genre)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(errc)
+		it := c.Iterator(opts...)
+		for {
+			if err := ctx.Err(); err != nil {
+				errc <- err
+				return
+			}
+			v, ok := it.Next(ctx)
+			if !ok {
+				if err := it.Err(); err != nil {
+					errc <- err
+				}
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+	}()
+	return out, errc
+}
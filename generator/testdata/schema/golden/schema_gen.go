@@ -0,0 +1,44 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package movies
+
+// DQLSchema is the Dgraph schema for the movies data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+aka: [string] @index(term) .
+color: bool .
+embedding: float32vector @index(hnsw(metric:"cosine")) .
+film.director: uid @reverse .
+genre: [uid] @reverse @count .
+initial_release_date: datetime @index(year) .
+location: geo @index(geo) .
+name: string @index(exact, fulltext) @upsert .
+rating: float @index(float) .
+runtime: int .
+tagline: string .
+
+type director {
+	name
+	location
+	<~film.director>
+}
+
+type Film {
+	name
+	initial_release_date
+	tagline
+	rating
+	runtime
+	color
+	aka
+	genre
+	film.director
+	embedding
+}
+
+type Genre {
+	name
+	<~genre>
+}
+`
@@ -0,0 +1,332 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package selfref
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// PersonAPI is the set of Person operations provided by PersonClient. Code
+// that depends on PersonAPI rather than *PersonClient can run against a test double.
+type PersonAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Person) error
+	Create(ctx context.Context, v *Person) (string, error)
+	Update(ctx context.Context, v *Person) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Person, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error)
+}
+
+// PersonClient provides typed CRUD operations for Person entities.
+//
+// Person mentors other people, an edge back to its own type.
+type PersonClient struct {
+	conn graphConn
+}
+
+var _ PersonAPI = (*PersonClient)(nil)
+
+// Get retrieves a single Person by its UID. Its self-referential edges are
+// expanded one level deep unless WithDepth says otherwise.
+func (c *PersonClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Person, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Person
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Person", personSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Person with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *PersonClient) GetExpanded(ctx context.Context, uid string) (*Person, error) {
+	var result Person
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Person", map[string]string{
+		"mentor": "mentors",
+		"team":   "teams",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Person type. A node of another type does not count.
+func (c *PersonClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Person")
+}
+
+// Load populates v with the Person stored under uid, using c.Person.Get.
+func (v *Person) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Person.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadMentors returns v.Mentors, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Mentors, with the scalar predicates of each Person, is kept in
+// v.Mentors, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Mentors to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Mentors may be loaded and empty, and
+// LoadMentors queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Person) LoadMentors(ctx context.Context, c *Client) ([]Person, error) {
+	if v.Mentors != nil {
+		return v.Mentors, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Person.LoadMentors: UID is empty")
+	}
+	var got Person
+	selection := "uid mentors: mentor { " + personSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Person", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Mentors = got.Mentors
+	if v.Mentors == nil {
+		v.Mentors = []Person{}
+	}
+	return v.Mentors, nil
+}
+
+// LoadTeams returns v.Teams, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Teams, with the scalar predicates of each Team, is kept in
+// v.Teams, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Teams to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Teams may be loaded and empty, and
+// LoadTeams queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Person) LoadTeams(ctx context.Context, c *Client) ([]Team, error) {
+	if v.Teams != nil {
+		return v.Teams, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Person.LoadTeams: UID is empty")
+	}
+	var got Person
+	selection := "uid teams: team { " + teamSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Person", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Teams = got.Teams
+	if v.Teams == nil {
+		v.Teams = []Team{}
+	}
+	return v.Teams, nil
+}
+
+var _ Entity = (*Person)(nil)
+
+// GetUID returns the Person's UID, empty until it has been added.
+func (v *Person) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Person's UID.
+func (v *Person) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Person's dgraph.type values: its DType, or
+// {"Person"} until Add sets it.
+func (v *Person) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Person"}
+}
+
+// String returns a one-line summary of the Person: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Person) String() string {
+	return fmt.Sprintf("Person(%s mentors=%d teams=%d)", v.UID, len(v.Mentors), len(v.Teams))
+}
+
+// Add inserts a new Person into the database.
+func (c *PersonClient) Add(ctx context.Context, v *Person) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Person node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *PersonClient) Create(ctx context.Context, v *Person) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Person.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Person"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Person.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Person in the database. The UID field must be set.
+func (c *PersonClient) Update(ctx context.Context, v *Person) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Person with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *PersonClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Person.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Person with the given UID to value, touching
+// no other predicate.
+func (c *PersonClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Person.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Person with the given UID from the database.
+func (c *PersonClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddMentors links the Person with the given UID to the Person nodes with the
+// given UIDs through mentor, keeping the Mentors it has.
+func (c *PersonClient) AddMentors(ctx context.Context, personUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "mentor", []string{personUID}, targetUIDs, false); err != nil {
+		return fmt.Errorf("Person.AddMentors: %w", err)
+	}
+	return nil
+}
+
+// RemoveMentors unlinks the Person with the given UID from the Person nodes
+// with the given UIDs, deleting their mentor edges.
+func (c *PersonClient) RemoveMentors(ctx context.Context, personUID string, targetUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "mentor", []string{personUID}, targetUIDs, true); err != nil {
+		return fmt.Errorf("Person.RemoveMentors: %w", err)
+	}
+	return nil
+}
+
+// AddTeams links the Person with the given UID to the Team nodes with the
+// given UIDs through team, keeping the Teams it has.
+func (c *PersonClient) AddTeams(ctx context.Context, personUID string, teamUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "team", []string{personUID}, teamUIDs, false); err != nil {
+		return fmt.Errorf("Person.AddTeams: %w", err)
+	}
+	return nil
+}
+
+// RemoveTeams unlinks the Person with the given UID from the Team nodes
+// with the given UIDs, deleting their team edges.
+func (c *PersonClient) RemoveTeams(ctx context.Context, personUID string, teamUIDs ...string) error {
+	if err := linkNodes(ctx, c.conn, "team", []string{personUID}, teamUIDs, true); err != nil {
+		return fmt.Errorf("Person.RemoveTeams: %w", err)
+	}
+	return nil
+}
+
+// RecurseMentors retrieves the Person with UID rootUID and follows its Mentors edge
+// recursively, depth levels deep, using @recurse over mentor. The
+// Person entities found carry their scalar fields; other edges are not expanded.
+// depth must be from 1 to 16, and a node reached twice is not expanded
+// again.
+func (c *PersonClient) RecurseMentors(ctx context.Context, rootUID string, depth int) (*Person, error) {
+	var result Person
+	if err := recurseFrom(ctx, c.conn.QueryRaw, rootUID, "Person", "uid dgraph.type name mentors: mentor", depth, &result); err != nil {
+		return nil, fmt.Errorf("Person.RecurseMentors: %w", err)
+	}
+	return &result, nil
+}
+
+// personSelection returns the DQL selection for a Person: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func personSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " mentors: mentor { " + personSelection(depth-1) + " }"
+		s += " teams: team { " + teamSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves People with optional pagination.
+func (c *PersonClient) List(ctx context.Context, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var people []Person
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&people)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// Find retrieves People matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *PersonClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var people []Person
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&people)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// GetByName retrieves the Person entities whose Name is value, with optional
+// pagination.
+func (c *PersonClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Person, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Person
+	err := queryNodes(ctx, c.conn.QueryRaw, "Person", "eq(name, "+formatString(value)+")", "", personSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
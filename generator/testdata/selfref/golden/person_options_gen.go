@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package selfref
+
+// PersonOption is a functional option for configuring Person mutations.
+type PersonOption func(*Person)
+
+// WithPersonName sets the Name field on a Person.
+func WithPersonName(v string) PersonOption {
+	return func(e *Person) {
+		e.Name = v
+	}
+}
+
+// ApplyPersonOptions applies the given options to a Person.
+func ApplyPersonOptions(e *Person, opts ...PersonOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package selfref
+
+// DQLSchema is the Dgraph schema for the selfref data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+mentor: [uid] @reverse .
+name: string @index(hash) .
+team: [uid] .
+
+type Person {
+	name
+	mentor
+	team
+}
+
+type Team {
+	name
+}
+`
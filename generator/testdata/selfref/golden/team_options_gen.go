@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package selfref
+
+// TeamOption is a functional option for configuring Team mutations.
+type TeamOption func(*Team)
+
+// WithTeamName sets the Name field on a Team.
+func WithTeamName(v string) TeamOption {
+	return func(e *Team) {
+		e.Name = v
+	}
+}
+
+// ApplyTeamOptions applies the given options to a Team.
+func ApplyTeamOptions(e *Team, opts ...TeamOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,17 @@
+package selfref
+
+// Person mentors other people, an edge back to its own type.
+type Person struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Name    string   `json:"name,omitempty" dgraph:"index=hash"`
+	Mentors []Person `json:"mentors,omitempty" dgraph:"predicate=mentor reverse"`
+	Teams   []Team   `json:"teams,omitempty" dgraph:"predicate=team"`
+}
+
+// Team groups people; its edge is not self-referential.
+type Team struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+}
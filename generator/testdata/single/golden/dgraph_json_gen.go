@@ -0,0 +1,43 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// decodeEdges decodes raw, the value of an edge predicate, into dst, a pointer
+// to a slice of entities. Dgraph returns a single object rather than a list
+// for a predicate of type uid; it is decoded as a one-element slice.
+func decodeEdges(raw json.RawMessage, dst any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	return json.Unmarshal(raw, dst)
+}
+
+// decodeEdge decodes raw, the value of an edge predicate, into dst, a pointer
+// to a single entity. Dgraph returns a list for a reverse predicate or one of
+// type [uid]; its first element, if any, is decoded.
+func decodeEdge(raw json.RawMessage, dst any) error {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil
+	}
+	if raw[0] != '[' {
+		return json.Unmarshal(raw, dst)
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return json.Unmarshal(list[0], dst)
+}
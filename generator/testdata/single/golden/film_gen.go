@@ -0,0 +1,346 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FilmAPI is the set of Film operations provided by FilmClient. Code
+// that depends on FilmAPI rather than *FilmClient can run against a test double.
+type FilmAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Film) error
+	Create(ctx context.Context, v *Film) (string, error)
+	Update(ctx context.Context, v *Film) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Film, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error)
+}
+
+// FilmClient provides typed CRUD operations for Film entities.
+//
+// Film has single-node edges: a pointer to its studio, counted, and its
+// director held by value.
+type FilmClient struct {
+	conn graphConn
+}
+
+var _ FilmAPI = (*FilmClient)(nil)
+
+// Get retrieves a single Film by its UID. Its self-referential edges are
+// expanded one level deep unless WithDepth says otherwise.
+func (c *FilmClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Film, error) {
+	cfg := getConfig{depth: 1}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Film
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Film", filmSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Film with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *FilmClient) GetExpanded(ctx context.Context, uid string) (*Film, error) {
+	var result Film
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Film", map[string]string{
+		"film.studio":   "studio",
+		"film.director": "director",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Film type. A node of another type does not count.
+func (c *FilmClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Film")
+}
+
+// Load populates v with the Film stored under uid, using c.Film.Get.
+func (v *Film) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Film.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Film)(nil)
+
+// GetUID returns the Film's UID, empty until it has been added.
+func (v *Film) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Film's UID.
+func (v *Film) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Film's dgraph.type values: its DType, or
+// {"Film"} until Add sets it.
+func (v *Film) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Film"}
+}
+
+// String returns a one-line summary of the Film: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Film) String() string {
+	return fmt.Sprintf("Film(%s studio=%d director=%d sequel=%d prequel=%d)", v.UID, nodeCount(v.Studio), min(len(v.Director.UID), 1), nodeCount(v.Sequel), nodeCount(v.Prequel))
+}
+
+// Add inserts a new Film into the database.
+func (c *FilmClient) Add(ctx context.Context, v *Film) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Film node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *FilmClient) Create(ctx context.Context, v *Film) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Film.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Film"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Film.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Film in the database. The UID field must be set.
+func (c *FilmClient) Update(ctx context.Context, v *Film) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Film with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *FilmClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Film.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Film with the given UID to value, touching
+// no other predicate.
+func (c *FilmClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Film.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Film with the given UID from the database.
+func (c *FilmClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// SetStudio links the Film with the given UID to the Studio with UID
+// studioUID through film.studio, replacing the Studio it had.
+func (c *FilmClient) SetStudio(ctx context.Context, filmUID, studioUID string) error {
+	_, err := formatUIDs([]string{studioUID})
+	if err == nil {
+		err = setFields(ctx, c.conn, filmUID, map[string]any{"film.studio": map[string]string{"uid": studioUID}}, "film.studio")
+	}
+	if err != nil {
+		return fmt.Errorf("Film.SetStudio: %w", err)
+	}
+	return nil
+}
+
+// RemoveStudio unlinks the Film with the given UID from its Studio, if any.
+func (c *FilmClient) RemoveStudio(ctx context.Context, filmUID string) error {
+	if err := setFields(ctx, c.conn, filmUID, nil, "film.studio"); err != nil {
+		return fmt.Errorf("Film.RemoveStudio: %w", err)
+	}
+	return nil
+}
+
+// SetDirector links the Film with the given UID to the Director with UID
+// directorUID through film.director, replacing the Director it had.
+func (c *FilmClient) SetDirector(ctx context.Context, filmUID, directorUID string) error {
+	_, err := formatUIDs([]string{directorUID})
+	if err == nil {
+		err = setFields(ctx, c.conn, filmUID, map[string]any{"film.director": map[string]string{"uid": directorUID}}, "film.director")
+	}
+	if err != nil {
+		return fmt.Errorf("Film.SetDirector: %w", err)
+	}
+	return nil
+}
+
+// RemoveDirector unlinks the Film with the given UID from its Director, if any.
+func (c *FilmClient) RemoveDirector(ctx context.Context, filmUID string) error {
+	if err := setFields(ctx, c.conn, filmUID, nil, "film.director"); err != nil {
+		return fmt.Errorf("Film.RemoveDirector: %w", err)
+	}
+	return nil
+}
+
+// SetSequel links the Film with the given UID to the Film with UID
+// sequelUID through sequel, replacing the Sequel it had.
+func (c *FilmClient) SetSequel(ctx context.Context, filmUID, sequelUID string) error {
+	_, err := formatUIDs([]string{sequelUID})
+	if err == nil {
+		err = setFields(ctx, c.conn, filmUID, map[string]any{"sequel": map[string]string{"uid": sequelUID}}, "sequel")
+	}
+	if err != nil {
+		return fmt.Errorf("Film.SetSequel: %w", err)
+	}
+	return nil
+}
+
+// RemoveSequel unlinks the Film with the given UID from its Sequel, if any.
+func (c *FilmClient) RemoveSequel(ctx context.Context, filmUID string) error {
+	if err := setFields(ctx, c.conn, filmUID, nil, "sequel"); err != nil {
+		return fmt.Errorf("Film.RemoveSequel: %w", err)
+	}
+	return nil
+}
+
+// AddPrequel links the Film with the given UID to the Film nodes with the
+// given UIDs. As Prequel is the reverse of sequel, a single edge, it sets the sequel
+// of each Film to the Film, moving it from the Film it had, if any.
+func (c *FilmClient) AddPrequel(ctx context.Context, filmUID string, targetUIDs ...string) error {
+	if err := linkSingle(ctx, c.conn, "sequel", targetUIDs, filmUID, false); err != nil {
+		return fmt.Errorf("Film.AddPrequel: %w", err)
+	}
+	return nil
+}
+
+// RemovePrequel unlinks the Film with the given UID from the Film nodes
+// with the given UIDs, deleting the sequel edge from each Film.
+func (c *FilmClient) RemovePrequel(ctx context.Context, filmUID string, targetUIDs ...string) error {
+	if err := linkSingle(ctx, c.conn, "sequel", targetUIDs, filmUID, true); err != nil {
+		return fmt.Errorf("Film.RemovePrequel: %w", err)
+	}
+	return nil
+}
+
+// RecurseSequel retrieves the Film with UID rootUID and follows its Sequel edge
+// recursively, depth levels deep, using @recurse over sequel. The
+// Film entities found carry their scalar fields; other edges are not expanded.
+// depth must be from 1 to 16, and a node reached twice is not expanded
+// again.
+func (c *FilmClient) RecurseSequel(ctx context.Context, rootUID string, depth int) (*Film, error) {
+	var result Film
+	if err := recurseFrom(ctx, c.conn.QueryRaw, rootUID, "Film", "uid dgraph.type name sequel", depth, &result); err != nil {
+		return nil, fmt.Errorf("Film.RecurseSequel: %w", err)
+	}
+	return &result, nil
+}
+
+// RecursePrequel retrieves the Film with UID rootUID and follows its Prequel edge
+// recursively, depth levels deep, using @recurse over ~sequel. The
+// Film entities found carry their scalar fields; other edges are not expanded.
+// depth must be from 1 to 16, and a node reached twice is not expanded
+// again.
+func (c *FilmClient) RecursePrequel(ctx context.Context, rootUID string, depth int) (*Film, error) {
+	var result Film
+	if err := recurseFrom(ctx, c.conn.QueryRaw, rootUID, "Film", "uid dgraph.type name prequel: ~sequel", depth, &result); err != nil {
+		return nil, fmt.Errorf("Film.RecursePrequel: %w", err)
+	}
+	return &result, nil
+}
+
+// CountStudio returns the number of Studio of the Film with the given UID, using
+// Dgraph's count(film.studio).
+func (c *FilmClient) CountStudio(ctx context.Context, uid string) (int, error) {
+	return countEdges(ctx, c.conn.QueryRaw, uid, "Film", "film.studio")
+}
+
+// filmSelection returns the DQL selection for a Film: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func filmSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " studio: film.studio { " + studioSelection(depth-1) + " }"
+		s += " director: film.director { " + directorSelection(depth-1) + " }"
+		s += " sequel { " + filmSelection(depth-1) + " }"
+		s += " prequel: ~sequel { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Films with optional pagination.
+func (c *FilmClient) List(ctx context.Context, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var films []Film
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// Find retrieves Films matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *FilmClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var films []Film
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&films)
+	if err != nil {
+		return nil, err
+	}
+	return films, nil
+}
+
+// GetByName retrieves the Film entities whose Name is value, with optional
+// pagination.
+func (c *FilmClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Film, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Film
+	err := queryNodes(ctx, c.conn.QueryRaw, "Film", "eq(name, "+formatString(value)+")", "", filmSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,37 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		Studio   json.RawMessage `json:"studio"`
+		Director json.RawMessage `json:"director"`
+		Sequel   json.RawMessage `json:"sequel"`
+		Prequel  json.RawMessage `json:"prequel"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeEdge(in.Studio, &v.Studio); err != nil {
+		return fmt.Errorf("Film.Studio: %w", err)
+	}
+	if err := decodeEdge(in.Director, &v.Director); err != nil {
+		return fmt.Errorf("Film.Director: %w", err)
+	}
+	if err := decodeEdge(in.Sequel, &v.Sequel); err != nil {
+		return fmt.Errorf("Film.Sequel: %w", err)
+	}
+	if err := decodeEdge(in.Prequel, &v.Prequel); err != nil {
+		return fmt.Errorf("Film.Prequel: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,425 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Film entities that have a value for Name, using
+// has(name).
+func (q *FilmQuery) HasName() *FilmQuery {
+	return q.Where(FilmWhere.HasName())
+}
+
+// NotName filters to Film entities that have no Name value.
+func (q *FilmQuery) NotName() *FilmQuery {
+	return q.Where(FilmWhere.NotName())
+}
+
+// HasStudio filters to Film entities that have a value for Studio, using
+// has(film.studio).
+func (q *FilmQuery) HasStudio() *FilmQuery {
+	return q.Where(FilmWhere.HasStudio())
+}
+
+// NotStudio filters to Film entities that have no Studio value.
+func (q *FilmQuery) NotStudio() *FilmQuery {
+	return q.Where(FilmWhere.NotStudio())
+}
+
+// HasDirector filters to Film entities that have a value for Director, using
+// has(film.director).
+func (q *FilmQuery) HasDirector() *FilmQuery {
+	return q.Where(FilmWhere.HasDirector())
+}
+
+// NotDirector filters to Film entities that have no Director value.
+func (q *FilmQuery) NotDirector() *FilmQuery {
+	return q.Where(FilmWhere.NotDirector())
+}
+
+// HasSequel filters to Film entities that have a value for Sequel, using
+// has(sequel).
+func (q *FilmQuery) HasSequel() *FilmQuery {
+	return q.Where(FilmWhere.HasSequel())
+}
+
+// NotSequel filters to Film entities that have no Sequel value.
+func (q *FilmQuery) NotSequel() *FilmQuery {
+	return q.Where(FilmWhere.NotSequel())
+}
+
+// HasPrequel filters to Film entities that have a value for Prequel, using
+// has(~sequel).
+func (q *FilmQuery) HasPrequel() *FilmQuery {
+	return q.Where(FilmWhere.HasPrequel())
+}
+
+// NotPrequel filters to Film entities that have no Prequel value.
+func (q *FilmQuery) NotPrequel() *FilmQuery {
+	return q.Where(FilmWhere.NotPrequel())
+}
+
+// StudioContains filters to Film entities whose Studio include any of the
+// Studio nodes with the given uids, using uid_in(film.studio, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) StudioContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.StudioContains(uids...))
+}
+
+// DirectorContains filters to Film entities whose Director include any of the
+// Director nodes with the given uids, using uid_in(film.director, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) DirectorContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.DirectorContains(uids...))
+}
+
+// SequelContains filters to Film entities whose Sequel include any of the
+// Film nodes with the given uids, using uid_in(sequel, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) SequelContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.SequelContains(uids...))
+}
+
+// PrequelContains filters to Film entities whose Prequel include any of the
+// Film nodes with the given uids, using uid_in(~sequel, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) PrequelContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.PrequelContains(uids...))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithStudio makes GetByUID and Exec also fetch the Studio edge, with the
+// scalar predicates of each Studio it leads to, in the same query.
+func (q *FilmQuery) WithStudio() *FilmQuery {
+	return q.withEdge("studio: film.studio { " + studioSelection(0) + " }")
+}
+
+// WithDirector makes GetByUID and Exec also fetch the Director edge, with the
+// scalar predicates of each Director it leads to, in the same query.
+func (q *FilmQuery) WithDirector() *FilmQuery {
+	return q.withEdge("director: film.director { " + directorSelection(0) + " }")
+}
+
+// WithSequel makes GetByUID and Exec also fetch the Sequel edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *FilmQuery) WithSequel() *FilmQuery {
+	return q.withEdge("sequel { " + filmSelection(0) + " }")
+}
+
+// WithPrequel makes GetByUID and Exec also fetch the Prequel edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *FilmQuery) WithPrequel() *FilmQuery {
+	return q.withEdge("prequel: ~sequel { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasName matches Film entities that have a value for Name, using
+// has(name).
+func (FilmConditions) HasName() Filter[Film] {
+	return Filter[Film]{expr: "has(name)"}
+}
+
+// NotName matches Film entities that have no Name value.
+func (FilmConditions) NotName() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(name)"}
+}
+
+// HasStudio matches Film entities that have a value for Studio, using
+// has(film.studio).
+func (FilmConditions) HasStudio() Filter[Film] {
+	return Filter[Film]{expr: "has(film.studio)"}
+}
+
+// NotStudio matches Film entities that have no Studio value.
+func (FilmConditions) NotStudio() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(film.studio)"}
+}
+
+// HasDirector matches Film entities that have a value for Director, using
+// has(film.director).
+func (FilmConditions) HasDirector() Filter[Film] {
+	return Filter[Film]{expr: "has(film.director)"}
+}
+
+// NotDirector matches Film entities that have no Director value.
+func (FilmConditions) NotDirector() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(film.director)"}
+}
+
+// HasSequel matches Film entities that have a value for Sequel, using
+// has(sequel).
+func (FilmConditions) HasSequel() Filter[Film] {
+	return Filter[Film]{expr: "has(sequel)"}
+}
+
+// NotSequel matches Film entities that have no Sequel value.
+func (FilmConditions) NotSequel() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(sequel)"}
+}
+
+// HasPrequel matches Film entities that have a value for Prequel, using
+// has(~sequel).
+func (FilmConditions) HasPrequel() Filter[Film] {
+	return Filter[Film]{expr: "has(~sequel)"}
+}
+
+// NotPrequel matches Film entities that have no Prequel value.
+func (FilmConditions) NotPrequel() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(~sequel)"}
+}
+
+// StudioContains matches Film entities whose Studio include any of the
+// Studio nodes with the given uids, using uid_in(film.studio, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) StudioContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Studio: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(film.studio, " + list + ")"}
+}
+
+// DirectorContains matches Film entities whose Director include any of the
+// Director nodes with the given uids, using uid_in(film.director, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) DirectorContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Director: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(film.director, " + list + ")"}
+}
+
+// SequelContains matches Film entities whose Sequel include any of the
+// Film nodes with the given uids, using uid_in(sequel, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) SequelContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Sequel: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(sequel, " + list + ")"}
+}
+
+// PrequelContains matches Film entities whose Prequel include any of the
+// Film nodes with the given uids, using uid_in(~sequel, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) PrequelContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Prequel: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(~sequel, " + list + ")"}
+}
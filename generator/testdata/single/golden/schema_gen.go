@@ -0,0 +1,30 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+// DQLSchema is the Dgraph schema for the single data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+film.director: uid .
+film.studio: uid @reverse @count .
+name: string @index(hash) .
+sequel: uid @reverse .
+
+type Director {
+	name
+}
+
+type Film {
+	name
+	film.studio
+	film.director
+	sequel
+	<~sequel>
+}
+
+type Studio {
+	name
+	<~film.studio>
+}
+`
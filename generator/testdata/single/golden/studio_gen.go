@@ -0,0 +1,270 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// StudioAPI is the set of Studio operations provided by StudioClient. Code
+// that depends on StudioAPI rather than *StudioClient can run against a test double.
+type StudioAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Studio, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Studio) error
+	Create(ctx context.Context, v *Studio) (string, error)
+	Update(ctx context.Context, v *Studio) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Studio, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Studio, error)
+}
+
+// StudioClient provides typed CRUD operations for Studio entities.
+//
+// Studio makes films; its reverse edge lists them.
+type StudioClient struct {
+	conn graphConn
+}
+
+var _ StudioAPI = (*StudioClient)(nil)
+
+// Get retrieves a single Studio by its UID.
+func (c *StudioClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Studio, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Studio
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Studio", studioSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Studio with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *StudioClient) GetExpanded(ctx context.Context, uid string) (*Studio, error) {
+	var result Studio
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Studio", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Studio type. A node of another type does not count.
+func (c *StudioClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Studio")
+}
+
+// Load populates v with the Studio stored under uid, using c.Studio.Get.
+func (v *Studio) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Studio.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+// LoadFilms returns v.Films, first fetching it from c if it is nil, i.e. not loaded
+// yet. The fetched Films, with the scalar predicates of each Film, is kept in
+// v.Films, as an empty, non-nil slice if the edge has no targets, so later calls
+// return it without a query even if the stored edge has since changed. Set
+// v.Films to nil to fetch it again. Get, like encoding/json, leaves an edge
+// with no targets nil, so after Get a nil v.Films may be loaded and empty, and
+// LoadFilms queries again to find out. Like the rest of v, it is not safe for
+// concurrent use.
+func (v *Studio) LoadFilms(ctx context.Context, c *Client) ([]Film, error) {
+	if v.Films != nil {
+		return v.Films, nil
+	}
+	if v.UID == "" {
+		return nil, fmt.Errorf("Studio.LoadFilms: UID is empty")
+	}
+	var got Studio
+	selection := "uid films: ~film.studio { " + filmSelection(0) + " }"
+	if err := getByUID(ctx, c.conn, v.UID, "Studio", selection, &got); err != nil {
+		return nil, err
+	}
+	v.Films = got.Films
+	if v.Films == nil {
+		v.Films = []Film{}
+	}
+	return v.Films, nil
+}
+
+var _ Entity = (*Studio)(nil)
+
+// GetUID returns the Studio's UID, empty until it has been added.
+func (v *Studio) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Studio's UID.
+func (v *Studio) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Studio's dgraph.type values: its DType, or
+// {"Studio"} until Add sets it.
+func (v *Studio) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Studio"}
+}
+
+// String returns a one-line summary of the Studio: its UID and the number of
+// entities on each edge, which are not expanded.
+func (v Studio) String() string {
+	return fmt.Sprintf("Studio(%s films=%d)", v.UID, len(v.Films))
+}
+
+// Add inserts a new Studio into the database.
+func (c *StudioClient) Add(ctx context.Context, v *Studio) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Studio node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *StudioClient) Create(ctx context.Context, v *Studio) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Studio.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Studio"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Studio.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Studio in the database. The UID field must be set.
+func (c *StudioClient) Update(ctx context.Context, v *Studio) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Studio with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *StudioClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Studio.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Studio with the given UID to value, touching
+// no other predicate.
+func (c *StudioClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Studio.SetName: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Studio with the given UID from the database.
+func (c *StudioClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddFilms links the Studio with the given UID to the Film nodes with the
+// given UIDs. As Films is the reverse of film.studio, a single edge, it sets the film.studio
+// of each Film to the Studio, moving it from the Studio it had, if any.
+func (c *StudioClient) AddFilms(ctx context.Context, studioUID string, filmUIDs ...string) error {
+	if err := linkSingle(ctx, c.conn, "film.studio", filmUIDs, studioUID, false); err != nil {
+		return fmt.Errorf("Studio.AddFilms: %w", err)
+	}
+	return nil
+}
+
+// RemoveFilms unlinks the Studio with the given UID from the Film nodes
+// with the given UIDs, deleting the film.studio edge from each Film.
+func (c *StudioClient) RemoveFilms(ctx context.Context, studioUID string, filmUIDs ...string) error {
+	if err := linkSingle(ctx, c.conn, "film.studio", filmUIDs, studioUID, true); err != nil {
+		return fmt.Errorf("Studio.RemoveFilms: %w", err)
+	}
+	return nil
+}
+
+// studioSelection returns the DQL selection for a Studio: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func studioSelection(depth int) string {
+	s := "uid dgraph.type name"
+	if depth > 0 {
+		s += " films: ~film.studio { " + filmSelection(depth-1) + " }"
+	}
+	return s
+}
+
+// List retrieves Studios with optional pagination.
+func (c *StudioClient) List(ctx context.Context, opts ...PageOption) ([]Studio, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var studios []Studio
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&studios)
+	if err != nil {
+		return nil, err
+	}
+	return studios, nil
+}
+
+// Find retrieves Studios matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *StudioClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Studio, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var studios []Studio
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&studios)
+	if err != nil {
+		return nil, err
+	}
+	return studios, nil
+}
+
+// GetByName retrieves the Studio entities whose Name is value, with optional
+// pagination.
+func (c *StudioClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Studio, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Studio
+	err := queryNodes(ctx, c.conn.QueryRaw, "Studio", "eq(name, "+formatString(value)+")", "", studioSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
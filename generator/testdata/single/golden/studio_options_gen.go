@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+// StudioOption is a functional option for configuring Studio mutations.
+type StudioOption func(*Studio)
+
+// WithStudioName sets the Name field on a Studio.
+func WithStudioName(v string) StudioOption {
+	return func(e *Studio) {
+		e.Name = v
+	}
+}
+
+// ApplyStudioOptions applies the given options to a Studio.
+func ApplyStudioOptions(e *Studio, opts ...StudioOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,287 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package single
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// StudioQuery is a typed query builder for Studio entities.
+type StudioQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Studio entities.
+func (c *StudioClient) Query(ctx context.Context) *StudioQuery {
+	return &StudioQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *StudioQuery) Filter(f string) *StudioQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *StudioQuery) where(expr string) *StudioQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from StudioWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *StudioQuery) Where(f Filter[Studio]) *StudioQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Studio entities that have a value for Name, using
+// has(name).
+func (q *StudioQuery) HasName() *StudioQuery {
+	return q.Where(StudioWhere.HasName())
+}
+
+// NotName filters to Studio entities that have no Name value.
+func (q *StudioQuery) NotName() *StudioQuery {
+	return q.Where(StudioWhere.NotName())
+}
+
+// HasFilms filters to Studio entities that have a value for Films, using
+// has(~film.studio).
+func (q *StudioQuery) HasFilms() *StudioQuery {
+	return q.Where(StudioWhere.HasFilms())
+}
+
+// NotFilms filters to Studio entities that have no Films value.
+func (q *StudioQuery) NotFilms() *StudioQuery {
+	return q.Where(StudioWhere.NotFilms())
+}
+
+// FilmsContains filters to Studio entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~film.studio, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *StudioQuery) FilmsContains(uids ...string) *StudioQuery {
+	return q.Where(StudioWhere.FilmsContains(uids...))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *StudioQuery) OrderAsc(field string) *StudioQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *StudioQuery) OrderDesc(field string) *StudioQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *StudioQuery) First(n int) *StudioQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *StudioQuery) Offset(n int) *StudioQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *StudioQuery) withEdge(selection string) *StudioQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithFilms makes GetByUID and Exec also fetch the Films edge, with the
+// scalar predicates of each Film it leads to, in the same query.
+func (q *StudioQuery) WithFilms() *StudioQuery {
+	return q.withEdge("films: ~film.studio { " + filmSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Studio and the edges added by the With methods.
+func (q *StudioQuery) selection() string {
+	s := studioSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *StudioQuery) dql() string {
+	return nodesQuery("Studio", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *StudioQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Studio with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Studio.
+func (q *StudioQuery) GetByUID(uid string) (*Studio, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Studio
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Studio", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *StudioQuery) Exec(dst *[]Studio) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Studio{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *StudioQuery) ExecAndCount(dst *[]Studio) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("StudioQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Studio", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Studio{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// StudioWhere builds the conditions on Studio fields that StudioQuery.Where takes.
+var StudioWhere StudioConditions
+
+// StudioConditions has a method for each typed filter of StudioQuery, returning it as a
+// Filter[Studio] to combine with And, Or, and Not.
+type StudioConditions struct{}
+
+// HasName matches Studio entities that have a value for Name, using
+// has(name).
+func (StudioConditions) HasName() Filter[Studio] {
+	return Filter[Studio]{expr: "has(name)"}
+}
+
+// NotName matches Studio entities that have no Name value.
+func (StudioConditions) NotName() Filter[Studio] {
+	return Filter[Studio]{expr: "NOT has(name)"}
+}
+
+// HasFilms matches Studio entities that have a value for Films, using
+// has(~film.studio).
+func (StudioConditions) HasFilms() Filter[Studio] {
+	return Filter[Studio]{expr: "has(~film.studio)"}
+}
+
+// NotFilms matches Studio entities that have no Films value.
+func (StudioConditions) NotFilms() Filter[Studio] {
+	return Filter[Studio]{expr: "NOT has(~film.studio)"}
+}
+
+// FilmsContains matches Studio entities whose Films include any of the
+// Film nodes with the given uids, using uid_in(~film.studio, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (StudioConditions) FilmsContains(uids ...string) Filter[Studio] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Studio]{err: fmt.Errorf("Studio.Films: %w", err)}
+	}
+	return Filter[Studio]{expr: "uid_in(~film.studio, " + list + ")"}
+}
@@ -0,0 +1,12 @@
+package terms
+
+// Article has term- and trigram-indexed predicates named differently from
+// their fields, and a fulltext-only field that gets neither term filters nor
+// a regexp method.
+type Article struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Title string   `json:"title,omitempty" dgraph:"predicate=article_title index=exact,term,trigram"`
+	Tags  []string `json:"tags,omitempty" dgraph:"predicate=article_tag index=term"`
+	Body  string   `json:"body,omitempty" dgraph:"predicate=article_body index=fulltext"`
+}
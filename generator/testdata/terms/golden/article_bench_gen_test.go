@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package terms
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkArticleToMap measures converting an Article to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkArticleToMap(b *testing.B) {
+	v := Article{
+		UID:   "0x1",
+		Title: "Title",
+		Body:  "Body",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkArticleQueryBuild measures building an Article query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkArticleQueryBuild(b *testing.B) {
+	c := &ArticleClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
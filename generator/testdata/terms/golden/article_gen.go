@@ -0,0 +1,333 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package terms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ArticleAPI is the set of Article operations provided by ArticleClient. Code
+// that depends on ArticleAPI rather than *ArticleClient can run against a test double.
+type ArticleAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Article, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Article) error
+	Create(ctx context.Context, v *Article) (string, error)
+	Update(ctx context.Context, v *Article) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Article, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Article, error)
+}
+
+// ArticleClient provides typed CRUD operations for Article entities.
+//
+// Article has term- and trigram-indexed predicates named differently from
+// their fields, and a fulltext-only field that gets neither term filters nor
+// a regexp method.
+type ArticleClient struct {
+	conn graphConn
+}
+
+var _ ArticleAPI = (*ArticleClient)(nil)
+
+// Get retrieves a single Article by its UID.
+func (c *ArticleClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Article, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Article
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Article", articleSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Article with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *ArticleClient) GetExpanded(ctx context.Context, uid string) (*Article, error) {
+	var result Article
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Article", map[string]string{
+		"article_title": "title",
+		"article_tag":   "tags",
+		"article_body":  "body",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Article type. A node of another type does not count.
+func (c *ArticleClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Article")
+}
+
+// Load populates v with the Article stored under uid, using c.Article.Get.
+func (v *Article) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Article.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Article)(nil)
+
+// GetUID returns the Article's UID, empty until it has been added.
+func (v *Article) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Article's UID.
+func (v *Article) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Article's dgraph.type values: its DType, or
+// {"Article"} until Add sets it.
+func (v *Article) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Article"}
+}
+
+// String returns a one-line summary of the Article: its UID and Body.
+func (v Article) String() string {
+	return fmt.Sprintf("Article(%s %q)", v.UID, v.Body)
+}
+
+// Add inserts a new Article into the database.
+func (c *ArticleClient) Add(ctx context.Context, v *Article) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Article node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *ArticleClient) Create(ctx context.Context, v *Article) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Article.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Article"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Article.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Article in the database. The UID field must be set.
+func (c *ArticleClient) Update(ctx context.Context, v *Article) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Article with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *ArticleClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Article.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetTitle sets the Title of the Article with the given UID to value, touching
+// no other predicate.
+func (c *ArticleClient) SetTitle(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"article_title": value}); err != nil {
+		return fmt.Errorf("Article.SetTitle: %w", err)
+	}
+	return nil
+}
+
+// SetTags replaces the Tags list of the Article with the given UID by values,
+// touching no other predicate. Use AddTags to append to it instead.
+func (c *ArticleClient) SetTags(ctx context.Context, uid string, values []string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"article_tag": values}, "article_tag"); err != nil {
+		return fmt.Errorf("Article.SetTags: %w", err)
+	}
+	return nil
+}
+
+// SetBody sets the Body of the Article with the given UID to value, touching
+// no other predicate.
+func (c *ArticleClient) SetBody(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"article_body": value}); err != nil {
+		return fmt.Errorf("Article.SetBody: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Article with the given UID from the database.
+func (c *ArticleClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddTags appends values to the Tags list of the Article with the given UID.
+func (c *ArticleClient) AddTags(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, map[string]any{"uid": uid, "article_tag": values}, nil)
+	return err
+}
+
+// RemoveTags removes values from the Tags list of the Article with the given UID.
+func (c *ArticleClient) RemoveTags(ctx context.Context, uid string, values ...string) error {
+	_, err := mutate(ctx, c.conn, nil, map[string]any{"uid": uid, "article_tag": values})
+	return err
+}
+
+// Search finds Article entities whose Body matches term using fulltext search.
+func (c *ArticleClient) Search(ctx context.Context, term string, opts ...PageOption) ([]Article, error) {
+	if _, bare := c.conn.(*dgoConn); bare {
+		return c.searchText(ctx, "alloftext", "article_body", term, opts)
+	}
+	var results []Article
+	q := c.conn.Query(ctx, Article{}).
+		Filter(`alloftext(article_body, "` + term + `")`).
+		First(defaultPageSize)
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first > 0 {
+		q = q.First(cfg.first)
+	}
+	if cfg.offset > 0 {
+		q = q.Offset(cfg.offset)
+	}
+	err := retryRead(ctx, c.conn, func() error { return q.Nodes(&results) })
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SearchAllOfText finds Article entities whose Body contains all of the words in
+// terms, using Dgraph's alloftext fulltext function.
+func (c *ArticleClient) SearchAllOfText(ctx context.Context, terms string, opts ...PageOption) ([]Article, error) {
+	return c.searchText(ctx, "alloftext", "article_body", terms, opts)
+}
+
+// SearchAnyOfText finds Article entities whose Body contains any of the words in
+// terms, using Dgraph's anyoftext fulltext function.
+func (c *ArticleClient) SearchAnyOfText(ctx context.Context, terms string, opts ...PageOption) ([]Article, error) {
+	return c.searchText(ctx, "anyoftext", "article_body", terms, opts)
+}
+
+// SearchBody finds Article entities whose Body contains all of the words in terms,
+// using Dgraph's alloftext fulltext function.
+func (c *ArticleClient) SearchBody(ctx context.Context, terms string, opts ...PageOption) ([]Article, error) {
+	return c.searchText(ctx, "alloftext", "article_body", terms, opts)
+}
+
+// searchText runs the fulltext function fn over predicate as the query root.
+func (c *ArticleClient) searchText(ctx context.Context, fn, predicate, terms string, opts []PageOption) ([]Article, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Article
+	err := searchNodes(ctx, c.conn.QueryRaw, fn, predicate, terms, "Article", articleSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// articleSelection returns the DQL selection for an Article: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func articleSelection(depth int) string {
+	s := "uid dgraph.type title: article_title tags: article_tag body: article_body"
+	return s
+}
+
+// List retrieves Articles with optional pagination.
+func (c *ArticleClient) List(ctx context.Context, opts ...PageOption) ([]Article, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var articles []Article
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&articles)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// Find retrieves Articles matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *ArticleClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Article, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var articles []Article
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&articles)
+	if err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+// GetByTitle retrieves the Article entities whose Title is value, with optional
+// pagination.
+func (c *ArticleClient) GetByTitle(ctx context.Context, value string, opts ...PageOption) ([]Article, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Article
+	err := queryNodes(ctx, c.conn.QueryRaw, "Article", "eq(article_title, "+formatString(value)+")", "", articleSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// TitleRegexp retrieves Article entities whose Title matches the regular expression
+// pattern, with optional pagination. An invalid pattern is an error.
+func (c *ArticleClient) TitleRegexp(ctx context.Context, pattern string, opts ...PageOption) ([]Article, error) {
+	re, err := formatRegexp(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("Article.Title: %w", err)
+	}
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Article
+	err = queryNodes(ctx, c.conn.QueryRaw, "Article", "regexp(article_title, "+re+")", "", articleSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package terms
+
+// ArticleOption is a functional option for configuring Article mutations.
+type ArticleOption func(*Article)
+
+// WithArticleTitle sets the Title field on an Article.
+func WithArticleTitle(v string) ArticleOption {
+	return func(e *Article) {
+		e.Title = v
+	}
+}
+
+// WithArticleTags sets the Tags field on an Article.
+func WithArticleTags(v []string) ArticleOption {
+	return func(e *Article) {
+		e.Tags = v
+	}
+}
+
+// WithArticleBody sets the Body field on an Article.
+func WithArticleBody(v string) ArticleOption {
+	return func(e *Article) {
+		e.Body = v
+	}
+}
+
+// ApplyArticleOptions applies the given options to an Article.
+func ApplyArticleOptions(e *Article, opts ...ArticleOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,339 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package terms
+
+import (
+	"context"
+)
+
+// ArticleQuery is a typed query builder for Article entities.
+type ArticleQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Article entities.
+func (c *ArticleClient) Query(ctx context.Context) *ArticleQuery {
+	return &ArticleQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *ArticleQuery) Filter(f string) *ArticleQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *ArticleQuery) where(expr string) *ArticleQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from ArticleWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *ArticleQuery) Where(f Filter[Article]) *ArticleQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasTitle filters to Article entities that have a value for Title, using
+// has(article_title).
+func (q *ArticleQuery) HasTitle() *ArticleQuery {
+	return q.Where(ArticleWhere.HasTitle())
+}
+
+// NotTitle filters to Article entities that have no Title value.
+func (q *ArticleQuery) NotTitle() *ArticleQuery {
+	return q.Where(ArticleWhere.NotTitle())
+}
+
+// HasTags filters to Article entities that have a value for Tags, using
+// has(article_tag).
+func (q *ArticleQuery) HasTags() *ArticleQuery {
+	return q.Where(ArticleWhere.HasTags())
+}
+
+// NotTags filters to Article entities that have no Tags value.
+func (q *ArticleQuery) NotTags() *ArticleQuery {
+	return q.Where(ArticleWhere.NotTags())
+}
+
+// HasBody filters to Article entities that have a value for Body, using
+// has(article_body).
+func (q *ArticleQuery) HasBody() *ArticleQuery {
+	return q.Where(ArticleWhere.HasBody())
+}
+
+// NotBody filters to Article entities that have no Body value.
+func (q *ArticleQuery) NotBody() *ArticleQuery {
+	return q.Where(ArticleWhere.NotBody())
+}
+
+// TitleAllOfTerms filters to Article entities whose Title contains all of the terms.
+func (q *ArticleQuery) TitleAllOfTerms(terms string) *ArticleQuery {
+	return q.Where(ArticleWhere.TitleAllOfTerms(terms))
+}
+
+// TitleAnyOfTerms filters to Article entities whose Title contains any of the terms.
+func (q *ArticleQuery) TitleAnyOfTerms(terms string) *ArticleQuery {
+	return q.Where(ArticleWhere.TitleAnyOfTerms(terms))
+}
+
+// TagsAllOfTerms filters to Article entities whose Tags contains all of the terms.
+func (q *ArticleQuery) TagsAllOfTerms(terms string) *ArticleQuery {
+	return q.Where(ArticleWhere.TagsAllOfTerms(terms))
+}
+
+// TagsAnyOfTerms filters to Article entities whose Tags contains any of the terms.
+func (q *ArticleQuery) TagsAnyOfTerms(terms string) *ArticleQuery {
+	return q.Where(ArticleWhere.TagsAnyOfTerms(terms))
+}
+
+// TitleGe filters to Article entities whose Title sorts at or after value.
+func (q *ArticleQuery) TitleGe(value string) *ArticleQuery {
+	return q.Where(ArticleWhere.TitleGe(value))
+}
+
+// TitleLe filters to Article entities whose Title sorts at or before value.
+func (q *ArticleQuery) TitleLe(value string) *ArticleQuery {
+	return q.Where(ArticleWhere.TitleLe(value))
+}
+
+// TitleBetween filters to Article entities whose Title sorts from from through to,
+// inclusive.
+func (q *ArticleQuery) TitleBetween(from, to string) *ArticleQuery {
+	return q.Where(ArticleWhere.TitleBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *ArticleQuery) OrderAsc(field string) *ArticleQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *ArticleQuery) OrderDesc(field string) *ArticleQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *ArticleQuery) First(n int) *ArticleQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *ArticleQuery) Offset(n int) *ArticleQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Article and the edges added by the With methods.
+func (q *ArticleQuery) selection() string {
+	s := articleSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *ArticleQuery) dql() string {
+	return nodesQuery("Article", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *ArticleQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Article with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Article.
+func (q *ArticleQuery) GetByUID(uid string) (*Article, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Article
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Article", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *ArticleQuery) Exec(dst *[]Article) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Article{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *ArticleQuery) ExecAndCount(dst *[]Article) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Article", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Article{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// ArticleWhere builds the conditions on Article fields that ArticleQuery.Where takes.
+var ArticleWhere ArticleConditions
+
+// ArticleConditions has a method for each typed filter of ArticleQuery, returning it as a
+// Filter[Article] to combine with And, Or, and Not.
+type ArticleConditions struct{}
+
+// HasTitle matches Article entities that have a value for Title, using
+// has(article_title).
+func (ArticleConditions) HasTitle() Filter[Article] {
+	return Filter[Article]{expr: "has(article_title)"}
+}
+
+// NotTitle matches Article entities that have no Title value.
+func (ArticleConditions) NotTitle() Filter[Article] {
+	return Filter[Article]{expr: "NOT has(article_title)"}
+}
+
+// HasTags matches Article entities that have a value for Tags, using
+// has(article_tag).
+func (ArticleConditions) HasTags() Filter[Article] {
+	return Filter[Article]{expr: "has(article_tag)"}
+}
+
+// NotTags matches Article entities that have no Tags value.
+func (ArticleConditions) NotTags() Filter[Article] {
+	return Filter[Article]{expr: "NOT has(article_tag)"}
+}
+
+// HasBody matches Article entities that have a value for Body, using
+// has(article_body).
+func (ArticleConditions) HasBody() Filter[Article] {
+	return Filter[Article]{expr: "has(article_body)"}
+}
+
+// NotBody matches Article entities that have no Body value.
+func (ArticleConditions) NotBody() Filter[Article] {
+	return Filter[Article]{expr: "NOT has(article_body)"}
+}
+
+// TitleAllOfTerms matches Article entities whose Title contains all of the terms.
+func (ArticleConditions) TitleAllOfTerms(terms string) Filter[Article] {
+	return Filter[Article]{expr: "allofterms(article_title, " + formatString(terms) + ")"}
+}
+
+// TitleAnyOfTerms matches Article entities whose Title contains any of the terms.
+func (ArticleConditions) TitleAnyOfTerms(terms string) Filter[Article] {
+	return Filter[Article]{expr: "anyofterms(article_title, " + formatString(terms) + ")"}
+}
+
+// TagsAllOfTerms matches Article entities whose Tags contains all of the terms.
+func (ArticleConditions) TagsAllOfTerms(terms string) Filter[Article] {
+	return Filter[Article]{expr: "allofterms(article_tag, " + formatString(terms) + ")"}
+}
+
+// TagsAnyOfTerms matches Article entities whose Tags contains any of the terms.
+func (ArticleConditions) TagsAnyOfTerms(terms string) Filter[Article] {
+	return Filter[Article]{expr: "anyofterms(article_tag, " + formatString(terms) + ")"}
+}
+
+// TitleGe matches Article entities whose Title sorts at or after value.
+func (ArticleConditions) TitleGe(value string) Filter[Article] {
+	return Filter[Article]{expr: "ge(article_title, " + formatString(value) + ")"}
+}
+
+// TitleLe matches Article entities whose Title sorts at or before value.
+func (ArticleConditions) TitleLe(value string) Filter[Article] {
+	return Filter[Article]{expr: "le(article_title, " + formatString(value) + ")"}
+}
+
+// TitleBetween matches Article entities whose Title sorts from from through to,
+// inclusive.
+func (ArticleConditions) TitleBetween(from, to string) Filter[Article] {
+	return Filter[Article]{expr: "between(article_title, " + formatString(from) + ", " + formatString(to) + ")"}
+}
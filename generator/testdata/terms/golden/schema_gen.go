@@ -0,0 +1,18 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package terms
+
+// DQLSchema is the Dgraph schema for the terms data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+article_body: string @index(fulltext) .
+article_tag: [string] @index(term) .
+article_title: string @index(exact, term, trigram) .
+
+type Article {
+	article_title
+	article_tag
+	article_body
+}
+`
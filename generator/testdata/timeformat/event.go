@@ -0,0 +1,13 @@
+package timeformat
+
+import "time"
+
+// Event stores its datetimes in legacy layouts rather than RFC 3339.
+type Event struct {
+	UID    string     `json:"uid,omitempty"`
+	DType  []string   `json:"dgraph.type,omitempty"`
+	Name   string     `json:"name,omitempty" dgraph:"index=exact"`
+	Day    time.Time  `json:"day,omitempty" dgraph:"index=day format=2006-01-02"`
+	Starts *time.Time `json:"starts" dgraph:"format=2006-01-02T15:04"`
+	Ends   time.Time  `json:"ends"`
+}
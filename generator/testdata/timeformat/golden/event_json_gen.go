@@ -0,0 +1,51 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timeformat
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes an Event in the form Dgraph expects:
+//   - Datetimes with a format= layout are strings in that layout.
+func (v Event) MarshalJSON() ([]byte, error) {
+	type plain Event
+	out := struct {
+		plain
+		Day    *string `json:"day,omitempty"`
+		Starts *string `json:"starts"`
+	}{plain: plain(v)}
+	out.Day = formatDatetime(v.Day, "2006-01-02", true)
+	if v.Starts != nil {
+		out.Starts = formatDatetime(*v.Starts, "2006-01-02T15:04", false)
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes an Event from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - Datetimes with a format= layout may also be in that layout.
+func (v *Event) UnmarshalJSON(data []byte) error {
+	type plain Event
+	in := struct {
+		*plain
+		Day    json.RawMessage `json:"day"`
+		Starts json.RawMessage `json:"starts"`
+		Ends   json.RawMessage `json:"ends"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.Day, &v.Day, "2006-01-02"); err != nil {
+		return fmt.Errorf("Event.Day: %w", err)
+	}
+	if err := decodeDatetimePtr(in.Starts, &v.Starts, "2006-01-02T15:04"); err != nil {
+		return fmt.Errorf("Event.Starts: %w", err)
+	}
+	if err := decodeDatetime(in.Ends, &v.Ends); err != nil {
+		return fmt.Errorf("Event.Ends: %w", err)
+	}
+	return nil
+}
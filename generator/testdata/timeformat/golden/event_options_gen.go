@@ -0,0 +1,43 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timeformat
+
+import "time"
+
+// EventOption is a functional option for configuring Event mutations.
+type EventOption func(*Event)
+
+// WithEventName sets the Name field on an Event.
+func WithEventName(v string) EventOption {
+	return func(e *Event) {
+		e.Name = v
+	}
+}
+
+// WithEventDay sets the Day field on an Event.
+func WithEventDay(v time.Time) EventOption {
+	return func(e *Event) {
+		e.Day = v
+	}
+}
+
+// WithEventStarts sets the Starts field on an Event.
+func WithEventStarts(v *time.Time) EventOption {
+	return func(e *Event) {
+		e.Starts = v
+	}
+}
+
+// WithEventEnds sets the Ends field on an Event.
+func WithEventEnds(v time.Time) EventOption {
+	return func(e *Event) {
+		e.Ends = v
+	}
+}
+
+// ApplyEventOptions applies the given options to an Event.
+func ApplyEventOptions(e *Event, opts ...EventOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timeformat
+
+import (
+	"context"
+	"time"
+)
+
+// EventQuery is a typed query builder for Event entities.
+type EventQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Event entities.
+func (c *EventClient) Query(ctx context.Context) *EventQuery {
+	return &EventQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *EventQuery) Filter(f string) *EventQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *EventQuery) where(expr string) *EventQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from EventWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *EventQuery) Where(f Filter[Event]) *EventQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Event entities that have a value for Name, using
+// has(name).
+func (q *EventQuery) HasName() *EventQuery {
+	return q.Where(EventWhere.HasName())
+}
+
+// NotName filters to Event entities that have no Name value.
+func (q *EventQuery) NotName() *EventQuery {
+	return q.Where(EventWhere.NotName())
+}
+
+// HasDay filters to Event entities that have a value for Day, using
+// has(day).
+func (q *EventQuery) HasDay() *EventQuery {
+	return q.Where(EventWhere.HasDay())
+}
+
+// NotDay filters to Event entities that have no Day value.
+func (q *EventQuery) NotDay() *EventQuery {
+	return q.Where(EventWhere.NotDay())
+}
+
+// HasStarts filters to Event entities that have a value for Starts, using
+// has(starts).
+func (q *EventQuery) HasStarts() *EventQuery {
+	return q.Where(EventWhere.HasStarts())
+}
+
+// NotStarts filters to Event entities that have no Starts value.
+func (q *EventQuery) NotStarts() *EventQuery {
+	return q.Where(EventWhere.NotStarts())
+}
+
+// HasEnds filters to Event entities that have a value for Ends, using
+// has(ends).
+func (q *EventQuery) HasEnds() *EventQuery {
+	return q.Where(EventWhere.HasEnds())
+}
+
+// NotEnds filters to Event entities that have no Ends value.
+func (q *EventQuery) NotEnds() *EventQuery {
+	return q.Where(EventWhere.NotEnds())
+}
+
+// NameGe filters to Event entities whose Name sorts at or after value.
+func (q *EventQuery) NameGe(value string) *EventQuery {
+	return q.Where(EventWhere.NameGe(value))
+}
+
+// NameLe filters to Event entities whose Name sorts at or before value.
+func (q *EventQuery) NameLe(value string) *EventQuery {
+	return q.Where(EventWhere.NameLe(value))
+}
+
+// NameBetween filters to Event entities whose Name sorts from from through to,
+// inclusive.
+func (q *EventQuery) NameBetween(from, to string) *EventQuery {
+	return q.Where(EventWhere.NameBetween(from, to))
+}
+
+// DayYearEquals filters to Event entities whose Day falls in year.
+func (q *EventQuery) DayYearEquals(year int) *EventQuery {
+	return q.Where(EventWhere.DayYearEquals(year))
+}
+
+// DayYearBetween filters to Event entities whose Day falls in the
+// years from through to, inclusive.
+func (q *EventQuery) DayYearBetween(from, to int) *EventQuery {
+	return q.Where(EventWhere.DayYearBetween(from, to))
+}
+
+// DayDateBetween filters to Event entities whose Day lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *EventQuery) DayDateBetween(from, to time.Time) *EventQuery {
+	return q.Where(EventWhere.DayDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *EventQuery) OrderAsc(field string) *EventQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *EventQuery) OrderDesc(field string) *EventQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *EventQuery) First(n int) *EventQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *EventQuery) Offset(n int) *EventQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Event and the edges added by the With methods.
+func (q *EventQuery) selection() string {
+	s := eventSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *EventQuery) dql() string {
+	return nodesQuery("Event", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *EventQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Event with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Event.
+func (q *EventQuery) GetByUID(uid string) (*Event, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Event
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Event", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *EventQuery) Exec(dst *[]Event) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Event{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *EventQuery) ExecAndCount(dst *[]Event) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Event", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Event{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// EventWhere builds the conditions on Event fields that EventQuery.Where takes.
+var EventWhere EventConditions
+
+// EventConditions has a method for each typed filter of EventQuery, returning it as a
+// Filter[Event] to combine with And, Or, and Not.
+type EventConditions struct{}
+
+// HasName matches Event entities that have a value for Name, using
+// has(name).
+func (EventConditions) HasName() Filter[Event] {
+	return Filter[Event]{expr: "has(name)"}
+}
+
+// NotName matches Event entities that have no Name value.
+func (EventConditions) NotName() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(name)"}
+}
+
+// HasDay matches Event entities that have a value for Day, using
+// has(day).
+func (EventConditions) HasDay() Filter[Event] {
+	return Filter[Event]{expr: "has(day)"}
+}
+
+// NotDay matches Event entities that have no Day value.
+func (EventConditions) NotDay() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(day)"}
+}
+
+// HasStarts matches Event entities that have a value for Starts, using
+// has(starts).
+func (EventConditions) HasStarts() Filter[Event] {
+	return Filter[Event]{expr: "has(starts)"}
+}
+
+// NotStarts matches Event entities that have no Starts value.
+func (EventConditions) NotStarts() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(starts)"}
+}
+
+// HasEnds matches Event entities that have a value for Ends, using
+// has(ends).
+func (EventConditions) HasEnds() Filter[Event] {
+	return Filter[Event]{expr: "has(ends)"}
+}
+
+// NotEnds matches Event entities that have no Ends value.
+func (EventConditions) NotEnds() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(ends)"}
+}
+
+// NameGe matches Event entities whose Name sorts at or after value.
+func (EventConditions) NameGe(value string) Filter[Event] {
+	return Filter[Event]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Event entities whose Name sorts at or before value.
+func (EventConditions) NameLe(value string) Filter[Event] {
+	return Filter[Event]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Event entities whose Name sorts from from through to,
+// inclusive.
+func (EventConditions) NameBetween(from, to string) Filter[Event] {
+	return Filter[Event]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// DayYearEquals matches Event entities whose Day falls in year.
+func (c EventConditions) DayYearEquals(year int) Filter[Event] {
+	return c.DayYearBetween(year, year)
+}
+
+// DayYearBetween matches Event entities whose Day falls in the
+// years from through to, inclusive.
+func (c EventConditions) DayYearBetween(from, to int) Filter[Event] {
+	return c.DayDateBetween(yearStart(from), yearEnd(to))
+}
+
+// DayDateBetween matches Event entities whose Day lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (EventConditions) DayDateBetween(from, to time.Time) Filter[Event] {
+	return Filter[Event]{expr: "between(day, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
@@ -0,0 +1,20 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timeformat
+
+// DQLSchema is the Dgraph schema for the timeformat data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+day: datetime @index(day) .
+ends: datetime .
+name: string @index(exact) .
+starts: datetime .
+
+type Event {
+	name
+	day
+	starts
+	ends
+}
+`
@@ -0,0 +1,13 @@
+package timelists
+
+import "time"
+
+// Event holds times only in a list and behind a pointer, so that its options
+// still import time although no field is a time.Time.
+type Event struct {
+	UID   string      `json:"uid,omitempty"`
+	DType []string    `json:"dgraph.type,omitempty"`
+	Name  string      `json:"name,omitempty" dgraph:"index=exact"`
+	Dates []time.Time `json:"dates,omitempty"`
+	Ended *time.Time  `json:"ended,omitempty" dgraph:"index=day"`
+}
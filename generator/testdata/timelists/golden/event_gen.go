@@ -0,0 +1,252 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timelists
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// EventAPI is the set of Event operations provided by EventClient. Code
+// that depends on EventAPI rather than *EventClient can run against a test double.
+type EventAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Event, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Event) error
+	Create(ctx context.Context, v *Event) (string, error)
+	Update(ctx context.Context, v *Event) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Event, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Event, error)
+}
+
+// EventClient provides typed CRUD operations for Event entities.
+//
+// Event holds times only in a list and behind a pointer, so that its options
+// still import time although no field is a time.Time.
+type EventClient struct {
+	conn graphConn
+}
+
+var _ EventAPI = (*EventClient)(nil)
+
+// Get retrieves a single Event by its UID.
+func (c *EventClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Event, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Event
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Event", eventSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Event with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *EventClient) GetExpanded(ctx context.Context, uid string) (*Event, error) {
+	var result Event
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Event", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Event type. A node of another type does not count.
+func (c *EventClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Event")
+}
+
+// Load populates v with the Event stored under uid, using c.Event.Get.
+func (v *Event) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Event.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Event)(nil)
+
+// GetUID returns the Event's UID, empty until it has been added.
+func (v *Event) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Event's UID.
+func (v *Event) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Event's dgraph.type values: its DType, or
+// {"Event"} until Add sets it.
+func (v *Event) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Event"}
+}
+
+// String returns a one-line summary of the Event: its UID.
+func (v Event) String() string {
+	return fmt.Sprintf("Event(%s)", v.UID)
+}
+
+// Add inserts a new Event into the database.
+func (c *EventClient) Add(ctx context.Context, v *Event) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Event node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *EventClient) Create(ctx context.Context, v *Event) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Event.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Event"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Event.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Event in the database. The UID field must be set.
+func (c *EventClient) Update(ctx context.Context, v *Event) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Event with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *EventClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Event.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetName sets the Name of the Event with the given UID to value, touching
+// no other predicate.
+func (c *EventClient) SetName(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"name": value}); err != nil {
+		return fmt.Errorf("Event.SetName: %w", err)
+	}
+	return nil
+}
+
+// SetDates replaces the Dates list of the Event with the given UID by values,
+// touching no other predicate. Use AddDates to append to it instead.
+func (c *EventClient) SetDates(ctx context.Context, uid string, values []time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"dates": values}, "dates"); err != nil {
+		return fmt.Errorf("Event.SetDates: %w", err)
+	}
+	return nil
+}
+
+// SetEnded sets the Ended of the Event with the given UID to value, touching
+// no other predicate.
+func (c *EventClient) SetEnded(ctx context.Context, uid string, value *time.Time) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"ended": value}); err != nil {
+		return fmt.Errorf("Event.SetEnded: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Event with the given UID from the database.
+func (c *EventClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// AddDates appends values to the Dates list of the Event with the given UID.
+func (c *EventClient) AddDates(ctx context.Context, uid string, values ...time.Time) error {
+	_, err := mutate(ctx, c.conn, map[string]any{"uid": uid, "dates": values}, nil)
+	return err
+}
+
+// RemoveDates removes values from the Dates list of the Event with the given UID.
+func (c *EventClient) RemoveDates(ctx context.Context, uid string, values ...time.Time) error {
+	_, err := mutate(ctx, c.conn, nil, map[string]any{"uid": uid, "dates": values})
+	return err
+}
+
+// eventSelection returns the DQL selection for an Event: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func eventSelection(depth int) string {
+	s := "uid dgraph.type name dates ended"
+	return s
+}
+
+// List retrieves Events with optional pagination.
+func (c *EventClient) List(ctx context.Context, opts ...PageOption) ([]Event, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var events []Event
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&events)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Find retrieves Events matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *EventClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Event, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var events []Event
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&events)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// GetByName retrieves the Event entities whose Name is value, with optional
+// pagination.
+func (c *EventClient) GetByName(ctx context.Context, value string, opts ...PageOption) ([]Event, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Event
+	err := queryNodes(ctx, c.conn.QueryRaw, "Event", "eq(name, "+formatString(value)+")", "", eventSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
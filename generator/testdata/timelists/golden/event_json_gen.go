@@ -0,0 +1,26 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timelists
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UnmarshalJSON decodes an Event from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+func (v *Event) UnmarshalJSON(data []byte) error {
+	type plain Event
+	in := struct {
+		*plain
+		Ended json.RawMessage `json:"ended"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetimePtr(in.Ended, &v.Ended); err != nil {
+		return fmt.Errorf("Event.Ended: %w", err)
+	}
+	return nil
+}
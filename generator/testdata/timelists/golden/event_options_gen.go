@@ -0,0 +1,36 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timelists
+
+import "time"
+
+// EventOption is a functional option for configuring Event mutations.
+type EventOption func(*Event)
+
+// WithEventName sets the Name field on an Event.
+func WithEventName(v string) EventOption {
+	return func(e *Event) {
+		e.Name = v
+	}
+}
+
+// WithEventDates sets the Dates field on an Event.
+func WithEventDates(v []time.Time) EventOption {
+	return func(e *Event) {
+		e.Dates = v
+	}
+}
+
+// WithEventEnded sets the Ended field on an Event.
+func WithEventEnded(v *time.Time) EventOption {
+	return func(e *Event) {
+		e.Ended = v
+	}
+}
+
+// ApplyEventOptions applies the given options to an Event.
+func ApplyEventOptions(e *Event, opts ...EventOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
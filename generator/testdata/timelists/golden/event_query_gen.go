@@ -0,0 +1,336 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timelists
+
+import (
+	"context"
+	"time"
+)
+
+// EventQuery is a typed query builder for Event entities.
+type EventQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Event entities.
+func (c *EventClient) Query(ctx context.Context) *EventQuery {
+	return &EventQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *EventQuery) Filter(f string) *EventQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *EventQuery) where(expr string) *EventQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from EventWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *EventQuery) Where(f Filter[Event]) *EventQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasName filters to Event entities that have a value for Name, using
+// has(name).
+func (q *EventQuery) HasName() *EventQuery {
+	return q.Where(EventWhere.HasName())
+}
+
+// NotName filters to Event entities that have no Name value.
+func (q *EventQuery) NotName() *EventQuery {
+	return q.Where(EventWhere.NotName())
+}
+
+// HasDates filters to Event entities that have a value for Dates, using
+// has(dates).
+func (q *EventQuery) HasDates() *EventQuery {
+	return q.Where(EventWhere.HasDates())
+}
+
+// NotDates filters to Event entities that have no Dates value.
+func (q *EventQuery) NotDates() *EventQuery {
+	return q.Where(EventWhere.NotDates())
+}
+
+// HasEnded filters to Event entities that have a value for Ended, using
+// has(ended).
+func (q *EventQuery) HasEnded() *EventQuery {
+	return q.Where(EventWhere.HasEnded())
+}
+
+// NotEnded filters to Event entities that have no Ended value.
+func (q *EventQuery) NotEnded() *EventQuery {
+	return q.Where(EventWhere.NotEnded())
+}
+
+// NameGe filters to Event entities whose Name sorts at or after value.
+func (q *EventQuery) NameGe(value string) *EventQuery {
+	return q.Where(EventWhere.NameGe(value))
+}
+
+// NameLe filters to Event entities whose Name sorts at or before value.
+func (q *EventQuery) NameLe(value string) *EventQuery {
+	return q.Where(EventWhere.NameLe(value))
+}
+
+// NameBetween filters to Event entities whose Name sorts from from through to,
+// inclusive.
+func (q *EventQuery) NameBetween(from, to string) *EventQuery {
+	return q.Where(EventWhere.NameBetween(from, to))
+}
+
+// EndedYearEquals filters to Event entities whose Ended falls in year.
+func (q *EventQuery) EndedYearEquals(year int) *EventQuery {
+	return q.Where(EventWhere.EndedYearEquals(year))
+}
+
+// EndedYearBetween filters to Event entities whose Ended falls in the
+// years from through to, inclusive.
+func (q *EventQuery) EndedYearBetween(from, to int) *EventQuery {
+	return q.Where(EventWhere.EndedYearBetween(from, to))
+}
+
+// EndedDateBetween filters to Event entities whose Ended lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *EventQuery) EndedDateBetween(from, to time.Time) *EventQuery {
+	return q.Where(EventWhere.EndedDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *EventQuery) OrderAsc(field string) *EventQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *EventQuery) OrderDesc(field string) *EventQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *EventQuery) First(n int) *EventQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *EventQuery) Offset(n int) *EventQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of an Event and the edges added by the With methods.
+func (q *EventQuery) selection() string {
+	s := eventSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *EventQuery) dql() string {
+	return nodesQuery("Event", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *EventQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Event with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Event.
+func (q *EventQuery) GetByUID(uid string) (*Event, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Event
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Event", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *EventQuery) Exec(dst *[]Event) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Event{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *EventQuery) ExecAndCount(dst *[]Event) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Event", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Event{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// EventWhere builds the conditions on Event fields that EventQuery.Where takes.
+var EventWhere EventConditions
+
+// EventConditions has a method for each typed filter of EventQuery, returning it as a
+// Filter[Event] to combine with And, Or, and Not.
+type EventConditions struct{}
+
+// HasName matches Event entities that have a value for Name, using
+// has(name).
+func (EventConditions) HasName() Filter[Event] {
+	return Filter[Event]{expr: "has(name)"}
+}
+
+// NotName matches Event entities that have no Name value.
+func (EventConditions) NotName() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(name)"}
+}
+
+// HasDates matches Event entities that have a value for Dates, using
+// has(dates).
+func (EventConditions) HasDates() Filter[Event] {
+	return Filter[Event]{expr: "has(dates)"}
+}
+
+// NotDates matches Event entities that have no Dates value.
+func (EventConditions) NotDates() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(dates)"}
+}
+
+// HasEnded matches Event entities that have a value for Ended, using
+// has(ended).
+func (EventConditions) HasEnded() Filter[Event] {
+	return Filter[Event]{expr: "has(ended)"}
+}
+
+// NotEnded matches Event entities that have no Ended value.
+func (EventConditions) NotEnded() Filter[Event] {
+	return Filter[Event]{expr: "NOT has(ended)"}
+}
+
+// NameGe matches Event entities whose Name sorts at or after value.
+func (EventConditions) NameGe(value string) Filter[Event] {
+	return Filter[Event]{expr: "ge(name, " + formatString(value) + ")"}
+}
+
+// NameLe matches Event entities whose Name sorts at or before value.
+func (EventConditions) NameLe(value string) Filter[Event] {
+	return Filter[Event]{expr: "le(name, " + formatString(value) + ")"}
+}
+
+// NameBetween matches Event entities whose Name sorts from from through to,
+// inclusive.
+func (EventConditions) NameBetween(from, to string) Filter[Event] {
+	return Filter[Event]{expr: "between(name, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// EndedYearEquals matches Event entities whose Ended falls in year.
+func (c EventConditions) EndedYearEquals(year int) Filter[Event] {
+	return c.EndedYearBetween(year, year)
+}
+
+// EndedYearBetween matches Event entities whose Ended falls in the
+// years from through to, inclusive.
+func (c EventConditions) EndedYearBetween(from, to int) Filter[Event] {
+	return c.EndedDateBetween(yearStart(from), yearEnd(to))
+}
+
+// EndedDateBetween matches Event entities whose Ended lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (EventConditions) EndedDateBetween(from, to time.Time) Filter[Event] {
+	return Filter[Event]{expr: "between(ended, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
@@ -0,0 +1,18 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package timelists
+
+// DQLSchema is the Dgraph schema for the timelists data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+dates: [datetime] .
+ended: datetime @index(day) .
+name: string @index(exact) .
+
+type Event {
+	name
+	dates
+	ended
+}
+`
@@ -0,0 +1,24 @@
+package unique
+
+import "time"
+
+// Film is known by its slug, and by its title and year together.
+type Film struct {
+	UID         string    `json:"uid,omitempty"`
+	DType       []string  `json:"dgraph.type,omitempty"`
+	Slug        string    `json:"slug,omitempty" dgraph:"index=hash unique"`
+	Title       string    `json:"title,omitempty" dgraph:"index=exact,term unique=release"`
+	Year        int       `json:"year,omitempty" dgraph:"index=int unique=release"`
+	ReleaseDate time.Time `json:"releaseDate,omitempty" dgraph:"predicate=release_date index=day"`
+	Tags        []string  `json:"tags,omitempty"`
+	Studio      *Studio   `json:"studio,omitempty" dgraph:"predicate=studio reverse"`
+}
+
+// Studio makes films and signs in with a password.
+type Studio struct {
+	UID      string   `json:"uid,omitempty"`
+	DType    []string `json:"dgraph.type,omitempty"`
+	Name     string   `json:"name,omitempty" dgraph:"index=hash unique"`
+	Password string   `json:"password,omitempty" dgraph:"type=password"`
+	Films    []Film   `json:"films,omitempty" dgraph:"predicate=~studio reverse"`
+}
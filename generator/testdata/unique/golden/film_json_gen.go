@@ -0,0 +1,46 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package unique
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// MarshalJSON encodes a Film in the form Dgraph expects:
+//   - Zero time.Time fields are left out if their json tag has omitempty.
+func (v Film) MarshalJSON() ([]byte, error) {
+	type plain Film
+	out := struct {
+		plain
+		ReleaseDate *time.Time `json:"releaseDate,omitempty"`
+	}{plain: plain(v)}
+	if !v.ReleaseDate.IsZero() {
+		out.ReleaseDate = &v.ReleaseDate
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a Film from a Dgraph query result:
+//   - Datetimes may be in any of the formats Dgraph returns, such as
+//     "2006-01-02" or RFC 3339.
+//   - An edge may be a single object rather than a list.
+func (v *Film) UnmarshalJSON(data []byte) error {
+	type plain Film
+	in := struct {
+		*plain
+		ReleaseDate json.RawMessage `json:"releaseDate"`
+		Studio      json.RawMessage `json:"studio"`
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if err := decodeDatetime(in.ReleaseDate, &v.ReleaseDate); err != nil {
+		return fmt.Errorf("Film.ReleaseDate: %w", err)
+	}
+	if err := decodeEdge(in.Studio, &v.Studio); err != nil {
+		return fmt.Errorf("Film.Studio: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package unique
+
+import "time"
+
+// FilmOption is a functional option for configuring Film mutations.
+type FilmOption func(*Film)
+
+// WithFilmSlug sets the Slug field on a Film.
+func WithFilmSlug(v string) FilmOption {
+	return func(e *Film) {
+		e.Slug = v
+	}
+}
+
+// WithFilmTitle sets the Title field on a Film.
+func WithFilmTitle(v string) FilmOption {
+	return func(e *Film) {
+		e.Title = v
+	}
+}
+
+// WithFilmYear sets the Year field on a Film.
+func WithFilmYear(v int) FilmOption {
+	return func(e *Film) {
+		e.Year = v
+	}
+}
+
+// WithFilmReleaseDate sets the ReleaseDate field on a Film.
+func WithFilmReleaseDate(v time.Time) FilmOption {
+	return func(e *Film) {
+		e.ReleaseDate = v
+	}
+}
+
+// WithFilmTags sets the Tags field on a Film.
+func WithFilmTags(v []string) FilmOption {
+	return func(e *Film) {
+		e.Tags = v
+	}
+}
+
+// ApplyFilmOptions applies the given options to a Film.
+func ApplyFilmOptions(e *Film, opts ...FilmOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
@@ -0,0 +1,464 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package unique
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FilmQuery is a typed query builder for Film entities.
+type FilmQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Film entities.
+func (c *FilmClient) Query(ctx context.Context) *FilmQuery {
+	return &FilmQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *FilmQuery) Filter(f string) *FilmQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *FilmQuery) where(expr string) *FilmQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from FilmWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *FilmQuery) Where(f Filter[Film]) *FilmQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasSlug filters to Film entities that have a value for Slug, using
+// has(slug).
+func (q *FilmQuery) HasSlug() *FilmQuery {
+	return q.Where(FilmWhere.HasSlug())
+}
+
+// NotSlug filters to Film entities that have no Slug value.
+func (q *FilmQuery) NotSlug() *FilmQuery {
+	return q.Where(FilmWhere.NotSlug())
+}
+
+// HasTitle filters to Film entities that have a value for Title, using
+// has(title).
+func (q *FilmQuery) HasTitle() *FilmQuery {
+	return q.Where(FilmWhere.HasTitle())
+}
+
+// NotTitle filters to Film entities that have no Title value.
+func (q *FilmQuery) NotTitle() *FilmQuery {
+	return q.Where(FilmWhere.NotTitle())
+}
+
+// HasYear filters to Film entities that have a value for Year, using
+// has(year).
+func (q *FilmQuery) HasYear() *FilmQuery {
+	return q.Where(FilmWhere.HasYear())
+}
+
+// NotYear filters to Film entities that have no Year value.
+func (q *FilmQuery) NotYear() *FilmQuery {
+	return q.Where(FilmWhere.NotYear())
+}
+
+// HasReleaseDate filters to Film entities that have a value for ReleaseDate, using
+// has(release_date).
+func (q *FilmQuery) HasReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.HasReleaseDate())
+}
+
+// NotReleaseDate filters to Film entities that have no ReleaseDate value.
+func (q *FilmQuery) NotReleaseDate() *FilmQuery {
+	return q.Where(FilmWhere.NotReleaseDate())
+}
+
+// HasTags filters to Film entities that have a value for Tags, using
+// has(tags).
+func (q *FilmQuery) HasTags() *FilmQuery {
+	return q.Where(FilmWhere.HasTags())
+}
+
+// NotTags filters to Film entities that have no Tags value.
+func (q *FilmQuery) NotTags() *FilmQuery {
+	return q.Where(FilmWhere.NotTags())
+}
+
+// HasStudio filters to Film entities that have a value for Studio, using
+// has(studio).
+func (q *FilmQuery) HasStudio() *FilmQuery {
+	return q.Where(FilmWhere.HasStudio())
+}
+
+// NotStudio filters to Film entities that have no Studio value.
+func (q *FilmQuery) NotStudio() *FilmQuery {
+	return q.Where(FilmWhere.NotStudio())
+}
+
+// StudioContains filters to Film entities whose Studio include any of the
+// Studio nodes with the given uids, using uid_in(studio, ...). Without
+// uids, or with one that is not a UID, Exec returns an error.
+func (q *FilmQuery) StudioContains(uids ...string) *FilmQuery {
+	return q.Where(FilmWhere.StudioContains(uids...))
+}
+
+// TitleAllOfTerms filters to Film entities whose Title contains all of the terms.
+func (q *FilmQuery) TitleAllOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.TitleAllOfTerms(terms))
+}
+
+// TitleAnyOfTerms filters to Film entities whose Title contains any of the terms.
+func (q *FilmQuery) TitleAnyOfTerms(terms string) *FilmQuery {
+	return q.Where(FilmWhere.TitleAnyOfTerms(terms))
+}
+
+// TitleGe filters to Film entities whose Title sorts at or after value.
+func (q *FilmQuery) TitleGe(value string) *FilmQuery {
+	return q.Where(FilmWhere.TitleGe(value))
+}
+
+// TitleLe filters to Film entities whose Title sorts at or before value.
+func (q *FilmQuery) TitleLe(value string) *FilmQuery {
+	return q.Where(FilmWhere.TitleLe(value))
+}
+
+// TitleBetween filters to Film entities whose Title sorts from from through to,
+// inclusive.
+func (q *FilmQuery) TitleBetween(from, to string) *FilmQuery {
+	return q.Where(FilmWhere.TitleBetween(from, to))
+}
+
+// ReleaseDateYearEquals filters to Film entities whose ReleaseDate falls in year.
+func (q *FilmQuery) ReleaseDateYearEquals(year int) *FilmQuery {
+	return q.Where(FilmWhere.ReleaseDateYearEquals(year))
+}
+
+// ReleaseDateYearBetween filters to Film entities whose ReleaseDate falls in the
+// years from through to, inclusive.
+func (q *FilmQuery) ReleaseDateYearBetween(from, to int) *FilmQuery {
+	return q.Where(FilmWhere.ReleaseDateYearBetween(from, to))
+}
+
+// ReleaseDateDateBetween filters to Film entities whose ReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (q *FilmQuery) ReleaseDateDateBetween(from, to time.Time) *FilmQuery {
+	return q.Where(FilmWhere.ReleaseDateDateBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *FilmQuery) OrderAsc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *FilmQuery) OrderDesc(field string) *FilmQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *FilmQuery) First(n int) *FilmQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *FilmQuery) Offset(n int) *FilmQuery {
+	q.offset = n
+	return q
+}
+
+// withEdge adds selection, of an edge, to those the query fetches, unless it
+// is there already.
+func (q *FilmQuery) withEdge(selection string) *FilmQuery {
+	for _, s := range q.with {
+		if s == selection {
+			return q
+		}
+	}
+	q.with = append(q.with, selection)
+	return q
+}
+
+// WithStudio makes GetByUID and Exec also fetch the Studio edge, with the
+// scalar predicates of each Studio it leads to, in the same query.
+func (q *FilmQuery) WithStudio() *FilmQuery {
+	return q.withEdge("studio { " + studioSelection(0) + " }")
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Film and the edges added by the With methods.
+func (q *FilmQuery) selection() string {
+	s := filmSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *FilmQuery) dql() string {
+	return nodesQuery("Film", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *FilmQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Film with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Film.
+func (q *FilmQuery) GetByUID(uid string) (*Film, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Film
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Film", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *FilmQuery) Exec(dst *[]Film) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count. It
+// does not take the selections of the With methods; use Exec for those.
+func (q *FilmQuery) ExecAndCount(dst *[]Film) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if len(q.with) > 0 {
+		return 0, errors.New("FilmQuery.ExecAndCount: With selections are not supported; use Exec")
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Film", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Film{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// FilmWhere builds the conditions on Film fields that FilmQuery.Where takes.
+var FilmWhere FilmConditions
+
+// FilmConditions has a method for each typed filter of FilmQuery, returning it as a
+// Filter[Film] to combine with And, Or, and Not.
+type FilmConditions struct{}
+
+// HasSlug matches Film entities that have a value for Slug, using
+// has(slug).
+func (FilmConditions) HasSlug() Filter[Film] {
+	return Filter[Film]{expr: "has(slug)"}
+}
+
+// NotSlug matches Film entities that have no Slug value.
+func (FilmConditions) NotSlug() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(slug)"}
+}
+
+// HasTitle matches Film entities that have a value for Title, using
+// has(title).
+func (FilmConditions) HasTitle() Filter[Film] {
+	return Filter[Film]{expr: "has(title)"}
+}
+
+// NotTitle matches Film entities that have no Title value.
+func (FilmConditions) NotTitle() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(title)"}
+}
+
+// HasYear matches Film entities that have a value for Year, using
+// has(year).
+func (FilmConditions) HasYear() Filter[Film] {
+	return Filter[Film]{expr: "has(year)"}
+}
+
+// NotYear matches Film entities that have no Year value.
+func (FilmConditions) NotYear() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(year)"}
+}
+
+// HasReleaseDate matches Film entities that have a value for ReleaseDate, using
+// has(release_date).
+func (FilmConditions) HasReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "has(release_date)"}
+}
+
+// NotReleaseDate matches Film entities that have no ReleaseDate value.
+func (FilmConditions) NotReleaseDate() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(release_date)"}
+}
+
+// HasTags matches Film entities that have a value for Tags, using
+// has(tags).
+func (FilmConditions) HasTags() Filter[Film] {
+	return Filter[Film]{expr: "has(tags)"}
+}
+
+// NotTags matches Film entities that have no Tags value.
+func (FilmConditions) NotTags() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(tags)"}
+}
+
+// HasStudio matches Film entities that have a value for Studio, using
+// has(studio).
+func (FilmConditions) HasStudio() Filter[Film] {
+	return Filter[Film]{expr: "has(studio)"}
+}
+
+// NotStudio matches Film entities that have no Studio value.
+func (FilmConditions) NotStudio() Filter[Film] {
+	return Filter[Film]{expr: "NOT has(studio)"}
+}
+
+// StudioContains matches Film entities whose Studio include any of the
+// Studio nodes with the given uids, using uid_in(studio, ...). Without
+// uids, or with one that is not a UID, the condition holds an error.
+func (FilmConditions) StudioContains(uids ...string) Filter[Film] {
+	list, err := formatUIDs(uids)
+	if err != nil {
+		return Filter[Film]{err: fmt.Errorf("Film.Studio: %w", err)}
+	}
+	return Filter[Film]{expr: "uid_in(studio, " + list + ")"}
+}
+
+// TitleAllOfTerms matches Film entities whose Title contains all of the terms.
+func (FilmConditions) TitleAllOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "allofterms(title, " + formatString(terms) + ")"}
+}
+
+// TitleAnyOfTerms matches Film entities whose Title contains any of the terms.
+func (FilmConditions) TitleAnyOfTerms(terms string) Filter[Film] {
+	return Filter[Film]{expr: "anyofterms(title, " + formatString(terms) + ")"}
+}
+
+// TitleGe matches Film entities whose Title sorts at or after value.
+func (FilmConditions) TitleGe(value string) Filter[Film] {
+	return Filter[Film]{expr: "ge(title, " + formatString(value) + ")"}
+}
+
+// TitleLe matches Film entities whose Title sorts at or before value.
+func (FilmConditions) TitleLe(value string) Filter[Film] {
+	return Filter[Film]{expr: "le(title, " + formatString(value) + ")"}
+}
+
+// TitleBetween matches Film entities whose Title sorts from from through to,
+// inclusive.
+func (FilmConditions) TitleBetween(from, to string) Filter[Film] {
+	return Filter[Film]{expr: "between(title, " + formatString(from) + ", " + formatString(to) + ")"}
+}
+
+// ReleaseDateYearEquals matches Film entities whose ReleaseDate falls in year.
+func (c FilmConditions) ReleaseDateYearEquals(year int) Filter[Film] {
+	return c.ReleaseDateYearBetween(year, year)
+}
+
+// ReleaseDateYearBetween matches Film entities whose ReleaseDate falls in the
+// years from through to, inclusive.
+func (c FilmConditions) ReleaseDateYearBetween(from, to int) Filter[Film] {
+	return c.ReleaseDateDateBetween(yearStart(from), yearEnd(to))
+}
+
+// ReleaseDateDateBetween matches Film entities whose ReleaseDate lies between
+// from and to, inclusive. The bounds are sent in UTC to the nanosecond; the
+// day index only narrows the candidates Dgraph compares.
+func (FilmConditions) ReleaseDateDateBetween(from, to time.Time) Filter[Film] {
+	return Filter[Film]{expr: "between(release_date, " + formatTime(from) + ", " + formatTime(to) + ")"}
+}
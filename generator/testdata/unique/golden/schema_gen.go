@@ -0,0 +1,32 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package unique
+
+// DQLSchema is the Dgraph schema for the unique data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+name: string @index(hash) @unique .
+password: password .
+release_date: datetime @index(day) .
+slug: string @index(hash) @unique .
+studio: uid @reverse .
+tags: [string] .
+title: string @index(exact, term) .
+year: int @index(int) .
+
+type Film {
+	slug
+	title
+	year
+	release_date
+	tags
+	studio
+}
+
+type Studio {
+	name
+	password
+	<~studio>
+}
+`
@@ -0,0 +1,48 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package unique
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkStudioToMap measures converting a Studio to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkStudioToMap(b *testing.B) {
+	v := Studio{
+		UID:      "0x1",
+		Name:     "Name",
+		Password: "Password",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkStudioQueryBuild measures building a Studio query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkStudioQueryBuild(b *testing.B) {
+	c := &StudioClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package vectors
+
+// Doc has an embedding under the default cosine metric, and a float64 vector
+// under an explicit euclidean one.
+type Doc struct {
+	UID       string    `json:"uid,omitempty"`
+	DType     []string  `json:"dgraph.type,omitempty"`
+	Title     string    `json:"title,omitempty" dgraph:"index=exact"`
+	Embedding []float32 `json:"embedding,omitempty" dgraph:"predicate=doc_embedding index=hnsw"`
+	Position  []float64 `json:"position,omitempty" dgraph:"index=hnsw metric=euclidean"`
+}
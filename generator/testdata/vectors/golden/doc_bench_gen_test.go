@@ -0,0 +1,47 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package vectors
+
+import (
+	"context"
+	"testing"
+)
+
+// BenchmarkDocToMap measures converting a Doc to the JSON object
+// of a mutation, the payload built for every write.
+func BenchmarkDocToMap(b *testing.B) {
+	v := Doc{
+		UID:   "0x1",
+		Title: "Title",
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		node, err := typedNode(&v, "_:node")
+		if err != nil {
+			b.Fatal(err)
+		}
+		if len(node) == 0 {
+			b.Fatal("empty node")
+		}
+	}
+}
+
+// BenchmarkDocQueryBuild measures building a Doc query, its
+// filter, order, paging, and selection, down to the DQL text, without
+// executing it, so no server is needed.
+func BenchmarkDocQueryBuild(b *testing.B) {
+	c := &DocClient{}
+	ctx := context.Background()
+	b.ReportAllocs()
+	for b.Loop() {
+		query := c.Query(ctx).
+			Filter(`has(dgraph.type)`).
+			OrderAsc("uid").
+			First(10).
+			Offset(20).
+			dql()
+		if query == "" {
+			b.Fatal("empty query")
+		}
+	}
+}
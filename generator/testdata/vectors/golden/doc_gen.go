@@ -0,0 +1,265 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package vectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// DocAPI is the set of Doc operations provided by DocClient. Code
+// that depends on DocAPI rather than *DocClient can run against a test double.
+type DocAPI interface {
+	Get(ctx context.Context, uid string, opts ...GetOption) (*Doc, error)
+	Exists(ctx context.Context, uid string) (bool, error)
+	Add(ctx context.Context, v *Doc) error
+	Create(ctx context.Context, v *Doc) (string, error)
+	Update(ctx context.Context, v *Doc) error
+	Delete(ctx context.Context, uid string) error
+	List(ctx context.Context, opts ...PageOption) ([]Doc, error)
+	Find(ctx context.Context, filter string, opts ...PageOption) ([]Doc, error)
+}
+
+// DocClient provides typed CRUD operations for Doc entities.
+//
+// Doc has an embedding under the default cosine metric, and a float64 vector
+// under an explicit euclidean one.
+type DocClient struct {
+	conn graphConn
+}
+
+var _ DocAPI = (*DocClient)(nil)
+
+// Get retrieves a single Doc by its UID.
+func (c *DocClient) Get(ctx context.Context, uid string, opts ...GetOption) (*Doc, error) {
+	cfg := getConfig{}
+	for _, opt := range opts {
+		opt.applyGet(&cfg)
+	}
+	var result Doc
+	var err error
+	if cfg.depth > 0 {
+		err = getByUID(ctx, c.conn, uid, "Doc", docSelection(cfg.depth), &result)
+	} else {
+		err = c.conn.Get(ctx, &result, uid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExpanded retrieves the Doc with the given UID using expand(_all_), which
+// returns every predicate of its type without naming them, e.g. for debugging
+// or admin tools. Edges come back with only the UIDs of their targets; use Get
+// to expand them.
+func (c *DocClient) GetExpanded(ctx context.Context, uid string) (*Doc, error) {
+	var result Doc
+	err := getExpanded(ctx, c.conn.QueryRaw, uid, "Doc", map[string]string{
+		"doc_embedding": "embedding",
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exists reports whether a node with the given UID exists and has the
+// Doc type. A node of another type does not count.
+func (c *DocClient) Exists(ctx context.Context, uid string) (bool, error) {
+	return nodeExists(ctx, c.conn.QueryRaw, uid, "Doc")
+}
+
+// Load populates v with the Doc stored under uid, using c.Doc.Get.
+func (v *Doc) Load(ctx context.Context, c *Client, uid string, opts ...GetOption) error {
+	got, err := c.Doc.Get(ctx, uid, opts...)
+	if err != nil {
+		return err
+	}
+	*v = *got
+	return nil
+}
+
+var _ Entity = (*Doc)(nil)
+
+// GetUID returns the Doc's UID, empty until it has been added.
+func (v *Doc) GetUID() string {
+	return v.UID
+}
+
+// SetUID sets the Doc's UID.
+func (v *Doc) SetUID(uid string) {
+	v.UID = uid
+}
+
+// Types returns the Doc's dgraph.type values: its DType, or
+// {"Doc"} until Add sets it.
+func (v *Doc) Types() []string {
+	if len(v.DType) > 0 {
+		return v.DType
+	}
+	return []string{"Doc"}
+}
+
+// String returns a one-line summary of the Doc: its UID.
+func (v Doc) String() string {
+	return fmt.Sprintf("Doc(%s)", v.UID)
+}
+
+// Add inserts a new Doc into the database.
+func (c *DocClient) Add(ctx context.Context, v *Doc) error {
+	return c.conn.Insert(ctx, v)
+}
+
+// Create inserts v, which must not have a UID yet, as a new Doc node and
+// returns the UID Dgraph assigned it, also setting v.UID and, if empty,
+// v.DType. Edges are written as they are: a target with a UID is linked, and
+// one without is created along with v, but its UID is not reported, so Create
+// it first to learn it.
+func (c *DocClient) Create(ctx context.Context, v *Doc) (string, error) {
+	if v.UID != "" {
+		return "", fmt.Errorf("Doc.Create: UID is already set to %s", v.UID)
+	}
+	if len(v.DType) == 0 {
+		v.DType = []string{"Doc"}
+	}
+	node := *v
+	node.UID = "_:node"
+	uids, err := mutate(ctx, c.conn, node, nil)
+	if err != nil {
+		return "", err
+	}
+	uid, ok := uids["node"]
+	if !ok {
+		return "", errors.New("Doc.Create: no UID assigned")
+	}
+	v.UID = uid
+	return uid, nil
+}
+
+// Update modifies an existing Doc in the database. The UID field must be set.
+func (c *DocClient) Update(ctx context.Context, v *Doc) error {
+	return c.conn.Update(ctx, v)
+}
+
+// UpdateFields sets the given predicates of the Doc with the given UID in a
+// single mutation, leaving its other predicates as they are. fields maps
+// predicate names to values as they are sent to Dgraph in JSON. A list
+// predicate gains the values, keeping those it has.
+func (c *DocClient) UpdateFields(ctx context.Context, uid string, fields map[string]any) error {
+	if err := setFields(ctx, c.conn, uid, fields); err != nil {
+		return fmt.Errorf("Doc.UpdateFields: %w", err)
+	}
+	return nil
+}
+
+// SetTitle sets the Title of the Doc with the given UID to value, touching
+// no other predicate.
+func (c *DocClient) SetTitle(ctx context.Context, uid string, value string) error {
+	if err := setFields(ctx, c.conn, uid, map[string]any{"title": value}); err != nil {
+		return fmt.Errorf("Doc.SetTitle: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the Doc with the given UID from the database.
+func (c *DocClient) Delete(ctx context.Context, uid string) error {
+	return c.conn.Delete(ctx, []string{uid})
+}
+
+// docSelection returns the DQL selection for a Doc: its uid, type,
+// scalar predicates, and edges expanded depth levels deep.
+func docSelection(depth int) string {
+	s := "uid dgraph.type title embedding: doc_embedding position"
+	return s
+}
+
+// List retrieves Docs with optional pagination.
+func (c *DocClient) List(ctx context.Context, opts ...PageOption) ([]Doc, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	if cfg.first <= 0 {
+		cfg.first = defaultPageSize
+	}
+	var docs []Doc
+	err := c.Query(ctx).First(cfg.first).Offset(cfg.offset).Exec(&docs)
+	if err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Find retrieves Docs matching the DQL filter expression, e.g.
+// `eq(name, "value")`, with optional pagination.
+func (c *DocClient) Find(ctx context.Context, filter string, opts ...PageOption) ([]Doc, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var docs []Doc
+	err := c.Query(ctx).Filter(filter).First(cfg.first).Offset(cfg.offset).Exec(&docs)
+	if err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// GetByTitle retrieves the Doc entities whose Title is value, with optional
+// pagination.
+func (c *DocClient) GetByTitle(ctx context.Context, value string, opts ...PageOption) ([]Doc, error) {
+	cfg := pageConfig{first: defaultPageSize}
+	for _, opt := range opts {
+		opt.applyPage(&cfg)
+	}
+	var results []Doc
+	err := queryNodes(ctx, c.conn.QueryRaw, "Doc", "eq(title, "+formatString(value)+")", "", docSelection(1), cfg.first, cfg.offset, &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DocMatch is a Doc found by a SimilarTo method, with its distance from the
+// query vector.
+type DocMatch struct {
+	Doc
+	Distance float64
+}
+
+// SimilarToEmbedding retrieves the topK Doc entities whose Embedding is nearest to vec,
+// using Dgraph's similar_to over its hnsw index, nearest first. Each match carries its
+// cosine distance from vec.
+func (c *DocClient) SimilarToEmbedding(ctx context.Context, vec []float32, topK int) ([]DocMatch, error) {
+	var nodes []Doc
+	err := similarNodes(ctx, c.conn.QueryRaw, "Doc", "doc_embedding", vec, topK, docSelection(1), &nodes)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]DocMatch, len(nodes))
+	for i, n := range nodes {
+		matches[i] = DocMatch{Doc: n, Distance: vectorDistance("cosine", n.Embedding, vec)}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Distance < matches[j].Distance })
+	return matches, nil
+}
+
+// SimilarToPosition retrieves the topK Doc entities whose Position is nearest to vec,
+// using Dgraph's similar_to over its hnsw index, nearest first. Each match carries its
+// euclidean distance from vec.
+func (c *DocClient) SimilarToPosition(ctx context.Context, vec []float32, topK int) ([]DocMatch, error) {
+	var nodes []Doc
+	err := similarNodes(ctx, c.conn.QueryRaw, "Doc", "position", vec, topK, docSelection(1), &nodes)
+	if err != nil {
+		return nil, err
+	}
+	matches := make([]DocMatch, len(nodes))
+	for i, n := range nodes {
+		matches[i] = DocMatch{Doc: n, Distance: vectorDistance("euclidean", n.Position, vec)}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Distance < matches[j].Distance })
+	return matches, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package vectors
+
+// DocOption is a functional option for configuring Doc mutations.
+type DocOption func(*Doc)
+
+// WithDocTitle sets the Title field on a Doc.
+func WithDocTitle(v string) DocOption {
+	return func(e *Doc) {
+		e.Title = v
+	}
+}
+
+// WithDocEmbedding sets the Embedding field on a Doc.
+func WithDocEmbedding(v []float32) DocOption {
+	return func(e *Doc) {
+		e.Embedding = v
+	}
+}
+
+// WithDocPosition sets the Position field on a Doc.
+func WithDocPosition(v []float64) DocOption {
+	return func(e *Doc) {
+		e.Position = v
+	}
+}
+
+// ApplyDocOptions applies the given options to a Doc.
+func ApplyDocOptions(e *Doc, opts ...DocOption) {
+	for _, opt := range opts {
+		opt(e)
+	}
+}
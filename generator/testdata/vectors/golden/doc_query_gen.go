@@ -0,0 +1,299 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package vectors
+
+import (
+	"context"
+)
+
+// DocQuery is a typed query builder for Doc entities.
+type DocQuery struct {
+	conn      graphConn
+	ctx       context.Context
+	filter    string
+	first     int
+	offset    int
+	orderBy   string
+	orderDesc bool
+	err       error    // First invalid argument to a typed filter, returned by Exec
+	with      []string // Edge selections added by the With methods
+}
+
+// Query begins a new query for Doc entities.
+func (c *DocClient) Query(ctx context.Context) *DocQuery {
+	return &DocQuery{conn: c.conn, ctx: ctx, first: defaultPageSize}
+}
+
+// Filter adds a DQL filter expression to the query.
+func (q *DocQuery) Filter(f string) *DocQuery {
+	q.filter = f
+	return q
+}
+
+// where ANDs expr onto the query's filter expression.
+func (q *DocQuery) where(expr string) *DocQuery {
+	if q.filter == "" {
+		q.filter = expr
+	} else {
+		q.filter = "(" + q.filter + ") AND " + expr
+	}
+	return q
+}
+
+// Where ANDs f, a condition built from DocWhere and combined with And,
+// Or, and Not, onto the query's filter expression. If f holds an invalid
+// argument, Exec returns its error instead of querying.
+func (q *DocQuery) Where(f Filter[Doc]) *DocQuery {
+	if f.err != nil {
+		if q.err == nil {
+			q.err = f.err
+		}
+		return q
+	}
+	if f.expr == "" {
+		return q
+	}
+	return q.where(f.expr)
+}
+
+// HasTitle filters to Doc entities that have a value for Title, using
+// has(title).
+func (q *DocQuery) HasTitle() *DocQuery {
+	return q.Where(DocWhere.HasTitle())
+}
+
+// NotTitle filters to Doc entities that have no Title value.
+func (q *DocQuery) NotTitle() *DocQuery {
+	return q.Where(DocWhere.NotTitle())
+}
+
+// HasEmbedding filters to Doc entities that have a value for Embedding, using
+// has(doc_embedding).
+func (q *DocQuery) HasEmbedding() *DocQuery {
+	return q.Where(DocWhere.HasEmbedding())
+}
+
+// NotEmbedding filters to Doc entities that have no Embedding value.
+func (q *DocQuery) NotEmbedding() *DocQuery {
+	return q.Where(DocWhere.NotEmbedding())
+}
+
+// HasPosition filters to Doc entities that have a value for Position, using
+// has(position).
+func (q *DocQuery) HasPosition() *DocQuery {
+	return q.Where(DocWhere.HasPosition())
+}
+
+// NotPosition filters to Doc entities that have no Position value.
+func (q *DocQuery) NotPosition() *DocQuery {
+	return q.Where(DocWhere.NotPosition())
+}
+
+// TitleGe filters to Doc entities whose Title sorts at or after value.
+func (q *DocQuery) TitleGe(value string) *DocQuery {
+	return q.Where(DocWhere.TitleGe(value))
+}
+
+// TitleLe filters to Doc entities whose Title sorts at or before value.
+func (q *DocQuery) TitleLe(value string) *DocQuery {
+	return q.Where(DocWhere.TitleLe(value))
+}
+
+// TitleBetween filters to Doc entities whose Title sorts from from through to,
+// inclusive.
+func (q *DocQuery) TitleBetween(from, to string) *DocQuery {
+	return q.Where(DocWhere.TitleBetween(from, to))
+}
+
+// OrderAsc sets ascending order on the given field.
+func (q *DocQuery) OrderAsc(field string) *DocQuery {
+	q.orderBy = field
+	q.orderDesc = false
+	return q
+}
+
+// OrderDesc sets descending order on the given field.
+func (q *DocQuery) OrderDesc(field string) *DocQuery {
+	q.orderBy = field
+	q.orderDesc = true
+	return q
+}
+
+// First limits the result to n nodes.
+func (q *DocQuery) First(n int) *DocQuery {
+	q.first = n
+	return q
+}
+
+// Offset skips the first n nodes.
+func (q *DocQuery) Offset(n int) *DocQuery {
+	q.offset = n
+	return q
+}
+
+// selection returns the DQL selection of the query's nodes: the scalar
+// predicates of a Doc and the edges added by the With methods.
+func (q *DocQuery) selection() string {
+	s := docSelection(0)
+	for _, edge := range q.with {
+		s += " " + edge
+	}
+	return s
+}
+
+// dql returns the DQL query that Exec sends after a With method, or on a
+// Client from NewClientWithDgo.
+func (q *DocQuery) dql() string {
+	return nodesQuery("Doc", q.filter, q.order(), q.selection(), q.first, q.offset)
+}
+
+// order returns the DQL ordering argument of q, e.g. "orderasc: name", or ""
+// if it is unordered.
+func (q *DocQuery) order() string {
+	switch {
+	case q.orderBy == "":
+		return ""
+	case q.orderDesc:
+		return "orderdesc: " + q.orderBy
+	}
+	return "orderasc: " + q.orderBy
+}
+
+// GetByUID retrieves the Doc with the given UID, with its scalar predicates and
+// the edges added by the With methods, in a single query. The filter, order,
+// and paging of q do not apply. The error wraps ErrNotFound if there is no
+// such Doc.
+func (q *DocQuery) GetByUID(uid string) (*Doc, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	var result Doc
+	err := retryRead(q.ctx, q.conn, func() error {
+		return getByUID(q.ctx, q.conn, uid, "Doc", q.selection(), &result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Exec executes the query and populates dst with the results. After a With
+// method, or on a Client from NewClientWithDgo, the query names its
+// predicates, so that the edges come back too.
+func (q *DocQuery) Exec(dst *[]Doc) error {
+	if q.err != nil {
+		return q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare || len(q.with) > 0 {
+		query := q.dql()
+		return retryRead(q.ctx, q.conn, func() error { return decodeNodes(q.ctx, q.conn.QueryRaw, query, dst) })
+	}
+	dq := q.conn.Query(q.ctx, Doc{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	return retryRead(q.ctx, q.conn, func() error { return dq.Nodes(dst) })
+}
+
+// ExecAndCount executes the query and returns both the results and total count.
+func (q *DocQuery) ExecAndCount(dst *[]Doc) (int, error) {
+	if q.err != nil {
+		return 0, q.err
+	}
+	if _, bare := q.conn.(*dgoConn); bare {
+		return queryNodesAndCount(q.ctx, q.conn.QueryRaw, "Doc", q.filter, q.order(), q.selection(), q.first, q.offset, dst)
+	}
+	dq := q.conn.Query(q.ctx, Doc{})
+	if q.filter != "" {
+		dq = dq.Filter(q.filter)
+	}
+	if q.first > 0 {
+		dq = dq.First(q.first)
+	}
+	if q.offset > 0 {
+		dq = dq.Offset(q.offset)
+	}
+	if q.orderBy != "" {
+		if q.orderDesc {
+			dq = dq.OrderDesc(q.orderBy)
+		} else {
+			dq = dq.OrderAsc(q.orderBy)
+		}
+	}
+	var n int
+	err := retryRead(q.ctx, q.conn, func() error {
+		var err error
+		n, err = dq.NodesAndCount(dst)
+		return err
+	})
+	return n, err
+}
+
+// DocWhere builds the conditions on Doc fields that DocQuery.Where takes.
+var DocWhere DocConditions
+
+// DocConditions has a method for each typed filter of DocQuery, returning it as a
+// Filter[Doc] to combine with And, Or, and Not.
+type DocConditions struct{}
+
+// HasTitle matches Doc entities that have a value for Title, using
+// has(title).
+func (DocConditions) HasTitle() Filter[Doc] {
+	return Filter[Doc]{expr: "has(title)"}
+}
+
+// NotTitle matches Doc entities that have no Title value.
+func (DocConditions) NotTitle() Filter[Doc] {
+	return Filter[Doc]{expr: "NOT has(title)"}
+}
+
+// HasEmbedding matches Doc entities that have a value for Embedding, using
+// has(doc_embedding).
+func (DocConditions) HasEmbedding() Filter[Doc] {
+	return Filter[Doc]{expr: "has(doc_embedding)"}
+}
+
+// NotEmbedding matches Doc entities that have no Embedding value.
+func (DocConditions) NotEmbedding() Filter[Doc] {
+	return Filter[Doc]{expr: "NOT has(doc_embedding)"}
+}
+
+// HasPosition matches Doc entities that have a value for Position, using
+// has(position).
+func (DocConditions) HasPosition() Filter[Doc] {
+	return Filter[Doc]{expr: "has(position)"}
+}
+
+// NotPosition matches Doc entities that have no Position value.
+func (DocConditions) NotPosition() Filter[Doc] {
+	return Filter[Doc]{expr: "NOT has(position)"}
+}
+
+// TitleGe matches Doc entities whose Title sorts at or after value.
+func (DocConditions) TitleGe(value string) Filter[Doc] {
+	return Filter[Doc]{expr: "ge(title, " + formatString(value) + ")"}
+}
+
+// TitleLe matches Doc entities whose Title sorts at or before value.
+func (DocConditions) TitleLe(value string) Filter[Doc] {
+	return Filter[Doc]{expr: "le(title, " + formatString(value) + ")"}
+}
+
+// TitleBetween matches Doc entities whose Title sorts from from through to,
+// inclusive.
+func (DocConditions) TitleBetween(from, to string) Filter[Doc] {
+	return Filter[Doc]{expr: "between(title, " + formatString(from) + ", " + formatString(to) + ")"}
+}
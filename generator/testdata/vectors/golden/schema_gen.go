@@ -0,0 +1,18 @@
+// Code generated by modusGraphGen. DO NOT EDIT.
+
+package vectors
+
+// DQLSchema is the Dgraph schema for the vectors data model, derived from
+// the dgraph struct tags. Reverse predicates are not declared; they follow from
+// @reverse on the forward predicate.
+const DQLSchema = `
+doc_embedding: float32vector @index(hnsw(metric:"cosine")) .
+position: float32vector @index(hnsw(metric:"euclidean")) .
+title: string @index(exact) .
+
+type Doc {
+	title
+	doc_embedding
+	position
+}
+`
@@ -0,0 +1,182 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// predicateUse is one field declaring a predicate, as seen by
+// ValidatePredicates.
+type predicateUse struct {
+	owner   string // Entity and field, e.g. "Film.Year"
+	typ     string // Dgraph type, with list predicates in brackets, e.g. "[string]"
+	indexes string // Sorted index tokenizers, e.g. "exact, term"
+}
+
+// ValidatePredicates checks that every predicate declared by more than one
+// field of pkg is declared compatibly. Dgraph predicates are global, so two
+// entities sharing a predicate must agree on its Dgraph type, whether it is a
+// list, and its index tokenizers; otherwise applying the schema fails. Each
+// conflict names the predicate and the two fields, and all are returned
+// joined. Reverse ("~") fields, which only traverse a predicate, are not
+// declarations and are skipped.
+func ValidatePredicates(pkg *model.Package) error {
+	uses := make(map[string]predicateUse)
+	var errs []error
+	for _, e := range pkg.Entities {
+		for _, f := range e.Fields {
+			if f.IsUID || f.IsDType || f.Predicate == "" || strings.HasPrefix(f.Predicate, "~") {
+				continue
+			}
+			use := predicateUse{
+				owner:   e.Name + "." + f.Name,
+				typ:     dgraphScalar(f),
+				indexes: strings.Join(sortedIndexes(f), ", "),
+			}
+			if schemaList(f) {
+				use.typ = "[" + use.typ + "]"
+			}
+			first, ok := uses[f.Predicate]
+			if !ok {
+				uses[f.Predicate] = use
+				continue
+			}
+			if first.typ != use.typ {
+				errs = append(errs, fmt.Errorf("predicate %q is %s on %s but %s on %s",
+					f.Predicate, first.typ, first.owner, use.typ, use.owner))
+			} else if first.indexes != use.indexes {
+				errs = append(errs, fmt.Errorf("predicate %q is %s on %s but %s on %s",
+					f.Predicate, describeIndexes(first.indexes), first.owner, describeIndexes(use.indexes), use.owner))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// describeIndexes renders a predicateUse's indexes for an error message.
+func describeIndexes(indexes string) string {
+	if indexes == "" {
+		return "not indexed"
+	}
+	return "indexed (" + indexes + ")"
+}
+
+// sortedIndexes returns the field's schema index tokenizers without
+// duplicates, in sorted order, so that index sets compare regardless of the
+// order they were tagged in.
+func sortedIndexes(f model.Field) []string {
+	var result []string
+	for _, idx := range schemaIndexes(f) {
+		if !hasString(result, idx) {
+			result = append(result, idx)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ValidateEdges checks that every edge of pkg's entities points at an entity
+// that is part of the model: one of pkg.Entities, or one of pkg.External for
+// an edge into another package. It also catches a slice of an exported type
+// that is not declared at all, e.g. []Genre after Genre was renamed, which the
+// parser takes for a scalar list. Each problem names the entity, the field,
+// and the missing target, and the file of pkg.Skipped that declares the target
+// if one does, and all are returned joined.
+func ValidateEdges(pkg *model.Package) error {
+	known := make(map[string]bool)
+	for _, e := range pkg.Entities {
+		known[e.Name] = true
+		if e.GoPackage != "" {
+			// ParseRecursive names entities unqualified, but edges from
+			// other packages refer to them as e.g. "people.Person".
+			known[e.GoPackage+"."+e.Name] = true
+		}
+	}
+	for _, e := range pkg.External {
+		known[e.Name] = true
+	}
+
+	var errs []error
+	for _, e := range pkg.Entities {
+		for _, f := range e.Fields {
+			switch {
+			case f.IsEdge && !known[f.EdgeEntity]:
+				if file := declaringFile(pkg.Skipped, f.EdgeEntity); file != "" {
+					errs = append(errs, fmt.Errorf("%s.%s: edge target %s is declared in %s, which does not parse",
+						e.Name, f.Name, f.EdgeEntity, file))
+					continue
+				}
+				errs = append(errs, fmt.Errorf("%s.%s: edge target %s is not an entity (it needs UID and DType fields)",
+					e.Name, f.Name, f.EdgeEntity))
+			case f.IsList:
+				elem := listElem(f)
+				if !isUndeclaredType(elem) {
+					continue
+				}
+				if file := declaringFile(pkg.Skipped, elem); file != "" {
+					errs = append(errs, fmt.Errorf("%s.%s: edge target %s is declared in %s, which does not parse",
+						e.Name, f.Name, elem, file))
+					continue
+				}
+				errs = append(errs, fmt.Errorf("%s.%s: edge target %s is not declared in package %s",
+					e.Name, f.Name, elem, pkg.Name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// declaringFile returns the first of skipped, source files left out because
+// they do not parse, that declares the type name, e.g. "Genre" or
+// "people.Person", or "" if none does. Each file is read as far as its first
+// syntax error, like the parser's partial result.
+func declaringFile(skipped []string, name string) string {
+	qualifier, typeName, ok := strings.Cut(name, ".")
+	if !ok {
+		qualifier, typeName = "", name
+	}
+	for _, file := range skipped {
+		f, _ := parser.ParseFile(token.NewFileSet(), file, nil, parser.SkipObjectResolution)
+		if f == nil || f.Name == nil || (qualifier != "" && f.Name.Name != qualifier) {
+			continue
+		}
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				if typeSpec, ok := spec.(*ast.TypeSpec); ok && typeSpec.Name.Name == typeName {
+					return file
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// listElem returns the element type of a list field, e.g. "Genre" for
+// "[]*Genre".
+func listElem(f model.Field) string {
+	elem, _ := cutList(underlyingType(f))
+	return strings.TrimPrefix(elem, "*")
+}
+
+// isUndeclaredType returns true if elem is an unqualified exported type name.
+// Go's predeclared types are all lower case, and the parser resolves the
+// package's own named types and treats its structs as edges, so such a name
+// left in a list can only be a type that no longer exists.
+func isUndeclaredType(elem string) bool {
+	if elem == "" || strings.ContainsAny(elem, ".[]*") {
+		return false
+	}
+	r := elem[0]
+	return r >= 'A' && r <= 'Z'
+}
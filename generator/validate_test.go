@@ -0,0 +1,204 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/mlwelles/modusGraphGen/model"
+	"github.com/mlwelles/modusGraphGen/parser"
+)
+
+func TestValidatePredicates(t *testing.T) {
+	field := func(name, goType, predicate string, indexes ...string) model.Field {
+		return model.Field{Name: name, GoType: goType, Predicate: predicate, Indexes: indexes}
+	}
+	tests := []struct {
+		name    string
+		show    []model.Field
+		wantErr string
+	}{
+		{
+			name: "compatible reuse",
+			show: []model.Field{
+				field("Year", "int64", "year", "int"),
+				field("Title", "string", "name", "term", "exact"),
+			},
+		},
+		{
+			name:    "type conflict",
+			show:    []model.Field{field("Year", "string", "year", "int")},
+			wantErr: `predicate "year" is int on Film.Year but string on Show.Year`,
+		},
+		{
+			name:    "list conflict",
+			show:    []model.Field{{Name: "Years", GoType: "[]int", Predicate: "year", Indexes: []string{"int"}, IsList: true}},
+			wantErr: `predicate "year" is int on Film.Year but [int] on Show.Years`,
+		},
+		{
+			name:    "index conflict",
+			show:    []model.Field{field("Title", "string", "name", "hash")},
+			wantErr: `predicate "name" is indexed (exact, term) on Film.Name but indexed (hash) on Show.Title`,
+		},
+		{
+			name:    "missing index",
+			show:    []model.Field{field("Year", "int", "year")},
+			wantErr: `predicate "year" is indexed (int) on Film.Year but not indexed on Show.Year`,
+		},
+		{
+			name: "reverse traversal",
+			show: []model.Field{{Name: "Films", GoType: "[]Film", Predicate: "~year", IsEdge: true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := &model.Package{
+				Name: "media",
+				Entities: []model.Entity{
+					{Name: "Film", Fields: []model.Field{
+						{Name: "UID", GoType: "string", Predicate: "uid", IsUID: true},
+						field("Year", "int", "year", "int"),
+						field("Name", "string", "name", "exact", "term"),
+					}},
+					{Name: "Show", Fields: append([]model.Field{
+						{Name: "UID", GoType: "string", Predicate: "uid", IsUID: true},
+					}, tt.show...)},
+				},
+			}
+			err := ValidatePredicates(pkg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidatePredicates = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidatePredicates = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePredicatesFixtures(t *testing.T) {
+	for _, name := range []string{"aliases", "declared", "embedded", "facets", "multisearch", "terms", "vectors"} {
+		pkg, err := parser.Parse(fixtureDir(t, name))
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", name, err)
+		}
+		if err := ValidatePredicates(pkg); err != nil {
+			t.Errorf("%s: ValidatePredicates = %v, want nil", name, err)
+		}
+	}
+}
+
+func TestValidateEdges(t *testing.T) {
+	uid := model.Field{Name: "UID", GoType: "string", Predicate: "uid", IsUID: true}
+	tests := []struct {
+		name    string
+		field   model.Field
+		wantErr string
+	}{
+		{
+			name:  "local edge",
+			field: model.Field{Name: "Genres", GoType: "[]Genre", Predicate: "genre", IsEdge: true, EdgeEntity: "Genre"},
+		},
+		{
+			name:  "cross-package edge",
+			field: model.Field{Name: "Cast", GoType: "[]people.Person", Predicate: "cast", IsEdge: true, EdgeEntity: "people.Person", EdgePackage: "example.com/people"},
+		},
+		{
+			name:  "scalar list",
+			field: model.Field{Name: "Tags", GoType: "[]Tag", UnderlyingType: "[]string", Predicate: "tags", IsList: true},
+		},
+		{
+			name:  "qualified list",
+			field: model.Field{Name: "Dates", GoType: "[]time.Time", Predicate: "dates", IsList: true},
+		},
+		{
+			name:    "struct that is not an entity",
+			field:   model.Field{Name: "Studios", GoType: "[]Studio", Predicate: "studio", IsEdge: true, EdgeEntity: "Studio"},
+			wantErr: "Film.Studios: edge target Studio is not an entity",
+		},
+		{
+			name:    "other package entity not loaded",
+			field:   model.Field{Name: "Crew", GoType: "[]people.Crew", Predicate: "crew", IsEdge: true, EdgeEntity: "people.Crew", EdgePackage: "example.com/people"},
+			wantErr: "Film.Crew: edge target people.Crew is not an entity",
+		},
+		{
+			name:    "undeclared type",
+			field:   model.Field{Name: "Categories", GoType: "[]*Category", Predicate: "category", IsList: true},
+			wantErr: "Film.Categories: edge target Category is not declared in package movies",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg := &model.Package{
+				Name: "movies",
+				Entities: []model.Entity{
+					{Name: "Film", Fields: []model.Field{uid, tt.field}},
+					{Name: "Genre", Fields: []model.Field{uid}},
+				},
+				External: []model.Entity{{Name: "people.Person", Fields: []model.Field{uid}}},
+			}
+			err := ValidateEdges(pkg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateEdges = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateEdges = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateEdgesSkippedFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"film.go": "package p\n\ntype Film struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+			"\tGenres []Genre `json:\"genres,omitempty\"`\n}\n",
+		"genre.go": "package p\n\ntype Genre struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tName string `json:\"name\"\n}\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pkg, err := parser.Parse(dir, parser.WithWarnings(func(error) {}))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	err = ValidateEdges(pkg)
+	want := "Film.Genres: edge target Genre is declared in " + filepath.Join(dir, "genre.go") + ", which does not parse"
+	if err == nil || err.Error() != want {
+		t.Errorf("ValidateEdges = %v, want %q", err, want)
+	}
+}
+
+func TestValidateEdgesFixtures(t *testing.T) {
+	for _, name := range []string{"aliases", "crosspkg", "declared", "embedded", "facets", "lists", "selfref", "vectors"} {
+		pkg, err := parser.Parse(fixtureDir(t, name))
+		if err != nil {
+			t.Fatalf("Parse(%s) failed: %v", name, err)
+		}
+		if err := ValidateEdges(pkg); err != nil {
+			t.Errorf("%s: ValidateEdges = %v, want nil", name, err)
+		}
+	}
+
+	// Entities merged by ParseRecursive keep their edges into each other.
+	pkg, err := parser.ParseRecursive(filepath.Join("..", "parser", "testdata", "nested"))
+	if err != nil {
+		t.Fatalf("ParseRecursive failed: %v", err)
+	}
+	if err := ValidateEdges(pkg); err != nil {
+		t.Errorf("nested: ValidateEdges = %v, want nil", err)
+	}
+}
@@ -1,3 +1,11 @@
 module github.com/mlwelles/modusGraphGen
 
 go 1.26.0
+
+require gopkg.in/yaml.v3 v3.0.1
+
+require (
+	golang.org/x/mod v0.37.0
+	golang.org/x/sync v0.21.0 // indirect
+	golang.org/x/tools v0.47.0
+)
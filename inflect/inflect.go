@@ -0,0 +1,200 @@
+// Package inflect forms the English plurals, singulars, and indefinite
+// articles, and the snake-case spellings, of the entity and predicate names
+// that modusGraphGen derives identifiers, file names, and doc comments from,
+// shared by the parser and the generator.
+package inflect
+
+import (
+	"strings"
+	"unicode"
+)
+
+// irregular maps the singular of each noun that the suffix rules get wrong to
+// its plural, in lower case.
+var irregular = map[string]string{
+	"child":      "children",
+	"criterion":  "criteria",
+	"foot":       "feet",
+	"goose":      "geese",
+	"leaf":       "leaves",
+	"life":       "lives",
+	"man":        "men",
+	"medium":     "media",
+	"mouse":      "mice",
+	"movie":      "movies", // Not "movy" in the singular
+	"person":     "people",
+	"phenomenon": "phenomena",
+	"quiz":       "quizzes",
+	"tooth":      "teeth",
+	"wife":       "wives",
+	"woman":      "women",
+}
+
+// uncountable lists the nouns whose plural is the singular.
+var uncountable = map[string]bool{
+	"data":        true,
+	"equipment":   true,
+	"information": true,
+	"metadata":    true,
+	"news":        true,
+	"series":      true,
+	"sheep":       true,
+	"species":     true,
+}
+
+// singular is irregular inverted.
+var singular = func() map[string]string {
+	m := make(map[string]string, len(irregular))
+	for s, p := range irregular {
+		m[p] = s
+	}
+	return m
+}()
+
+// Plural returns the plural of name, e.g. "Films" for "Film", "Countries" for
+// "Country", and "People" for "Person". Only the last word of a CamelCase
+// name is inflected, so "LeadActor" becomes "LeadActors", and the case of
+// its first letter is kept.
+func Plural(name string) string {
+	head, word := lastWord(name)
+	lower := strings.ToLower(word)
+	switch {
+	case word == "" || uncountable[lower]:
+		return name
+	case irregular[lower] != "":
+		return head + matchCase(irregular[lower], word)
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "z"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// Singular undoes Plural, e.g. "genre" for "genres" and "Person" for
+// "People", and returns other names unchanged.
+func Singular(name string) string {
+	head, word := lastWord(name)
+	lower := strings.ToLower(word)
+	switch {
+	case word == "" || uncountable[lower]:
+		return name
+	case singular[lower] != "":
+		return head + matchCase(singular[lower], word)
+	case strings.HasSuffix(lower, "ies") && len(lower) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(lower, "ses"), strings.HasSuffix(lower, "xes"), strings.HasSuffix(lower, "zes"),
+		strings.HasSuffix(lower, "ches"), strings.HasSuffix(lower, "shes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(lower, "s") && !strings.HasSuffix(lower, "ss"):
+		return name[:len(name)-1]
+	}
+	return name
+}
+
+// consonantSounds lists the starts of words, in lower case, that are
+// spelled with a vowel but sound like a consonant, and vowelSounds the
+// reverse.
+var (
+	consonantSounds = []string{"eu", "one", "once", "ubi", "uni", "ura", "ure", "uri", "uro", "use", "usu", "uti"}
+	vowelSounds     = []string{"heir", "honest", "honor", "honour", "hour"}
+)
+
+// Article returns the indefinite article for name, "an" for "Event" and
+// "InitialReleaseDate" and "a" for "Film" and "UniqueID". A name starting
+// with an acronym, e.g. "HTTPServer" or "URL", takes the article of its
+// first letter's sound.
+func Article(name string) string {
+	word, _, _ := strings.Cut(SnakeCase(name), "_")
+	if word == "" {
+		return "a"
+	}
+	if n := len(word); n <= len(name) && strings.ToUpper(name[:n]) == name[:n] && (n > 1 || n == len(name)) {
+		if strings.ContainsRune("aefhilmnorsx", rune(word[0])) {
+			return "an"
+		}
+		return "a"
+	}
+	for _, prefix := range consonantSounds {
+		if strings.HasPrefix(word, prefix) {
+			return "a"
+		}
+	}
+	for _, prefix := range vowelSounds {
+		if strings.HasPrefix(word, prefix) {
+			return "an"
+		}
+	}
+	if strings.ContainsRune("aeiou", rune(word[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+// lastWord splits name before the capital that starts its last CamelCase
+// word. A name without one, e.g. "genre", is all last word.
+func lastWord(name string) (head, word string) {
+	for i := len(name) - 1; i > 0; i-- {
+		if unicode.IsUpper(rune(name[i])) && unicode.IsLower(rune(name[i-1])) {
+			return name[:i], name[i:]
+		}
+	}
+	return "", name
+}
+
+// matchCase returns word, in lower case, with the first letter capitalized
+// if that of like is.
+func matchCase(word, like string) string {
+	if like != "" && unicode.IsUpper(rune(like[0])) {
+		return strings.ToUpper(word[:1]) + word[1:]
+	}
+	return word
+}
+
+// SnakeCase converts a Go identifier like "InitialReleaseDate" to
+// "initial_release_date". Runs of capitals are kept together, so "IMDbID"
+// becomes "im_db_id", and digits stay with the word before them, so
+// "OAuth2Token" becomes "oauth2_token". A single leading capital joins the
+// next word, so "XCoord" becomes "xcoord".
+func SnakeCase(s string) string {
+	runes := []rune(s)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, r := runes[i-1], runes[i]
+		var split bool
+		switch {
+		case unicode.IsUpper(r):
+			// A capital after a lower-case letter or digit starts a word, as
+			// does the last capital of a run followed by a lower-case word,
+			// but not by a plural "s" or a version such as "v4".
+			split = !unicode.IsUpper(prev) ||
+				i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !pluralOrVersion(runes[i+1:])
+		case unicode.IsLower(r):
+			// A version after a run of capitals, as in "IDv4", is a word.
+			split = unicode.IsUpper(prev) && i-start > 1 && i+1 < len(runes) && unicode.IsDigit(runes[i+1])
+		}
+		if split {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+
+	// A single leading capital belongs to the word after it, as in "OAuth".
+	if len(words) > 1 && len([]rune(words[0])) == 1 && unicode.IsUpper(runes[0]) {
+		words = append([]string{words[0] + words[1]}, words[2:]...)
+	}
+	return strings.ToLower(strings.Join(words, "_"))
+}
+
+// pluralOrVersion returns true if rest, which follows a run of capitals,
+// starts with a plural "s" ending the word, as in "IDs", or with a lower-case
+// letter and a digit, as in "IDv4".
+func pluralOrVersion(rest []rune) bool {
+	if len(rest) < 2 {
+		return len(rest) == 1 && rest[0] == 's'
+	}
+	return rest[0] == 's' && !unicode.IsLower(rest[1]) || unicode.IsDigit(rest[1])
+}
@@ -0,0 +1,97 @@
+package inflect
+
+import "testing"
+
+func TestPlural(t *testing.T) {
+	tests := []struct {
+		singular, plural string
+	}{
+		{"Film", "Films"},
+		{"Genre", "Genres"},
+		{"Country", "Countries"},
+		{"Day", "Days"},
+		{"Performance", "Performances"},
+		{"Box", "Boxes"},
+		{"Match", "Matches"},
+		{"Status", "Statuses"},
+		{"Person", "People"},
+		{"SalesPerson", "SalesPeople"},
+		{"Child", "Children"},
+		{"Movie", "Movies"},
+		{"LeadActor", "LeadActors"},
+		{"Series", "Series"},
+		{"URL", "URLs"},
+		{"genre", "genres"},
+		{"person", "people"},
+	}
+	for _, tt := range tests {
+		if got := Plural(tt.singular); got != tt.plural {
+			t.Errorf("Plural(%q) = %q, want %q", tt.singular, got, tt.plural)
+		}
+		if got := Singular(tt.plural); got != tt.singular {
+			t.Errorf("Singular(%q) = %q, want %q", tt.plural, got, tt.singular)
+		}
+	}
+}
+
+func TestSingularUnchanged(t *testing.T) {
+	for _, name := range []string{"film", "Class", "news", ""} {
+		if got := Singular(name); got != name {
+			t.Errorf("Singular(%q) = %q, want it unchanged", name, got)
+		}
+	}
+}
+
+func TestArticle(t *testing.T) {
+	tests := map[string]string{
+		"Film":               "a",
+		"Event":              "an",
+		"InitialReleaseDate": "an",
+		"Award":              "an",
+		"UniqueID":           "a",
+		"User":               "a",
+		"Umbrella":           "an",
+		"Hour":               "an",
+		"Studio":             "a",
+		"UID":                "a",
+		"URL":                "a",
+		"HTTPServer":         "an",
+		"IMDbID":             "an",
+		"OAuth2Token":        "an",
+		"X":                  "an",
+		"genre":              "a",
+		"":                   "a",
+	}
+	for in, want := range tests {
+		if got := Article(in); got != want {
+			t.Errorf("Article(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Name":               "name",
+		"ContentRating":      "content_rating",
+		"InitialReleaseDate": "initial_release_date",
+		"UID":                "uid",
+		"IMDbID":             "im_db_id",
+		"URL":                "url",
+		"HTTPServer":         "http_server",
+		"OAuth2Token":        "oauth2_token",
+		"Film2":              "film2",
+		"IDv4":               "id_v4",
+		"Top10Films":         "top10_films",
+		"HTTP2Server":        "http2_server",
+		"FilmID":             "film_id",
+		"FilmIDs":            "film_ids",
+		"UserURLs":           "user_urls",
+		"V2Client":           "v2_client",
+		"XCoord":             "xcoord",
+	}
+	for in, want := range tests {
+		if got := SnakeCase(in); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
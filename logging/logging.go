@@ -0,0 +1,91 @@
+// Package logging provides the small leveled logger that modusGraphGen writes
+// its diagnostics through, shared by main, the parser, and the generator.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Level selects how much a Logger writes.
+type Level int
+
+const (
+	// Quiet writes errors only.
+	Quiet Level = iota
+	// Normal also writes warnings and a one-line summary per entity and per
+	// generated package.
+	Normal
+	// Verbose also writes tag parsing decisions, inference reasoning, and
+	// each file written.
+	Verbose
+)
+
+// Sink receives the diagnostics of the parser and generator, so that a tool
+// embedding them can capture those in its own logger. *Logger implements it.
+type Sink interface {
+	// Debugf receives a detail line, such as how a field's tags were read.
+	Debugf(format string, args ...any)
+	// Warnf receives a problem that was skipped over.
+	Warnf(format string, args ...any)
+}
+
+// Discard is a Sink that discards everything, the default of the parser and
+// generator.
+var Discard Sink = (*Logger)(nil)
+
+// Logger writes leveled diagnostics to a writer, one line per message. A nil
+// *Logger discards everything, so that packages can log unconditionally. It
+// is safe for concurrent use.
+type Logger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level Level
+}
+
+// New returns a Logger writing the messages at or below level to w.
+func New(w io.Writer, level Level) *Logger {
+	return &Logger{w: w, level: level}
+}
+
+// Enabled reports whether messages at level are written.
+func (l *Logger) Enabled(level Level) bool {
+	return l != nil && level <= l.level
+}
+
+// Errorf writes an error, at any level.
+func (l *Logger) Errorf(format string, args ...any) {
+	l.logf(Quiet, "error: ", format, args...)
+}
+
+// Warnf writes a warning at Normal and Verbose.
+func (l *Logger) Warnf(format string, args ...any) {
+	l.logf(Normal, "warning: ", format, args...)
+}
+
+// Infof writes a summary line at Normal and Verbose.
+func (l *Logger) Infof(format string, args ...any) {
+	l.logf(Normal, "", format, args...)
+}
+
+// Debugf writes a detail line at Verbose only.
+func (l *Logger) Debugf(format string, args ...any) {
+	l.logf(Verbose, "", format, args...)
+}
+
+// logf writes the message with prefix if level is enabled, adding a trailing
+// newline if it lacks one.
+func (l *Logger) logf(level Level, prefix, format string, args ...any) {
+	if !l.Enabled(level) {
+		return
+	}
+	msg := prefix + fmt.Sprintf(format, args...)
+	if !strings.HasSuffix(msg, "\n") {
+		msg += "\n"
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = io.WriteString(l.w, msg)
+}
@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	tests := []struct {
+		level Level
+		want  string
+	}{
+		{Quiet, "error: e 1\n"},
+		{Normal, "error: e 1\nwarning: w 2\ni 3\n"},
+		{Verbose, "error: e 1\nwarning: w 2\ni 3\nd 4\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		l := New(&buf, tt.level)
+		l.Errorf("e %d", 1)
+		l.Warnf("w %d", 2)
+		l.Infof("i %d\n", 3)
+		l.Debugf("d %d", 4)
+		if buf.String() != tt.want {
+			t.Errorf("level %d wrote %q, want %q", tt.level, buf.String(), tt.want)
+		}
+	}
+}
+
+func TestNilLogger(t *testing.T) {
+	var l *Logger
+	if l.Enabled(Quiet) {
+		t.Error("nil Logger is enabled")
+	}
+	l.Errorf("discarded")
+	l.Debugf("discarded")
+}
@@ -7,32 +7,121 @@
 //	go run github.com/mlwelles/modusGraphGen [flags]
 //
 // When invoked via go:generate (the typical case), it uses the current working
-// directory as the target package.
+// directory as the target package. Settings shared by every run can be kept in a
+// .modusgraphgen.yaml file in the package directory; flags override them. With
+// -watch it keeps running, and regenerates whenever a source file changes.
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
-	"log"
 	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/mlwelles/modusGraphGen/generator"
+	"github.com/mlwelles/modusGraphGen/logging"
 	"github.com/mlwelles/modusGraphGen/parser"
 )
 
 func main() {
 	pkgDir := flag.String("pkg", ".", "path to the target Go package directory")
 	outputDir := flag.String("output", "", "output directory (default: same as -pkg)")
+	packageName := flag.String("package", "", "package name for the generated files (default: that of the parsed package)")
+	predicateNaming := flag.String("naming", "json_tag", "how to name the predicate of a field without a dgraph predicate=: json_tag (the json tag, else the snake-cased field name), field_name (the snake-cased field name), entity_dot_field (e.g. director.film for Director.Film), or explicit (none; every field must declare one)")
+	strictPredicates := flag.Bool("strict-predicates", false, "short for -naming=explicit: require an explicit dgraph predicate= on every field")
+	fieldNamePredicates := flag.Bool("field-name-predicates", false, "short for -naming=field_name: without a dgraph predicate=, use the snake-cased field name even if the field has a json tag")
+	strictTags := flag.Bool("strict-tags", false, "fail on unknown dgraph tag directives instead of warning and skipping them")
+	allErrors := flag.Bool("all-errors", false, "like -strict-tags, but report every problem with the entities' tags and predicates, one per line, before failing")
+	allowPartial := flag.Bool("allow-partial", false, "generate even when source files with syntax errors were skipped, leaving their entities out of the generated files")
+	recursive := flag.Bool("recursive", false, "also parse the packages in subdirectories of -pkg, generating a client in each")
+	schemaFile := flag.String("schema", "", "read the entities from this Dgraph schema file instead of the Go structs of -pkg, generating their structs too; the package is named after the file")
+	mock := flag.Bool("mock", false, "also generate an in-memory MockClient (mock_client_gen.go) for tests")
+	tests := flag.Bool("tests", false, "also generate a table-driven round-trip test per entity (<entity>_gen_test.go) against the MockClient; implies -mock")
+	noCLI := flag.Bool("no-cli", false, "do not generate the CLI stub (cmd/<pkg>/main.go), e.g. for library-only packages")
+	singleFile := flag.Bool("single-file", false, "write the generated Go code into one generated.go instead of a file per template and entity")
+	mermaid := flag.Bool("mermaid", false, "also write a Mermaid ER diagram of the entities (graph_gen.mmd) for docs")
+	graphql := flag.Bool("graphql", false, "also write a Dgraph GraphQL schema of the entities (schema_gen.graphql), with unique fields as @id")
+	strict := flag.Bool("strict", false, "warn about model problems that are otherwise tolerated, e.g. reverse edges without a forward predicate")
+	overlay := flag.Bool("overlay", false, "keep hand-written files intact and warn about method and package-level name collisions with them")
+	sourcePositions := flag.Bool("source-positions", false, "end the doc comment of each generated field method and entity client with where it was declared, e.g. \"from film.go:42\", for debugging")
+	entityPrefix := flag.String("entity-prefix", "", "prefix for the entity name in generated type names, e.g. Gen for GenFilmClient")
+	entitySuffix := flag.String("entity-suffix", "", "suffix for the entity name in generated type names, e.g. Model for FilmModelClient")
+	headerFile := flag.String("header", "", "file holding a header template for generated Go files, e.g. a license block; see generator.WithHeader")
+	importPath := flag.String("import-path", "", "import path of the -pkg package, imported by the generated CLI stub (default: from the go.mod enclosing -output)")
+	templateDir := flag.String("templates", "", "directory of .tmpl files that replace the built-in templates of the same name")
+	modelJSON := flag.String("model-json", "", "also write the parsed model as JSON to this file, for review or diffing")
+	jsonIndent := flag.String("json-indent", "  ", "indentation for JSON output such as -model-json; empty for compact")
+	format := flag.String("format", "text", "output format: text logs a summary of each entity and generates code; json writes the parsed model, and dot a Graphviz diagram of the entity graph, to stdout instead, generating nothing")
+	verbose := flag.Bool("v", false, "verbose: also log tag parsing decisions, inference reasoning, and each file written")
+	quiet := flag.Bool("q", false, "quiet: log errors only")
+	watchMode := flag.Bool("watch", false, "keep running, and regenerate whenever a source file of -pkg (or the -schema file) changes")
 	flag.Parse()
 
+	// Diagnostics go to stderr through a leveled logger: by default warnings
+	// and one line per entity and per generated package.
+	level := logging.Normal
+	switch {
+	case *verbose && *quiet:
+		fmt.Fprintln(os.Stderr, "-v and -q cannot be used together")
+		os.Exit(2)
+	case *verbose:
+		level = logging.Verbose
+	case *quiet:
+		level = logging.Quiet
+	}
+	switch *format {
+	case "text", "json", "dot":
+	default:
+		fmt.Fprintf(os.Stderr, "-format must be text, json, or dot, not %q\n", *format)
+		os.Exit(2)
+	}
+	if *watchMode && *format != "text" {
+		fmt.Fprintln(os.Stderr, "-watch and -format="+*format+" cannot be used together")
+		os.Exit(2)
+	}
+	// -strict-predicates and -field-name-predicates are short for a -naming
+	// value, and conflict with any other.
+	switch {
+	case *strictPredicates && *fieldNamePredicates:
+		fmt.Fprintln(os.Stderr, "-strict-predicates and -field-name-predicates cannot be used together")
+		os.Exit(2)
+	case *strictPredicates:
+		setNaming("-strict-predicates", "explicit")
+	case *fieldNamePredicates:
+		setNaming("-field-name-predicates", "field_name")
+	}
+	logger := logging.New(os.Stderr, level)
+	fatalf := func(format string, args ...any) {
+		logger.Errorf(format, args...)
+		os.Exit(1)
+	}
+
 	// Resolve the package directory.
 	dir := *pkgDir
 	if dir == "." {
 		var err error
 		dir, err = os.Getwd()
 		if err != nil {
-			log.Fatalf("failed to get working directory: %v", err)
+			fatalf("failed to get working directory: %v", err)
+		}
+	}
+
+	// Settings from a config file in the package directory apply unless the
+	// command line sets the same flags.
+	cfg, err := loadConfig(dir)
+	if err != nil {
+		fatalf("config error: %v", err)
+	}
+	if cfg != nil {
+		if err := cfg.apply(flag.CommandLine); err != nil {
+			fatalf("config error: %v", err)
 		}
+		logger.Debugf("read settings from %s", filepath.Join(dir, configFileName))
 	}
 
 	// Resolve the output directory.
@@ -41,26 +130,224 @@ func main() {
 		outDir = dir
 	}
 
-	// Parse phase: extract the model from Go source files.
-	pkg, err := parser.Parse(dir)
+	// Parse phase: extract the model from Go source files, or from a Dgraph
+	// schema file.
+	parseOpts := []parser.Option{parser.WithLogger(logger)}
+	naming, err := parser.ParsePredicateNaming(*predicateNaming)
 	if err != nil {
-		log.Fatalf("parse error: %v", err)
+		fmt.Fprintf(os.Stderr, "-naming: %v\n", err)
+		os.Exit(2)
+	}
+	parseOpts = append(parseOpts, parser.WithPredicateNaming(naming))
+	// genFlags are the flags of this run that the CLI stub's go:generate
+	// directive must repeat but that no generator option stands for.
+	var genFlags []string
+	if *predicateNaming != "json_tag" {
+		genFlags = append(genFlags, "-naming", *predicateNaming)
+	}
+	if *strictTags {
+		parseOpts = append(parseOpts, parser.WithStrictTags())
+		genFlags = append(genFlags, "-strict-tags")
+	}
+	if *allErrors {
+		parseOpts = append(parseOpts, parser.WithAllErrors())
+	}
+	parse, source := parser.Parse, dir
+	switch {
+	case *schemaFile != "" && *recursive:
+		fatalf("-schema and -recursive cannot be used together")
+	case *schemaFile != "":
+		parse, source = parser.ParseSchema, *schemaFile
+		genFlags = append(genFlags, "-schema", absPath(*schemaFile))
+	case *recursive:
+		parse = parser.ParseRecursive
+	}
+
+	// Generate phase options.
+	opts := []generator.Option{generator.WithLogger(logger)}
+	if *strict {
+		opts = append(opts, generator.WithStrict(func(msg string) {
+			logger.Warnf("%s", msg)
+		}))
+	}
+	if *mock {
+		opts = append(opts, generator.WithMock())
+	}
+	if *tests {
+		opts = append(opts, generator.WithTests())
+	}
+	if *packageName != "" {
+		opts = append(opts, generator.WithPackageName(*packageName))
+	}
+	if *noCLI {
+		opts = append(opts, generator.WithSkipCLI())
+	}
+	if *singleFile {
+		opts = append(opts, generator.WithSingleFile())
+	}
+	if *mermaid {
+		opts = append(opts, generator.WithMermaid())
+	}
+	if *graphql {
+		opts = append(opts, generator.WithGraphQL())
+	}
+	if *overlay {
+		opts = append(opts, generator.WithOverlay(func(msg string) {
+			logger.Warnf("%s", msg)
+		}))
+	}
+	if *sourcePositions {
+		opts = append(opts, generator.WithSourcePositions())
+	}
+	if *headerFile != "" {
+		text, err := os.ReadFile(*headerFile)
+		if err != nil {
+			fatalf("header: %v", err)
+		}
+		opts = append(opts, generator.WithHeader(string(text)))
+		genFlags = append(genFlags, "-header", absPath(*headerFile))
+	}
+	if *templateDir != "" {
+		opts = append(opts, generator.WithTemplateDir(*templateDir))
+	}
+	if *entityPrefix != "" || *entitySuffix != "" {
+		opts = append(opts, generator.WithTypeAffixes(*entityPrefix, *entitySuffix))
+	}
+	opts = append(opts, generator.WithGenerateFlags(genFlags...))
+
+	// regenerate runs the parse and generate phases, or with -format=json or
+	// -format=dot writes the model instead of generating code.
+	regenerate := func() error {
+		pkg, err := parse(source, parseOpts...)
+		var parseErrs parser.ParseErrors
+		if errors.As(err, &parseErrs) {
+			for _, pe := range parseErrs {
+				logger.Errorf("%v", pe)
+			}
+			return fmt.Errorf("parse error: %d problems", len(parseErrs))
+		}
+		if err != nil {
+			return fmt.Errorf("parse error: %w", err)
+		}
+
+		// Generating without the entities of a file saved mid-edit would drop
+		// them from the client, schema, and entity list, so the previous
+		// output is left as it is.
+		if len(pkg.Skipped) > 0 && !*allowPartial {
+			return fmt.Errorf("%s did not parse, so nothing was generated; fix it or pass -allow-partial",
+				strings.Join(pkg.Skipped, ", "))
+		}
+
+		if *format == "text" {
+			for _, e := range pkg.Entities {
+				searchInfo := ""
+				if e.Searchable {
+					searchInfo = fmt.Sprintf(", searchable on %s", e.SearchField)
+				}
+				logger.Infof("%s: %d fields%s", e.Name, len(e.Fields), searchInfo)
+			}
+		}
+
+		// Predicates are global in Dgraph, so entities sharing one, in any of
+		// the parsed packages, must declare it alike.
+		if err := generator.ValidatePredicates(pkg); err != nil {
+			return fmt.Errorf("predicate conflict: %w", err)
+		}
+
+		// A slice of a type that is not an entity, e.g. one since renamed, would
+		// otherwise be generated as a scalar list or an edge to nowhere.
+		if err := generator.ValidateEdges(pkg); err != nil {
+			return fmt.Errorf("dangling edge: %w", err)
+		}
+
+		if *modelJSON != "" {
+			f, err := os.Create(*modelJSON)
+			if err != nil {
+				return fmt.Errorf("model export error: %w", err)
+			}
+			err = generator.ExportModel(f, pkg, *jsonIndent)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return fmt.Errorf("model export error: %w", err)
+			}
+		}
+
+		// With -format=json or -format=dot the model is the output, for tools
+		// that read it rather than the generated code.
+		switch *format {
+		case "json":
+			if err := generator.ExportModel(os.Stdout, pkg, *jsonIndent); err != nil {
+				return fmt.Errorf("model export error: %w", err)
+			}
+			return nil
+		case "dot":
+			if err := generator.WriteDOT(os.Stdout, pkg); err != nil {
+				return fmt.Errorf("diagram error: %w", err)
+			}
+			return nil
+		}
+
+		// Generate phase: execute templates and write output files, once per Go
+		// package when -recursive found several.
+		for _, sub := range parser.SplitPackages(pkg) {
+			subDir, srcDir := outDir, dir
+			if len(sub.Entities) > 0 {
+				subDir = filepath.Join(outDir, sub.Entities[0].Dir)
+				srcDir = filepath.Join(dir, sub.Entities[0].Dir)
+			}
+			subOpts := append([]generator.Option{generator.WithOutputDir(subDir), generator.WithSourceDir(srcDir)}, opts...)
+			if *importPath != "" {
+				subPath := *importPath
+				if len(sub.Entities) > 0 {
+					subPath = path.Join(subPath, filepath.ToSlash(sub.Entities[0].Dir))
+				}
+				subOpts = append(subOpts, generator.WithImportPath(subPath))
+			}
+			if err := generator.Run(sub, subOpts...); err != nil {
+				return fmt.Errorf("generation error: %w", err)
+			}
+			logger.Infof("%s: generated into %s", sub.Name, subDir)
+		}
+		return nil
 	}
 
-	fmt.Printf("Package: %s\n", pkg.Name)
-	fmt.Printf("Entities: %d\n", len(pkg.Entities))
-	for _, e := range pkg.Entities {
-		searchInfo := ""
-		if e.Searchable {
-			searchInfo = fmt.Sprintf(" (searchable on %s)", e.SearchField)
+	if !*watchMode {
+		if err := regenerate(); err != nil {
+			fatalf("%v", err)
 		}
-		fmt.Printf("  - %s: %d fields%s\n", e.Name, len(e.Fields), searchInfo)
+		return
+	}
+	// Watch until interrupted, reporting each run rather than exiting on
+	// its errors.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	list := func() (map[string]fileStamp, error) {
+		return watchedFiles(dir, *recursive, *schemaFile)
 	}
+	if err := watch(ctx, list, regenerate, watchInterval, logger); err != nil {
+		fatalf("watch error: %v", err)
+	}
+}
 
-	// Generate phase: execute templates and write output files.
-	fmt.Printf("\nGenerating code into %s ...\n", outDir)
-	if err := generator.Generate(pkg, outDir); err != nil {
-		log.Fatalf("generation error: %v", err)
+// absPath returns path made absolute, or path itself if the working directory
+// cannot be told.
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
 	}
-	fmt.Println("Done.")
+	return path
+}
+
+// setNaming sets -naming to naming for alias, a flag short for it, and exits
+// with a usage error if the command line set -naming to anything else.
+func setNaming(alias, naming string) {
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "naming" && f.Value.String() != naming {
+			fmt.Fprintf(os.Stderr, "%s and -naming=%s cannot be used together\n", alias, f.Value)
+			os.Exit(2)
+		}
+	})
+	flag.Set("naming", naming)
 }
@@ -6,31 +6,65 @@ package model
 // Package represents the fully parsed target package and all its entities.
 type Package struct {
 	Name     string   // Go package name, e.g. "movies"
-	Entities []Entity // All detected entities (structs with UID + DType)
+	Entities []Entity // All detected entities (structs with UID + DType, and directive blocks), sorted by name within each package
+	External []Entity // Entities in other packages reachable through edges, named e.g. "people.Person"
+	Skipped  []string // Source files left out because they do not parse, e.g. mid-edit, as paths including the package directory
 }
 
 // Entity represents a single Dgraph type derived from a Go struct.
 type Entity struct {
-	Name        string  // Go struct name, e.g. "Film"
-	Fields      []Field // All exported fields from the struct
-	Searchable  bool    // True if the entity has a string field with index=fulltext
-	SearchField string  // Name of the field with fulltext index (empty if not searchable)
+	Name         string   // Go struct name, e.g. "Film"
+	Fields       []Field  // All exported fields from the struct, in declaration order
+	Searchable   bool     // True if the entity has a string field with index=fulltext
+	SearchField  string   // Name of the primary fulltext field: the search=primary one, else Name, else the first (empty if not searchable)
+	SearchFields []string // Names of all fields with a fulltext index, in declaration order
+	Dir          string   // Directory of the declaring package relative to the ParseRecursive root, e.g. "people"; empty from Parse
+	GoPackage    string   // Name of the declaring Go package; set by ParseRecursive only
+	Declaration  string   // Field lines of a "//modusGraphGen:entity" block, whose struct is generated; empty for Go structs
+	DgraphType   string   // Dgraph type name, e.g. "film"; the unqualified struct name unless a "//dgraph:type=" doc comment line overrides it
+	ReadOnly     bool     // True if a "//dgraph:readonly" doc comment line limits the generated client to reads
+	Doc          string   // Text of the struct's doc comment, without directive lines; empty if it has none
+	SourceFile   string   // File declaring the struct, directive block, or schema type, as a path including the package directory
+	Line         int      // Line of the declaration in SourceFile
 }
 
 // Field represents a single exported field within an entity struct.
 type Field struct {
-	Name       string   // Go field name, e.g. "InitialReleaseDate"
-	GoType     string   // Go type as string, e.g. "time.Time", "string", "[]Genre"
-	JSONTag    string   // Value from the json struct tag, e.g. "initialReleaseDate"
-	Predicate  string   // Resolved Dgraph predicate name
-	IsEdge     bool     // True if the field type is a slice of another entity
-	EdgeEntity string   // Target entity name for edge fields, e.g. "Genre"
-	IsReverse  bool     // True if dgraph tag contains "reverse" or predicate starts with "~"
-	HasCount   bool     // True if dgraph tag contains "count"
-	Indexes    []string // Parsed index directives, e.g. ["hash", "term", "trigram", "fulltext"]
-	TypeHint   string   // Value from dgraph "type=" directive, e.g. "geo", "datetime"
-	IsUID      bool     // True if the field represents the UID
-	IsDType    bool     // True if the field represents the DType (dgraph.type)
-	OmitEmpty  bool     // True if json tag contains ",omitempty"
-	Upsert     bool     // True if dgraph tag contains "upsert"
+	Name              string   // Go field name, e.g. "InitialReleaseDate"
+	GoType            string   // Go type as string, e.g. "time.Time", "string", "[]Genre"
+	UnderlyingType    string   // GoType with same-package named types and aliases resolved, e.g. "string" for Email
+	Embedded          string   // Embedded struct the field is inherited from, e.g. "Node"; empty for fields declared directly
+	Doc               string   // Text of the field's doc comment, or else its line comment; empty if it has neither
+	SourceFile        string   // File declaring the field, that of Embedded's struct for an inherited field
+	Line              int      // Line of the field declaration in SourceFile
+	JSONTag           string   // Value from the json struct tag, e.g. "initialReleaseDate"
+	Predicate         string   // Resolved Dgraph predicate name
+	ImplicitPredicate bool     // True if Predicate fell back to the json tag, or a name made from Name, for lack of a dgraph "predicate="
+	IsEdge            bool     // True if the field type is another entity, a pointer to one, or a slice or array of them
+	IsList            bool     // True if the field is a slice or array of a scalar, e.g. []string (a Dgraph list predicate)
+	IsMap             bool     // True if the field is a map without "locales=", e.g. map[string]string, stored as a JSON string
+	EdgeEntity        string   // Target entity name for edge fields, e.g. "Genre", or "people.Person" in another package
+	EdgePackage       string   // Import path of EdgeEntity's package when it is not the parsed package
+	IsSelfRef         bool     // True if the edge targets the entity that declares it, e.g. Person.Mentors
+	IsReverse         bool     // True if dgraph tag contains "reverse" or predicate starts with "~"
+	ForwardEntity     string   // For "~predicate" fields, the entity declaring the forward predicate (empty if not in this package)
+	HasCount          bool     // True if dgraph tag contains "count"
+	CountOf           string   // Predicate counted by a dgraph "count=" field, e.g. "performance"; such a field has no predicate of its own
+	FacetOrder        string   // Facet an edge is expanded in order of, from dgraph "orderasc=" or "orderdesc=", e.g. "billing_order"
+	FacetOrderDesc    bool     // True if FacetOrder came from "orderdesc="
+	Indexes           []string // Parsed index directives, e.g. ["hash", "term", "trigram", "fulltext"]
+	TypeHint          string   // Value from dgraph "type=" directive, e.g. "geo", "datetime"
+	TimeFormat        string   // Go layout of a datetime's JSON value from dgraph "format=", e.g. "2006-01-02"; empty for RFC 3339
+	VectorMetric      string   // Distance metric of an hnsw index from dgraph "metric=", e.g. "cosine"; empty without hnsw
+	IsUID             bool     // True if the field represents the UID
+	IsDType           bool     // True if the field represents the DType (dgraph.type)
+	OmitEmpty         bool     // True if json tag contains ",omitempty"
+	Upsert            bool     // True if dgraph tag contains "upsert"
+	Unique            bool     // True if dgraph tag contains "unique"
+	UniqueKey         string   // Composite unique key the field is part of, from dgraph "unique=<key>", e.g. "release"; the fields sharing it are unique together
+	PrimarySearch     bool     // True if dgraph tag contains "search=primary"
+	Required          bool     // True if dgraph tag contains "required"
+	Deprecated        bool     // True if dgraph tag contains "deprecated" or "deprecated=<note>"
+	DeprecationNote   string   // Note from dgraph "deprecated=", e.g. "use Title instead"; empty for a bare "deprecated"
+	Locales           []string // Language tags from dgraph "locales=" directive, e.g. ["en", "fr"]
 }
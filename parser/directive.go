@@ -0,0 +1,222 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// entityDirective starts a comment block that declares an entity without a Go
+// struct. Each following line of the block declares one field as it would
+// appear in a struct, and the generator emits the struct, with UID and DType
+// fields, alongside the entity's client:
+//
+//	//modusGraphGen:entity Award
+//	//	Name  string `json:"name,omitempty" dgraph:"index=hash,fulltext"`
+//	//	Year  int    `json:"year,omitempty" dgraph:"index=int"`
+//	//	Films []Film `json:"films,omitempty" dgraph:"predicate=award_film"`
+const entityDirective = "//modusGraphGen:entity"
+
+// declaredEntity is an entity declared by an entityDirective block.
+type declaredEntity struct {
+	name   string
+	pos    token.Position // Position of the directive
+	fields []*ast.Comment // The block's field lines
+}
+
+// collectDeclaredEntities returns the entities declared by entityDirective
+// blocks in the comments of file.
+func collectDeclaredEntities(fset *token.FileSet, file *ast.File) ([]declaredEntity, error) {
+	var declared []declaredEntity
+	for _, group := range file.Comments {
+		for i, c := range group.List {
+			name, ok := strings.CutPrefix(c.Text, entityDirective)
+			if !ok || (name != "" && name[0] != ' ' && name[0] != '\t') {
+				continue
+			}
+			pos := fset.Position(c.Pos())
+			name = strings.TrimSpace(name)
+			if !token.IsIdentifier(name) || !ast.IsExported(name) {
+				return nil, fmt.Errorf("%s: %s needs an exported entity name, got %q", pos, entityDirective, name)
+			}
+			d := declaredEntity{name: name, pos: pos}
+			for _, line := range group.List[i+1:] {
+				if strings.HasPrefix(line.Text, entityDirective) {
+					break
+				}
+				if strings.TrimSpace(strings.TrimPrefix(line.Text, "//")) != "" {
+					d.fields = append(d.fields, line)
+				}
+			}
+			declared = append(declared, d)
+		}
+	}
+	return declared, nil
+}
+
+// parseDeclaredEntity parses the fields of d into a model.Entity named with
+// qualifier, as parseStruct does for a struct. Errors are reported at the
+// block's lines. Field types may refer to the package's own types and to
+// time.Time, which are all that the generated struct can use without further
+// imports.
+func parseDeclaredEntity(fset *token.FileSet, qualifier string, d declaredEntity, targets map[string]edgeTarget, resolver *typeResolver) (parsedEntity, error) {
+	var src, decl strings.Builder
+	fmt.Fprintf(&src, "package p\n\ntype %s struct {\n", d.name)
+	// Line directives name the file by its base name: go/scanner takes a
+	// relative one to be in the directory of the file being parsed, which is
+	// that of d.
+	base := filepath.Base(d.pos.Filename)
+	// The implicit UID and DType fields are placed at the directive.
+	fmt.Fprintf(&src, "//line %s:%d\n\tUID   string   `json:\"uid,omitempty\"`\n", base, d.pos.Line)
+	fmt.Fprintf(&src, "//line %s:%d\n\tDType []string `json:\"dgraph.type,omitempty\"`\n", base, d.pos.Line)
+	for _, c := range d.fields {
+		// A line directive maps each field back to its comment line.
+		pos := fset.Position(c.Pos())
+		line := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		fmt.Fprintf(&src, "//line %s:%d\n\t%s\n", base, pos.Line, line)
+		decl.WriteString(line + "\n")
+	}
+	src.WriteString("}\n")
+
+	file, err := parser.ParseFile(fset, d.pos.Filename, src.String(), 0)
+	if err != nil {
+		return parsedEntity{}, fmt.Errorf("parsing %s %s: %w", entityDirective, d.name, err)
+	}
+	st := file.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type.(*ast.StructType)
+	for _, f := range st.Fields.List {
+		var bad string
+		ast.Inspect(f.Type, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if ok && bad == "" && typeString(sel) != "time.Time" {
+				bad = typeString(sel)
+			}
+			return bad == ""
+		})
+		if bad != "" {
+			pos := fset.Position(f.Pos())
+			return parsedEntity{}, fmt.Errorf("%s:%d: %s: type %s cannot be used in a %s block; declare a struct instead",
+				pos.Filename, pos.Line, d.name, bad, entityDirective)
+		}
+	}
+
+	p, _ := parseStruct(fset, qualifier+d.name, st, nil, targets, resolver, nil)
+	p.entity.Declaration = strings.TrimSuffix(decl.String(), "\n")
+	p.entity.SourceFile, p.entity.Line = d.pos.Filename, d.pos.Line
+	return p, nil
+}
+
+// structDirectivePrefix starts the lines of an entity struct's doc comment
+// that configure the entity as a whole, which a field's tags cannot:
+//
+//	// Film is stored as Dgraph type film.
+//	//
+//	//dgraph:type=film
+//	type Film struct { ... }
+const structDirectivePrefix = "//dgraph:"
+
+// structDirectives holds the settings read from the directives of a struct.
+type structDirectives struct {
+	dgraphType string // From type=<name>: the Dgraph type, if not the struct name
+	skip       bool   // From skip or generate=false: the struct is not an entity
+	readOnly   bool   // From readonly: only read methods are generated
+}
+
+// structDirectiveHandlers apply each struct directive, by name, to d. value is
+// the text after "=", or "" for a bare directive such as skip.
+var structDirectiveHandlers = map[string]func(d *structDirectives, value string) error{
+	"type": func(d *structDirectives, value string) error {
+		if value == "" || strings.ContainsAny(value, " \t()<>{}\"") {
+			return fmt.Errorf("type needs a Dgraph type name, got %q", value)
+		}
+		d.dgraphType = value
+		return nil
+	},
+	"skip": func(d *structDirectives, value string) error {
+		if value != "" {
+			return fmt.Errorf("skip takes no value, got %q", value)
+		}
+		d.skip = true
+		return nil
+	},
+	"generate": func(d *structDirectives, value string) error {
+		generate, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("generate needs true or false, got %q", value)
+		}
+		d.skip = !generate
+		return nil
+	},
+	"readonly": func(d *structDirectives, value string) error {
+		if value != "" {
+			return fmt.Errorf("readonly takes no value, got %q", value)
+		}
+		d.readOnly = true
+		return nil
+	},
+}
+
+// parseStructDirectives reads the structDirectivePrefix lines of doc, the doc
+// comment of the struct name. Unknown directives are skipped and reported in
+// unknown, like unknown tag directives, and known ones with a bad value, such
+// as an empty type=, in malformed, which always fail the parse: the entity
+// would otherwise be generated under another type name or despite a skip.
+// Both are reported with their position in fset.
+func parseStructDirectives(fset *token.FileSet, name string, doc *ast.CommentGroup) (d structDirectives, unknown, malformed []*ParseError) {
+	if doc == nil {
+		return d, nil, nil
+	}
+	for _, c := range doc.List {
+		text, ok := strings.CutPrefix(c.Text, structDirectivePrefix)
+		if !ok {
+			continue
+		}
+		pos := fset.Position(c.Pos())
+		key, value, _ := strings.Cut(strings.TrimSpace(text), "=")
+		handle, ok := structDirectiveHandlers[key]
+		if !ok {
+			unknown = append(unknown, &ParseError{File: pos.Filename, Line: pos.Line, Entity: name, Message: fmt.Sprintf("unknown struct directive %q", key)})
+			continue
+		}
+		if err := handle(&d, strings.TrimSpace(value)); err != nil {
+			malformed = append(malformed, &ParseError{File: pos.Filename, Line: pos.Line, Entity: name, Message: err.Error()})
+		}
+	}
+	return d, unknown, malformed
+}
+
+// isSkipped returns true if doc, a struct's doc comment, has a skip or
+// generate=false directive. Malformed directives are left for parseStruct to
+// report.
+func isSkipped(doc *ast.CommentGroup) bool {
+	d, _, _ := parseStructDirectives(token.NewFileSet(), "", doc)
+	return d.skip
+}
+
+// docText returns the text of doc, a struct or field comment, without its
+// directive lines, such as those read by parseStructDirectives, or "" if doc
+// is nil.
+func docText(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	var kept ast.CommentGroup
+	for _, c := range doc.List {
+		if !strings.HasPrefix(c.Text, structDirectivePrefix) {
+			kept.List = append(kept.List, c)
+		}
+	}
+	return strings.TrimSpace(kept.Text())
+}
+
+// structDoc returns the doc comment of typeSpec, declared in genDecl: its own,
+// or that of genDecl if it declares typeSpec alone.
+func structDoc(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) *ast.CommentGroup {
+	if typeSpec.Doc == nil && len(genDecl.Specs) == 1 {
+		return genDecl.Doc
+	}
+	return typeSpec.Doc
+}
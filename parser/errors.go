@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseError describes a problem with an entity field, with a directive of
+// the entity's struct, or with a source file that does not parse, located in
+// the source.
+type ParseError struct {
+	File    string // Source file name
+	Line    int    // Line of the field declaration, or of a syntax error
+	Entity  string // Struct name, e.g. "Film", or "" for a syntax error
+	Field   string // Field name, e.g. "Name", or "" for a struct directive
+	Message string // What is wrong, e.g. `unknown dgraph tag directive "indx=hash"`
+}
+
+// Error formats the problem as "file:line: Entity.Field: message",
+// "file:line: Entity: message" for a struct directive, or "file:line: message"
+// for a syntax error.
+func (e *ParseError) Error() string {
+	if e.Entity == "" {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	}
+	if e.Field == "" {
+		return fmt.Sprintf("%s:%d: %s: %s", e.File, e.Line, e.Entity, e.Message)
+	}
+	return fmt.Sprintf("%s:%d: %s.%s: %s", e.File, e.Line, e.Entity, e.Field, e.Message)
+}
+
+// ParseErrors is every problem Parse found with the entities of a package, in
+// order of position, as returned under WithAllErrors.
+type ParseErrors []*ParseError
+
+// Error formats each problem as ParseError does, one per line.
+func (e ParseErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns the problems, so that errors.As finds the first *ParseError
+// and callers can range over them all.
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// sort orders e by file and line.
+func (e ParseErrors) sort() {
+	sort.SliceStable(e, func(i, j int) bool {
+		if e[i].File != e[j].File {
+			return e[i].File < e[j].File
+		}
+		return e[i].Line < e[j].Line
+	})
+}
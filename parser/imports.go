@@ -0,0 +1,247 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// scope names the package whose structs are being parsed: empty for the
+// package passed to Parse, or the qualifier ("people.") and import path of a
+// package it imports.
+type scope struct {
+	qualifier string
+	path      string
+}
+
+// edgeTarget is the struct that an edge's element type refers to.
+type edgeTarget struct {
+	entity  string // Name, qualified when in another package, e.g. "people.Person"
+	pkgPath string // Import path of the package declaring it, empty for the parsed package
+}
+
+// module is the Go module enclosing the parsed package. Imports of other
+// packages in the same module are followed to find cross-package edges.
+type module struct {
+	dir  string // Directory containing go.mod
+	path string // Module path declared in go.mod
+}
+
+// findModule walks up from dir to the nearest go.mod. It returns nil if dir is
+// not inside a module.
+func findModule(dir string) (*module, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		f, err := os.Open(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			defer f.Close()
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				line := strings.TrimSpace(scanner.Text())
+				if rest, ok := strings.CutPrefix(line, "module"); ok && rest != line {
+					path := strings.TrimSpace(rest)
+					if unquoted, err := strconv.Unquote(path); err == nil {
+						path = unquoted
+					}
+					return &module{dir: dir, path: path}, nil
+				}
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%s: no module directive", f.Name())
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// importDir returns the directory of the package with the given import path,
+// and false if the path is outside the module.
+func (m *module) importDir(path string) (string, bool) {
+	if path == m.path {
+		return m.dir, true
+	}
+	rel, ok := strings.CutPrefix(path, m.path+"/")
+	if !ok {
+		return "", false
+	}
+	return filepath.Join(m.dir, filepath.FromSlash(rel)), true
+}
+
+// importedPackage holds the entities of a package imported by the parsed one.
+type importedPackage struct {
+	name     string
+	entities []model.Entity // Named with the package qualifier, e.g. "people.Person"
+}
+
+// importer loads the same-module packages that entity fields refer to, each at
+// most once.
+type importer struct {
+	fset *token.FileSet
+	mod  *module                     // nil outside a module
+	pkgs map[string]*importedPackage // By import path; nil while loading or if not loadable
+
+	naming PredicateNaming // From WithPredicateNaming, for every package loaded
+}
+
+// newImporter returns an importer for the module enclosing pkgDir.
+func newImporter(fset *token.FileSet, pkgDir string) (*importer, error) {
+	mod, err := findModule(pkgDir)
+	if err != nil {
+		return nil, err
+	}
+	return &importer{fset: fset, mod: mod, pkgs: make(map[string]*importedPackage)}, nil
+}
+
+// load parses the entities of the package with the given import path. It
+// returns nil if the package is outside the module.
+func (imp *importer) load(path string) (*importedPackage, error) {
+	if pkg, ok := imp.pkgs[path]; ok {
+		return pkg, nil
+	}
+	imp.pkgs[path] = nil
+	if imp.mod == nil {
+		return nil, nil
+	}
+	dir, ok := imp.mod.importDir(path)
+	if !ok {
+		return nil, nil
+	}
+	// Files of the imported package with syntax errors are left out quietly;
+	// they are reported when that package is parsed itself.
+	name, pkgAST, _, err := loadPackage(imp.fset, dir)
+	if err != nil {
+		return nil, fmt.Errorf("loading imported package %s: %w", path, err)
+	}
+	entities, err := parseEntities(imp.fset, pkgAST, scope{qualifier: name + ".", path: path}, imp, nil)
+	if err != nil {
+		return nil, err
+	}
+	pkg := &importedPackage{name: name, entities: entities}
+	imp.pkgs[path] = pkg
+	return pkg, nil
+}
+
+// localTargets returns the edge targets of a package's own exported structs,
+// keyed by type name.
+func localTargets(structNames map[string]bool, sc scope) map[string]edgeTarget {
+	targets := make(map[string]edgeTarget, len(structNames))
+	for name := range structNames {
+		targets[name] = edgeTarget{entity: sc.qualifier + name, pkgPath: sc.path}
+	}
+	return targets
+}
+
+// edgeTargets returns the structs that field types in file can refer to, keyed
+// by the type name as written there: own, the targets of the file's own
+// package from localTargets, and the entities of same-module packages it
+// imports, e.g. "people.Person". own itself is returned, unmodified, for a
+// file that imports no entities, so it must not be written to.
+func (imp *importer) edgeTargets(file *ast.File, own map[string]edgeTarget) (map[string]edgeTarget, error) {
+	targets, copied := own, false
+	used := typeQualifiers(file)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		local := pathpkg.Base(path)
+		if spec.Name != nil {
+			local = spec.Name.Name
+		}
+		if !used[local] {
+			continue
+		}
+		pkg, err := imp.load(path)
+		if err != nil {
+			return nil, err
+		}
+		if pkg == nil {
+			continue
+		}
+		if !copied {
+			targets = make(map[string]edgeTarget, len(own)+len(pkg.entities))
+			for name, target := range own {
+				targets[name] = target
+			}
+			copied = true
+		}
+		for _, e := range pkg.entities {
+			typeName := strings.TrimPrefix(e.Name, pkg.name+".")
+			targets[local+"."+typeName] = edgeTarget{entity: e.Name, pkgPath: path}
+		}
+	}
+	return targets, nil
+}
+
+// typeQualifiers returns the package names that qualify types in file's type
+// declarations, e.g. "people" for a field of type []people.Person.
+func typeQualifiers(file *ast.File) map[string]bool {
+	used := make(map[string]bool)
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		ast.Inspect(genDecl, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok {
+					used[x.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	return used
+}
+
+// external returns the imported entities reachable from entities through
+// edges, sorted by name.
+func (imp *importer) external(entities []model.Entity) []model.Entity {
+	byName := make(map[string]model.Entity)
+	for _, pkg := range imp.pkgs {
+		if pkg == nil {
+			continue
+		}
+		for _, e := range pkg.entities {
+			byName[e.Name] = e
+		}
+	}
+	var result []model.Entity
+	seen := make(map[string]bool)
+	queue := entities
+	for len(queue) > 0 {
+		e := queue[0]
+		queue = queue[1:]
+		for _, f := range e.Fields {
+			if !f.IsEdge || f.EdgePackage == "" || seen[f.EdgeEntity] {
+				continue
+			}
+			seen[f.EdgeEntity] = true
+			if target, ok := byName[f.EdgeEntity]; ok {
+				result = append(result, target)
+				queue = append(queue, target)
+			}
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -1,6 +1,11 @@
 package parser
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/inflect"
+	"github.com/mlwelles/modusGraphGen/logging"
 	"github.com/mlwelles/modusGraphGen/model"
 )
 
@@ -11,7 +16,9 @@ import (
 // Inference rules:
 //
 //   - Searchable: An entity is searchable if it has a string field with
-//     "fulltext" in its index list. The SearchField is set to that field's name.
+//     "fulltext" in its index list. SearchFields lists every such field in
+//     declaration order, and SearchField names the one marked
+//     "search=primary", or else the one named Name, or else the first.
 //
 //   - Relationships (edges): Already detected during struct parsing based on
 //     whether the field type is []OtherEntity.
@@ -25,15 +32,126 @@ import (
 //
 //   - Hash-filterable: A field with index=hash supports exact-match lookups.
 func applyInference(entity *model.Entity) {
+	rank := -1 // of SearchField: 2 for search=primary, 1 for Name, else 0
 	for _, f := range entity.Fields {
 		if f.IsUID || f.IsDType {
 			continue
 		}
 		// Searchable: string field with fulltext index.
-		if isStringType(f.GoType) && hasIndex(f.Indexes, "fulltext") {
+		if isStringType(f.UnderlyingType) && hasIndex(f.Indexes, "fulltext") {
 			entity.Searchable = true
-			entity.SearchField = f.Name
-			break // Use the first one found.
+			entity.SearchFields = append(entity.SearchFields, f.Name)
+			// The first field of the highest rank wins.
+			r := 0
+			if f.PrimarySearch {
+				r = 2
+			} else if f.Name == "Name" {
+				r = 1
+			}
+			if r > rank {
+				entity.SearchField = f.Name
+				rank = r
+			}
+		}
+	}
+}
+
+// logEntity explains through l's Debugf what Parse made of entity: each
+// field's predicate and where it came from, its indexes and kind, and why the
+// search field was chosen.
+func logEntity(l logging.Sink, entity model.Entity) {
+	if lg, ok := l.(*logging.Logger); ok && !lg.Enabled(logging.Verbose) {
+		return
+	}
+	l.Debugf("%s:", entity.Name)
+	for _, f := range entity.Fields {
+		var notes []string
+		switch {
+		case f.IsUID:
+			notes = append(notes, "uid")
+		case f.IsDType:
+			notes = append(notes, "dgraph.type")
+		case f.CountOf != "":
+			notes = append(notes, fmt.Sprintf("count of %s", f.CountOf))
+		case f.ImplicitPredicate && f.Predicate == f.JSONTag:
+			notes = append(notes, fmt.Sprintf("predicate %s (from the json tag)", f.Predicate))
+		case f.ImplicitPredicate && strings.HasSuffix(f.Predicate, "."+inflect.SnakeCase(f.Name)):
+			notes = append(notes, fmt.Sprintf("predicate %s (from the entity and field names)", f.Predicate))
+		case f.ImplicitPredicate:
+			notes = append(notes, fmt.Sprintf("predicate %s (from the field name)", f.Predicate))
+		case f.Predicate != "":
+			notes = append(notes, fmt.Sprintf("predicate %s", f.Predicate))
+		default:
+			notes = append(notes, "no predicate")
+		}
+		switch {
+		case f.IsEdge && strings.HasPrefix(f.Predicate, "~"):
+			notes = append(notes, "reverse edge to "+f.EdgeEntity)
+		case f.IsEdge && f.IsReverse:
+			notes = append(notes, "edge to "+f.EdgeEntity+" with @reverse")
+		case f.IsEdge:
+			notes = append(notes, "edge to "+f.EdgeEntity)
+		case f.IsList:
+			notes = append(notes, "list")
+		case f.IsMap:
+			notes = append(notes, "map stored as JSON")
+		case len(f.Locales) > 0:
+			notes = append(notes, "locales "+strings.Join(f.Locales, ","))
+		}
+		if len(f.Indexes) > 0 {
+			notes = append(notes, "index "+strings.Join(f.Indexes, ","))
+		}
+		if f.TypeHint != "" {
+			notes = append(notes, "type "+f.TypeHint)
+		}
+		l.Debugf("  %s: %s", f.Name, strings.Join(notes, ", "))
+	}
+	if entity.Searchable {
+		l.Debugf("  searchable on %s (%s)", entity.SearchField, searchReason(entity))
+	}
+}
+
+// searchReason returns why applyInference chose entity.SearchField.
+func searchReason(entity model.Entity) string {
+	for _, f := range entity.Fields {
+		if f.Name != entity.SearchField {
+			continue
+		}
+		switch {
+		case f.PrimarySearch:
+			return "search=primary"
+		case f.Name == "Name" && len(entity.SearchFields) > 1:
+			return "named Name"
+		}
+	}
+	if len(entity.SearchFields) > 1 {
+		return "first of " + strings.Join(entity.SearchFields, ", ")
+	}
+	return "only fulltext field"
+}
+
+// linkReverseEdges connects each reverse edge (a field whose predicate starts
+// with "~") to the entity that declares the forward predicate, recording it in
+// the field's ForwardEntity. A reverse edge whose forward predicate isn't
+// modeled in the package is left unlinked.
+func linkReverseEdges(entities []model.Entity) {
+	forward := make(map[string]string) // forward predicate → declaring entity
+	for _, e := range entities {
+		for _, f := range e.Fields {
+			if !f.IsEdge || f.Predicate == "" || strings.HasPrefix(f.Predicate, "~") {
+				continue
+			}
+			if _, ok := forward[f.Predicate]; !ok {
+				forward[f.Predicate] = e.Name
+			}
+		}
+	}
+	for i := range entities {
+		for j := range entities[i].Fields {
+			f := &entities[i].Fields[j]
+			if strings.HasPrefix(f.Predicate, "~") {
+				f.ForwardEntity = forward[f.Predicate[1:]]
+			}
 		}
 	}
 }
@@ -0,0 +1,142 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/mlwelles/modusGraphGen/logging"
+)
+
+// Option configures Parse.
+type Option func(*options)
+
+// options holds the settings applied by Option values.
+type options struct {
+	naming     PredicateNaming
+	strictTags bool
+	allErrors  bool
+	warn       func(err error)
+	log        logging.Sink
+}
+
+// newOptions returns the settings of opts, applied in order to the defaults.
+func newOptions(opts []Option) options {
+	cfg := options{log: logging.Discard}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// PredicateNaming is how Parse names the predicate of a field without a
+// dgraph "predicate=".
+type PredicateNaming int
+
+const (
+	// JSONTagNaming uses the json tag's name, or for a field without one the
+	// snake-cased field name. It is the default.
+	JSONTagNaming PredicateNaming = iota
+	// FieldNameNaming uses the snake-cased field name, e.g.
+	// "initial_release_date" for InitialReleaseDate, even if the field has a
+	// json tag.
+	FieldNameNaming
+	// EntityDotFieldNaming uses the snake-cased entity and field names joined
+	// by a dot, e.g. "director.film" for the Film field of Director, as
+	// Dgraph datasets often do. The json tag is not used.
+	EntityDotFieldNaming
+	// ExplicitNaming names no predicate: every field other than UID and DType
+	// must declare one.
+	ExplicitNaming
+)
+
+// predicateNamings are the names of the PredicateNaming values, as
+// ParsePredicateNaming reads them.
+var predicateNamings = []string{
+	JSONTagNaming:        "json_tag",
+	FieldNameNaming:      "field_name",
+	EntityDotFieldNaming: "entity_dot_field",
+	ExplicitNaming:       "explicit",
+}
+
+// String returns the name of n, e.g. "entity_dot_field".
+func (n PredicateNaming) String() string {
+	if n < 0 || int(n) >= len(predicateNamings) {
+		return fmt.Sprintf("PredicateNaming(%d)", int(n))
+	}
+	return predicateNamings[n]
+}
+
+// ParsePredicateNaming returns the PredicateNaming named s, one of
+// "json_tag", "field_name", "entity_dot_field", and "explicit".
+func ParsePredicateNaming(s string) (PredicateNaming, error) {
+	for n, name := range predicateNamings {
+		if s == name {
+			return PredicateNaming(n), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown predicate naming %q (want json_tag, field_name, entity_dot_field, or explicit)", s)
+}
+
+// WithPredicateNaming makes Parse name the predicate of each field without a
+// dgraph "predicate=" as n describes. The choice applies to imported packages
+// as well.
+func WithPredicateNaming(n PredicateNaming) Option {
+	return func(o *options) {
+		o.naming = n
+	}
+}
+
+// WithStrictPredicates makes Parse reject entity fields (other than UID and
+// DType) whose predicate would fall back to the json tag or field name, so
+// that every predicate must be declared with an explicit dgraph "predicate=".
+// It is WithPredicateNaming(ExplicitNaming).
+func WithStrictPredicates() Option {
+	return WithPredicateNaming(ExplicitNaming)
+}
+
+// WithStrictTags makes Parse fail with a *ParseError on the first entity field
+// whose dgraph tag contains an unknown directive, instead of skipping it.
+func WithStrictTags() Option {
+	return func(o *options) {
+		o.strictTags = true
+	}
+}
+
+// WithAllErrors makes Parse, as WithStrictTags does, fail on malformed dgraph
+// tags and struct directives, but only after checking every entity: the error
+// is a ParseErrors holding each problem found, including those of the strict
+// predicate checks, so that they can all be fixed at once.
+func WithAllErrors() Option {
+	return func(o *options) {
+		o.allErrors = true
+	}
+}
+
+// WithLogger makes Parse explain through l's Debugf how it read each entity
+// field's tags and which inference rules applied, and pass the problems it
+// skips over to l's Warnf unless WithWarnings is given. l may be a
+// *logging.Logger, which writes the explanations at logging.Verbose only.
+func WithLogger(l logging.Sink) Option {
+	return func(o *options) {
+		if l == nil {
+			l = logging.Discard
+		}
+		o.log = l
+	}
+}
+
+// WithWarnings passes each problem that Parse skips over, such as an unknown
+// dgraph tag directive, to warn. The error is a *ParseError.
+func WithWarnings(warn func(err error)) Option {
+	return func(o *options) {
+		o.warn = warn
+	}
+}
+
+// WithFieldNamePredicates makes the snake-cased field name, e.g.
+// "initial_release_date" for InitialReleaseDate, the predicate of each field
+// without a dgraph "predicate=", even if it has a json tag. By default the
+// json tag wins, and the field name is used only for fields without one. It
+// is WithPredicateNaming(FieldNameNaming).
+func WithFieldNamePredicates() Option {
+	return WithPredicateNaming(FieldNameNaming)
+}
@@ -1,53 +1,418 @@
 // Package parser extracts entity and field metadata from Go source files by
 // inspecting struct declarations and their struct tags. It uses go/ast and
 // go/parser to walk the AST, then builds a model.Package for the generator.
+// ParseSchema builds the same model from a Dgraph schema file instead.
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
+	"go/scanner"
 	"go/token"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
 
+	"github.com/mlwelles/modusGraphGen/inflect"
 	"github.com/mlwelles/modusGraphGen/model"
 )
 
 // Parse loads all Go source files in the directory at pkgDir, extracts exported
 // structs, and returns a model.Package with fully resolved entities and fields.
-func Parse(pkgDir string) (*model.Package, error) {
+// Field types are resolved with go/types, loading the packages they import
+// with go/packages. Edges whose element type is an entity in another package
+// of the same module, e.g. []people.Person, are followed into that package.
+//
+// A file with a syntax error, such as one being edited, is left out and listed
+// in the result's Skipped, and the entities of the other files are parsed as
+// usual. The error is a problem skipped over, like an unknown tag directive:
+// a warning, unless WithStrictTags or WithAllErrors makes it fail the parse.
+func Parse(pkgDir string, opts ...Option) (*model.Package, error) {
+	cfg := newOptions(opts)
+
 	fset := token.NewFileSet()
-	pkgs, err := parser.ParseDir(fset, pkgDir, nil, parser.ParseComments)
+	pkgName, pkgAST, broken, err := loadPackage(fset, pkgDir)
 	if err != nil {
-		return nil, fmt.Errorf("parsing package at %s: %w", pkgDir, err)
+		return nil, err
 	}
+	var errs ParseErrors
+	var skipped []string
+	for _, fileErr := range broken {
+		switch {
+		case cfg.allErrors:
+			errs = append(errs, fileErr)
+		case cfg.strictTags:
+			return nil, fileErr
+		case cfg.warn != nil:
+			cfg.warn(fileErr)
+		default:
+			cfg.log.Warnf("%v", fileErr)
+		}
+		skipped = append(skipped, fileErr.File)
+	}
+	imp, err := newImporter(fset, pkgDir)
+	if err != nil {
+		return nil, fmt.Errorf("finding module of %s: %w", pkgDir, err)
+	}
+	imp.naming = cfg.naming
 
-	if len(pkgs) == 0 {
-		return nil, fmt.Errorf("no Go packages found in %s", pkgDir)
+	entities, err := parseEntities(fset, pkgAST, scope{}, imp, &cfg)
+	var entityErrs ParseErrors
+	if len(errs) > 0 && (err == nil || errors.As(err, &entityErrs)) {
+		// Under WithAllErrors, report the syntax errors with the problems
+		// of the entities parsed without them.
+		errs = append(errs, entityErrs...)
+		errs.sort()
+		return nil, errs
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Link reverse edges to the entities declaring their forward predicates.
+	linkReverseEdges(entities)
+
+	return &model.Package{
+		Name:     pkgName,
+		Entities: entities,
+		External: imp.external(entities),
+		Skipped:  skipped,
+	}, nil
+}
+
+// loadPackage parses the Go source files in dir and returns the name and AST
+// of its non-test package. go/parser ignores build constraints, so a directory
+// can yield several, such as a "//go:build ignore" main beside the entities.
+// The choice is deterministic: the package named after the directory if there
+// is one, otherwise the only package other than main, with main only as a last
+// resort. Two or more packages that could each be the one meant, e.g. "alpha"
+// and "beta" in a directory named "films", are an error rather than a guess.
+//
+// Files with syntax errors are left out of the AST. Those of the chosen
+// package, or whose package cannot be told, are returned as broken, with a
+// *ParseError each for the first syntax error. If no file parses, the first
+// error is returned instead.
+func loadPackage(fset *token.FileSet, dir string) (string, *ast.Package, []*ParseError, error) {
+	pkgs, broken, err := parseDir(fset, dir)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("parsing package at %s: %w", dir, err)
+	}
+	name, pkg, err := choosePackage(dir, pkgs)
+	if err != nil {
+		return "", nil, nil, err
 	}
+	var result []*ParseError
+	for _, b := range broken {
+		if b.pkg == "" || b.pkg == name {
+			result = append(result, b.err)
+		}
+	}
+	return name, pkg, result, nil
+}
 
-	// Take the first (and typically only) non-test package.
-	var pkgName string
-	var pkgAST *ast.Package
-	for name, pkg := range pkgs {
-		if strings.HasSuffix(name, "_test") {
+// brokenFile is a source file with a syntax error, and the name in its package
+// clause, or "" if that cannot be read.
+type brokenFile struct {
+	err *ParseError
+	pkg string
+}
+
+// parseDir parses the Go source files in dir as parser.ParseDir does, but
+// leaves out the files with syntax errors instead of failing, returning them
+// as broken. It fails only if there are no Go files, or none of them parse.
+func parseDir(fset *token.FileSet, dir string) (map[string]*ast.Package, []brokenFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkgs := make(map[string]*ast.Package)
+	var broken []brokenFile
+	var firstErr error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			b := brokenFile{err: &ParseError{File: path, Line: list[0].Pos.Line, Message: list[0].Msg}}
+			if file != nil && file.Name != nil && file.Name.Name != "_" {
+				b.pkg = file.Name.Name
+			}
+			broken = append(broken, b)
+			if firstErr == nil {
+				firstErr = err
+			}
 			continue
 		}
-		pkgName = name
-		pkgAST = pkg
-		break
+		if err != nil {
+			return nil, nil, err
+		}
+		name := file.Name.Name
+		pkg, ok := pkgs[name]
+		if !ok {
+			pkg = &ast.Package{Name: name, Files: make(map[string]*ast.File)}
+			pkgs[name] = pkg
+		}
+		pkg.Files[path] = file
+	}
+	if len(pkgs) == 0 && firstErr != nil {
+		return nil, nil, firstErr
 	}
-	if pkgAST == nil {
-		return nil, fmt.Errorf("no non-test package found in %s", pkgDir)
+	return pkgs, broken, nil
+}
+
+// choosePackage returns the name and AST of the package of dir among pkgs, as
+// loadPackage describes.
+func choosePackage(dir string, pkgs map[string]*ast.Package) (string, *ast.Package, error) {
+	if len(pkgs) == 0 {
+		return "", nil, fmt.Errorf("no Go packages found in %s", dir)
 	}
 
-	// First pass: collect all struct names so we can identify edges.
+	base := filepath.Base(dir)
+	if abs, err := filepath.Abs(dir); err == nil {
+		base = filepath.Base(abs)
+	}
+	if pkg, ok := pkgs[base]; ok {
+		return base, pkg, nil
+	}
+	var names []string
+	for name := range pkgs {
+		if !strings.HasSuffix(name, "_test") && name != "main" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	switch {
+	case len(names) == 1:
+		return names[0], pkgs[names[0]], nil
+	case len(names) > 1:
+		return "", nil, fmt.Errorf("%s holds packages %s, and none is named after the directory; move all but one elsewhere",
+			dir, strings.Join(names, ", "))
+	}
+	if pkg, ok := pkgs["main"]; ok {
+		return "main", pkg, nil
+	}
+	return "", nil, fmt.Errorf("no non-test package found in %s", dir)
+}
+
+// parseEntities parses the entity structs of pkgAST. Tag problems are reported
+// and strict checks applied according to cfg, or ignored if cfg is nil, as for
+// imported packages.
+func parseEntities(fset *token.FileSet, pkgAST *ast.Package, sc scope, imp *importer, cfg *options) ([]model.Entity, error) {
+	// First pass: collect all struct names so we can identify edges,
+	// including the entities declared by directive blocks.
 	structNames := collectStructNames(pkgAST)
+	resolver := newTypeResolver(fset, pkgAST, imp.mod)
+	structs, bases := collectStructs(pkgAST, resolver)
+	files := sortedFiles(pkgAST)
+	declared := make(map[*ast.File][]declaredEntity)
+	for _, file := range files {
+		decls, err := collectDeclaredEntities(fset, file)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range decls {
+			if structNames[d.name] {
+				return nil, fmt.Errorf("%s: entity %s is declared by both a struct and %s", d.pos, d.name, entityDirective)
+			}
+			structNames[d.name] = true
+		}
+		declared[file] = decls
+	}
 
-	// Second pass: parse each struct into an Entity.
+	// Resolve each file's edge targets up front: loading imported packages
+	// goes through the importer's cache, which is not safe for concurrent use.
+	own := localTargets(structNames, sc)
+	targets := make([]map[string]edgeTarget, len(files))
+	for i, file := range files {
+		t, err := imp.edgeTargets(file, own)
+		if err != nil {
+			return nil, err
+		}
+		targets[i] = t
+	}
+
+	// Second pass: parse each file's structs into entities, with a bounded
+	// pool of workers.
+	results := make([]fileEntities, len(files))
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				results[i] = parseFileEntities(fset, files[i], sc, targets[i], resolver, structs, bases, declared[files[i]])
+			}
+		}()
+	}
+	for i := range files {
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	// Merge in entity name order, so that the output does not depend on how
+	// the work was scheduled, then report and check each entity in turn.
+	var parsed []parsedEntity
+	for _, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		parsed = append(parsed, r.entities...)
+	}
+	return checkEntities(parsed, imp.naming, cfg)
+}
+
+// checkEntities sorts parsed by entity name, then renames the implicit
+// predicates of each entity as naming asks, reports its tag problems and
+// applies the strict checks according to cfg, as parseEntities describes, and
+// returns the entities. Under WithAllErrors, the problems of every entity are
+// returned together as ParseErrors.
+func checkEntities(parsed []parsedEntity, naming PredicateNaming, cfg *options) ([]model.Entity, error) {
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return parsed[i].entity.Name < parsed[j].entity.Name
+	})
+	var errs ParseErrors
+	// fail records err, and returns true if it is to be returned at once.
+	fail := func(err *ParseError) bool {
+		errs = append(errs, err)
+		return cfg == nil || !cfg.allErrors
+	}
 	var entities []model.Entity
-	for _, file := range pkgAST.Files {
+	for _, p := range parsed {
+		switch naming {
+		case FieldNameNaming:
+			preferFieldNames(&p.entity, "")
+		case EntityDotFieldNaming:
+			name := p.entity.Name[strings.LastIndex(p.entity.Name, ".")+1:]
+			preferFieldNames(&p.entity, inflect.SnakeCase(name)+".")
+		}
+		for _, err := range p.directiveErrs {
+			if fail(err) {
+				return nil, err
+			}
+		}
+		if err := checkPrimarySearch(p); err != nil && fail(err) {
+			return nil, err
+		}
+		for _, err := range checkUnique(p) {
+			if fail(err) {
+				return nil, err
+			}
+		}
+		if cfg != nil {
+			for _, tagErr := range p.tagErrs {
+				if cfg.strictTags || cfg.allErrors {
+					if fail(tagErr) {
+						return nil, tagErr
+					}
+				} else if cfg.warn != nil {
+					cfg.warn(tagErr)
+				} else {
+					cfg.log.Warnf("%v", tagErr)
+				}
+			}
+			if naming == ExplicitNaming {
+				for _, err := range checkExplicitPredicates(p) {
+					if fail(err) {
+						return nil, err
+					}
+				}
+			}
+			logEntity(cfg.log, p.entity)
+		}
+		entities = append(entities, p.entity)
+	}
+	if len(errs) > 0 {
+		errs.sort()
+		return nil, errs
+	}
+	return entities, nil
+}
+
+// parsedEntity is an entity parsed from a struct or directive block, with the
+// problems found in its tags.
+type parsedEntity struct {
+	entity        model.Entity
+	tagErrs       []*ParseError
+	directiveErrs []*ParseError // Malformed struct directives, always errors
+}
+
+// fileEntities is the result of parsing the entities of one file.
+type fileEntities struct {
+	entities []parsedEntity
+	err      error
+}
+
+// sortedFiles returns the files of pkg in order of file name.
+func sortedFiles(pkg *ast.Package) []*ast.File {
+	names := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	files := make([]*ast.File, len(names))
+	for i, name := range names {
+		files[i] = pkg.Files[name]
+	}
+	return files
+}
+
+// parseFileEntities parses the exported entity structs of file, other than
+// embedded bases, and the entities declared by its directive blocks. It only
+// reads its arguments, so files can be parsed concurrently.
+func parseFileEntities(fset *token.FileSet, file *ast.File, sc scope, targets map[string]edgeTarget, resolver *typeResolver, structs map[string]*ast.StructType, bases map[string]bool, declared []declaredEntity) fileEntities {
+	var result fileEntities
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec, ok := spec.(*ast.TypeSpec)
+			if !ok {
+				continue
+			}
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			if !typeSpec.Name.IsExported() || bases[typeSpec.Name.Name] {
+				continue
+			}
+
+			p, isEntity := parseStruct(fset, sc.qualifier+typeSpec.Name.Name, structType, structDoc(genDecl, typeSpec), targets, resolver, structs)
+			if !isEntity {
+				continue
+			}
+			result.entities = append(result.entities, p)
+		}
+	}
+	for _, d := range declared {
+		p, err := parseDeclaredEntity(fset, sc.qualifier, d, targets, resolver)
+		if err != nil {
+			result.err = err
+			return result
+		}
+		result.entities = append(result.entities, p)
+	}
+	return result
+}
+
+// collectStructNames returns a set of all exported struct type names in the
+// package, except those with a skip directive.
+func collectStructNames(pkg *ast.Package) map[string]bool {
+	names := make(map[string]bool)
+	for _, file := range pkg.Files {
 		for _, decl := range file.Decls {
 			genDecl, ok := decl.(*ast.GenDecl)
 			if !ok || genDecl.Tok != token.TYPE {
@@ -58,31 +423,146 @@ func Parse(pkgDir string) (*model.Package, error) {
 				if !ok {
 					continue
 				}
-				structType, ok := typeSpec.Type.(*ast.StructType)
+				if _, ok := typeSpec.Type.(*ast.StructType); ok {
+					if typeSpec.Name.IsExported() && !isSkipped(structDoc(genDecl, typeSpec)) {
+						names[typeSpec.Name.Name] = true
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// collectStructs returns every struct type declared in the package by name,
+// for entities to inherit the fields of those they embed, and the set of
+// embedded bases, which are not entities themselves. An embedded struct is a
+// base if it declares no fields but UID and DType, as a shared Node does, or
+// if more than one struct embeds it. One that a single struct extends, such as
+// Film in type DetailedFilm struct{ Film; Budget int }, stays an entity, and
+// so does any struct that an edge points to.
+func collectStructs(pkg *ast.Package, resolver *typeResolver) (map[string]*ast.StructType, map[string]bool) {
+	structs := make(map[string]*ast.StructType)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
 				if !ok {
 					continue
 				}
-				if !typeSpec.Name.IsExported() {
+				st, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
 					continue
 				}
-
-				entity, isEntity := parseStruct(typeSpec.Name.Name, structType, structNames)
-				if isEntity {
-					entities = append(entities, entity)
+				structs[typeSpec.Name.Name] = st
+			}
+		}
+	}
+	embedders := make(map[string]int)
+	targets := make(map[string]bool)
+	for _, st := range structs {
+		for _, f := range st.Fields.List {
+			if len(f.Names) == 0 {
+				if base, ok := resolver.embeddedStruct(f.Type, structs); ok {
+					embedders[base]++
 				}
+			} else if name, ok := edgeStructName(f.Type); ok {
+				targets[name] = true
+			}
+		}
+	}
+	bases := make(map[string]bool)
+	for name, n := range embedders {
+		if !targets[name] && (n > 1 || !declaresPredicates(structs[name])) {
+			bases[name] = true
+		}
+	}
+	return structs, bases
+}
+
+// edgeStructName returns the name of the struct that a field of type expr,
+// such as Film, *Film, []Film, or []*Film, may be an edge to.
+func edgeStructName(expr ast.Expr) (string, bool) {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ArrayType:
+			expr = e.Elt
+		case *ast.Ident:
+			return e.Name, true
+		default:
+			return "", false
+		}
+	}
+}
+
+// declaresPredicates returns true if st declares an exported field of its own
+// other than UID and DType.
+func declaresPredicates(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		for _, name := range f.Names {
+			if name.IsExported() && name.Name != "UID" && name.Name != "DType" {
+				return true
 			}
 		}
 	}
+	return false
+}
 
-	return &model.Package{
-		Name:     pkgName,
-		Entities: entities,
-	}, nil
+// structField is a field of an entity struct, declared directly or inherited
+// from an embedded struct.
+type structField struct {
+	*ast.Field
+	embedded string // Embedded struct of the entity it comes from; empty if declared directly
 }
 
-// collectStructNames returns a set of all exported struct type names in the package.
-func collectStructNames(pkg *ast.Package) map[string]bool {
-	names := make(map[string]bool)
+// flattenFields returns the named fields of st in declaration order, with the
+// fields of each same-package struct it embeds by value in place of the
+// embedded field, recursively. As in Go, a field declared directly shadows an
+// inherited one of the same name. Pointer and other-package embeds are
+// skipped, as is any struct already being flattened. An alias of a struct,
+// e.g. "type Base = Node", is flattened as the struct, as resolver finds it.
+func flattenFields(st *ast.StructType, structs map[string]*ast.StructType, resolver *typeResolver, seen map[string]bool) []structField {
+	own := make(map[string]bool)
+	for _, f := range st.Fields.List {
+		for _, n := range f.Names {
+			own[n.Name] = true
+		}
+	}
+	var result []structField
+	for _, f := range st.Fields.List {
+		if len(f.Names) > 0 {
+			result = append(result, structField{Field: f})
+			continue
+		}
+		base, ok := resolver.embeddedStruct(f.Type, structs)
+		if !ok || seen[base] {
+			continue
+		}
+		seen[base] = true
+		for _, inherited := range flattenFields(structs[base], structs, resolver, seen) {
+			if own[inherited.Names[0].Name] {
+				continue
+			}
+			own[inherited.Names[0].Name] = true
+			inherited.embedded = typeString(f.Type)
+			result = append(result, inherited)
+		}
+		delete(seen, base)
+	}
+	return result
+}
+
+// collectTypeDecls returns the non-struct type declarations in the package,
+// both named types ("type Email string") and aliases ("type Timestamp =
+// time.Time"), mapped to the type string they are declared as.
+func collectTypeDecls(pkg *ast.Package) map[string]string {
+	decls := make(map[string]string)
 	for _, file := range pkg.Files {
 		for _, decl := range file.Decls {
 			genDecl, ok := decl.(*ast.GenDecl)
@@ -95,37 +575,111 @@ func collectStructNames(pkg *ast.Package) map[string]bool {
 					continue
 				}
 				if _, ok := typeSpec.Type.(*ast.StructType); ok {
-					if typeSpec.Name.IsExported() {
-						names[typeSpec.Name.Name] = true
-					}
+					continue
 				}
+				decls[typeSpec.Name.Name] = typeString(typeSpec.Type)
 			}
 		}
 	}
-	return names
+	return decls
 }
 
-// parseStruct parses a single struct into a model.Entity. Returns the entity and
-// true if the struct qualifies as an entity (has both UID and DType fields),
-// or a zero Entity and false otherwise.
-func parseStruct(name string, st *ast.StructType, structNames map[string]bool) (model.Entity, bool) {
+// resolveType resolves the same-package named types and aliases in goType to
+// the types they are declared as, e.g. "[]Email" becomes "[]string" given
+// "type Email string". Struct names are left alone so edges keep their entity.
+func resolveType(goType string, typeDecls map[string]string) string {
+	if elem, ok := listElem(goType); ok {
+		return goType[:len(goType)-len(elem)] + resolveType(elem, typeDecls)
+	}
+	if strings.HasPrefix(goType, "*") {
+		return "*" + resolveType(goType[1:], typeDecls)
+	}
+	if strings.HasPrefix(goType, "map[") {
+		if i := strings.Index(goType, "]"); i >= 0 {
+			return "map[" + resolveType(goType[len("map["):i], typeDecls) + "]" +
+				resolveType(goType[i+1:], typeDecls)
+		}
+	}
+	// Follow chains such as "type A B; type B string". The bound guards
+	// against cyclic declarations, which the compiler rejects anyway.
+	for i := 0; i < len(typeDecls); i++ {
+		declared, ok := typeDecls[goType]
+		if !ok {
+			return goType
+		}
+		if !isIdent(declared) {
+			return resolveType(declared, typeDecls)
+		}
+		goType = declared
+	}
+	return goType
+}
+
+// listElem returns the element type of a slice or array type, e.g. "Genre"
+// for "[]Genre" or "[3]Genre", and false for any other type.
+func listElem(goType string) (string, bool) {
+	if !strings.HasPrefix(goType, "[") {
+		return "", false
+	}
+	i := strings.Index(goType, "]")
+	if i < 0 {
+		return "", false
+	}
+	return goType[i+1:], true
+}
+
+// isIdent returns true if goType is a plain or package-qualified type name
+// rather than a composite type such as a slice, pointer, or map.
+func isIdent(goType string) bool {
+	return !strings.ContainsAny(goType, "[]*")
+}
+
+// parseStruct parses a single struct, documented by doc, into a model.Entity.
+// Returns the entity and true if the struct qualifies as an entity (has both
+// UID and DType fields, possibly inherited from an embedded struct in structs,
+// and no skip directive), or a zero parsedEntity and false otherwise. Edge
+// element types are looked up in targets. Malformed dgraph tags and unknown
+// struct directives are skipped and reported, with their position in fset, in
+// the tagErrs of the result, and malformed struct directives in its
+// directiveErrs.
+func parseStruct(fset *token.FileSet, name string, st *ast.StructType, doc *ast.CommentGroup, targets map[string]edgeTarget, resolver *typeResolver, structs map[string]*ast.StructType) (parsedEntity, bool) {
+	directives, tagErrs, directiveErrs := parseStructDirectives(fset, name, doc)
+	if directives.skip {
+		return parsedEntity{}, false
+	}
 	var fields []model.Field
 	hasUID := false
 	hasDType := false
 
-	for _, f := range st.Fields.List {
-		if len(f.Names) == 0 {
-			continue // embedded field, skip
-		}
+	for _, sf := range flattenFields(st, structs, resolver, map[string]bool{}) {
+		f := sf.Field
 		fieldName := f.Names[0].Name
 		if !ast.IsExported(fieldName) {
 			continue
 		}
 
 		goType := typeString(f.Type)
+		underlying := resolver.resolve(f.Type, goType)
 		field := model.Field{
-			Name:   fieldName,
-			GoType: goType,
+			Name:           fieldName,
+			GoType:         goType,
+			UnderlyingType: underlying,
+			Embedded:       sf.embedded,
+			Doc:            docText(f.Doc),
+		}
+		if field.Doc == "" {
+			field.Doc = docText(f.Comment)
+		}
+		pos := fset.Position(f.Pos())
+		field.SourceFile, field.Line = pos.Filename, pos.Line
+		report := func(err error) {
+			tagErrs = append(tagErrs, &ParseError{
+				File:    pos.Filename,
+				Line:    pos.Line,
+				Entity:  name,
+				Field:   fieldName,
+				Message: err.Error(),
+			})
 		}
 
 		// Parse struct tags.
@@ -146,7 +700,14 @@ func parseStruct(name string, st *ast.StructType, structNames map[string]bool) (
 			// Parse dgraph tag.
 			dgraphTag := tag.Get("dgraph")
 			if dgraphTag != "" {
-				parseDgraphTag(dgraphTag, &field)
+				err := parseDgraphTag(dgraphTag, &field)
+				if err == nil && field.PrimarySearch && !hasIndex(field.Indexes, "fulltext") {
+					err = errors.New("search=primary requires index=fulltext")
+					field.PrimarySearch = false
+				}
+				if err != nil {
+					report(err)
+				}
 			}
 		}
 
@@ -160,18 +721,81 @@ func parseStruct(name string, st *ast.StructType, structNames map[string]bool) (
 			hasDType = true
 		}
 
-		// Resolve predicate: use explicit predicate if set, else fall back to json tag.
-		if field.Predicate == "" {
+		// Resolve predicate: use explicit predicate if set, else fall back to
+		// the json tag, else to the snake-cased field name. A count= field
+		// holds a computed count and has none.
+		if field.CountOf != "" {
+			field.Predicate = ""
+		} else if field.Predicate == "" {
 			field.Predicate = field.JSONTag
+			if field.Predicate == "" && !field.IsUID && !field.IsDType {
+				field.Predicate = inflect.SnakeCase(fieldName)
+			}
+			field.ImplicitPredicate = field.Predicate != ""
+		}
+
+		// An hnsw index holds an embedding, stored whole as a float32vector
+		// rather than as a scalar list.
+		if hasIndex(field.Indexes, "hnsw") {
+			if underlying != "[]float32" && underlying != "[]float64" {
+				report(errors.New("index=hnsw requires a []float32 or []float64 field"))
+				var kept []string
+				for _, idx := range field.Indexes {
+					if idx != "hnsw" {
+						kept = append(kept, idx)
+					}
+				}
+				field.Indexes = kept
+				field.VectorMetric = ""
+			} else if field.VectorMetric == "" {
+				field.VectorMetric = "cosine"
+			}
+		} else if field.VectorMetric != "" {
+			report(errors.New("metric= requires index=hnsw"))
+			field.VectorMetric = ""
 		}
 
-		// Detect edges: field type is []SomeEntity where SomeEntity is a known struct.
-		if strings.HasPrefix(goType, "[]") {
-			elemType := goType[2:]
-			if structNames[elemType] {
+		// Detect edges: field type is []SomeEntity or []*SomeEntity where
+		// SomeEntity is a known struct, or []pkg.SomeEntity for an entity in
+		// another package. Any other slice is a scalar list, except DType, geo
+		// values, and byte slices, which Dgraph stores as a single value. The
+		// underlying type is used so aliases such as "type Crew = []Person" are
+		// detected too. Vectors are single values as well. Fixed-size arrays
+		// such as [3]SomeEntity are treated like slices.
+		if elem, ok := listElem(underlying); ok {
+			elemType := strings.TrimPrefix(elem, "*")
+			if target, ok := targets[elemType]; ok {
 				field.IsEdge = true
-				field.EdgeEntity = elemType
+				field.EdgeEntity = target.entity
+				field.EdgePackage = target.pkgPath
+				field.IsSelfRef = target.entity == name
+			} else if !field.IsDType && field.TypeHint != "geo" && field.VectorMetric == "" && !isBytesType(underlying) {
+				field.IsList = true
 			}
+		} else if target, ok := targets[strings.TrimPrefix(underlying, "*")]; ok {
+			// A single entity, e.g. *Film, is an edge to one node.
+			field.IsEdge = true
+			field.EdgeEntity = target.entity
+			field.EdgePackage = target.pkgPath
+			field.IsSelfRef = target.entity == name
+		}
+
+		// A layout applies to the JSON value of a datetime.
+		if field.TimeFormat != "" && strings.TrimPrefix(underlying, "*") != "time.Time" {
+			report(errors.New("format= requires a time.Time field"))
+			field.TimeFormat = ""
+		}
+
+		// Facet ordering applies to the expansion of an edge.
+		if field.FacetOrder != "" && !field.IsEdge {
+			report(errors.New("orderasc= and orderdesc= require an edge"))
+			field.FacetOrder, field.FacetOrderDesc = "", false
+		}
+
+		// A map holds per-language values with locales=, and is otherwise
+		// stored whole as a JSON string.
+		if strings.HasPrefix(underlying, "map[") && len(field.Locales) == 0 {
+			field.IsMap = true
 		}
 
 		// Detect reverse edges from predicate.
@@ -183,18 +807,117 @@ func parseStruct(name string, st *ast.StructType, structNames map[string]bool) (
 	}
 
 	if !hasUID || !hasDType {
-		return model.Entity{}, false
+		return parsedEntity{}, false
 	}
 
 	entity := model.Entity{
-		Name:   name,
-		Fields: fields,
+		Name:       name,
+		Fields:     fields,
+		DgraphType: name[strings.LastIndex(name, ".")+1:],
+	}
+	if directives.dgraphType != "" {
+		entity.DgraphType = directives.dgraphType
 	}
+	entity.ReadOnly = directives.readOnly
+	entity.Doc = docText(doc)
+	pos := fset.Position(st.Pos())
+	entity.SourceFile, entity.Line = pos.Filename, pos.Line
 
 	// Apply inference rules.
 	applyInference(&entity)
 
-	return entity, true
+	return parsedEntity{entity: entity, tagErrs: tagErrs, directiveErrs: directiveErrs}, true
+}
+
+// preferFieldNames gives each field of entity whose predicate fell back to
+// the json tag or field name the snake-cased field name, after prefix, as its
+// predicate instead, as FieldNameNaming and EntityDotFieldNaming ask.
+func preferFieldNames(entity *model.Entity, prefix string) {
+	for i := range entity.Fields {
+		f := &entity.Fields[i]
+		if f.ImplicitPredicate && !f.IsUID && !f.IsDType && f.JSONTag != "-" {
+			f.Predicate = prefix + inflect.SnakeCase(f.Name)
+		}
+	}
+}
+
+// checkExplicitPredicates returns an error for each field of p whose
+// predicate came from the json tag or field name rather than a dgraph
+// "predicate=".
+func checkExplicitPredicates(p parsedEntity) []*ParseError {
+	var errs []*ParseError
+	for _, f := range p.entity.Fields {
+		if f.IsUID || f.IsDType || f.JSONTag == "-" || f.CountOf != "" {
+			continue
+		}
+		if f.ImplicitPredicate || f.Predicate == "" {
+			errs = append(errs, p.fieldError(f.Name, "no explicit dgraph predicate= (strict predicates forbid the json tag and field name fallbacks)"))
+		}
+	}
+	return errs
+}
+
+// checkPrimarySearch returns an error if more than one field of p is tagged
+// search=primary, which would leave its Search field ambiguous.
+func checkPrimarySearch(p parsedEntity) *ParseError {
+	primary := ""
+	for _, f := range p.entity.Fields {
+		if !f.PrimarySearch {
+			continue
+		}
+		if primary != "" {
+			err := p.fieldError(f.Name, fmt.Sprintf("search=primary is set on both %s and %s", primary, f.Name))
+			err.Field = ""
+			return err
+		}
+		primary = f.Name
+	}
+	return nil
+}
+
+// checkUnique returns an error for each field of p tagged unique without an
+// index, which Dgraph's @unique needs to look values up, and for each
+// unique=<key> that no other field of p shares, which would make a composite
+// key of one field.
+func checkUnique(p parsedEntity) []*ParseError {
+	var errs []*ParseError
+	keys := make(map[string][]string)
+	for _, f := range p.entity.Fields {
+		if f.Unique && len(f.Indexes) == 0 {
+			errs = append(errs, p.fieldError(f.Name, "unique requires an index, e.g. index=hash"))
+		}
+		if f.UniqueKey != "" {
+			keys[f.UniqueKey] = append(keys[f.UniqueKey], f.Name)
+		}
+	}
+	for _, f := range p.entity.Fields {
+		if f.UniqueKey == "" {
+			continue
+		}
+		if names := keys[f.UniqueKey]; len(names) == 1 {
+			errs = append(errs, p.fieldError(f.Name, fmt.Sprintf("unique=%s is a composite key of %s alone; tag it unique instead", f.UniqueKey, f.Name)))
+		}
+	}
+	return errs
+}
+
+// fieldError returns a ParseError with message about the field of p named
+// field, at its position.
+func (p parsedEntity) fieldError(field, message string) *ParseError {
+	err := &ParseError{Entity: p.entity.Name, Field: field, Message: message}
+	for _, f := range p.entity.Fields {
+		if f.Name == field {
+			err.File, err.Line = f.SourceFile, f.Line
+			break
+		}
+	}
+	return err
+}
+
+// isBytesType returns true for []byte and its spelling []uint8. encoding/json
+// stores these as a single base64 string rather than a list of ints.
+func isBytesType(goType string) bool {
+	return goType == "[]byte" || goType == "[]uint8"
 }
 
 // typeString converts an ast.Expr representing a type into a human-readable Go
@@ -214,7 +937,14 @@ func typeString(expr ast.Expr) string {
 			// slice type
 			return "[]" + typeString(t.Elt)
 		}
-		// array type (unlikely in our structs but handle it)
+		// Array type: keep a literal or named length so the type can be
+		// written out again in generated code.
+		switch n := t.Len.(type) {
+		case *ast.BasicLit:
+			return "[" + n.Value + "]" + typeString(t.Elt)
+		case *ast.Ident:
+			return "[" + n.Name + "]" + typeString(t.Elt)
+		}
 		return "[...]" + typeString(t.Elt)
 	case *ast.StarExpr:
 		return "*" + typeString(t.X)
@@ -237,21 +967,57 @@ func typeString(expr ast.Expr) string {
 //	dgraph:"index=geo,type=geo"
 //	dgraph:"index=exact,upsert"
 //	dgraph:"count"
+//	dgraph:"index=exact,required"
+//	dgraph:"locales=en,fr"
+//	dgraph:"predicate=performance count orderasc=billing_order"
+//	dgraph:"count=performance"
+//	dgraph:"index=day format=2006-01-02"
+//	dgraph:"index=hnsw metric=euclidean"
+//	dgraph:"index=term deprecated=use Title instead"
 //
 // Parsing rules:
 //  1. Split on spaces first to get independent directives.
 //  2. For each directive, split on commas to get tokens.
 //  3. Each token is either "key=value" or a bare flag.
 //  4. Special handling: "predicate=" sets the predicate, "index=" starts an index
-//     list, "type=" sets the type hint, "reverse"/"count"/"upsert" are boolean flags.
-//  5. Bare tokens after "index=" that don't contain "=" are additional index values.
-func parseDgraphTag(tag string, field *model.Field) {
+//     list, "locales=" starts a language tag list, "type=" sets the type hint,
+//     "search=primary" marks the field for the default Search,
+//     "orderasc="/"orderdesc=" name the facet an edge is expanded in order
+//     of, "count=" names the predicate whose count the field holds,
+//     "format=" sets the Go layout of a datetime's JSON value, "unique="
+//     names a composite unique key the field is part of,
+//     "reverse"/"count"/"upsert"/"required"/"unique"/"deprecated" are
+//     boolean flags. "deprecated=" takes a note that may contain spaces and
+//     commas, so it runs to the next directive with a value, e.g. "index=",
+//     or the end of the tag; bare flags after it are read as part of it.
+//  5. Bare tokens after "index=" or "locales=" that don't contain "=" are
+//     additional values for that list.
+//  6. "lang" and "noconflict" are accepted for dgman and otherwise
+//     ignored. Any other token is skipped, and the first one is returned as
+//     an error once the whole tag has been applied. So is a "type=" naming
+//     something other than a Dgraph scalar type.
+func parseDgraphTag(tag string, field *model.Field) error {
+	var unknown []string
+	var badType, badMetric string
+	if i := directiveIndex(tag, "deprecated="); i >= 0 {
+		note := tag[i+len("deprecated="):]
+		rest := ""
+		for _, prefix := range valueDirectives {
+			if j := directiveIndex(note, prefix); j >= 0 {
+				note, rest = note[:j], note[j:]+" "+rest
+			}
+		}
+		field.Deprecated = true
+		field.DeprecationNote = strings.TrimRight(strings.TrimSpace(note), ",")
+		tag = tag[:i] + " " + rest
+	}
 	// Split on spaces for independent directives.
 	directives := strings.Fields(tag)
 
 	for _, directive := range directives {
 		tokens := strings.Split(directive, ",")
-		inIndex := false
+		// list points at the slice that bare tokens are appended to, if any.
+		var list *[]string
 
 		for _, tok := range tokens {
 			tok = strings.TrimSpace(tok)
@@ -261,37 +1027,150 @@ func parseDgraphTag(tag string, field *model.Field) {
 
 			if strings.HasPrefix(tok, "predicate=") {
 				field.Predicate = tok[len("predicate="):]
-				inIndex = false
+				list = nil
 				continue
 			}
 			if strings.HasPrefix(tok, "index=") {
 				indexVal := tok[len("index="):]
 				field.Indexes = append(field.Indexes, indexVal)
-				inIndex = true
+				list = &field.Indexes
+				continue
+			}
+			if strings.HasPrefix(tok, "locales=") {
+				field.Locales = append(field.Locales, tok[len("locales="):])
+				list = &field.Locales
+				continue
+			}
+			if tok == "search=primary" {
+				field.PrimarySearch = true
+				list = nil
+				continue
+			}
+			if strings.HasPrefix(tok, "orderasc=") || strings.HasPrefix(tok, "orderdesc=") {
+				key, facet, _ := strings.Cut(tok, "=")
+				field.FacetOrder = facet
+				field.FacetOrderDesc = key == "orderdesc"
+				list = nil
+				continue
+			}
+			if strings.HasPrefix(tok, "format=") {
+				field.TimeFormat = tok[len("format="):]
+				list = nil
+				continue
+			}
+			if strings.HasPrefix(tok, "metric=") {
+				if metric := tok[len("metric="):]; vectorMetrics[metric] {
+					field.VectorMetric = metric
+				} else if badMetric == "" {
+					badMetric = metric
+				}
+				list = nil
+				continue
+			}
+			if strings.HasPrefix(tok, "count=") {
+				field.CountOf = tok[len("count="):]
+				list = nil
+				continue
+			}
+			if key, ok := strings.CutPrefix(tok, "unique="); ok && key != "" {
+				field.UniqueKey = key
+				list = nil
 				continue
 			}
 			if strings.HasPrefix(tok, "type=") {
-				field.TypeHint = tok[len("type="):]
-				inIndex = false
+				if hint := tok[len("type="):]; typeHints[hint] {
+					field.TypeHint = hint
+				} else if badType == "" {
+					badType = hint
+				}
+				list = nil
 				continue
 			}
 
 			switch tok {
 			case "reverse":
 				field.IsReverse = true
-				inIndex = false
+				list = nil
 			case "count":
 				field.HasCount = true
-				inIndex = false
+				list = nil
 			case "upsert":
 				field.Upsert = true
-				inIndex = false
+				list = nil
+			case "required":
+				field.Required = true
+				list = nil
+			case "unique":
+				field.Unique = true
+				list = nil
+			case "deprecated":
+				field.Deprecated = true
+				list = nil
+			case "lang", "noconflict":
+				list = nil
 			default:
-				// Bare token: if we were in an index= list, treat as additional index value.
-				if inIndex {
-					field.Indexes = append(field.Indexes, tok)
+				// Bare token: if we were in an index= or locales= list, treat
+				// it as an additional value for that list.
+				if list != nil && !strings.Contains(tok, "=") {
+					*list = append(*list, tok)
+				} else {
+					unknown = append(unknown, tok)
 				}
 			}
 		}
 	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown dgraph tag directive %q", unknown[0])
+	}
+	if badType != "" {
+		return fmt.Errorf("unknown dgraph type hint %q", badType)
+	}
+	if badMetric != "" {
+		return fmt.Errorf("unknown hnsw metric %q", badMetric)
+	}
+	return nil
+}
+
+// directiveIndex returns the index in tag of the first directive or token that
+// starts with prefix, one at the start of tag or after a space or comma, or -1
+// if there is none.
+func directiveIndex(tag, prefix string) int {
+	for i := 0; ; {
+		j := strings.Index(tag[i:], prefix)
+		if j < 0 {
+			return -1
+		}
+		i += j
+		if i == 0 || tag[i-1] == ' ' || tag[i-1] == ',' {
+			return i
+		}
+		i++
+	}
+}
+
+// valueDirectives are the prefixes of the directives that take a value, at
+// the first of which a "deprecated=" note ends.
+var valueDirectives = []string{
+	"predicate=", "index=", "locales=", "search=", "orderasc=", "orderdesc=",
+	"format=", "metric=", "count=", "unique=", "type=",
+}
+
+// typeHints is the set of Dgraph scalar types a "type=" directive may name.
+var typeHints = map[string]bool{
+	"default":  true,
+	"int":      true,
+	"float":    true,
+	"string":   true,
+	"bool":     true,
+	"datetime": true,
+	"geo":      true,
+	"password": true,
+}
+
+// vectorMetrics is the set of distance metrics a "metric=" directive may name
+// for an hnsw index.
+var vectorMetrics = map[string]bool{
+	"cosine":     true,
+	"euclidean":  true,
+	"dotproduct": true,
 }
@@ -1,10 +1,19 @@
 package parser
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/mlwelles/modusGraphGen/logging"
 	"github.com/mlwelles/modusGraphGen/model"
 )
 
@@ -159,6 +168,9 @@ func TestParseMoviesPackage(t *testing.T) {
 		if !f.IsEdge {
 			t.Error("Genre.Films should be an edge")
 		}
+		if f.ForwardEntity != "Film" {
+			t.Errorf("ForwardEntity = %q, want %q", f.ForwardEntity, "Film")
+		}
 	})
 
 	t.Run("ActorFilmsPredicate", func(t *testing.T) {
@@ -274,101 +286,1747 @@ func TestParseMoviesPackage(t *testing.T) {
 	})
 }
 
-func TestParseDgraphTag(t *testing.T) {
+// testdataDir returns the absolute path to the fixture package testdata/<name>.
+func testdataDir(t *testing.T, name string) string {
+	t.Helper()
+	_, thisFile, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("runtime.Caller failed")
+	}
+	return filepath.Join(filepath.Dir(thisFile), "testdata", name)
+}
+
+func TestParseScalarLists(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "lists"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var person *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Person" {
+			person = &pkg.Entities[i]
+		}
+	}
+	if person == nil {
+		t.Fatalf("Person entity not found; detected: %v", entityNames(pkg.Entities))
+	}
+
 	tests := []struct {
-		name     string
-		tag      string
-		expected model.Field
+		field  string
+		isList bool
+		isEdge bool
 	}{
-		{
-			name: "index only",
-			tag:  "index=hash,term,trigram,fulltext",
-			expected: model.Field{
-				Indexes: []string{"hash", "term", "trigram", "fulltext"},
-			},
-		},
-		{
-			name: "predicate with space-separated index",
-			tag:  "predicate=initial_release_date index=year",
-			expected: model.Field{
-				Predicate: "initial_release_date",
-				Indexes:   []string{"year"},
-			},
-		},
-		{
-			name: "predicate with reverse and count",
-			tag:  "predicate=genre,reverse,count",
-			expected: model.Field{
-				Predicate: "genre",
-				IsReverse: true,
-				HasCount:  true,
-			},
-		},
-		{
-			name: "count only",
-			tag:  "count",
-			expected: model.Field{
-				HasCount: true,
-			},
-		},
-		{
-			name: "index with type hint",
-			tag:  "index=geo,type=geo",
-			expected: model.Field{
-				Indexes:  []string{"geo"},
-				TypeHint: "geo",
-			},
-		},
-		{
-			name: "index with upsert",
-			tag:  "index=exact,upsert",
-			expected: model.Field{
-				Indexes: []string{"exact"},
-				Upsert:  true,
-			},
-		},
-		{
-			name: "tilde predicate",
-			tag:  "predicate=~genre",
-			expected: model.Field{
-				Predicate: "~genre",
-			},
-		},
+		{"Name", false, false},
+		{"Aliases", true, false},
+		{"Scores", true, false},
+		{"Avatar", false, false}, // []byte is a single base64 value
+		{"Home", false, false},   // geo values are a single predicate value
+		{"Tags", false, true},
+		{"DType", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f := findField(person.Fields, tt.field)
+			if f == nil {
+				t.Fatalf("Person.%s field not found", tt.field)
+			}
+			if f.IsList != tt.isList {
+				t.Errorf("IsList = %v, want %v", f.IsList, tt.isList)
+			}
+			if f.IsEdge != tt.isEdge {
+				t.Errorf("IsEdge = %v, want %v", f.IsEdge, tt.isEdge)
+			}
+		})
+	}
+}
+
+func TestParseMapFields(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "maps"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(pkg.Entities) != 1 {
+		t.Fatalf("entities = %v, want [Asset]", entityNames(pkg.Entities))
 	}
+	asset := pkg.Entities[0]
 
+	tests := []struct {
+		field  string
+		goType string
+		isMap  bool
+	}{
+		{"Labels", "map[string]string", true},
+		{"Attrs", "Attributes", true},
+		{"Title", "map[string]string", false}, // locales= values are per-language predicates
+	}
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			var f model.Field
-			parseDgraphTag(tt.tag, &f)
+		t.Run(tt.field, func(t *testing.T) {
+			f := findField(asset.Fields, tt.field)
+			if f == nil {
+				t.Fatalf("Asset.%s field not found", tt.field)
+			}
+			if f.GoType != tt.goType {
+				t.Errorf("GoType = %q, want %q", f.GoType, tt.goType)
+			}
+			if f.IsMap != tt.isMap {
+				t.Errorf("IsMap = %v, want %v", f.IsMap, tt.isMap)
+			}
+			if f.IsEdge || f.IsList || len(f.Indexes) > 0 {
+				t.Errorf("IsEdge = %v, IsList = %v, Indexes = %v, want neither edge nor list, and no indexes", f.IsEdge, f.IsList, f.Indexes)
+			}
+		})
+	}
+}
 
-			if f.Predicate != tt.expected.Predicate {
-				t.Errorf("Predicate = %q, want %q", f.Predicate, tt.expected.Predicate)
+func TestParseSelfReferentialEdge(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "selfref"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var person *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Person" {
+			person = &pkg.Entities[i]
+		}
+	}
+	if person == nil {
+		t.Fatalf("Person entity not found; detected: %v", entityNames(pkg.Entities))
+	}
+
+	mentors := findField(person.Fields, "Mentors")
+	if mentors == nil {
+		t.Fatal("Person.Mentors field not found")
+	}
+	if !mentors.IsEdge || mentors.EdgeEntity != "Person" {
+		t.Errorf("Mentors: IsEdge = %v, EdgeEntity = %q; want edge to Person", mentors.IsEdge, mentors.EdgeEntity)
+	}
+	if !mentors.IsSelfRef {
+		t.Error("Mentors should be marked self-referential")
+	}
+
+	teams := findField(person.Fields, "Teams")
+	if teams == nil {
+		t.Fatal("Person.Teams field not found")
+	}
+	if teams.IsSelfRef {
+		t.Error("Teams should not be marked self-referential")
+	}
+}
+
+func TestParseArrays(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "arrays"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var route *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Route" {
+			route = &pkg.Entities[i]
+		}
+	}
+	if route == nil {
+		t.Fatalf("Route entity not found; detected: %v", entityNames(pkg.Entities))
+	}
+
+	tests := []struct {
+		field  string
+		goType string
+		edge   string // EdgeEntity, empty for a scalar list
+	}{
+		{"Points", "[3]Coordinate", "Coordinate"},
+		{"Legs", "[legs]*Leg", "Leg"},
+		{"Scores", "[2]int", ""},
+		{"Digest", "[32]byte", ""},
+	}
+	for _, tt := range tests {
+		f := findField(route.Fields, tt.field)
+		if f == nil {
+			t.Errorf("Route.%s field not found", tt.field)
+			continue
+		}
+		if f.GoType != tt.goType {
+			t.Errorf("%s: GoType = %q, want %q", tt.field, f.GoType, tt.goType)
+		}
+		if f.IsEdge != (tt.edge != "") || f.EdgeEntity != tt.edge {
+			t.Errorf("%s: IsEdge = %v, EdgeEntity = %q; want edge to %q", tt.field, f.IsEdge, f.EdgeEntity, tt.edge)
+		}
+		if f.IsList != (tt.edge == "") {
+			t.Errorf("%s: IsList = %v, want %v", tt.field, f.IsList, tt.edge == "")
+		}
+	}
+}
+
+func TestParseLogger(t *testing.T) {
+	var buf bytes.Buffer
+	if _, err := Parse(testdataDir(t, "aliases"), WithLogger(logging.New(&buf, logging.Verbose))); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	for _, want := range []string{
+		"Person:\n",
+		"  Name: predicate name (from the json tag), index hash,fulltext\n",
+		"  Aliases: predicate aliases (from the json tag), list\n",
+		"  Friends: predicate friends (from the json tag), edge to Person\n",
+		"  searchable on Name (only fulltext field)\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("log does not contain %q:\n%s", want, buf.String())
+		}
+	}
+
+	// Below Verbose, nothing is logged.
+	buf.Reset()
+	if _, err := Parse(testdataDir(t, "aliases"), WithLogger(logging.New(&buf, logging.Normal))); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Normal level logged:\n%s", buf.String())
+	}
+
+	// Any logging.Sink gets every detail, and the problems skipped over.
+	var sink recordSink
+	if _, err := Parse(testdataDir(t, "badtag"), WithLogger(&sink)); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(sink.debug) == 0 || sink.debug[0] != "Person:" {
+		t.Errorf("Debugf got %q, want the explanation of Person", sink.debug)
+	}
+	if len(sink.warn) != 1 || !strings.Contains(sink.warn[0], `unknown dgraph tag directive "indx=hash"`) {
+		t.Errorf("Warnf got %q, want the unknown directive", sink.warn)
+	}
+}
+
+// recordSink is a logging.Sink that records the messages it gets.
+type recordSink struct {
+	debug, warn []string
+}
+
+func (s *recordSink) Debugf(format string, args ...any) {
+	s.debug = append(s.debug, fmt.Sprintf(format, args...))
+}
+
+func (s *recordSink) Warnf(format string, args ...any) {
+	s.warn = append(s.warn, fmt.Sprintf(format, args...))
+}
+
+func TestParsePointerSliceEdge(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "pointers"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var film *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Film" {
+			film = &pkg.Entities[i]
+		}
+	}
+	if film == nil {
+		t.Fatalf("Film entity not found; detected: %v", entityNames(pkg.Entities))
+	}
+
+	genres := findField(film.Fields, "Genres")
+	if genres == nil {
+		t.Fatal("Film.Genres field not found")
+	}
+	if !genres.IsEdge || genres.EdgeEntity != "Genre" {
+		t.Errorf("Genres: IsEdge = %v, EdgeEntity = %q; want edge to Genre", genres.IsEdge, genres.EdgeEntity)
+	}
+	if genres.IsList {
+		t.Error("Genres should not be marked a scalar list")
+	}
+	if genres.GoType != "[]*Genre" {
+		t.Errorf("Genres.GoType = %q, want %q", genres.GoType, "[]*Genre")
+	}
+}
+
+// TestParsePredicateFallback checks each source of a field's predicate, with
+// the json tag or, under FieldNameNaming and EntityDotFieldNaming, the field
+// name preferred.
+func TestParsePredicateFallback(t *testing.T) {
+	tests := []struct {
+		field        string
+		jsonFirst    string
+		nameFirst    string
+		entityDot    string
+		wantImplicit bool
+	}{
+		{"UID", "uid", "uid", "uid", true},
+		{"DType", "dgraph.type", "dgraph.type", "dgraph.type", true},
+		{"Name", "film.name", "film.name", "film.name", false},
+		{"InitialReleaseDate", "initialReleaseDate", "initial_release_date", "film.initial_release_date", true},
+		{"RunningTime", "running_time", "running_time", "film.running_time", true},
+		{"Tagline", "tagline", "tagline", "film.tagline", true},
+		{"Notes", "-", "-", "-", true},
+	}
+	for _, naming := range []PredicateNaming{JSONTagNaming, FieldNameNaming, EntityDotFieldNaming} {
+		pkg, err := Parse(testdataDir(t, "fallback"), WithPredicateNaming(naming))
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		film := pkg.Entities[0]
+		for _, tt := range tests {
+			f := findField(film.Fields, tt.field)
+			if f == nil {
+				t.Fatalf("Film.%s field not found", tt.field)
 			}
-			if f.IsReverse != tt.expected.IsReverse {
-				t.Errorf("IsReverse = %v, want %v", f.IsReverse, tt.expected.IsReverse)
+			want := map[PredicateNaming]string{
+				JSONTagNaming:        tt.jsonFirst,
+				FieldNameNaming:      tt.nameFirst,
+				EntityDotFieldNaming: tt.entityDot,
+			}[naming]
+			if f.Predicate != want || f.ImplicitPredicate != tt.wantImplicit {
+				t.Errorf("naming %s: %s predicate = %q (implicit %v), want %q (implicit %v)",
+					naming, tt.field, f.Predicate, f.ImplicitPredicate, want, tt.wantImplicit)
 			}
-			if f.HasCount != tt.expected.HasCount {
-				t.Errorf("HasCount = %v, want %v", f.HasCount, tt.expected.HasCount)
+		}
+	}
+
+	if _, err := Parse(testdataDir(t, "fallback"), WithStrictPredicates()); err == nil || !strings.Contains(err.Error(), "InitialReleaseDate") {
+		t.Errorf("strict predicates: error = %v, want one naming InitialReleaseDate", err)
+	}
+}
+
+func TestParsePredicateNaming(t *testing.T) {
+	for _, n := range []PredicateNaming{JSONTagNaming, FieldNameNaming, EntityDotFieldNaming, ExplicitNaming} {
+		got, err := ParsePredicateNaming(n.String())
+		if err != nil || got != n {
+			t.Errorf("ParsePredicateNaming(%q) = %v, %v, want %v", n.String(), got, err, n)
+		}
+	}
+	if _, err := ParsePredicateNaming("entity.field"); err == nil {
+		t.Error("ParsePredicateNaming(\"entity.field\") succeeded, want an error")
+	}
+}
+
+func TestParseSingleEdges(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "single"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var film *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Film" {
+			film = &pkg.Entities[i]
+		}
+	}
+	if film == nil {
+		t.Fatalf("Film entity not found; detected: %v", entityNames(pkg.Entities))
+	}
+
+	tests := []struct {
+		field         string
+		edgeEntity    string
+		selfRef       bool
+		reverse       bool
+		forwardEntity string
+	}{
+		{"Studio", "Studio", false, true, ""},
+		{"Director", "Director", false, false, ""},
+		{"Sequel", "Film", true, true, ""},
+		{"Prequel", "Film", true, true, "Film"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f := findField(film.Fields, tt.field)
+			if f == nil {
+				t.Fatalf("Film.%s field not found", tt.field)
 			}
-			if f.Upsert != tt.expected.Upsert {
-				t.Errorf("Upsert = %v, want %v", f.Upsert, tt.expected.Upsert)
+			if !f.IsEdge || f.EdgeEntity != tt.edgeEntity {
+				t.Errorf("IsEdge = %v, EdgeEntity = %q; want edge to %s", f.IsEdge, f.EdgeEntity, tt.edgeEntity)
 			}
-			if f.TypeHint != tt.expected.TypeHint {
-				t.Errorf("TypeHint = %q, want %q", f.TypeHint, tt.expected.TypeHint)
+			if f.IsList {
+				t.Error("single edge should not be marked a scalar list")
 			}
-			if len(f.Indexes) != len(tt.expected.Indexes) {
-				t.Errorf("Indexes = %v, want %v", f.Indexes, tt.expected.Indexes)
-			} else {
-				for i := range f.Indexes {
-					if f.Indexes[i] != tt.expected.Indexes[i] {
-						t.Errorf("Indexes[%d] = %q, want %q", i, f.Indexes[i], tt.expected.Indexes[i])
-					}
-				}
+			if f.IsSelfRef != tt.selfRef {
+				t.Errorf("IsSelfRef = %v, want %v", f.IsSelfRef, tt.selfRef)
+			}
+			if f.IsReverse != tt.reverse {
+				t.Errorf("IsReverse = %v, want %v", f.IsReverse, tt.reverse)
+			}
+			if f.ForwardEntity != tt.forwardEntity {
+				t.Errorf("ForwardEntity = %q, want %q", f.ForwardEntity, tt.forwardEntity)
+			}
+		})
+	}
+}
+
+func TestParseEmbeddedBase(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "embedded"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := entityNames(pkg.Entities); strings.Join(got, " ") != "Film Label Studio" {
+		t.Fatalf("entities = %v, want [Film Label Studio]", got)
+	}
+
+	film, label, studio := pkg.Entities[0], pkg.Entities[1], pkg.Entities[2]
+	for _, name := range []string{"UID", "DType", "Note"} {
+		if f := findField(label.Fields, name); f == nil || f.Embedded != "Base" {
+			t.Errorf("Label.%s = %+v, want it inherited through the alias Base", name, f)
+		}
+	}
+	for _, name := range []string{"UID", "DType", "Note"} {
+		f := findField(film.Fields, name)
+		if f == nil || f.Embedded != "Node" {
+			t.Errorf("Film.%s = %+v, want it inherited from Node", name, f)
+		}
+	}
+	if uid := findField(film.Fields, "UID"); uid == nil || !uid.IsUID {
+		t.Error("Film.UID is not marked IsUID")
+	}
+	if name := findField(film.Fields, "Name"); name == nil || name.Embedded != "" {
+		t.Errorf("Film.Name = %+v, want it declared directly", name)
+	}
+	if studios := findField(film.Fields, "Studios"); studios == nil || studios.EdgeEntity != "Studio" {
+		t.Errorf("Film.Studios = %+v, want an edge to Studio", studios)
+	}
+
+	// Studio's own Note shadows the one inherited through Node.
+	var notes []model.Field
+	for _, f := range studio.Fields {
+		if f.Name == "Note" {
+			notes = append(notes, f)
+		}
+	}
+	if len(notes) != 1 || notes[0].Predicate != "studio_note" || notes[0].Embedded != "" {
+		t.Errorf("Studio Note fields = %+v, want only its own", notes)
+	}
+}
+
+func TestParseEmbeddedEntity(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Film struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\tName string `json:\"name,omitempty\" dgraph:\"index=exact\"`\n}\n\n" +
+		"type DetailedFilm struct {\n\tFilm\n\tBudget int `json:\"budget,omitempty\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := Parse(dir)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	// Film has fields of its own and only DetailedFilm embeds it, so it is
+	// not a base.
+	if got := entityNames(pkg.Entities); strings.Join(got, " ") != "DetailedFilm Film" {
+		t.Fatalf("entities = %v, want [DetailedFilm Film]", got)
+	}
+	if name := findField(pkg.Entities[0].Fields, "Name"); name == nil || name.Embedded != "Film" {
+		t.Errorf("DetailedFilm.Name = %+v, want it inherited from Film", name)
+	}
+}
+
+func TestParseEmbeddedBaseDetection(t *testing.T) {
+	const node = "type Node struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n"
+	for _, tt := range []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"only UID and DType, embedded once",
+			node + "}\n\ntype Animal struct {\n\tNode\n\tName string `json:\"name,omitempty\"`\n}\n",
+			"Animal"},
+		{"own predicates, embedded twice",
+			node + "\tCreated int `json:\"created,omitempty\"`\n}\n\n" +
+				"type Film struct {\n\tNode\n}\n\ntype Studio struct {\n\tNode\n}\n",
+			"Film Studio"},
+		{"own predicates, embedded once",
+			node + "\tName string `json:\"name,omitempty\"`\n}\n\ntype Film struct {\n\tNode\n}\n",
+			"Film Node"},
+		{"edge target",
+			node + "}\n\ntype Film struct {\n\tNode\n\tParent *Node `json:\"parent,omitempty\"`\n}\n",
+			"Film Node"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte("package p\n\n"+tt.src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			pkg, err := Parse(dir)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if got := strings.Join(entityNames(pkg.Entities), " "); got != tt.want {
+				t.Errorf("entities = %s, want %s", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestParseCrossPackageEdge(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "crosspkg"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(pkg.Entities) != 1 || pkg.Entities[0].Name != "Film" {
+		t.Fatalf("entities = %v, want [Film]", entityNames(pkg.Entities))
+	}
+	film := pkg.Entities[0]
+	const peoplePath = "github.com/mlwelles/modusGraphGen/parser/testdata/crosspkg/people"
+
+	// The import is aliased as ppl, but the entity is named by its package.
+	cast := findField(film.Fields, "Cast")
+	if cast == nil || !cast.IsEdge || cast.EdgeEntity != "people.Person" || cast.EdgePackage != peoplePath {
+		t.Errorf("Cast = %+v, want edge to people.Person in %s", cast, peoplePath)
+	}
+	sequel := findField(film.Fields, "Sequel")
+	if sequel == nil || sequel.EdgeEntity != "Film" || sequel.EdgePackage != "" {
+		t.Errorf("Sequel = %+v, want same-package edge to Film", sequel)
+	}
+
+	// Go types resolve through the other package's declarations: an alias of
+	// a list of people is an edge, and a named string type a string.
+	crew := findField(film.Fields, "Crew")
+	if crew == nil || !crew.IsEdge || crew.EdgeEntity != "people.Person" || crew.UnderlyingType != "[]ppl.Person" {
+		t.Errorf("Crew = %+v, want edge to people.Person", crew)
+	}
+	if email := findField(film.Fields, "Email"); email == nil || email.UnderlyingType != "string" || email.IsEdge {
+		t.Errorf("Email = %+v, want a string", email)
+	}
+
+	if len(pkg.External) != 1 || pkg.External[0].Name != "people.Person" {
+		t.Fatalf("External = %v, want [people.Person]", entityNames(pkg.External))
+	}
+	friends := findField(pkg.External[0].Fields, "Friends")
+	if friends == nil || friends.EdgeEntity != "people.Person" || friends.EdgePackage != peoplePath || !friends.IsSelfRef {
+		t.Errorf("people.Person.Friends = %+v, want self edge to people.Person", friends)
+	}
+}
+
+func TestParseEntityDirective(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "declared"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	var award *model.Entity
+	for i := range pkg.Entities {
+		if pkg.Entities[i].Name == "Award" {
+			award = &pkg.Entities[i]
+		}
+	}
+	if award == nil {
+		t.Fatalf("entities = %v, want Award among them", entityNames(pkg.Entities))
+	}
+
+	// The block's fields are parsed like a struct's, after UID and DType.
+	var names []string
+	for _, f := range award.Fields {
+		names = append(names, f.Name)
+	}
+	if got := strings.Join(names, " "); got != "UID DType Name Year Awarded Films" {
+		t.Errorf("Award fields = %s, want UID DType Name Year Awarded Films", got)
+	}
+	if !award.Searchable || award.SearchField != "Name" {
+		t.Errorf("Award search field = %q, want Name", award.SearchField)
+	}
+	if year := findField(award.Fields, "Year"); year == nil || year.Predicate != "year" || len(year.Indexes) != 1 {
+		t.Errorf("Year = %+v, want predicate year with an int index", year)
+	}
+	if films := findField(award.Fields, "Films"); films == nil || !films.IsEdge || films.EdgeEntity != "Film" {
+		t.Errorf("Films = %+v, want edge to Film", films)
+	}
+	if !strings.HasPrefix(award.Declaration, "Name    string    `json") {
+		t.Errorf("Declaration = %q, want the block's field lines", award.Declaration)
+	}
+
+	// Structs can have edges to declared entities.
+	for _, e := range pkg.Entities {
+		if e.Name != "Film" {
+			continue
+		}
+		if e.Declaration != "" {
+			t.Errorf("Film.Declaration = %q, want empty for a struct", e.Declaration)
+		}
+		if awards := findField(e.Fields, "Awards"); awards == nil || !awards.IsEdge || awards.EdgeEntity != "Award" {
+			t.Errorf("Film.Awards = %+v, want edge to Award", awards)
+		}
+	}
+}
+
+func TestParseEntityDirectiveErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{
+			name: "unknown tag directive",
+			src: "package p\n\n//modusGraphGen:entity Award\n//\tName string `json:\"name\"`\n" +
+				"//\tYear int `json:\"year\" dgraph:\"indx=int\"`\n",
+			wantErr: "award.go:5: Award.Year: unknown dgraph tag directive \"indx=int\"",
+		},
+		{
+			name:    "type from another package",
+			src:     "package p\n\n//modusGraphGen:entity Award\n//\tPrize big.Int `json:\"prize\"`\n",
+			wantErr: "award.go:4: Award: type big.Int cannot be used",
+		},
+		{
+			name:    "unexported name",
+			src:     "package p\n\n//modusGraphGen:entity award\n",
+			wantErr: "needs an exported entity name",
+		},
+		{
+			name: "struct of the same name",
+			src: "package p\n\n//modusGraphGen:entity Award\n\n" +
+				"type Award struct {\n\tUID string\n\tDType []string\n}\n",
+			wantErr: "entity Award is declared by both a struct and //modusGraphGen:entity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "award.go"), []byte(tt.src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Parse(dir, WithStrictTags())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseSchema(t *testing.T) {
+	pkg, err := ParseSchema(filepath.Join(testdataDir(t, "schema"), "movies.schema"), WithStrictTags())
+	if err != nil {
+		t.Fatalf("ParseSchema failed: %v", err)
+	}
+	if pkg.Name != "movies" {
+		t.Errorf("package = %q, want movies", pkg.Name)
+	}
+	if got := strings.Join(entityNames(pkg.Entities), " "); got != "Director Film Genre" {
+		t.Fatalf("entities = %s, want Director Film Genre", got)
+	}
+	director, film, genre := pkg.Entities[0], pkg.Entities[1], pkg.Entities[2]
+	if director.DgraphType != "director" || film.DgraphType != "Film" {
+		t.Errorf("Dgraph types = %s, %s, want director, Film", director.DgraphType, film.DgraphType)
+	}
+
+	// Fields have the shape Parse gives a struct's.
+	var names []string
+	for _, f := range film.Fields {
+		names = append(names, f.Name)
+	}
+	want := "UID DType Name InitialReleaseDate Tagline Rating Runtime Color Aka Genre Director Embedding"
+	if got := strings.Join(names, " "); got != want {
+		t.Errorf("Film fields = %s, want %s", got, want)
+	}
+	if !film.Searchable || film.SearchField != "Name" {
+		t.Errorf("Film search field = %q, want Name", film.SearchField)
+	}
+	name := findField(film.Fields, "Name")
+	if name.Predicate != "name" || strings.Join(name.Indexes, ",") != "exact,fulltext" || !name.Upsert {
+		t.Errorf("Name = %+v, want predicate name, indexes exact and fulltext, and upsert", name)
+	}
+	if f := findField(film.Fields, "InitialReleaseDate"); f.GoType != "time.Time" || f.Indexes[0] != "year" {
+		t.Errorf("InitialReleaseDate = %+v, want time.Time with a year index", f)
+	}
+	if f := findField(film.Fields, "Aka"); !f.IsList || f.GoType != "[]string" {
+		t.Errorf("Aka = %+v, want a []string list", f)
+	}
+	if f := findField(film.Fields, "Embedding"); f.VectorMetric != "cosine" {
+		t.Errorf("Embedding = %+v, want an hnsw vector with metric cosine", f)
+	}
+	if f := findField(director.Fields, "Location"); f.TypeHint != "geo" || f.IsList {
+		t.Errorf("Location = %+v, want a geo value", f)
+	}
+
+	// uid predicates are edges to the type listing their reverse.
+	if f := findField(film.Fields, "Genre"); !f.IsEdge || f.EdgeEntity != "Genre" || !f.IsReverse || !f.HasCount {
+		t.Errorf("Genre = %+v, want a counted, reversed edge to Genre", f)
+	}
+	if f := findField(film.Fields, "Director"); !f.IsEdge || f.GoType != "*Director" || f.Predicate != "film.director" {
+		t.Errorf("Director = %+v, want a single edge to Director on film.director", f)
+	}
+	if f := findField(genre.Fields, "Films"); f == nil || f.Predicate != "~genre" || f.ForwardEntity != "Film" || f.GoType != "[]Film" {
+		t.Errorf("Genre.Films = %+v, want the reverse of Film.Genre", f)
+	}
+	if !strings.Contains(director.Declaration, "Films []Film `json:\"films,omitempty\" dgraph:\"predicate=~film.director reverse\"`") {
+		t.Errorf("Director.Declaration = %q, want a Films field reversing film.director", director.Declaration)
+	}
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{
+			name:    "missing period",
+			src:     "name: string @index(exact)\n",
+			wantErr: "movies.schema:1: want",
+		},
+		{
+			name:    "unknown type",
+			src:     "name: text .\n",
+			wantErr: `movies.schema:1: predicate name has unknown type "text"`,
+		},
+		{
+			name:    "unknown directive",
+			src:     "name: string @indexx(exact) .\n",
+			wantErr: "predicate name has unknown directive @indexx",
+		},
+		{
+			name:    "unclosed type",
+			src:     "name: string .\ntype Film {\n\tname\n",
+			wantErr: `movies.schema:2: type Film has no closing "}"`,
+		},
+		{
+			name:    "undeclared predicate",
+			src:     "type Film {\n\tname\n}\n",
+			wantErr: "movies.schema:2: Film: predicate name is listed in type Film but not declared",
+		},
+		{
+			name:    "edge to an unknown type",
+			src:     "sequel: uid .\ntype Film {\n\tsequel\n}\n",
+			wantErr: "movies.schema:3: Film: cannot tell which type uid predicate sequel links to",
+		},
+		{
+			name:    "lang",
+			src:     "title: string @lang .\ntype Film {\n\ttitle\n}\n",
+			wantErr: "Film: predicate title is declared @lang",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "movies.schema")
+			if err := os.WriteFile(path, []byte(tt.src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := ParseSchema(path, WithStrictTags())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseSchema error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseRecursive(t *testing.T) {
+	pkg, err := ParseRecursive(testdataDir(t, "nested"))
+	if err != nil {
+		t.Fatalf("ParseRecursive failed: %v", err)
+	}
+	if pkg.Name != "nested" {
+		t.Errorf("Name = %q, want nested", pkg.Name)
+	}
+	got := make(map[string]model.Entity)
+	for _, e := range pkg.Entities {
+		got[e.Name] = e
+	}
+	if len(got) != 2 {
+		t.Fatalf("entities = %v, want Film and Person", entityNames(pkg.Entities))
+	}
+	if film := got["Film"]; film.Dir != "" || film.GoPackage != "nested" {
+		t.Errorf("Film: Dir = %q, GoPackage = %q; want root package nested", film.Dir, film.GoPackage)
+	}
+	if person := got["Person"]; person.Dir != "people" || person.GoPackage != "people" {
+		t.Errorf("Person: Dir = %q, GoPackage = %q; want people", person.Dir, person.GoPackage)
+	}
+	if len(pkg.External) != 1 || pkg.External[0].Name != "people.Person" {
+		t.Errorf("External = %v, want [people.Person]", entityNames(pkg.External))
+	}
+
+	split := SplitPackages(pkg)
+	if len(split) != 2 {
+		t.Fatalf("SplitPackages returned %d packages, want 2", len(split))
+	}
+	for _, sub := range split {
+		if len(sub.Entities) != 1 || sub.Entities[0].GoPackage != sub.Name {
+			t.Errorf("package %s has entities %v", sub.Name, entityNames(sub.Entities))
+		}
+		if sub.Name == "people" && len(sub.External) != 0 {
+			t.Errorf("package people has its own entities as External: %v", entityNames(sub.External))
+		}
+	}
+}
+
+func TestSplitPackagesQualifiers(t *testing.T) {
+	pkg := &model.Package{
+		Name: "catalog",
+		Entities: []model.Entity{
+			{Name: "Film", Dir: "film", GoPackage: "film"},
+			{Name: "Credit", Dir: "filmography", GoPackage: "filmography"},
+			{Name: "Cast", Dir: "archive/film", GoPackage: "film"},
+		},
+		External: []model.Entity{
+			{Name: "film.Film"},
+			{Name: "filmography.Credit"},
+			{Name: "film.Cast"},
+		},
+	}
+	want := map[string][]string{
+		"film":         {"filmography.Credit", "film.Cast"},
+		"filmography":  {"film.Film", "film.Cast"},
+		"archive/film": {"film.Film", "filmography.Credit"},
+	}
+	split := SplitPackages(pkg)
+	if len(split) != 3 {
+		t.Fatalf("SplitPackages returned %d packages, want 3", len(split))
+	}
+	for _, sub := range split {
+		dir := sub.Entities[0].Dir
+		if got := entityNames(sub.External); !slices.Equal(got, want[dir]) {
+			t.Errorf("package in %s: External = %v, want %v", dir, got, want[dir])
+		}
+	}
+}
+
+func TestParseRecursiveDuplicateEntity(t *testing.T) {
+	_, err := ParseRecursive(testdataDir(t, "nesteddup"))
+	if err == nil {
+		t.Fatal("ParseRecursive accepted two entities named Person")
+	}
+	for _, want := range []string{"Person", "the root package", "archive"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
+
+func TestParseTypeAliases(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "aliases"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(pkg.Entities) != 1 || pkg.Entities[0].Name != "Person" {
+		t.Fatalf("entities = %v, want [Person]", entityNames(pkg.Entities))
+	}
+	person := pkg.Entities[0]
+
+	tests := []struct {
+		field      string
+		goType     string
+		underlying string
+		isList     bool
+		isEdge     bool
+	}{
+		{"Name", "Handle", "string", false, false},
+		{"Email", "Email", "string", false, false},
+		{"Born", "Timestamp", "time.Time", false, false},
+		{"Labels", "Labels", "[]string", true, false},
+		{"Aliases", "[]Email", "[]string", true, false},
+		{"Friends", "Crew", "[]Person", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			f := findField(person.Fields, tt.field)
+			if f == nil {
+				t.Fatalf("field %s not found", tt.field)
+			}
+			if f.GoType != tt.goType {
+				t.Errorf("GoType = %q, want %q", f.GoType, tt.goType)
+			}
+			if f.UnderlyingType != tt.underlying {
+				t.Errorf("UnderlyingType = %q, want %q", f.UnderlyingType, tt.underlying)
+			}
+			if f.IsList != tt.isList {
+				t.Errorf("IsList = %v, want %v", f.IsList, tt.isList)
+			}
+			if f.IsEdge != tt.isEdge {
+				t.Errorf("IsEdge = %v, want %v", f.IsEdge, tt.isEdge)
+			}
+		})
+	}
+
+	friends := findField(person.Fields, "Friends")
+	if friends.EdgeEntity != "Person" || !friends.IsSelfRef {
+		t.Errorf("Friends: EdgeEntity = %q, IsSelfRef = %v; want self-referential edge to Person",
+			friends.EdgeEntity, friends.IsSelfRef)
+	}
+	if !person.Searchable || person.SearchField != "Name" {
+		t.Errorf("Searchable = %v, SearchField = %q; want searchable on Name", person.Searchable, person.SearchField)
+	}
+}
+
+func TestParseStrictPredicates(t *testing.T) {
+	// Person.Name in the lists fixture relies on the json tag fallback.
+	_, err := Parse(testdataDir(t, "lists"), WithStrictPredicates())
+	if err == nil {
+		t.Fatal("Parse succeeded despite a json-tag predicate fallback")
+	}
+	if !strings.Contains(err.Error(), "Person.Name") {
+		t.Errorf("error %q does not name Person.Name", err)
+	}
+
+	// Without the option the fallback is allowed and recorded.
+	pkg, err := Parse(testdataDir(t, "lists"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	name := findField(pkg.Entities[0].Fields, "Name")
+	if name == nil || name.Predicate != "name" || !name.ImplicitPredicate {
+		t.Errorf("Name = %+v, want implicit predicate \"name\"", name)
+	}
+
+	// Explicit predicates (and fields not persisted at all) pass.
+	pkg, err = Parse(testdataDir(t, "explicit"), WithStrictPredicates())
+	if err != nil {
+		t.Fatalf("Parse(explicit) failed: %v", err)
+	}
+	for _, name := range []string{"Name", "Friends"} {
+		if f := findField(pkg.Entities[0].Fields, name); f.ImplicitPredicate {
+			t.Errorf("%s.ImplicitPredicate = true, want false", name)
+		}
+	}
+}
+
+func TestParseUnknownTagDirective(t *testing.T) {
+	dir := testdataDir(t, "badtag")
+
+	// By default the directive is skipped and reported as a warning.
+	var warnings []error
+	pkg, err := Parse(dir, WithWarnings(func(err error) { warnings = append(warnings, err) }))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+	if name := findField(pkg.Entities[0].Fields, "Name"); name == nil || len(name.Indexes) != 0 {
+		t.Errorf("Name = %+v, want no indexes", name)
+	}
+
+	// Under WithStrictTags it fails the parse.
+	_, err = Parse(dir, WithStrictTags())
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Parse error = %v, want a *ParseError", err)
+	}
+	want := ParseError{
+		File:    filepath.Join(dir, "person.go"),
+		Line:    7,
+		Entity:  "Person",
+		Field:   "Name",
+		Message: `unknown dgraph tag directive "indx=hash"`,
+	}
+	if *pe != want {
+		t.Errorf("ParseError = %+v, want %+v", *pe, want)
+	}
+}
+
+func TestParseAllErrors(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Film struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\tName string `json:\"name\" dgraph:\"indx=hash\"`\n" +
+		"\tAltTitle string `json:\"altTitle\" dgraph:\"index=fulltext search=primary\"`\n" +
+		"\tTitle string `json:\"title\" dgraph:\"index=fulltext search=primary\"`\n}\n\n" +
+		"type Genre struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\tName string `json:\"name\" dgraph:\"metric=cosine\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := Parse(dir, WithAllErrors())
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Parse error = %v, want ParseErrors", err)
+	}
+	want := []string{
+		`film.go:6: Film.Name: unknown dgraph tag directive "indx=hash"`,
+		`film.go:8: Film: search=primary is set on both AltTitle and Title`,
+		`film.go:14: Genre.Name: metric= requires index=hnsw`,
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d:\n%v", len(errs), len(want), err)
+	}
+	for i, w := range want {
+		if !strings.HasSuffix(errs[i].Error(), w) {
+			t.Errorf("error %d = %q, want it to end in %q", i, errs[i].Error(), w)
+		}
+	}
+	if got := len(strings.Split(err.Error(), "\n")); got != len(want) {
+		t.Errorf("Error() has %d lines, want %d", got, len(want))
+	}
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe != errs[0] {
+		t.Errorf("errors.As(*ParseError) = %v, want the first error", pe)
+	}
+
+	// The strict predicate checks are collected too, one per field.
+	_, err = Parse(dir, WithAllErrors(), WithStrictPredicates())
+	if !errors.As(err, &errs) || len(errs) != 3+4 {
+		t.Errorf("Parse with strict predicates error = %v, want 7 problems", err)
+	}
+
+	// ParseRecursive collects the errors of every package.
+	sub := filepath.Join(dir, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(sub, "film.go"), []byte(strings.Replace(src, "package p", "package sub", 1)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseRecursive(dir, WithAllErrors())
+	if !errors.As(err, &errs) || len(errs) != 2*len(want) {
+		t.Errorf("ParseRecursive error = %v, want %d problems", err, 2*len(want))
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"film.go": "package p\n\ntype Film struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+			"\tName string `json:\"name\"`\n}\n",
+		"genre.go": "package p\n\ntype Genre struct {\n" +
+			"\tUID string `json:\"uid,omitempty\"`\n" +
+			"\tName string `json:\"name\"\n}\n",
+		"other.go": "package q\n\nfunc (\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	genre := filepath.Join(dir, "genre.go")
+
+	// The broken file of the package is skipped with a warning; that of
+	// another package is not the parse's concern.
+	var warnings []error
+	pkg, err := Parse(dir, WithWarnings(func(err error) { warnings = append(warnings, err) }))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := strings.Join(entityNames(pkg.Entities), " "); got != "Film" {
+		t.Errorf("Entities = %s, want Film", got)
+	}
+	if len(pkg.Skipped) != 1 || pkg.Skipped[0] != genre {
+		t.Errorf("Skipped = %q, want %q", pkg.Skipped, []string{genre})
+	}
+	var pe *ParseError
+	if len(warnings) != 1 || !errors.As(warnings[0], &pe) || pe.File != genre || pe.Line != 5 {
+		t.Fatalf("warnings = %v, want one *ParseError at genre.go:5", warnings)
+	}
+	if want := genre + ":5: " + pe.Message; pe.Error() != want {
+		t.Errorf("Error() = %q, want %q", pe.Error(), want)
+	}
+
+	// Under WithStrictTags it fails the parse, and under WithAllErrors it is
+	// one of the problems.
+	if _, err := Parse(dir, WithStrictTags()); !errors.As(err, &pe) || pe.File != genre {
+		t.Errorf("Parse with WithStrictTags = %v, want the syntax error", err)
+	}
+	var errs ParseErrors
+	if _, err := Parse(dir, WithAllErrors()); !errors.As(err, &errs) || len(errs) != 1 || errs[0].File != genre {
+		t.Errorf("Parse with WithAllErrors = %v, want the syntax error", err)
+	}
+
+	// A package none of whose files parse is an error.
+	if err := os.Remove(filepath.Join(dir, "film.go")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "other.go")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(dir); err == nil || !strings.Contains(err.Error(), "genre.go:5") {
+		t.Errorf("Parse error = %v, want the syntax error at genre.go:5", err)
+	}
+}
+
+func TestApplyInferencePrimarySearch(t *testing.T) {
+	fulltext := []string{"fulltext"}
+	entity := model.Entity{
+		Name: "Film",
+		Fields: []model.Field{
+			{Name: "Tagline", UnderlyingType: "string", Indexes: fulltext},
+			{Name: "Name", UnderlyingType: "string", Indexes: fulltext, PrimarySearch: true},
+			{Name: "Synopsis", UnderlyingType: "string", Indexes: fulltext},
+		},
+	}
+	applyInference(&entity)
+	if !entity.Searchable || entity.SearchField != "Name" {
+		t.Errorf("Searchable = %v, SearchField = %q; want primary field Name", entity.Searchable, entity.SearchField)
+	}
+	want := []string{"Tagline", "Name", "Synopsis"}
+	if strings.Join(entity.SearchFields, ",") != strings.Join(want, ",") {
+		t.Errorf("SearchFields = %v, want %v", entity.SearchFields, want)
+	}
+
+	// Without a primary field, the field named Name is used.
+	entity.Fields[1].PrimarySearch = false
+	entity.SearchField, entity.SearchFields = "", nil
+	applyInference(&entity)
+	if entity.SearchField != "Name" {
+		t.Errorf("SearchField = %q, want Name", entity.SearchField)
+	}
+
+	// Without either, the first fulltext field is used.
+	entity.Fields[1].Name = "Title"
+	entity.SearchField, entity.SearchFields = "", nil
+	applyInference(&entity)
+	if entity.SearchField != "Tagline" {
+		t.Errorf("SearchField = %q, want Tagline", entity.SearchField)
+	}
+}
+
+func TestParsePrimarySearchConflict(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Film struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\tAltTitle string `json:\"altTitle\" dgraph:\"index=fulltext search=primary\"`\n" +
+		"\tTitle string `json:\"title\" dgraph:\"index=fulltext search=primary\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := Parse(dir)
+	want := "Film: search=primary is set on both AltTitle and Title"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Parse error = %v, want it to contain %q", err, want)
+	}
+}
+
+func TestParseUnique(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  string
+		wantErr string
+	}{
+		{
+			name:   "indexed",
+			fields: "\tEmail string `json:\"email\" dgraph:\"index=hash unique\"`\n",
+		},
+		{
+			name:    "without an index",
+			fields:  "\tEmail string `json:\"email\" dgraph:\"unique\"`\n",
+			wantErr: "film.go:6: Film.Email: unique requires an index",
+		},
+		{
+			name: "composite key",
+			fields: "\tTitle string `json:\"title\" dgraph:\"unique=release\"`\n" +
+				"\tYear int `json:\"year\" dgraph:\"unique=release\"`\n",
+		},
+		{
+			name:    "composite key of one field",
+			fields:  "\tTitle string `json:\"title\" dgraph:\"unique=release\"`\n",
+			wantErr: "Film.Title: unique=release is a composite key of Title alone",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := "package p\n\ntype Film struct {\n" +
+				"\tUID string `json:\"uid,omitempty\"`\n" +
+				"\tDType []string `json:\"dgraph.type,omitempty\"`\n" + tt.fields + "}\n"
+			if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Parse(dir)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("Parse failed: %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("Parse error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDgraphType(t *testing.T) {
+	pkg, err := Parse(testdataDir(t, "dgraphtype"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	want := map[string]string{"Film": "film", "Genre": "Genre"}
+	for _, e := range pkg.Entities {
+		if e.DgraphType != want[e.Name] {
+			t.Errorf("%s.DgraphType = %q, want %q", e.Name, e.DgraphType, want[e.Name])
+		}
+	}
+
+	dir := t.TempDir()
+	src := "package p\n\n//dgraph:type=\ntype Film struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Parse(dir, WithWarnings(func(error) {})); err == nil || !strings.Contains(err.Error(), "film.go:3") {
+		t.Errorf("Parse error = %v, want one at film.go:3 for the empty type name", err)
+	}
+}
+
+func TestParseDocComments(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\n// Film is a motion picture.\n//\n//dgraph:type=film\ntype Film struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\t// Title is the release title.\n\t// It may change.\n" +
+		"\tTitle string `json:\"title\"`\n" +
+		"\tYear int `json:\"year\"` // Year of release.\n" +
+		"\tRating float64 `json:\"rating\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "film.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := Parse(dir)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	film := pkg.Entities[0]
+	if want := "Film is a motion picture."; film.Doc != want {
+		t.Errorf("Film.Doc = %q, want %q", film.Doc, want)
+	}
+	want := map[string]string{
+		"Title":  "Title is the release title.\nIt may change.",
+		"Year":   "Year of release.",
+		"Rating": "",
+	}
+	for _, f := range film.Fields {
+		if doc, ok := want[f.Name]; ok && f.Doc != doc {
+			t.Errorf("%s.Doc = %q, want %q", f.Name, f.Doc, doc)
+		}
+	}
+}
+
+func TestParseSourcePositions(t *testing.T) {
+	type position struct {
+		file string
+		line int
+	}
+	for _, tt := range []struct {
+		fixture string
+		want    map[string]position // By "Entity" or "Entity.Field"
+	}{
+		{"embedded", map[string]position{
+			"Film":         {"node.go", 17},
+			"Film.Name":    {"node.go", 19},
+			"Film.Note":    {"node.go", 13}, // Inherited through Node from Audit
+			"Studio.Note":  {"node.go", 27},
+			"Studio.DType": {"node.go", 7},
+		}},
+		{"declared", map[string]position{
+			"Film":          {"film.go", 6},
+			"Film.Awards":   {"film.go", 11},
+			"Award":         {"film.go", 16},
+			"Award.UID":     {"film.go", 16}, // Implicit, at the directive
+			"Award.Awarded": {"film.go", 19},
+		}},
+	} {
+		t.Run(tt.fixture, func(t *testing.T) {
+			dir := testdataDir(t, tt.fixture)
+			pkg, err := Parse(dir)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			got := make(map[string]position)
+			for _, e := range pkg.Entities {
+				got[e.Name] = position{e.SourceFile, e.Line}
+				for _, f := range e.Fields {
+					got[e.Name+"."+f.Name] = position{f.SourceFile, f.Line}
+				}
+			}
+			for name, want := range tt.want {
+				want.file = filepath.Join(dir, want.file)
+				if got[name] != want {
+					t.Errorf("%s declared at %s:%d, want %s:%d", name, got[name].file, got[name].line, want.file, want.line)
+				}
+			}
+		})
+	}
+}
+
+func TestParseStructDirectives(t *testing.T) {
+	dir := testdataDir(t, "directives")
+
+	// Draft and Summary are skipped; Film's unknown directive is reported as
+	// a warning.
+	var warnings []error
+	pkg, err := Parse(dir, WithWarnings(func(err error) { warnings = append(warnings, err) }))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := strings.Join(entityNames(pkg.Entities), " "); got != "Film Rating" {
+		t.Fatalf("Entities = %s, want Film Rating", got)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+	if film, rating := pkg.Entities[0], pkg.Entities[1]; film.ReadOnly || !rating.ReadOnly {
+		t.Errorf("ReadOnly = %v, %v, want only Rating read-only", film.ReadOnly, rating.ReadOnly)
+	}
+
+	// Under WithStrictTags it fails the parse.
+	_, err = Parse(dir, WithStrictTags())
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("Parse error = %v, want a *ParseError", err)
+	}
+	want := ParseError{
+		File:    filepath.Join(dir, "film.go"),
+		Line:    5,
+		Entity:  "Film",
+		Message: `unknown struct directive "cache"`,
+	}
+	if *pe != want {
+		t.Errorf("ParseError = %+v, want %+v", *pe, want)
+	}
+	if !strings.HasSuffix(pe.Error(), `film.go:5: Film: unknown struct directive "cache"`) {
+		t.Errorf("Error() = %q", pe.Error())
+	}
+}
+
+func TestParseVectorField(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\ntype Doc struct {\n" +
+		"\tUID string `json:\"uid,omitempty\"`\n" +
+		"\tDType []string `json:\"dgraph.type,omitempty\"`\n" +
+		"\tEmbedding []float32 `json:\"embedding\" dgraph:\"index=hnsw\"`\n" +
+		"\tScores []float64 `json:\"scores\" dgraph:\"index=hnsw metric=dotproduct\"`\n" +
+		"\tTitle string `json:\"title\" dgraph:\"index=hnsw\"`\n" +
+		"\tBody string `json:\"body\" dgraph:\"metric=cosine\"`\n}\n"
+	if err := os.WriteFile(filepath.Join(dir, "doc.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var warnings []error
+	pkg, err := Parse(dir, WithWarnings(func(err error) { warnings = append(warnings, err) }))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	fields := pkg.Entities[0].Fields
+
+	// Vectors are single values with a metric, defaulting to cosine.
+	for name, metric := range map[string]string{"Embedding": "cosine", "Scores": "dotproduct"} {
+		f := findField(fields, name)
+		if f.IsList || f.VectorMetric != metric {
+			t.Errorf("%s: IsList = %v, VectorMetric = %q, want false, %q", name, f.IsList, f.VectorMetric, metric)
+		}
+	}
+
+	// Misplaced hnsw and metric= are reported and dropped.
+	if len(warnings) != 2 {
+		t.Fatalf("got %d warnings, want 2: %v", len(warnings), warnings)
+	}
+	for i, want := range []string{"index=hnsw requires a []float32 or []float64 field", "metric= requires index=hnsw"} {
+		if !strings.Contains(warnings[i].Error(), want) {
+			t.Errorf("warning %d = %v, want it to contain %q", i, warnings[i], want)
+		}
+	}
+	if f := findField(fields, "Title"); len(f.Indexes) != 0 || f.VectorMetric != "" {
+		t.Errorf("Title = %+v, want no index or metric", f)
+	}
+	if f := findField(fields, "Body"); f.VectorMetric != "" {
+		t.Errorf("Body.VectorMetric = %q, want empty", f.VectorMetric)
+	}
+}
+
+func TestParseDgraphTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		expected model.Field
+		wantErr  string
+	}{
+		{
+			name: "index only",
+			tag:  "index=hash,term,trigram,fulltext",
+			expected: model.Field{
+				Indexes: []string{"hash", "term", "trigram", "fulltext"},
+			},
+		},
+		{
+			name: "predicate with space-separated index",
+			tag:  "predicate=initial_release_date index=year",
+			expected: model.Field{
+				Predicate: "initial_release_date",
+				Indexes:   []string{"year"},
+			},
+		},
+		{
+			name: "predicate with reverse and count",
+			tag:  "predicate=genre,reverse,count",
+			expected: model.Field{
+				Predicate: "genre",
+				IsReverse: true,
+				HasCount:  true,
+			},
+		},
+		{
+			name: "count only",
+			tag:  "count",
+			expected: model.Field{
+				HasCount: true,
+			},
+		},
+		{
+			name: "index with type hint",
+			tag:  "index=geo,type=geo",
+			expected: model.Field{
+				Indexes:  []string{"geo"},
+				TypeHint: "geo",
+			},
+		},
+		{
+			name: "index with upsert",
+			tag:  "index=exact,upsert",
+			expected: model.Field{
+				Indexes: []string{"exact"},
+				Upsert:  true,
+			},
+		},
+		{
+			name: "index with required",
+			tag:  "index=exact,required",
+			expected: model.Field{
+				Indexes:  []string{"exact"},
+				Required: true,
+			},
+		},
+		{
+			name: "required alone",
+			tag:  "required",
+			expected: model.Field{
+				Required: true,
+			},
+		},
+		{
+			name: "tilde predicate",
+			tag:  "predicate=~genre",
+			expected: model.Field{
+				Predicate: "~genre",
+			},
+		},
+		{
+			name: "locales list",
+			tag:  "locales=en,fr index=term",
+			expected: model.Field{
+				Indexes: []string{"term"},
+				Locales: []string{"en", "fr"},
+			},
+		},
+		{
+			name: "dgman directives",
+			tag:  "index=exact unique noconflict",
+			expected: model.Field{
+				Indexes: []string{"exact"},
+				Unique:  true,
+			},
+		},
+		{
+			name: "composite unique key",
+			tag:  "index=exact unique=release",
+			expected: model.Field{
+				Indexes:   []string{"exact"},
+				UniqueKey: "release",
+			},
+		},
+		{
+			name: "search primary",
+			tag:  "index=fulltext search=primary",
+			expected: model.Field{
+				Indexes:       []string{"fulltext"},
+				PrimarySearch: true,
+			},
+		},
+		{
+			name: "count with facet order",
+			tag:  "predicate=performance count orderdesc=billing_order",
+			expected: model.Field{
+				Predicate:      "performance",
+				HasCount:       true,
+				FacetOrder:     "billing_order",
+				FacetOrderDesc: true,
+			},
+		},
+		{
+			name: "count of predicate",
+			tag:  "count=~performance",
+			expected: model.Field{
+				CountOf: "~performance",
+			},
+		},
+		{
+			name: "datetime format",
+			tag:  "index=day format=2006-01-02",
+			expected: model.Field{
+				Indexes:    []string{"day"},
+				TimeFormat: "2006-01-02",
+			},
+		},
+		{
+			name: "deprecated",
+			tag:  "index=exact,deprecated upsert",
+			expected: model.Field{
+				Indexes:    []string{"exact"},
+				Upsert:     true,
+				Deprecated: true,
+			},
+		},
+		{
+			name: "deprecated with note",
+			tag:  "index=term deprecated=use Title, or Name, instead",
+			expected: model.Field{
+				Indexes:         []string{"term"},
+				Deprecated:      true,
+				DeprecationNote: "use Title, or Name, instead",
+			},
+		},
+		{
+			name: "deprecated note before directives",
+			tag:  "deprecated=use Title, or Name index=hash upsert predicate=film.title",
+			expected: model.Field{
+				Predicate:       "film.title",
+				Indexes:         []string{"hash"},
+				Upsert:          true,
+				Deprecated:      true,
+				DeprecationNote: "use Title, or Name",
+			},
+		},
+		{
+			name: "password type hint",
+			tag:  "type=password",
+			expected: model.Field{
+				TypeHint: "password",
+			},
+		},
+		{
+			name: "unknown type hint",
+			tag:  "type=decimal index=exact",
+			expected: model.Field{
+				Indexes: []string{"exact"},
+			},
+			wantErr: `unknown dgraph type hint "decimal"`,
+		},
+		{
+			name: "hnsw with metric",
+			tag:  "index=hnsw metric=euclidean",
+			expected: model.Field{
+				Indexes:      []string{"hnsw"},
+				VectorMetric: "euclidean",
+			},
+		},
+		{
+			name: "unknown hnsw metric",
+			tag:  "index=hnsw metric=manhattan",
+			expected: model.Field{
+				Indexes: []string{"hnsw"},
+			},
+			wantErr: `unknown hnsw metric "manhattan"`,
+		},
+		{
+			name: "unknown search mode",
+			tag:  "index=fulltext search=secondary",
+			expected: model.Field{
+				Indexes: []string{"fulltext"},
+			},
+			wantErr: `unknown dgraph tag directive "search=secondary"`,
+		},
+		{
+			name: "unknown directive",
+			tag:  "indx=hash count",
+			expected: model.Field{
+				HasCount: true,
+			},
+			wantErr: `unknown dgraph tag directive "indx=hash"`,
+		},
+		{
+			name: "unknown key after index list",
+			tag:  "index=exact,uniq=true",
+			expected: model.Field{
+				Indexes: []string{"exact"},
+			},
+			wantErr: `unknown dgraph tag directive "uniq=true"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f model.Field
+			err := parseDgraphTag(tt.tag, &f)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			} else if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("error = %v, want %q", err, tt.wantErr)
+			}
+
+			if f.Predicate != tt.expected.Predicate {
+				t.Errorf("Predicate = %q, want %q", f.Predicate, tt.expected.Predicate)
+			}
+			if f.IsReverse != tt.expected.IsReverse {
+				t.Errorf("IsReverse = %v, want %v", f.IsReverse, tt.expected.IsReverse)
+			}
+			if f.HasCount != tt.expected.HasCount {
+				t.Errorf("HasCount = %v, want %v", f.HasCount, tt.expected.HasCount)
+			}
+			if f.Upsert != tt.expected.Upsert {
+				t.Errorf("Upsert = %v, want %v", f.Upsert, tt.expected.Upsert)
+			}
+			if f.Required != tt.expected.Required {
+				t.Errorf("Required = %v, want %v", f.Required, tt.expected.Required)
+			}
+			if f.Unique != tt.expected.Unique {
+				t.Errorf("Unique = %v, want %v", f.Unique, tt.expected.Unique)
+			}
+			if f.PrimarySearch != tt.expected.PrimarySearch {
+				t.Errorf("PrimarySearch = %v, want %v", f.PrimarySearch, tt.expected.PrimarySearch)
+			}
+			if f.TimeFormat != tt.expected.TimeFormat {
+				t.Errorf("TimeFormat = %q, want %q", f.TimeFormat, tt.expected.TimeFormat)
+			}
+			if f.CountOf != tt.expected.CountOf {
+				t.Errorf("CountOf = %q, want %q", f.CountOf, tt.expected.CountOf)
+			}
+			if f.FacetOrder != tt.expected.FacetOrder || f.FacetOrderDesc != tt.expected.FacetOrderDesc {
+				t.Errorf("FacetOrder = %q (desc %v), want %q (desc %v)", f.FacetOrder, f.FacetOrderDesc, tt.expected.FacetOrder, tt.expected.FacetOrderDesc)
+			}
+			if f.TypeHint != tt.expected.TypeHint {
+				t.Errorf("TypeHint = %q, want %q", f.TypeHint, tt.expected.TypeHint)
+			}
+			if len(f.Indexes) != len(tt.expected.Indexes) {
+				t.Errorf("Indexes = %v, want %v", f.Indexes, tt.expected.Indexes)
+			} else {
+				for i := range f.Indexes {
+					if f.Indexes[i] != tt.expected.Indexes[i] {
+						t.Errorf("Indexes[%d] = %q, want %q", i, f.Indexes[i], tt.expected.Indexes[i])
+					}
+				}
+			}
+			if strings.Join(f.Locales, ",") != strings.Join(tt.expected.Locales, ",") {
+				t.Errorf("Locales = %v, want %v", f.Locales, tt.expected.Locales)
+			}
+		})
+	}
+}
+
+// writeLargePackage writes a package of files source files, each declaring
+// perFile entities with scalar fields and edges to entities of other files,
+// and returns its directory.
+func writeLargePackage(tb testing.TB, files, perFile int) string {
+	tb.Helper()
+	dir := tb.TempDir()
+	for i := range files {
+		var b strings.Builder
+		b.WriteString("package large\n\nimport \"time\"\n")
+		for j := range perFile {
+			next := fmt.Sprintf("E%03d_%d", (i+1)%files, j)
+			fmt.Fprintf(&b, "\ntype E%03d_%d struct {\n", i, j)
+			b.WriteString("\tUID string `json:\"uid,omitempty\"`\n")
+			b.WriteString("\tDType []string `json:\"dgraph.type,omitempty\"`\n")
+			b.WriteString("\tName string `json:\"name,omitempty\" dgraph:\"index=hash,term,trigram,fulltext\"`\n")
+			b.WriteString("\tEmail string `json:\"email,omitempty\" dgraph:\"index=exact upsert\"`\n")
+			b.WriteString("\tCreated time.Time `json:\"created,omitempty\" dgraph:\"index=day\"`\n")
+			b.WriteString("\tTags []string `json:\"tags,omitempty\" dgraph:\"index=term\"`\n")
+			fmt.Fprintf(&b, "\tNext []%s `json:\"next,omitempty\" dgraph:\"predicate=next_%d reverse count\"`\n}\n", next, j)
+		}
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("e%03d.go", i)), []byte(b.String()), 0o644); err != nil {
+			tb.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadPackageChoice(t *testing.T) {
+	tests := []struct {
+		name    string
+		dir     string
+		files   map[string]string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "named after directory",
+			dir:   "films",
+			files: map[string]string{"a.go": "package alpha\n", "b.go": "package films\n", "c.go": "package main\n"},
+			want:  "films",
+		},
+		{
+			name:  "only package besides main",
+			dir:   "other",
+			files: map[string]string{"a.go": "package zeta\n", "c.go": "package main\n"},
+			want:  "zeta",
+		},
+		{
+			name:    "several candidates",
+			dir:     "other",
+			files:   map[string]string{"a.go": "package zeta\n", "b.go": "package beta\n", "c.go": "package main\n"},
+			wantErr: "holds packages beta, zeta",
+		},
+		{
+			name:  "main as last resort",
+			dir:   "cmd",
+			files: map[string]string{"a.go": "package main\n", "a_test.go": "package main_test\n"},
+			want:  "main",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), tt.dir)
+			if err := os.Mkdir(dir, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			for name, src := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for range 5 {
+				got, _, _, err := loadPackage(token.NewFileSet(), dir)
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("loadPackage = %q, %v, want error %q", got, err, tt.wantErr)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("loadPackage failed: %v", err)
+				}
+				if got != tt.want {
+					t.Fatalf("loadPackage = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTwoPackages(t *testing.T) {
+	for range 5 {
+		pkg, err := Parse(testdataDir(t, "twopkgs"))
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if pkg.Name != "twopkgs" || len(pkg.Entities) != 1 || pkg.Entities[0].Name != "Film" {
+			t.Fatalf("Parse = package %s with %v, want twopkgs with [Film]", pkg.Name, entityNames(pkg.Entities))
+		}
+	}
+}
+
+func TestParseOrderDeterministic(t *testing.T) {
+	dir := writeLargePackage(t, 40, 3)
+	var first []string
+	for i := range 5 {
+		pkg, err := Parse(dir)
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		names := entityNames(pkg.Entities)
+		if !sort.StringsAreSorted(names) || len(names) != 120 {
+			t.Fatalf("got %d entities, want 120 sorted by name: %v", len(names), names)
+		}
+		if i == 0 {
+			first = names
+		} else if strings.Join(names, " ") != strings.Join(first, " ") {
+			t.Fatalf("run %d order = %v, want %v", i, names, first)
+		}
+	}
+}
+
+func BenchmarkParseLargePackage(b *testing.B) {
+	dir := writeLargePackage(b, 120, 5)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := Parse(dir); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 // findField returns the field with the given name, or nil if not found.
 func findField(fields []model.Field, name string) *model.Field {
 	for i := range fields {
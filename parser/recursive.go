@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// ParseRecursive parses the package at root and every package in the
+// directories below it, and merges their entities into a single model.Package
+// named after the root package. Each entity records the directory and name of
+// the Go package declaring it; SplitPackages regroups them for generation.
+// Because Dgraph type names are global, two packages declaring an entity of
+// the same name is an error. Directories named testdata or vendor, or starting
+// with "." or "_", are skipped, as the go tool does.
+func ParseRecursive(root string, opts ...Option) (*model.Package, error) {
+	merged := &model.Package{}
+	declared := make(map[string]string) // entity name → relative dir
+	external := make(map[string]bool)
+	var errs ParseErrors
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		name := d.Name()
+		if path != root && (name == "testdata" || name == "vendor" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+			return filepath.SkipDir
+		}
+		ok, err := hasGoFiles(path)
+		if err != nil || !ok {
+			return err
+		}
+
+		pkg, err := Parse(path, opts...)
+		var pkgErrs ParseErrors
+		if errors.As(err, &pkgErrs) {
+			// Under WithAllErrors, go on to report the problems of the
+			// other packages too.
+			errs = append(errs, pkgErrs...)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			rel = ""
+		}
+		if merged.Name == "" || rel == "" {
+			merged.Name = pkg.Name
+		}
+		for _, e := range pkg.Entities {
+			if other, dup := declared[e.Name]; dup {
+				return fmt.Errorf("entity %s is declared in both %s and %s", e.Name, displayDir(other), displayDir(rel))
+			}
+			declared[e.Name] = rel
+			e.Dir, e.GoPackage = rel, pkg.Name
+			merged.Entities = append(merged.Entities, e)
+		}
+		merged.Skipped = append(merged.Skipped, pkg.Skipped...)
+		for _, e := range pkg.External {
+			if !external[e.Name] {
+				external[e.Name] = true
+				merged.External = append(merged.External, e)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	if merged.Name == "" {
+		return nil, fmt.Errorf("no Go packages found in or below %s", root)
+	}
+	return merged, nil
+}
+
+// SplitPackages regroups the entities of a package returned by ParseRecursive
+// into one model.Package per Go package, in the order first seen. Each gets the
+// merged External entities declared outside it: those whose qualifier is not
+// its package name, or whose entity it does not declare, so that neither a
+// package "film" nor one named "film" in another directory claims the
+// entities of "filmography" or of that other directory. A package from Parse
+// is returned as is.
+func SplitPackages(pkg *model.Package) []*model.Package {
+	if len(pkg.Entities) == 0 {
+		return []*model.Package{pkg}
+	}
+	var result []*model.Package
+	byDir := make(map[string]*model.Package)
+	dirOf := make(map[string]string, len(pkg.Entities)) // entity name → Dir
+	for _, e := range pkg.Entities {
+		dirOf[e.Name] = e.Dir
+	}
+	for _, e := range pkg.Entities {
+		if e.GoPackage == "" {
+			return []*model.Package{pkg}
+		}
+		sub, ok := byDir[e.Dir]
+		if !ok {
+			sub = &model.Package{Name: e.GoPackage}
+			for _, ext := range pkg.External {
+				qualifier, name, _ := strings.Cut(ext.Name, ".")
+				if dir, ok := dirOf[name]; !ok || qualifier != e.GoPackage || dir != e.Dir {
+					sub.External = append(sub.External, ext)
+				}
+			}
+			byDir[e.Dir] = sub
+			result = append(result, sub)
+		}
+		sub.Entities = append(sub.Entities, e)
+	}
+	return result
+}
+
+// hasGoFiles returns true if dir contains a non-test Go source file.
+func hasGoFiles(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// displayDir names a directory relative to the ParseRecursive root in errors.
+func displayDir(rel string) string {
+	if rel == "" {
+		return "the root package"
+	}
+	return rel
+}
@@ -0,0 +1,515 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/mlwelles/modusGraphGen/inflect"
+	"github.com/mlwelles/modusGraphGen/model"
+)
+
+// ParseSchema reads the Dgraph schema file at path, such as one exported from
+// a cluster or the DQLSchema of a generated package, and returns a
+// model.Package of the same shape Parse returns for Go structs. Each type
+// block becomes an entity, declared as if by a "//modusGraphGen:entity" block,
+// so the generator emits its struct along with its client. Each predicate the
+// block lists becomes a field named after the predicate, with its @index,
+// @reverse, @count, @upsert, and @unique carried over as dgraph tags:
+//
+//	name: string @index(exact, fulltext) @upsert .
+//	genre: [uid] @reverse @count .
+//	type Film {
+//		name
+//		genre
+//	}
+//
+// A schema does not say which type a uid predicate links to. It is the type
+// whose block lists the reverse predicate, e.g. <~genre> in type Genre, or
+// else the type named after the predicate, e.g. Genre for genre or genres.
+// Predicates whose target cannot be told this way, and other problems that
+// the struct tags cannot express, are skipped and reported as Parse reports
+// tag problems. The package is named after the file, e.g. "movies" for
+// movies.schema.
+func ParseSchema(path string, opts ...Option) (*model.Package, error) {
+	cfg := newOptions(opts)
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema: %w", err)
+	}
+	pkgName, err := schemaPackageName(path)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := readSchema(path, string(src))
+	if err != nil {
+		return nil, err
+	}
+
+	// Entity names come first, so that fields can refer to any type.
+	structNames := make(map[string]bool)
+	for _, t := range schema.types {
+		if structNames[t.entity] {
+			return nil, fmt.Errorf("%s:%d: type %s is named %s in Go, as is another type", path, t.line, t.name, t.entity)
+		}
+		structNames[t.entity] = true
+	}
+	targets := localTargets(structNames, scope{})
+
+	// Positions in fset map each field line back to the schema line that
+	// produced it, so errors point there.
+	fset := token.NewFileSet()
+	file := fset.AddFile(path, -1, len(src))
+	file.SetLinesForContent(src)
+
+	var parsed []parsedEntity
+	for _, t := range schema.types {
+		d, schemaErrs := schema.declare(t, file)
+		p, err := parseDeclaredEntity(fset, "", d, targets, &typeResolver{decls: map[string]string{}})
+		if err != nil {
+			return nil, err
+		}
+		p.entity.DgraphType = t.name
+		p.tagErrs = append(schemaErrs, p.tagErrs...)
+		parsed = append(parsed, p)
+	}
+	entities, err := checkEntities(parsed, cfg.naming, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	linkReverseEdges(entities)
+
+	return &model.Package{
+		Name:     pkgName,
+		Entities: entities,
+	}, nil
+}
+
+// schemaPackageName returns the Go package name for the schema file at path:
+// its base name without extension, lower-cased, with anything but letters and
+// digits dropped.
+func schemaPackageName(path string) (string, error) {
+	base := filepath.Base(path)
+	base = strings.TrimSuffix(base, filepath.Ext(base))
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, base)
+	if !token.IsIdentifier(name) {
+		return "", fmt.Errorf("cannot name a Go package after %s; rename it, e.g. to movies.schema", path)
+	}
+	return name, nil
+}
+
+// dgraphSchema is a parsed Dgraph schema file.
+type dgraphSchema struct {
+	path       string
+	predicates map[string]*schemaPredicate
+	types      []schemaTypeBlock
+}
+
+// schemaPredicate is a predicate declaration of a schema file.
+type schemaPredicate struct {
+	name    string
+	typ     string   // Dgraph type, e.g. "string" or "uid"
+	list    bool     // Declared as [typ]
+	indexes []string // Tokenizers, with an hnsw index as just "hnsw"
+	metric  string   // Metric of the hnsw index, if any
+	reverse bool
+	count   bool
+	upsert  bool
+	unique  bool
+	lang    bool
+	line    int
+}
+
+// schemaTypeBlock is a type block of a schema file.
+type schemaTypeBlock struct {
+	name       string // Dgraph type, e.g. "Film" or "film"
+	entity     string // Go name of its entity, e.g. "Film"
+	line       int
+	predicates []schemaTypeField
+}
+
+// schemaTypeField is a predicate listed in a type block, e.g. "name" or
+// "~genre".
+type schemaTypeField struct {
+	name string
+	line int
+}
+
+// schemaGoTypes maps the scalar Dgraph types to the Go types of their fields,
+// and to the dgraph type= hint that the Go type needs to map back.
+var schemaGoTypes = map[string]struct{ goType, hint string }{
+	"string":        {"string", ""},
+	"int":           {"int64", ""},
+	"float":         {"float64", ""},
+	"bool":          {"bool", ""},
+	"datetime":      {"time.Time", ""},
+	"geo":           {"[]float64", "geo"},
+	"password":      {"string", "password"},
+	"default":       {"string", "default"},
+	"float32vector": {"[]float32", ""},
+}
+
+// namespacePrefix matches the namespace that exported schemas put in front of
+// each line, e.g. "[0x0] ".
+var namespacePrefix = regexp.MustCompile(`^\[0x[0-9a-fA-F]+\]\s*`)
+
+// hnswMetric matches the metric option of an hnsw index, e.g.
+// `metric:"cosine"`.
+var hnswMetric = regexp.MustCompile(`metric\s*:\s*"(\w+)"`)
+
+// readSchema parses src, the content of the schema file at path. Predicates
+// and types of Dgraph's own, named "dgraph.*", are left out.
+func readSchema(path, src string) (*dgraphSchema, error) {
+	schema := &dgraphSchema{path: path, predicates: make(map[string]*schemaPredicate)}
+	var block *schemaTypeBlock
+	for i, line := range strings.Split(src, "\n") {
+		lineNo := i + 1
+		if j := strings.Index(line, "#"); j >= 0 {
+			line = line[:j]
+		}
+		line = namespacePrefix.ReplaceAllString(strings.TrimSpace(line), "")
+		if line == "" {
+			continue
+		}
+
+		if block == nil {
+			rest, ok := strings.CutPrefix(line, "type")
+			if !ok || (rest != "" && rest[0] != ' ' && rest[0] != '\t' && rest[0] != '<') {
+				p, err := parseSchemaPredicate(line)
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: %w", path, lineNo, err)
+				}
+				p.line = lineNo
+				if strings.HasPrefix(p.name, "dgraph.") {
+					continue
+				}
+				if _, ok := schema.predicates[p.name]; ok {
+					return nil, fmt.Errorf("%s:%d: predicate %s is declared twice", path, lineNo, p.name)
+				}
+				schema.predicates[p.name] = p
+				continue
+			}
+			name, body, ok := strings.Cut(rest, "{")
+			name = trimAngles(strings.TrimSpace(name))
+			if !ok || name == "" {
+				return nil, fmt.Errorf("%s:%d: want \"type <name> {\"", path, lineNo)
+			}
+			entity := exportedName(name)
+			if entity == "" {
+				return nil, fmt.Errorf("%s:%d: cannot name a Go struct after type %s", path, lineNo, name)
+			}
+			block = &schemaTypeBlock{name: name, entity: entity, line: lineNo}
+			line = body
+		}
+
+		body, closed := strings.CutSuffix(strings.TrimSpace(line), "}")
+		if strings.Contains(body, "}") {
+			return nil, fmt.Errorf("%s:%d: unexpected text after \"}\"", path, lineNo)
+		}
+		// Older schemas give each predicate's type in the block, e.g.
+		// "name: string"; the type is declared with the predicate anyway.
+		if name, _, ok := strings.Cut(body, ":"); ok {
+			body = name
+		}
+		for _, name := range strings.Fields(body) {
+			block.predicates = append(block.predicates, schemaTypeField{name: trimAngles(name), line: lineNo})
+		}
+		if closed {
+			if !strings.HasPrefix(block.name, "dgraph.") {
+				schema.types = append(schema.types, *block)
+			}
+			block = nil
+		}
+	}
+	if block != nil {
+		return nil, fmt.Errorf("%s:%d: type %s has no closing \"}\"", path, block.line, block.name)
+	}
+	return schema, nil
+}
+
+// parseSchemaPredicate parses a predicate declaration, e.g.
+// "genre: [uid] @reverse @count .".
+func parseSchemaPredicate(line string) (*schemaPredicate, error) {
+	decl, ok := strings.CutSuffix(line, ".")
+	name, rest, found := strings.Cut(decl, ":")
+	if !ok || !found {
+		return nil, fmt.Errorf("want \"<predicate>: <type> ... .\", got %q", line)
+	}
+	p := &schemaPredicate{name: trimAngles(strings.TrimSpace(name))}
+	rest = strings.TrimSpace(rest)
+	end := strings.IndexAny(rest, " \t@")
+	if end < 0 {
+		end = len(rest)
+	}
+	p.typ, rest = rest[:end], rest[end:]
+	if elem, ok := strings.CutPrefix(p.typ, "["); ok {
+		p.typ, p.list = strings.TrimSuffix(elem, "]"), true
+	}
+	if _, ok := schemaGoTypes[p.typ]; !ok && p.typ != "uid" {
+		return nil, fmt.Errorf("predicate %s has unknown type %q", p.name, p.typ)
+	}
+
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		if rest[0] != '@' {
+			return nil, fmt.Errorf("predicate %s: want a directive, got %q", p.name, rest)
+		}
+		end := 1
+		for end < len(rest) && (unicode.IsLetter(rune(rest[end])) || rest[end] == '_') {
+			end++
+		}
+		directive, args := rest[1:end], ""
+		rest = rest[end:]
+		if strings.HasPrefix(rest, "(") {
+			closing := matchingParen(rest)
+			if closing < 0 {
+				return nil, fmt.Errorf("predicate %s: @%s has no closing \")\"", p.name, directive)
+			}
+			args, rest = rest[1:closing], rest[closing+1:]
+		}
+		switch directive {
+		case "index":
+			for _, tok := range splitTopLevel(args) {
+				if strings.HasPrefix(tok, "hnsw") {
+					// Dgraph's default metric is euclidean.
+					p.metric = "euclidean"
+					if m := hnswMetric.FindStringSubmatch(tok); m != nil {
+						p.metric = m[1]
+					}
+					tok = "hnsw"
+				}
+				p.indexes = append(p.indexes, tok)
+			}
+		case "reverse":
+			p.reverse = true
+		case "count":
+			p.count = true
+		case "upsert":
+			p.upsert = true
+		case "unique":
+			p.unique = true
+		case "lang":
+			p.lang = true
+		case "noconflict":
+		default:
+			return nil, fmt.Errorf("predicate %s has unknown directive @%s", p.name, directive)
+		}
+	}
+	return p, nil
+}
+
+// matchingParen returns the index of the ")" closing the "(" that s starts
+// with, or -1 if there is none.
+func matchingParen(s string) int {
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits s at the commas outside parentheses, e.g.
+// `exact, hnsw(metric:"cosine", exponent:"4")` into two, and trims each part.
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		parts = append(parts, last)
+	}
+	return parts
+}
+
+// declare returns the declaredEntity of t, one field line per predicate of
+// its block, each positioned at the block line listing it in file. Predicates
+// that cannot be made a field are reported in the returned errors.
+func (s *dgraphSchema) declare(t schemaTypeBlock, file *token.File) (declaredEntity, []*ParseError) {
+	d := declaredEntity{name: t.entity, pos: token.Position{Filename: s.path, Line: t.line}}
+	var errs []*ParseError
+	used := map[string]bool{"UID": true, "DType": true}
+	for _, tf := range t.predicates {
+		report := func(format string, args ...any) {
+			errs = append(errs, &ParseError{File: s.path, Line: tf.line, Entity: t.entity, Message: fmt.Sprintf(format, args...)})
+		}
+
+		forward, isReverse := strings.CutPrefix(tf.name, "~")
+		p, ok := s.predicates[forward]
+		switch {
+		case strings.HasPrefix(forward, "dgraph."):
+			continue
+		case !ok:
+			report("predicate %s is listed in type %s but not declared", forward, t.name)
+			continue
+		case isReverse && p.typ != "uid":
+			report("<%s> reverses %s, which is not a uid predicate", tf.name, forward)
+			continue
+		}
+
+		var name, goType, jsonKey string
+		var tags []string
+		if isReverse {
+			source, err := s.reverseSource(forward)
+			if err != nil {
+				report("<%s>: %v", tf.name, err)
+				continue
+			}
+			name, goType = inflect.Plural(source), "[]"+source
+			if used[name] {
+				name += "By" + exportedName(forward)
+			}
+			jsonKey = strings.ToLower(name[:1]) + name[1:]
+			tags = append(tags, "predicate="+tf.name, "reverse")
+		} else {
+			name, jsonKey = exportedName(forward[strings.LastIndex(forward, ".")+1:]), forward
+			if used[name] {
+				name = exportedName(forward)
+			}
+			tags = append(tags, "predicate="+forward)
+			if p.typ == "uid" {
+				target, ok := s.edgeTarget(forward)
+				if !ok {
+					report("cannot tell which type uid predicate %s links to; list <~%s> in the block of that type", forward, forward)
+					continue
+				}
+				goType = "*" + target
+				if p.list {
+					goType = "[]" + target
+				}
+			} else {
+				scalar := schemaGoTypes[p.typ]
+				goType = scalar.goType
+				if p.list {
+					goType = "[]" + goType
+				}
+				if scalar.hint != "" {
+					tags = append(tags, "type="+scalar.hint)
+				}
+			}
+			if len(p.indexes) > 0 {
+				tags = append(tags, "index="+strings.Join(p.indexes, ","))
+			}
+			if p.metric != "" {
+				tags = append(tags, "metric="+p.metric)
+			}
+			for _, flag := range []struct {
+				set  bool
+				name string
+			}{{p.reverse, "reverse"}, {p.count, "count"}, {p.upsert, "upsert"}, {p.unique, "unique"}} {
+				if flag.set {
+					tags = append(tags, flag.name)
+				}
+			}
+			if p.lang {
+				report("predicate %s is declared @lang, which a field cannot express; give it locales= once generated", forward)
+			}
+		}
+		if name == "" || used[name] {
+			report("predicate %s has no Go field name of its own", tf.name)
+			continue
+		}
+		used[name] = true
+
+		text := fmt.Sprintf("//\t%s %s `json:\"%s,omitempty\" dgraph:\"%s\"`", name, goType, jsonKey, strings.Join(tags, " "))
+		d.fields = append(d.fields, &ast.Comment{Slash: file.LineStart(tf.line), Text: text})
+	}
+	return d, errs
+}
+
+// edgeTarget returns the Go name of the type that the uid predicate pred links
+// to, as ParseSchema describes.
+func (s *dgraphSchema) edgeTarget(pred string) (string, bool) {
+	var reversing []string
+	for _, t := range s.types {
+		for _, tf := range t.predicates {
+			if tf.name == "~"+pred {
+				reversing = append(reversing, t.entity)
+			}
+		}
+	}
+	if len(reversing) == 1 {
+		return reversing[0], true
+	}
+	last := pred[strings.LastIndex(pred, ".")+1:]
+	for _, name := range []string{exportedName(last), exportedName(inflect.Singular(last))} {
+		for _, t := range s.types {
+			if name != "" && t.entity == name {
+				return name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// reverseSource returns the Go name of the type whose block lists the forward
+// predicate pred, which the reverse predicate ~pred leads back to.
+func (s *dgraphSchema) reverseSource(pred string) (string, error) {
+	var sources []string
+	for _, t := range s.types {
+		for _, tf := range t.predicates {
+			if tf.name == pred {
+				sources = append(sources, t.entity)
+			}
+		}
+	}
+	switch len(sources) {
+	case 0:
+		return "", fmt.Errorf("no type lists %s", pred)
+	case 1:
+		return sources[0], nil
+	}
+	return "", fmt.Errorf("types %s all list %s, so its reverse has no single type", strings.Join(sources, ", "), pred)
+}
+
+// exportedName converts a Dgraph name to an exported Go identifier, e.g.
+// "InitialReleaseDate" for "initial_release_date" and "Film" for "film". It
+// returns "" for a name without a letter to start the identifier with.
+func exportedName(s string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	name := b.String()
+	if !token.IsIdentifier(name) || !ast.IsExported(name) {
+		return ""
+	}
+	return name
+}
+
+// trimAngles removes the angle brackets around a name, e.g. "<~genre>".
+func trimAngles(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(name, "<"), ">")
+}
@@ -0,0 +1,30 @@
+package arrays
+
+// legs is the number of legs in a Route.
+const legs = 2
+
+// Route has fixed-size arrays of entities and of scalars.
+type Route struct {
+	UID    string        `json:"uid,omitempty"`
+	DType  []string      `json:"dgraph.type,omitempty"`
+	Name   string        `json:"name,omitempty" dgraph:"index=hash"`
+	Points [3]Coordinate `json:"points,omitempty" dgraph:"predicate=route.point"`
+	Legs   [legs]*Leg    `json:"legs,omitempty" dgraph:"predicate=route.leg"`
+	Scores [2]int        `json:"scores,omitempty"`
+	Digest [32]byte      `json:"digest,omitempty"`
+}
+
+// Coordinate is one point of a Route.
+type Coordinate struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Lat   float64  `json:"lat,omitempty"`
+	Lng   float64  `json:"lng,omitempty"`
+}
+
+// Leg is the part of a Route between two stops.
+type Leg struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Miles float64  `json:"miles,omitempty"`
+}
@@ -0,0 +1,8 @@
+package badtag
+
+// Person misspells the index directive on Name.
+type Person struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"indx=hash"`
+}
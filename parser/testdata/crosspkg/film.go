@@ -0,0 +1,14 @@
+package crosspkg
+
+import ppl "github.com/mlwelles/modusGraphGen/parser/testdata/crosspkg/people"
+
+// Film's cast are people, an entity declared in another package.
+type Film struct {
+	UID    string       `json:"uid,omitempty"`
+	DType  []string     `json:"dgraph.type,omitempty"`
+	Name   string       `json:"name,omitempty" dgraph:"index=hash"`
+	Cast   []ppl.Person `json:"cast,omitempty" dgraph:"predicate=film.cast"`
+	Sequel []Film       `json:"sequel,omitempty"`
+	Crew   ppl.Crew     `json:"crew,omitempty"`
+	Email  ppl.Email    `json:"email,omitempty" dgraph:"index=exact"`
+}
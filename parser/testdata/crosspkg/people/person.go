@@ -0,0 +1,15 @@
+package people
+
+// Person knows other people in the same package.
+type Person struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Name    string   `json:"name,omitempty" dgraph:"index=exact"`
+	Friends []Person `json:"friends,omitempty" dgraph:"predicate=person.friend"`
+}
+
+// Email is a named string type, resolved across packages.
+type Email string
+
+// Crew is an alias for a list of people, whose elements are still an edge.
+type Crew = []Person
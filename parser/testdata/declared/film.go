@@ -0,0 +1,20 @@
+package declared
+
+import "time"
+
+// Film is an ordinary entity with an edge to Award, which has no struct.
+type Film struct {
+	UID      string    `json:"uid,omitempty"`
+	DType    []string  `json:"dgraph.type,omitempty"`
+	Name     string    `json:"name,omitempty" dgraph:"index=term"`
+	Released time.Time `json:"released,omitempty" dgraph:"index=year"`
+	Awards   []Award   `json:"awards,omitempty" dgraph:"predicate=film_award"`
+}
+
+// Award is declared field by field; modusGraphGen generates its struct.
+//
+//modusGraphGen:entity Award
+//	Name    string    `json:"name,omitempty" dgraph:"index=hash,fulltext"`
+//	Year    int       `json:"year,omitempty" dgraph:"index=int"`
+//	Awarded time.Time `json:"awarded,omitempty" dgraph:"index=day"`
+//	Films   []Film    `json:"films,omitempty" dgraph:"predicate=award_film"`
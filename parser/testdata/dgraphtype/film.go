@@ -0,0 +1,19 @@
+package dgraphtype
+
+// Film is stored as Dgraph type film.
+//
+//dgraph:type=film
+type Film struct {
+	UID    string   `json:"uid,omitempty"`
+	DType  []string `json:"dgraph.type,omitempty"`
+	Name   string   `json:"name,omitempty" dgraph:"index=hash"`
+	Genres []Genre  `json:"genres,omitempty" dgraph:"predicate=genre reverse"`
+}
+
+// Genre keeps its struct name as its Dgraph type.
+type Genre struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+	Films []Film   `json:"films,omitempty" dgraph:"predicate=~genre reverse"`
+}
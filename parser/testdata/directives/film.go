@@ -0,0 +1,37 @@
+package directives
+
+// Film has a directive the parser does not know.
+//
+//dgraph:cache
+type Film struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+}
+
+// Draft has UID and DType fields but is kept out of the graph.
+//
+//dgraph:skip
+type Draft struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Notes string   `json:"notes,omitempty"`
+}
+
+// Summary is a DTO that happens to have UID and DType fields.
+//
+//dgraph:generate=false
+type Summary struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Films int      `json:"films,omitempty"`
+}
+
+// Rating is maintained by another service; this package only reads it.
+//
+//dgraph:readonly
+type Rating struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Score float64  `json:"score,omitempty"`
+}
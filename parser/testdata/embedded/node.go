@@ -0,0 +1,44 @@
+package embedded
+
+// Node is the base every entity embeds for its UID and DType. It is not an
+// entity itself.
+type Node struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Audit
+}
+
+// Audit is embedded by Node, so entities inherit Note through two levels.
+type Audit struct {
+	Note string `json:"note,omitempty" dgraph:"index=term"`
+}
+
+// Film inherits UID, DType, and Note, and shadows nothing.
+type Film struct {
+	Node
+	Name    string   `json:"name,omitempty" dgraph:"index=exact"`
+	Studios []Studio `json:"studios,omitempty" dgraph:"predicate=studio"`
+}
+
+// Studio declares its own Note, which shadows the inherited one.
+type Studio struct {
+	Node
+	Name string `json:"name,omitempty" dgraph:"index=exact"`
+	Note string `json:"studioNote,omitempty" dgraph:"predicate=studio_note"`
+}
+
+// Stub embeds a pointer to Node, which is not followed, so it has no UID or
+// DType and is not an entity.
+type Stub struct {
+	*Node
+	Name string `json:"name,omitempty"`
+}
+
+// Base is an alias for Node, which Label embeds as it would Node.
+type Base = Node
+
+// Label inherits UID, DType, and Note through the alias.
+type Label struct {
+	Base
+	Name string `json:"name,omitempty" dgraph:"index=exact"`
+}
@@ -0,0 +1,11 @@
+package explicit
+
+// Person declares every predicate explicitly, apart from a field that is not
+// persisted at all.
+type Person struct {
+	UID     string   `json:"uid,omitempty"`
+	DType   []string `json:"dgraph.type,omitempty"`
+	Name    string   `json:"name,omitempty" dgraph:"predicate=person.name index=exact"`
+	Friends []Person `json:"friends,omitempty" dgraph:"predicate=person.friend"`
+	Cache   string   `json:"-"`
+}
@@ -0,0 +1,13 @@
+package fallback
+
+// Film takes its predicates from each source in turn: an explicit
+// predicate=, the json tag, and the snake-cased field name.
+type Film struct {
+	UID                string   `json:"uid,omitempty"`
+	DType              []string `json:"dgraph.type,omitempty"`
+	Name               string   `json:"name,omitempty" dgraph:"predicate=film.name index=hash"`
+	InitialReleaseDate string   `json:"initialReleaseDate,omitempty"`
+	RunningTime        int
+	Tagline            string `dgraph:"index=term"`
+	Notes              string `json:"-"`
+}
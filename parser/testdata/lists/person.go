@@ -0,0 +1,21 @@
+package lists
+
+// Person has scalar lists alongside an edge, a geo value, and a byte slice,
+// all of which are Go slices.
+type Person struct {
+	UID     string    `json:"uid,omitempty"`
+	DType   []string  `json:"dgraph.type,omitempty"`
+	Name    string    `json:"name,omitempty" dgraph:"index=exact"`
+	Aliases []string  `json:"aliases,omitempty" dgraph:"index=term"`
+	Scores  []int     `json:"scores,omitempty"`
+	Avatar  []byte    `json:"avatar,omitempty"`
+	Home    []float64 `json:"home,omitempty" dgraph:"index=geo type=geo"`
+	Tags    []Tag     `json:"tags,omitempty"`
+}
+
+// Tag is the target of Person.Tags.
+type Tag struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Label string   `json:"label,omitempty"`
+}
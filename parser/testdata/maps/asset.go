@@ -0,0 +1,14 @@
+package maps
+
+// Attributes is a named map type.
+type Attributes map[string]string
+
+// Asset keeps key/value metadata in maps, and localized names in a map with
+// locales=.
+type Asset struct {
+	UID    string            `json:"uid,omitempty"`
+	DType  []string          `json:"dgraph.type,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Attrs  Attributes        `json:"attrs,omitempty"`
+	Title  map[string]string `json:"title,omitempty" dgraph:"locales=en,fr"`
+}
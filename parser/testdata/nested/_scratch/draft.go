@@ -0,0 +1,8 @@
+package scratch
+
+// Draft would clash with nothing, but directories starting with "_" are
+// skipped.
+type Draft struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+}
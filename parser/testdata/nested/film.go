@@ -0,0 +1,11 @@
+package nested
+
+import "github.com/mlwelles/modusGraphGen/parser/testdata/nested/people"
+
+// Film is declared in the root package; its cast lives in a subpackage.
+type Film struct {
+	UID   string          `json:"uid,omitempty"`
+	DType []string        `json:"dgraph.type,omitempty"`
+	Name  string          `json:"name,omitempty" dgraph:"index=hash"`
+	Cast  []people.Person `json:"cast,omitempty" dgraph:"predicate=film.cast"`
+}
@@ -0,0 +1,8 @@
+package people
+
+// Person is declared in a subpackage of the nested root.
+type Person struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=exact"`
+}
@@ -0,0 +1,8 @@
+package archive
+
+// Person duplicates the root package's entity name.
+type Person struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty"`
+}
@@ -0,0 +1,8 @@
+package nesteddup
+
+// Person is also declared in the archive subpackage.
+type Person struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty"`
+}
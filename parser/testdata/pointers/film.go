@@ -0,0 +1,16 @@
+package pointers
+
+// Film holds its genres as a pointer slice.
+type Film struct {
+	UID    string   `json:"uid,omitempty"`
+	DType  []string `json:"dgraph.type,omitempty"`
+	Name   string   `json:"name,omitempty" dgraph:"index=term"`
+	Genres []*Genre `json:"genres,omitempty" dgraph:"predicate=genre reverse count"`
+}
+
+// Genre is the target of Film's pointer-slice edge.
+type Genre struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=term"`
+}
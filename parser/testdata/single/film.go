@@ -0,0 +1,28 @@
+package single
+
+// Film has single-node edges: a pointer to its studio, counted, and its
+// director held by value.
+type Film struct {
+	UID      string   `json:"uid,omitempty"`
+	DType    []string `json:"dgraph.type,omitempty"`
+	Name     string   `json:"name,omitempty" dgraph:"index=hash"`
+	Studio   *Studio  `json:"studio,omitempty" dgraph:"predicate=film.studio reverse count"`
+	Director Director `json:"director,omitempty" dgraph:"predicate=film.director"`
+	Sequel   *Film    `json:"sequel,omitempty" dgraph:"predicate=sequel reverse"`
+	Prequel  *Film    `json:"prequel,omitempty" dgraph:"predicate=~sequel reverse"`
+}
+
+// Studio makes films; its reverse edge lists them.
+type Studio struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+	Films []Film   `json:"films,omitempty" dgraph:"predicate=~film.studio reverse"`
+}
+
+// Director directs films.
+type Director struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+}
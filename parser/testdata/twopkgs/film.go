@@ -0,0 +1,8 @@
+package twopkgs
+
+// Film is the entity; seed.go beside it is a stray main package.
+type Film struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+	Name  string   `json:"name,omitempty" dgraph:"index=hash"`
+}
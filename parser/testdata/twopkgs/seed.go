@@ -0,0 +1,12 @@
+//go:build ignore
+
+// seed loads sample films; it is run with "go run seed.go".
+package main
+
+// Seed is an unrelated struct that must not be taken for an entity.
+type Seed struct {
+	UID   string   `json:"uid,omitempty"`
+	DType []string `json:"dgraph.type,omitempty"`
+}
+
+func main() {}
@@ -0,0 +1,214 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// typeResolver resolves the field types of a package's structs to the types
+// they are declared as, as model.Field.UnderlyingType holds them. It uses the
+// go/types information of the package where it has it, and falls back to the
+// package's type declarations as written for the rest, such as the fields of
+// directive blocks and schema types, or a package that does not type-check.
+type typeResolver struct {
+	fset  *token.FileSet
+	info  *types.Info                  // Nil if the package was not type-checked
+	pkg   *types.Package               // The package itself, nil with info
+	mod   *module                      // Module of the package, nil outside one
+	names map[string]map[string]string // By file name: import path → local package name
+	decls map[string]string
+}
+
+// newTypeResolver type-checks the files of pkgAST, loading the packages they
+// import with go/packages. Problems loading or checking them leave the types
+// of the affected fields to the fallback, so that a package that does not yet
+// build can still be parsed.
+func newTypeResolver(fset *token.FileSet, pkgAST *ast.Package, mod *module) *typeResolver {
+	r := &typeResolver{fset: fset, mod: mod, names: make(map[string]map[string]string), decls: collectTypeDecls(pkgAST)}
+	files := sortedFiles(pkgAST)
+	if len(files) == 0 {
+		return r
+	}
+	for _, file := range files {
+		names := make(map[string]string)
+		for _, spec := range file.Imports {
+			if path, err := strconv.Unquote(spec.Path.Value); err == nil && spec.Name != nil {
+				names[path] = spec.Name.Name
+			}
+		}
+		r.names[fset.Position(file.Package).Filename] = names
+	}
+	dir := filepath.Dir(fset.Position(files[0].Package).Filename)
+	imports, err := loadImports(dir, files, mod)
+	if err != nil {
+		return r
+	}
+	conf := types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			if pkg, ok := imports[path]; ok {
+				return pkg, nil
+			}
+			return nil, fmt.Errorf("package %s not loaded", path)
+		}),
+		Error: func(error) {}, // Keep checking; the fallback covers what fails.
+	}
+	r.info = &types.Info{
+		Types: make(map[ast.Expr]types.TypeAndValue),
+		Uses:  make(map[*ast.Ident]types.Object),
+	}
+	path := pkgAST.Name
+	if mod != nil {
+		if rel, err := filepath.Rel(mod.dir, dir); err == nil {
+			path = filepath.ToSlash(filepath.Join(mod.path, rel))
+		}
+	}
+	r.pkg, _ = conf.Check(path, fset, files, r.info)
+	return r
+}
+
+// importerFunc is a types.Importer that is a function.
+type importerFunc func(path string) (*types.Package, error)
+
+// Import returns the package with the given import path.
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// loadImports loads the type information of the packages that files import
+// with go/packages, keyed by import path. Outside a module, dir is loaded in
+// GOPATH mode, where the standard library still resolves.
+func loadImports(dir string, files []*ast.File, mod *module) (map[string]*types.Package, error) {
+	var paths []string
+	seen := make(map[string]bool)
+	for _, file := range files {
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || path == "C" || seen[path] {
+				continue
+			}
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	result := make(map[string]*types.Package)
+	if len(paths) == 0 {
+		return result, nil
+	}
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+		Dir:  dir,
+	}
+	if mod == nil {
+		cfg.Env = append(os.Environ(), "GO111MODULE=off")
+	}
+	pkgs, err := packages.Load(cfg, paths...)
+	if err != nil {
+		return nil, err
+	}
+	for _, pkg := range pkgs {
+		if pkg.Types != nil && pkg.Types.Complete() {
+			result[pkg.PkgPath] = pkg.Types
+		}
+	}
+	return result, nil
+}
+
+// resolve returns the type of the field type expression expr, written as
+// goType, with its named types and aliases resolved: aliases to the types
+// they stand for, wherever declared, and named types other than structs
+// declared in the module to their underlying types. Struct names are left
+// alone so edges keep their entity, as are named types from outside the
+// module, such as time.Time.
+func (r *typeResolver) resolve(expr ast.Expr, goType string) string {
+	if r.info != nil {
+		if t := r.info.TypeOf(expr); t != nil && t != types.Typ[types.Invalid] {
+			if s, ok := r.typeString(t, r.names[r.fset.Position(expr.Pos()).Filename]); ok {
+				return s
+			}
+		}
+	}
+	return resolveType(goType, r.decls)
+}
+
+// typeString writes t as resolve describes, qualifying the types of other
+// packages with their names in names, by import path, or else their own. It
+// returns false for a type it contains that has no such spelling, such as a
+// function or an unchecked one.
+func (r *typeResolver) typeString(t types.Type, names map[string]string) (string, bool) {
+	switch t := t.(type) {
+	case *types.Alias:
+		return r.typeString(types.Unalias(t), names)
+	case *types.Basic:
+		return t.Name(), t.Kind() != types.Invalid
+	case *types.Pointer:
+		elem, ok := r.typeString(t.Elem(), names)
+		return "*" + elem, ok
+	case *types.Slice:
+		elem, ok := r.typeString(t.Elem(), names)
+		return "[]" + elem, ok
+	case *types.Array:
+		elem, ok := r.typeString(t.Elem(), names)
+		return fmt.Sprintf("[%d]%s", t.Len(), elem), ok
+	case *types.Map:
+		key, ok := r.typeString(t.Key(), names)
+		value, ok2 := r.typeString(t.Elem(), names)
+		return "map[" + key + "]" + value, ok && ok2
+	case *types.Named:
+		obj := t.Obj()
+		if t.TypeArgs().Len() > 0 || obj.Pkg() == nil {
+			return "", false
+		}
+		if _, isStruct := t.Underlying().(*types.Struct); !isStruct && r.inModule(obj.Pkg()) {
+			return r.typeString(t.Underlying(), names)
+		}
+		if obj.Pkg() == r.pkg {
+			return obj.Name(), true
+		}
+		if name, ok := names[obj.Pkg().Path()]; ok {
+			return name + "." + obj.Name(), true
+		}
+		return obj.Pkg().Name() + "." + obj.Name(), true
+	case *types.Interface:
+		return "any", t.Empty()
+	}
+	return "", false
+}
+
+// inModule returns true if pkg is the parsed package or another package of
+// its module.
+func (r *typeResolver) inModule(pkg *types.Package) bool {
+	if pkg == r.pkg {
+		return true
+	}
+	if r.mod == nil {
+		return false
+	}
+	return pkg.Path() == r.mod.path || strings.HasPrefix(pkg.Path(), r.mod.path+"/")
+}
+
+// embeddedStruct returns the name of the same-package struct that the
+// embedded field type expr denotes, following aliases such as "type Base =
+// Node", and false if it denotes none.
+func (r *typeResolver) embeddedStruct(expr ast.Expr, structs map[string]*ast.StructType) (string, bool) {
+	if r.info != nil {
+		if t := r.info.TypeOf(expr); t != nil {
+			named, ok := types.Unalias(t).(*types.Named)
+			if ok && named.Obj().Pkg() == r.pkg && structs[named.Obj().Name()] != nil {
+				return named.Obj().Name(), true
+			}
+		}
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok || structs[ident.Name] == nil {
+		return "", false
+	}
+	return ident.Name, true
+}
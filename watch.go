@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mlwelles/modusGraphGen/logging"
+)
+
+// watchInterval is how often -watch looks for changed files. A change is acted
+// on once the files have stayed the same for another interval.
+const watchInterval = 500 * time.Millisecond
+
+// fileStamp identifies one version of a watched file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// watchedFiles returns the files that -watch follows, keyed by their path
+// relative to dir: the .go files in dir and, with recursive, in the
+// subdirectories that ParseRecursive parses, or only schemaFile if it is set.
+// Generated *_gen.go files are left out, so that regenerating them does not
+// count as a change.
+func watchedFiles(dir string, recursive bool, schemaFile string) (map[string]fileStamp, error) {
+	files := make(map[string]fileStamp)
+	if schemaFile != "" {
+		info, err := os.Stat(schemaFile)
+		if err != nil {
+			return nil, err
+		}
+		files[schemaFile] = fileStamp{info.ModTime(), info.Size()}
+		return files, nil
+	}
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if path != dir && (!recursive || name == "testdata" || name == "vendor" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_gen.go") || strings.HasSuffix(name, "_gen_test.go") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[rel] = fileStamp{info.ModTime(), info.Size()}
+		return nil
+	})
+	return files, err
+}
+
+// watch runs regenerate, then runs it again whenever the files that list
+// returns change, until ctx is done. Changes are debounced: a burst of saves
+// is acted on once, after the files have stayed the same for an interval.
+// Each run is reported in one line, and its errors do not stop the watch. The
+// files are listed afresh after each run, so that files it writes, such as
+// the CLI stub, do not set off another.
+func watch(ctx context.Context, list func() (map[string]fileStamp, error), regenerate func() error, interval time.Duration, logger *logging.Logger) error {
+	run := func(what string) {
+		start := time.Now()
+		if err := regenerate(); err != nil {
+			logger.Errorf("%s: %v", what, err)
+			return
+		}
+		logger.Infof("%s: regenerated in %s", what, time.Since(start).Round(time.Millisecond))
+	}
+
+	if _, err := list(); err != nil {
+		return err
+	}
+	run("watching")
+	baseline, err := list()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var pending map[string]fileStamp // Files as last seen while changing, nil when settled
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		current, err := list()
+		if err != nil {
+			logger.Errorf("watch: %v", err)
+			continue
+		}
+		if pending == nil {
+			if len(changedFiles(baseline, current)) > 0 {
+				pending = current
+			}
+			continue
+		}
+		if len(changedFiles(pending, current)) > 0 {
+			// Still being written.
+			pending = current
+			continue
+		}
+		run(strings.Join(changedFiles(baseline, current), ", ") + " changed")
+		pending = nil
+		if baseline, err = list(); err != nil {
+			logger.Errorf("watch: %v", err)
+			baseline = current
+		}
+	}
+}
+
+// changedFiles returns, in order, the files added, removed, or modified
+// between before and after.
+func changedFiles(before, after map[string]fileStamp) []string {
+	var changed []string
+	for name, stamp := range after {
+		if old, ok := before[name]; !ok || !old.modTime.Equal(stamp.modTime) || old.size != stamp.size {
+			changed = append(changed, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mlwelles/modusGraphGen/logging"
+)
+
+func TestWatchedFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"film.go", "film_test.go", "film_gen.go", "film_bench_gen_test.go", "notes.txt",
+		"sub/actor.go", "sub/actor_gen.go", "testdata/fixture.go", ".hidden/x.go", "_old/y.go",
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("package x\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range []struct {
+		recursive bool
+		want      []string
+	}{
+		{false, []string{"film.go", "film_test.go"}},
+		{true, []string{"film.go", "film_test.go", filepath.Join("sub", "actor.go")}},
+	} {
+		files, err := watchedFiles(dir, tt.recursive, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for name := range files {
+			got = append(got, name)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("watchedFiles(recursive=%v) = %q, want %q", tt.recursive, got, tt.want)
+		}
+	}
+
+	schema := filepath.Join(dir, "notes.txt")
+	files, err := watchedFiles(dir, false, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := files[schema]; !ok || len(files) != 1 {
+		t.Errorf("watchedFiles with a schema file = %v, want only %s", files, schema)
+	}
+}
+
+// syncBuffer is a bytes.Buffer that watch's logger and the test can share.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "film.go")
+	if err := os.WriteFile(src, []byte("package x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each run rewrites a generated file, which must not set off another.
+	var runs atomic.Int32
+	regenerate := func() error {
+		runs.Add(1)
+		return os.WriteFile(filepath.Join(dir, "film_gen.go"), []byte(time.Now().String()), 0o644)
+	}
+	list := func() (map[string]fileStamp, error) {
+		return watchedFiles(dir, false, "")
+	}
+	var out syncBuffer
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- watch(ctx, list, regenerate, 20*time.Millisecond, logging.New(&out, logging.Normal))
+	}()
+
+	waitFor := func(n int32) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for runs.Load() < n {
+			if time.Now().After(deadline) {
+				t.Fatalf("%d runs after 5s, want %d\n%s", runs.Load(), n, out.String())
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	waitFor(1)
+
+	// A burst of saves is one change.
+	for i := range 3 {
+		stamp := time.Now().Add(time.Duration(i+1) * time.Second)
+		if err := os.Chtimes(src, stamp, stamp); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	waitFor(2)
+	time.Sleep(200 * time.Millisecond)
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watch = %v", err)
+	}
+	if got := runs.Load(); got != 2 {
+		t.Errorf("regenerated %d times, want 2\n%s", got, out.String())
+	}
+	for _, want := range []string{"watching: regenerated in", "film.go changed: regenerated in"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("log does not contain %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestChangedFiles(t *testing.T) {
+	now := time.Now()
+	before := map[string]fileStamp{
+		"a.go": {now, 1},
+		"b.go": {now, 1},
+		"c.go": {now, 1},
+	}
+	after := map[string]fileStamp{
+		"a.go": {now, 1},
+		"b.go": {now.Add(time.Second), 1},
+		"d.go": {now, 1},
+	}
+	if got, want := changedFiles(before, after), []string{"b.go", "c.go", "d.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("changedFiles = %q, want %q", got, want)
+	}
+}